@@ -30,10 +30,37 @@ func main() {
 	cfg.DefineFlags(myflags)
 
 	err := myflags.Parse(os.Args[1:])
+	if rest := myflags.Args(); len(rest) > 0 {
+		switch rest[0] {
+		case "run":
+			// gi run main.go [args]
+			cfg.RunArgs = rest[1:]
+			if len(cfg.RunArgs) == 0 {
+				log.Fatalf("usage: %s run <file.go>... [arguments]", ProgramName)
+			}
+		case "build":
+			// gi build -o app.lua ./pkg
+			buildFlags := flag.NewFlagSet("gi build", flag.ExitOnError)
+			buildFlags.StringVar(&cfg.BuildOut, "o", "", "the standalone .lua file to write. Default: named for the package.")
+			buildFlags.Parse(rest[1:])
+			cfg.BuildArgs = buildFlags.Args()
+			if len(cfg.BuildArgs) == 0 {
+				log.Fatalf("usage: %s build [-o app.lua] <file.go>... | <dir>", ProgramName)
+			}
+		}
+	}
 	err = cfg.ValidateConfig()
 	if err != nil {
 		log.Fatalf("%s command line flag error: '%s'", ProgramName, err)
 	}
+	if cfg.ClearCache {
+		err = compiler.ClearArchiveCache(cfg.CacheDir)
+		if err != nil {
+			log.Fatalf("%s -clear-cache error: '%s'", ProgramName, err)
+		}
+		fmt.Printf("removed the cache '%s'.\n", cfg.CacheDir)
+		os.Exit(0)
+	}
 	if !cfg.Quiet {
 		fmt.Printf(
 			`====================
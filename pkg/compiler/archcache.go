@@ -0,0 +1,147 @@
+package compiler
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/gob"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// The on-disk cache of compiled source imports.
+//
+// Compiling the source-imported packages, and all that
+// they import, is most of what a gi start spends its time
+// on. So BuildPackage keeps each Archive it compiles in
+// Options.CacheDir (gi -cache; ~/.cache/gijit or the like
+// by default), named by a hash of all that went into it:
+// the files of the package, the build tags, and this
+// gijit. An entry also notes the keys of the source
+// packages it imported, and is only used while they still
+// match, since its export data refers to their types.
+// gi -clear-cache removes the lot.
+
+// DefaultCacheDir is where gi caches compiled source
+// imports unless told otherwise; "" if there is no
+// user cache directory.
+func DefaultCacheDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "gijit")
+}
+
+// ClearArchiveCache removes the cache in dir.
+func ClearArchiveCache(dir string) error {
+	if dir == "" {
+		return nil
+	}
+	return os.RemoveAll(dir)
+}
+
+// archiveKey hashes what the Archive of pkg is made of.
+func (s *Session) archiveKey(pkg *PackageData) (string, error) {
+	h := sha256.New()
+	fmt.Fprintf(h, "gijit archive cache 1\n%s\n", Version())
+	if exe, err := os.Executable(); err == nil {
+		// unreleased builds have no version to tell them apart.
+		if fi, err := os.Stat(exe); err == nil {
+			fmt.Fprintf(h, "%s %d %d\n", exe, fi.Size(), fi.ModTime().UnixNano())
+		}
+	}
+	fmt.Fprintf(h, "%s\n%v %v\n%q\n", pkg.ImportPath, pkg.IsTest, s.options.Minify, s.options.BuildTags)
+	for _, name := range append(append([]string{}, pkg.GoFiles...), pkg.JSFiles...) {
+		by, err := ioutil.ReadFile(filepath.Join(pkg.Dir, name))
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(h, "%s %d\n", name, len(by))
+		h.Write(by)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func (s *Session) cacheFile(key string) string {
+	return filepath.Join(s.options.CacheDir, key[:2], key)
+}
+
+// cachedArchive gives the key of pkg, and its Archive
+// from the cache if there and still good; else nil. It
+// imports what the Archive imports, as compiling would.
+func (s *Session) cachedArchive(pkg *PackageData, importContext *ImportContext, depth int) (key string, archive *Archive) {
+	if s.options.CacheDir == "" {
+		return "", nil
+	}
+	key, err := s.archiveKey(pkg)
+	if err != nil {
+		return "", nil
+	}
+	fn := s.cacheFile(key)
+	by, err := ioutil.ReadFile(fn)
+	if err != nil {
+		return key, nil
+	}
+	r := bytes.NewReader(by)
+	var deps map[string]string
+	if gob.NewDecoder(r).Decode(&deps) != nil {
+		return key, nil
+	}
+	var paths []string
+	for path := range deps {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		a, err := importContext.Import(path, pkg.Dir, depth+1)
+		if err != nil || s.archiveKeys[path] != deps[path] {
+			return key, nil
+		}
+		importContext.Packages[a.ImportPath] = a.Pkg
+	}
+	archive, err = ReadArchive(fn, pkg.ImportPath, r, s.Types)
+	if err != nil {
+		return key, nil
+	}
+	archive.Pkg = s.Types[pkg.ImportPath]
+	s.cached++
+	return key, archive
+}
+
+// cacheArchive writes archive, compiled from the package
+// with key, to the cache.
+func (s *Session) cacheArchive(key string, archive *Archive) {
+	if s.options.CacheDir == "" || key == "" {
+		return
+	}
+	deps := make(map[string]string)
+	for _, path := range archive.Imports {
+		if path != "unsafe" {
+			deps[path] = s.archiveKeys[path]
+		}
+	}
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(deps)
+	if err == nil {
+		err = WriteArchive(archive, &buf)
+	}
+	fn := s.cacheFile(key)
+	if err == nil {
+		err = os.MkdirAll(filepath.Dir(fn), 0755)
+	}
+	if err == nil {
+		// others may be reading; rename is atomic.
+		tmp := fmt.Sprintf("%s.%d.tmp", fn, os.Getpid())
+		err = ioutil.WriteFile(tmp, buf.Bytes(), 0644)
+		if err == nil {
+			err = os.Rename(tmp, fn)
+		}
+	}
+	if err != nil {
+		s.ic.warnf("warning: could not cache the compiled '%s': %v\n", archive.ImportPath, err)
+	}
+}
@@ -0,0 +1,73 @@
+package compiler
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1760CompiledSourceImportsAreCached(t *testing.T) {
+
+	cv.Convey(`the Archives of source imports should be kept on disk, keyed by their files, and used by the next interpreter while what they import is unchanged`, t, func() {
+
+		top, err := ioutil.TempDir("", "gi-archcache")
+		panicOn(err)
+		defer os.RemoveAll(top)
+		write := func(name, content string) {
+			name = filepath.Join(top, name)
+			panicOn(os.MkdirAll(filepath.Dir(name), 0755))
+			panicOn(ioutil.WriteFile(name, []byte(content), 0644))
+		}
+		write("lib/go.mod", "module example.com/lib\n")
+		write("lib/b/b.go", "package b\n\nfunc Base() int { return 10 }\n")
+		write("lib/a/a.go", "package a\n\nimport \"example.com/lib/b\"\n\nfunc Twice() int { return 2 * b.Base() }\n")
+		cache := filepath.Join(top, "cache")
+
+		wd, err := os.Getwd()
+		panicOn(err)
+		defer os.Chdir(wd)
+		defer os.Setenv("GO111MODULE", os.Getenv("GO111MODULE"))
+		os.Setenv("GO111MODULE", "on")
+
+		// the prelude is found from the working directory.
+		var ins []*Interpreter
+		for i := 0; i < 3; i++ {
+			cfg := NewGIConfig()
+			cfg.Quiet = true
+			cfg.CacheDir = cache
+			in, err := NewInterpreter(cfg)
+			panicOn(err)
+			defer in.Close()
+			ins = append(ins, in)
+		}
+		panicOn(os.Chdir(filepath.Join(top, "lib")))
+
+		ctx := context.Background()
+		twice := func(in *Interpreter) string {
+			_, err := in.Eval(ctx, `import "example.com/lib/a"`)
+			panicOn(err)
+			res, err := in.Eval(ctx, `a.Twice()`)
+			panicOn(err)
+			return res.Text
+		}
+
+		cv.So(twice(ins[0]), cv.ShouldEqual, "20")
+		cv.So(ins[0].inc.Session.cached, cv.ShouldEqual, 0)
+
+		cv.So(twice(ins[1]), cv.ShouldEqual, "20")
+		cv.So(ins[1].inc.Session.cached, cv.ShouldEqual, 2)
+
+		// a's files are as they were, but b changed under it.
+		write("lib/b/b.go", "package b\n\nfunc Base() int { return 21 }\n")
+		cv.So(twice(ins[2]), cv.ShouldEqual, "42")
+		cv.So(ins[2].inc.Session.cached, cv.ShouldEqual, 0)
+
+		panicOn(ClearArchiveCache(cache))
+		_, err = os.Stat(cache)
+		cv.So(os.IsNotExist(err), cv.ShouldBeTrue)
+	})
+}
@@ -155,10 +155,12 @@ func (s *Session) importWithSrcDir(path string, srcDir string, mode build.Import
 
 	if _, err := os.Stat(pkg.PkgObj); os.IsNotExist(err) && strings.HasPrefix(pkg.PkgObj, build.Default.GOROOT) {
 		// fall back to GOPATH
-		firstGopathWorkspace := filepath.SplitList(build.Default.GOPATH)[0] // TODO: Need to check inside all GOPATH workspaces.
-		gopathPkgObj := filepath.Join(firstGopathWorkspace, pkg.PkgObj[len(build.Default.GOROOT):])
-		if _, err := os.Stat(gopathPkgObj); err == nil {
-			pkg.PkgObj = gopathPkgObj
+		for _, workspace := range filepath.SplitList(build.Default.GOPATH) {
+			gopathPkgObj := filepath.Join(workspace, pkg.PkgObj[len(build.Default.GOROOT):])
+			if _, err := os.Stat(gopathPkgObj); err == nil {
+				pkg.PkgObj = gopathPkgObj
+				break
+			}
 		}
 	}
 
@@ -451,6 +453,10 @@ type Options struct {
 	Color          bool
 	BuildTags      []string
 	WriteToFile    bool
+
+	// CacheDir holds compiled source imports; "" for
+	// no cache. See archcache.go.
+	CacheDir string
 }
 
 func (o *Options) PrintError(format string, a ...interface{}) {
@@ -480,6 +486,13 @@ type Session struct {
 	Archives map[string]*Archive
 	Types    map[string]*types.Package
 	Watcher  *fsnotify.Watcher
+
+	// the cache keys of the source packages built, by
+	// import path, and how many came from the cache;
+	// see archcache.go.
+	archiveKeys map[string]string
+	cached      int
+
 	//AllowImportCaching bool
 	ic *IncrState
 }
@@ -497,6 +510,8 @@ func NewSession(options *Options, ic *IncrState) *Session {
 		options:  options,
 		Archives: make(map[string]*Archive),
 		ic:       ic,
+
+		archiveKeys: make(map[string]string),
 	}
 	s.Types = make(map[string]*types.Package)
 	return s
@@ -700,12 +715,6 @@ func (s *Session) BuildPackage(pkg *PackageData, depth int) (*Archive, error) {
 		} // end if cachingAllowed
 	}
 
-	fileSet := token.NewFileSet()
-	files, err := parseAndAugment(pkg.Package, pkg.IsTest, fileSet)
-	if err != nil {
-		return nil, err
-	}
-
 	//localImportPathCache := make(map[string]*Archive)
 	importContext := &ImportContext{
 		Packages: s.Types,
@@ -752,10 +761,35 @@ func (s *Session) BuildPackage(pkg *PackageData, depth int) (*Archive, error) {
 			return archive, nil
 		},
 	}
-	archive, err := FullPackageCompile(pkg.ImportPath, files, fileSet, importContext, s.options.Minify, depth+1)
-	if err != nil {
-		return nil, err
+	if s.ic != nil {
+		importContext.Binary = s.ic.binaryPackage
+	}
+
+	// see archcache.go
+	key, archive := s.cachedArchive(pkg, importContext, depth)
+	if archive == nil {
+		fileSet := token.NewFileSet()
+		files, err := parseAndAugment(pkg.Package, pkg.IsTest, fileSet)
+		if err != nil {
+			return nil, err
+		}
+		archive, err = FullPackageCompile(pkg.ImportPath, files, fileSet, importContext, s.options.Minify, depth+1)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, jsFile := range pkg.JSFiles {
+			code, err := ioutil.ReadFile(filepath.Join(pkg.Dir, jsFile))
+			if err != nil {
+				return nil, err
+			}
+			archive.IncJSCode = append(archive.IncJSCode, []byte("\t(function() \n")...)
+			archive.IncJSCode = append(archive.IncJSCode, code...)
+			archive.IncJSCode = append(archive.IncJSCode, []byte("\n\t end)(_global);\n")...)
+		}
+		s.cacheArchive(key, archive)
 	}
+	s.archiveKeys[pkg.ImportPath] = key
 	pp("archive back from FullPackageCompile, pkg.ImportPath='%v'\n", pkg.ImportPath)
 	pp(" here is the global env:")
 	//S.showGlobal()
@@ -765,21 +799,14 @@ func (s *Session) BuildPackage(pkg *PackageData, depth int) (*Archive, error) {
 		pp("and here is stack: '%s'", stack())
 	}()
 
-	for _, jsFile := range pkg.JSFiles {
-		code, err := ioutil.ReadFile(filepath.Join(pkg.Dir, jsFile))
-		if err != nil {
-			return nil, err
-		}
-		archive.IncJSCode = append(archive.IncJSCode, []byte("\t(function() \n")...)
-		archive.IncJSCode = append(archive.IncJSCode, code...)
-		archive.IncJSCode = append(archive.IncJSCode, []byte("\n\t end)(_global);\n")...)
-	}
-
 	if s.options.Verbose {
 		fmt.Println(pkg.ImportPath)
 	}
 
 	s.Archives[pkg.ImportPath] = archive
+	if s.ic != nil && !pkg.IsCommand() {
+		s.ic.noteSourcePackage(pkg.ImportPath, pkg.Dir)
+	}
 
 	if pkg.PkgObj == "" || pkg.IsCommand() {
 		pp("\n\n returning early, pkg.PkgObj==\"\" or pkg.IsCommand()=%v, archive.Pkg='%#v'\n", pkg.IsCommand(), archive.Pkg)
@@ -924,10 +951,7 @@ func (s *Session) WaitForChange() {
 	for {
 		select {
 		case ev := <-s.Watcher.Events:
-			if ev.Op&(fsnotify.Create|fsnotify.Write|fsnotify.Remove|fsnotify.Rename) == 0 || filepath.Base(ev.Name)[0] == '.' {
-				continue
-			}
-			if !strings.HasSuffix(ev.Name, ".go") && !strings.HasSuffix(ev.Name, ".inc.gijit") {
+			if !isSourceChange(ev) {
 				continue
 			}
 			s.options.PrintSuccess("change detected: %s\n", ev.Name)
@@ -0,0 +1,189 @@
+package compiler
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/gijit/gi/pkg/compiler/shadow"
+	"github.com/gijit/gi/pkg/types"
+)
+
+// Standalone bundles.
+//
+// `gi build -o app.lua ./dir` writes a single Lua file
+// that stock luajit runs with no Go host: each prelude
+// file, wrapped in a function so that its locals stay
+// its own as under dofile, then the source packages
+// the program imports, then the package main, which
+// the prelude's __eval runs just as gi would. Binary
+// (shadow) packages are Go code called through Luar,
+// so a program that imports one, directly or not,
+// can't be bundled.
+
+// BuildBundle carries out gi build, returning the
+// exit status.
+func (r *Repl) BuildBundle() int {
+	files, extra, err := splitRunArgs(r.cfg.BuildArgs)
+	if err == nil && len(extra) > 0 {
+		err = fmt.Errorf("unexpected arguments after the package: %v", extra)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "gi build: %v\n", err)
+		return 1
+	}
+	out := r.cfg.BuildOut
+	if out == "" {
+		out = bundleName(r.cfg.BuildArgs[0])
+	}
+	lua, err := r.inc.CompileBundle(files, r.cfg.PreludePath)
+	if err == nil {
+		err = ioutil.WriteFile(out, lua, 0644)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "gi build: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// bundleName is the default output file for gi build
+// of arg: main.go gives main.lua, and ./cmd/app, app.lua.
+func bundleName(arg string) string {
+	if strings.HasSuffix(arg, ".go") {
+		return strings.TrimSuffix(filepath.Base(arg), ".go") + ".lua"
+	}
+	if abs, err := filepath.Abs(arg); err == nil {
+		arg = abs
+	}
+	return filepath.Base(arg) + ".lua"
+}
+
+// CompileBundle compiles the package main in files,
+// with the prelude from preludeDir (or the static copy
+// when preludeDir is ""), into one Lua file that runs
+// under stock luajit.
+func (ic *IncrState) CompileBundle(files []string, preludeDir string) ([]byte, error) {
+	prog, pkg, err := ic.compileProgram(files, true)
+	if err != nil {
+		return nil, err
+	}
+	if bin := binaryImports(pkg); len(bin) > 0 {
+		return nil, binaryImportError(strings.Join(bin, "', '"))
+	}
+	names, srcs, err := preludeSources(preludeDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	b.WriteString("-- Go compiled to Lua by `gi build`. Run it with luajit.\n")
+	b.WriteString("__preludePath = \"/\"\n")
+	for i, nm := range names {
+		// the do keeps Lua from reading the line before
+		// as a call of this function.
+		fmt.Fprintf(&b, "\n-- prelude/%s\ndo (function(...)\n%s\nend)() end\n", nm, srcs[i])
+	}
+	b.WriteString("\n__dfsGlobal:reset()\n")
+	b.WriteString("\n-- the imported packages are bundled below.\n__go_run_import = function(path) end\n")
+	fmt.Fprintf(&b, "\nlocal __bundled = %s\n", luaLongString(prog))
+	// __eval keeps quiet about code that doesn't load.
+	b.WriteString("assert(loadstring(__bundled))\n__eval(__bundled)\n")
+	b.WriteString("if __lastEvalErr ~= \"\" then os.exit(2) end\n")
+	return b.Bytes(), nil
+}
+
+// binaryImports lists the binary (shadow) packages
+// that pkg imports, directly or not.
+func binaryImports(pkg *types.Package) (bin []string) {
+	seen := make(map[*types.Package]bool)
+	var walk func(p *types.Package)
+	walk = func(p *types.Package) {
+		for _, imp := range p.Imports() {
+			if seen[imp] {
+				continue
+			}
+			seen[imp] = true
+			path := imp.Path()
+			if strings.HasPrefix(path, shadow.PathPrefix) || shadow.Lookup(path) != nil {
+				bin = append(bin, omitAnyShadowPathPrefix(path, false))
+				continue
+			}
+			walk(imp)
+		}
+	}
+	walk(pkg)
+	sort.Strings(bin)
+	return
+}
+
+func binaryImportError(path string) error {
+	return fmt.Errorf("cannot bundle binary (shadow) package '%s': it runs as Go in the gi host, which a standalone .lua file doesn't have", path)
+}
+
+// preludeSources reads the prelude .lua files, in
+// the order NewLuaVmWithPrelude runs them: from dir,
+// or from the static copy when dir is "".
+func preludeSources(dir string) (names []string, srcs [][]byte, err error) {
+	if dir != "" {
+		files, err := FetchPreludeFilenames(dir, true)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, fn := range files {
+			by, err := ioutil.ReadFile(fn)
+			if err != nil {
+				return nil, nil, err
+			}
+			names = append(names, filepath.Base(fn))
+			srcs = append(srcs, by)
+		}
+		return names, srcs, nil
+	}
+
+	root, err := preludeFiles.Open("")
+	if err != nil {
+		return nil, nil, err
+	}
+	fis, err := root.Readdir(-1)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, fi := range fis {
+		nm := fi.Name()
+		if !fi.IsDir() && fi.Size() > 0 && strings.HasSuffix(nm, ".lua") && !strings.HasSuffix(nm, "_test.lua") {
+			names = append(names, nm)
+		}
+	}
+	sort.Strings(names)
+	for _, nm := range names {
+		f, err := preludeFiles.Open(nm)
+		if err != nil {
+			return nil, nil, err
+		}
+		by, err := ioutil.ReadAll(f)
+		f.Close()
+		if err != nil {
+			return nil, nil, err
+		}
+		srcs = append(srcs, by)
+	}
+	return names, srcs, nil
+}
+
+// luaLongString quotes s as a Lua long string, with
+// enough '=' in its brackets that s can't close it.
+func luaLongString(s []byte) string {
+	eq := ""
+	for bytes.Contains(s, []byte("]"+eq+"]")) {
+		eq += "="
+	}
+	// a newline straight after the opening bracket is
+	// dropped; the one before the closing keeps a final
+	// ']' in s from closing it early.
+	return "[" + eq + "[\n" + string(s) + "\n]" + eq + "]"
+}
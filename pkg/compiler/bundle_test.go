@@ -0,0 +1,61 @@
+package compiler
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	cv "github.com/glycerine/goconvey/convey"
+	golua "github.com/glycerine/golua/lua"
+)
+
+func Test1670BundleRunsWithoutGoHost(t *testing.T) {
+
+	cv.Convey(`gi build should write one .lua file, prelude and source imports included, that a bare LuaJIT without Luar can run; binary imports are refused`, t, func() {
+
+		dir, err := ioutil.TempDir("", "gi-build")
+		panicOn(err)
+		defer os.RemoveAll(dir)
+
+		prog := filepath.Join(dir, "main.go")
+		panicOn(ioutil.WriteFile(prog, []byte(`package main
+
+import "github.com/gijit/gi/pkg/compiler/spkg_tst"
+
+var caught int
+
+func main() {
+	ch := make(chan int)
+	go func() { ch <- spkg_tst.Fish(21) }()
+	caught = <-ch
+}
+`), 0644))
+		cv.So(bundleName(prog), cv.ShouldEqual, "main.lua")
+		cv.So(bundleName(dir), cv.ShouldEqual, filepath.Base(dir)+".lua")
+
+		vm, err := NewLuaVmWithPrelude(nil)
+		panicOn(err)
+		defer vm.Close()
+		inc := NewIncrState(vm, nil)
+
+		cwd, err := os.Getwd()
+		panicOn(err)
+		bundle, err := inc.CompileBundle([]string{prog}, filepath.Join(cwd, "prelude"))
+		panicOn(err)
+
+		// stock LuaJIT: no Luar, no Go functions registered.
+		L := golua.NewState()
+		defer L.Close()
+		L.OpenLibs()
+		panicOn(L.DoString(string(bundle)))
+		panicOn(L.DoString(`__bundled = tostring(__packages["main"].caught)`))
+		L.GetGlobal("__bundled")
+		cv.So(L.ToString(-1), cv.ShouldEqual, "42LL")
+
+		bin := filepath.Join(dir, "bin.go")
+		panicOn(ioutil.WriteFile(bin, []byte("package main\n\nimport \"fmt\"\n\nfunc main() { fmt.Println() }\n"), 0644))
+		_, err = inc.CompileBundle([]string{bin}, "")
+		cv.So(err.Error(), cv.ShouldContainSubstring, "cannot bundle binary (shadow) package 'fmt'")
+	})
+}
@@ -1,10 +1,18 @@
 package compiler
 
 import (
+	"sync"
+
 	"github.com/glycerine/zygomys/zygo"
 )
 
+// zygo sets package globals as it starts an environment,
+// so two Interpreters must not start theirs at once.
+var zygoInitMut sync.Mutex
+
 func initZygo() *zygo.Zlisp {
+	zygoInitMut.Lock()
+	defer zygoInitMut.Unlock()
 	env := zygo.NewZlisp()
 	env.StandardSetup()
 	return env
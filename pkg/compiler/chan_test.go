@@ -405,3 +405,87 @@ a := <-ch
 		cv.So(true, cv.ShouldBeTrue)
 	})
 }
+
+func Test911BlockingRecvAtThePrompt(t *testing.T) {
+
+	cv.Convey("all-lua system: a receive at the prompt should wait for a sleeping goroutine; deadlock and Interrupt() should abandon the wait", t, func() {
+
+		vm, err := NewLuaVmWithPrelude(nil)
+		panicOn(err)
+		defer vm.Close()
+		inc := NewIncrState(vm, nil)
+
+		LuaRunAndReport(vm, `__tt = {Now = function() return 1 end}; __installTimers(__tt)`)
+		LuaRunAndReport(vm, string(inc.trMust([]byte(`var sleep func(int64)`))))
+		LuaRunAndReport(vm, `sleep = __tt.Sleep`)
+
+		code := `
+ch := make(chan int)
+go func() { sleep(20000000); ch <- 5 }()
+a := <-ch
+`
+		LuaRunAndReport(vm, string(inc.trMust([]byte(code))))
+		LuaMustInt64(vm, "a", 5)
+
+		// nobody will ever send: give up rather than hang.
+		code = `b := 1; b = <-ch`
+		LuaRunAndReport(vm, string(inc.trMust([]byte(code))))
+		LuaMustInt64(vm, "b", 1)
+
+		// a ticking goroutine keeps us from
+		// calling it a deadlock; Ctrl-C must.
+		code = `
+go func() { for { sleep(1000000) } }()
+c := 1
+select {
+case c = <-ch:
+}
+`
+		go func() {
+			time.Sleep(100 * time.Millisecond)
+			vm.Interrupt()
+		}()
+		t0 := time.Now()
+		LuaRunAndReport(vm, string(inc.trMust([]byte(code))))
+		cv.So(time.Since(t0), cv.ShouldBeGreaterThanOrEqualTo, 100*time.Millisecond)
+		LuaMustInt64(vm, "c", 1)
+
+		// and the prompt still works afterwards.
+		code = `d := make(chan int, 1); d <- 3; e := <-d`
+		LuaRunAndReport(vm, string(inc.trMust([]byte(code))))
+		LuaMustInt64(vm, "e", 3)
+	})
+}
+
+func Test912RecvFromClosedChannel(t *testing.T) {
+
+	cv.Convey("all-lua system: once a closed channel is drained, a receive should not block, and give the zero value and false", t, func() {
+
+		vm, err := NewLuaVmWithPrelude(nil)
+		panicOn(err)
+		defer vm.Close()
+		inc := NewIncrState(vm, nil)
+
+		code := `
+ch := make(chan int, 1)
+ch <- 7
+close(ch)
+y, ok1 := <-ch
+z, ok2 := <-ch
+`
+		LuaRunAndReport(vm, string(inc.trMust([]byte(code))))
+		LuaMustInt64(vm, "y", 7)
+		LuaMustBool(vm, "ok1", true)
+		LuaMustInt64(vm, "z", 0)
+		LuaMustBool(vm, "ok2", false)
+
+		code = `
+un := make(chan string)
+close(un)
+s, ok3 := <-un
+`
+		LuaRunAndReport(vm, string(inc.trMust([]byte(code))))
+		LuaMustString(vm, "s", "")
+		LuaMustBool(vm, "ok3", false)
+	})
+}
@@ -22,7 +22,6 @@ import (
 //var sizes32 = &types.StdSizes{WordSize: 4, MaxAlign: 8}
 var sizes64 = &types.StdSizes{WordSize: 8, MaxAlign: 8}
 var reservedKeywords = make(map[string]bool)
-var predeclared = make(map[string]bool)
 
 func init() {
 	// javascript reserved words
@@ -33,7 +32,6 @@ func init() {
 	// predeclared numeric types
 	for _, w := range []string{"int", "uint", "uint8", "uint16", "uint32", "uint64", "int8", "int16", "int32", "int64", "float32", "float64", "complex64", "complex128", "byte", "rune", "uintptr"} {
 		reservedKeywords[w] = true
-		predeclared[w] = true
 	}
 
 	// lua reserved words
@@ -60,7 +58,11 @@ func init() {
 type ErrorList []error
 
 func (err ErrorList) Error() string {
-	return err[0].Error()
+	msgs := make([]string, len(err))
+	for i, e := range err {
+		msgs[i] = e.Error()
+	}
+	return strings.Join(msgs, "\n")
 }
 
 type SavedArchive struct {
@@ -88,6 +90,45 @@ type Archive struct {
 	Check     *types.Checker
 
 	FuncSrcCache map[string]string
+
+	// DeclDeps maps each top-level func, method and
+	// type, by declID, to the declarations it uses
+	// (in the DceDeps form), so that a redefinition
+	// can find what must be recompiled.
+	DeclDeps map[string][]string
+}
+
+// archiveSnapshot is what translating one input can
+// change in an Archive, so that an input that fails
+// can be undone; see TrWithPrepend. IncrementallyCompile
+// copies FuncSrcCache and DeclDeps before writing them,
+// so the snapshot can keep the maps themselves.
+type archiveSnapshot struct {
+	a            *Archive
+	check        *types.Snapshot
+	funcSrcCache map[string]string
+	declDeps     map[string][]string
+}
+
+func (a *Archive) snapshot() *archiveSnapshot {
+	return &archiveSnapshot{
+		a:            a,
+		check:        a.Check.Snapshot(),
+		funcSrcCache: a.FuncSrcCache,
+		declDeps:     a.DeclDeps,
+	}
+}
+
+func (s *archiveSnapshot) restore() {
+	s.check.Restore()
+	s.a.FuncSrcCache = s.funcSrcCache
+	s.a.DeclDeps = s.declDeps
+	s.a.NewCodeText = nil
+}
+
+// release keeps what the input changed.
+func (s *archiveSnapshot) release() {
+	s.check.Release()
 }
 
 type Decl struct {
@@ -0,0 +1,262 @@
+package compiler
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gijit/gi/pkg/ast"
+	"github.com/gijit/gi/pkg/token"
+	"github.com/gijit/gi/pkg/types"
+)
+
+// replCommands are the colon commands that Read
+// understands, offered when a line starts with ':'.
+var replCommands = []string{
+	":?", ":ast", ":clear", ":describe ", ":do ", ":doc ", ":export ", ":g",
+	":gls", ":glst", ":go", ":h", ":help", ":load ", ":ls", ":lst",
+	":noast", ":prelude", ":q", ":r", ":reimport ", ":reload", ":reset",
+	":rm ", ":save ", ":set", ":source ", ":stacks", ":type ", ":v", ":vv",
+}
+
+// complete is the liner WordCompleter for the prompt.
+func (r *Repl) complete(line string, pos int) (head string, completions []string, tail string) {
+	b := bytePos(line, pos)
+	pre := line[:b]
+	if strings.HasPrefix(pre, ":") && !strings.ContainsAny(pre, " \t") {
+		for _, c := range replCommands {
+			if strings.HasPrefix(c, pre) {
+				completions = append(completions, c)
+			}
+		}
+		return "", completions, line[b:]
+	}
+	if r.cfg.RawLua {
+		return pre, nil, line[b:]
+	}
+	return r.inc.Complete(line, pos)
+}
+
+// bytePos gives the byte offset in line of pos,
+// which liner counts in runes.
+func bytePos(line string, pos int) int {
+	rs := []rune(line)
+	if pos > len(rs) {
+		pos = len(rs)
+	}
+	return len(string(rs[:pos]))
+}
+
+// Complete finds the ways to finish the identifier
+// before pos, in runes, in line, using what the type
+// checker knows so far: package-level names and
+// builtins for a plain identifier; members for
+// `pkg.Na`; and fields and methods for `x.Na`,
+// following a chain such as `x.f.g.Na` through the
+// static types.
+func (ic *IncrState) Complete(line string, pos int) (head string, completions []string, tail string) {
+	pos = bytePos(line, pos)
+	tail = line[pos:]
+	pre := line[:pos]
+
+	// the selector chain ending at the cursor.
+	beg := pos
+	for beg > 0 {
+		c, size := utf8.DecodeLastRuneInString(pre[:beg])
+		if c == '.' || c == '_' || unicode.IsLetter(c) || unicode.IsDigit(c) {
+			beg -= size
+			continue
+		}
+		break
+	}
+	chain := strings.Split(pre[beg:], ".")
+	partial := chain[len(chain)-1]
+	head = pre[:pos-len(partial)]
+	if len(chain) > 1 && chain[0] == "" {
+		// a leading '.', as in a float; nothing to offer.
+		return head, nil, tail
+	}
+
+	var scope *types.Scope
+	var mainPkg *types.Package
+	if ic.CurPkg.Arch != nil && ic.CurPkg.Arch.Pkg != nil {
+		mainPkg = ic.CurPkg.Arch.Pkg
+		scope = mainPkg.Scope()
+	}
+
+	var names []string
+	if len(chain) == 1 {
+		names = scopeNames(scope)
+	} else {
+		names = ic.memberNames(scope, mainPkg, chain[:len(chain)-1])
+	}
+	seen := make(map[string]bool)
+	for _, nm := range names {
+		if strings.HasPrefix(nm, partial) && !seen[nm] {
+			seen[nm] = true
+			completions = append(completions, nm)
+		}
+	}
+	sort.Strings(completions)
+	return head, completions, tail
+}
+
+// scopeNames lists what a plain identifier at the
+// prompt may name: user declarations, imported
+// packages, builtins, and keywords.
+func scopeNames(scope *types.Scope) (names []string) {
+	if scope != nil {
+		for _, nm := range scope.Names() {
+			if nm == "_" || strings.HasPrefix(nm, "__") {
+				continue
+			}
+			names = append(names, nm)
+		}
+	}
+	for _, nm := range types.Universe.Names() {
+		// our own hidden builtins, not Go's.
+		if nm == "assert" || nm == "trace" {
+			continue
+		}
+		names = append(names, nm)
+	}
+	for tok := token.BREAK; tok <= token.VAR; tok++ {
+		names = append(names, tok.String())
+	}
+	return
+}
+
+// memberNames lists what may follow the selector
+// chain sel, e.g. ["fmt"] or ["x", "f"].
+func (ic *IncrState) memberNames(scope *types.Scope, mainPkg *types.Package, sel []string) []string {
+	var obj types.Object
+	if scope != nil {
+		_, obj = scope.LookupParent(sel[0], token.NoPos)
+	}
+	if obj == nil {
+		// a package the importer knows, though
+		// not under that name in scope.
+		for _, pkg := range ic.CurPkg.importContext.Packages {
+			if pkg != nil && pkg.Name() == sel[0] {
+				obj = types.NewPkgName(token.NoPos, mainPkg, sel[0], pkg)
+				break
+			}
+		}
+	}
+	if obj == nil {
+		return nil
+	}
+
+	var typ types.Type
+	isType := false
+	switch x := obj.(type) {
+	case *types.PkgName:
+		pkg := x.Imported()
+		if len(sel) == 1 {
+			var names []string
+			for _, nm := range pkg.Scope().Names() {
+				if ast.IsExported(nm) {
+					names = append(names, nm)
+				}
+			}
+			return names
+		}
+		obj = pkg.Scope().Lookup(sel[1])
+		if obj == nil || !obj.Exported() {
+			return nil
+		}
+		sel = sel[1:]
+		_, isType = obj.(*types.TypeName)
+		typ = obj.Type()
+	case *types.TypeName:
+		isType = true
+		typ = x.Type()
+	case *types.Var, *types.Const:
+		typ = x.Type()
+	default:
+		return nil
+	}
+
+	for _, nm := range sel[1:] {
+		if isType {
+			// method expressions, T.Method, end the chain.
+			return nil
+		}
+		o, _, _ := types.LookupFieldOrMethod(typ, true, mainPkg, nm)
+		v, ok := o.(*types.Var)
+		if !ok {
+			return nil
+		}
+		typ = v.Type()
+	}
+	if typ == nil {
+		return nil
+	}
+	if isType {
+		return methodNames(typ, mainPkg)
+	}
+	// a variable is addressable, so it has the methods of *T too.
+	mtyp := typ
+	switch typ.Underlying().(type) {
+	case *types.Pointer, *types.Interface:
+	default:
+		mtyp = types.NewPointer(typ)
+	}
+	return append(fieldNames(typ, mainPkg), methodNames(mtyp, mainPkg)...)
+}
+
+// visible reports whether name, declared in pkg,
+// may be selected from package main.
+func visible(name string, pkg, mainPkg *types.Package) bool {
+	return ast.IsExported(name) || pkg == nil || pkg == mainPkg
+}
+
+func methodNames(typ types.Type, mainPkg *types.Package) (names []string) {
+	if _, isPtr := typ.Underlying().(*types.Pointer); isPtr {
+		if _, isNamed := typ.(*types.Named); isNamed {
+			// no methods on a named pointer type.
+			return nil
+		}
+	}
+	ms := types.NewMethodSet(typ)
+	for i := 0; i < ms.Len(); i++ {
+		f := ms.At(i).Obj()
+		if visible(f.Name(), f.Pkg(), mainPkg) {
+			names = append(names, f.Name())
+		}
+	}
+	return
+}
+
+// fieldNames lists the fields of typ's struct,
+// or of the struct it points to, with those
+// promoted from embedded structs.
+func fieldNames(typ types.Type, mainPkg *types.Package) (names []string) {
+	seen := make(map[types.Type]bool)
+	var walk func(t types.Type)
+	walk = func(t types.Type) {
+		if p, ok := t.Underlying().(*types.Pointer); ok {
+			t = p.Elem()
+		}
+		if seen[t] {
+			return
+		}
+		seen[t] = true
+		st, ok := t.Underlying().(*types.Struct)
+		if !ok {
+			return
+		}
+		for i := 0; i < st.NumFields(); i++ {
+			f := st.Field(i)
+			if visible(f.Name(), f.Pkg(), mainPkg) {
+				names = append(names, f.Name())
+			}
+			if f.Anonymous() {
+				walk(f.Type())
+			}
+		}
+	}
+	walk(typ)
+	return
+}
@@ -0,0 +1,85 @@
+package compiler
+
+import (
+	"testing"
+	"unicode/utf8"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1630TabCompletion(t *testing.T) {
+
+	cv.Convey(`tab completion should offer globals, builtins, package members, the fields and methods of a variable's type, and the colon commands`, t, func() {
+
+		code := `
+import "github.com/gijit/gi/pkg/compiler/spkg_tst"
+type Inner struct { Deep int }
+type Point struct { Inner; X, Y int; name string }
+func (p *Point) Move(dx int) { p.X += dx }
+pt := Point{}
+ptr := &pt
+`
+		vm, err := NewLuaVmWithPrelude(nil)
+		panicOn(err)
+		defer vm.Close()
+		inc := NewIncrState(vm, nil)
+		translation := inc.trMust([]byte(code))
+		LuaRunAndReport(vm, string(translation))
+
+		complete := func(line string) (string, []string) {
+			head, completions, tail := inc.Complete(line, utf8.RuneCountInString(line))
+			cv.So(tail, cv.ShouldEqual, "")
+			return head, completions
+		}
+
+		head, c := complete(`pt`)
+		cv.So(head, cv.ShouldEqual, "")
+		cv.So(c, cv.ShouldResemble, []string{"pt", "ptr"})
+
+		_, c = complete(`x := appe`)
+		cv.So(c, cv.ShouldResemble, []string{"append"})
+
+		_, c = complete(`fo`)
+		cv.So(c, cv.ShouldResemble, []string{"for"})
+		_, c = complete(`va`)
+		cv.So(c, cv.ShouldResemble, []string{"var"})
+
+		head, c = complete(`z := pt.`)
+		cv.So(head, cv.ShouldEqual, "z := pt.")
+		cv.So(c, cv.ShouldResemble, []string{"Deep", "Inner", "Move", "X", "Y", "name"})
+
+		_, c = complete(`ptr.M`)
+		cv.So(c, cv.ShouldResemble, []string{"Move"})
+
+		_, c = complete(`pt.Inner.D`)
+		cv.So(c, cv.ShouldResemble, []string{"Deep"})
+
+		head, c = complete(`caught := spkg_tst.F`)
+		cv.So(head, cv.ShouldEqual, "caught := spkg_tst.")
+		cv.So(c, cv.ShouldResemble, []string{"Fish"})
+
+		// the cursor need not be at the end.
+		head, c, tail := inc.Complete(`pt.X + pt.Y`, 4)
+		cv.So(head, cv.ShouldEqual, "pt.")
+		cv.So(c, cv.ShouldResemble, []string{"X"})
+		cv.So(tail, cv.ShouldEqual, " + pt.Y")
+
+		// liner counts the cursor in runes, not bytes.
+		LuaRunAndReport(vm, string(inc.trMust([]byte(`größe := 3`))))
+		head, c = complete(`x := "é" + grö`)
+		cv.So(head, cv.ShouldEqual, `x := "é" + `)
+		cv.So(c, cv.ShouldResemble, []string{"größe"})
+
+		head, c, tail = inc.Complete(`ñ := pt.X + pt.Y`, 9)
+		cv.So(head, cv.ShouldEqual, "ñ := pt.")
+		cv.So(c, cv.ShouldResemble, []string{"X"})
+		cv.So(tail, cv.ShouldEqual, " + pt.Y")
+
+		r := &Repl{cfg: NewGIConfig(), inc: inc}
+		_, c, _ = r.complete(`:sa`, 3)
+		cv.So(c, cv.ShouldResemble, []string{":save "})
+		head, c, _ = r.complete(`é := pt.M`, 9)
+		cv.So(head, cv.ShouldEqual, "é := pt.")
+		cv.So(c, cv.ShouldResemble, []string{"Move"})
+	})
+}
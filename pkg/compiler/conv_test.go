@@ -36,3 +36,63 @@ i := int(f)
 
 	})
 }
+
+func Test111NarrowIntegersWrapAround(t *testing.T) {
+
+	cv.Convey(`arithmetic, shifts and conversions on int8/16/32 and uint8/16/32 should overflow to their width, as compiled Go does`, t, func() {
+
+		code := `
+var b uint8 = 255
+b++
+var i8 int8 = 127
+i8 += 2
+var m8 int8 = -128
+n8 := -m8
+d8 := m8 / int8(-1)
+var u16 uint16 = 3
+u16 -= 4
+var i32 int32 = 1 << 30
+i32 *= 4
+var u32 uint32 = 0xffffffff
+u32 = u32 * u32
+c := ^uint8(5)
+x := 1000
+f := uint8(x)
+g := int8(x)
+var bb byte = 0x81
+r := bb << 1
+var s uint = 70
+k := 1 << s
+l := -8 >> s
+ru := rune(1<<31 - 1)
+ru++
+q := 17 / 5
+rem := -17 % 5
+`
+		vm, err := NewLuaVmWithPrelude(nil)
+		panicOn(err)
+		defer vm.Close()
+		inc := NewIncrState(vm, nil)
+
+		translation := inc.trMust([]byte(code))
+		LuaRunAndReport(vm, string(translation))
+
+		// the unsigned are uint64 cdata.
+		LuaMustEvalToInt64(vm, "int64(b)", 0)
+		LuaMustInt64(vm, "i8", -127)
+		LuaMustInt64(vm, "n8", -128)
+		LuaMustInt64(vm, "d8", -128)
+		LuaMustEvalToInt64(vm, "int64(u16)", 65535)
+		LuaMustInt64(vm, "i32", 0)
+		LuaMustEvalToInt64(vm, "int64(u32)", 1)
+		LuaMustEvalToInt64(vm, "int64(c)", 250)
+		LuaMustEvalToInt64(vm, "int64(f)", 232)
+		LuaMustInt64(vm, "g", -24)
+		LuaMustEvalToInt64(vm, "int64(r)", 2)
+		LuaMustInt64(vm, "k", 0)
+		LuaMustInt64(vm, "l", -1)
+		LuaMustInt64(vm, "ru", -2147483648)
+		LuaMustInt64(vm, "q", 3)
+		LuaMustInt64(vm, "rem", -2)
+	})
+}
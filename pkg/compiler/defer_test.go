@@ -340,3 +340,69 @@ println("test2() ran")
 		cv.So(true, cv.ShouldBeTrue)
 	})
 }
+
+func Test033nRuntimeFaultsPanicWithRuntimeErrors(t *testing.T) {
+
+	cv.Convey(`an index out of range, divide by zero, nil dereference, or close of a closed channel should panic with a runtime.Error carrying Go's message`, t, func() {
+
+		// The assertions are to an interface with the
+		// method set of runtime.Error, not to
+		// runtime.Error itself: the shadow runtime package
+		// gives Lua types to its structs only, so
+		// r.(runtime.Error) has no __type__.runtime.Error
+		// to check r against. Its Go side cannot match
+		// either, as r is a Lua value, not a Go one.
+		code := `
+// the method set of runtime.Error
+type rtError interface {
+	error
+	RuntimeError()
+}
+
+func fault(f func()) (msg string, isRt bool) {
+	defer func() {
+		r := recover()
+		e, ok := r.(error)
+		if ok {
+			msg = e.Error()
+		}
+		_, isRt = r.(rtError)
+	}()
+	f()
+	return
+}
+
+type pt struct{ x int }
+
+a := []int{1, 2, 3}
+i := 5
+zero := 0
+var p *pt
+ch := make(chan int)
+close(ch)
+
+idx, idxRt := fault(func() { a[i] = 1 })
+div, divRt := fault(func() { i = i / zero })
+nilp, nilpRt := fault(func() { i = p.x })
+clo, cloRt := fault(func() { close(ch) })
+`
+		vm, err := NewLuaVmWithPrelude(nil)
+		panicOn(err)
+		defer vm.Close()
+
+		inc := NewIncrState(vm, nil)
+		translation, err := inc.Tr([]byte(code))
+		panicOn(err)
+
+		pp("translation='%s'", string(translation))
+		LuaRunAndReport(vm, string(translation))
+		LuaMustString(vm, "idx", "runtime error: index out of range [5] with length 3")
+		LuaMustBool(vm, "idxRt", true)
+		LuaMustString(vm, "div", "runtime error: integer divide by zero")
+		LuaMustBool(vm, "divRt", true)
+		LuaMustString(vm, "nilp", "runtime error: invalid memory address or nil pointer dereference")
+		LuaMustBool(vm, "nilpRt", true)
+		LuaMustString(vm, "clo", "close of closed channel")
+		LuaMustBool(vm, "cloRt", true)
+	})
+}
@@ -0,0 +1,222 @@
+package compiler
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/gijit/gi/pkg/ast"
+	"github.com/gijit/gi/pkg/doc"
+	"github.com/gijit/gi/pkg/gostd/build"
+	"github.com/gijit/gi/pkg/parser"
+	"github.com/gijit/gi/pkg/printer"
+	"github.com/gijit/gi/pkg/token"
+	"github.com/gijit/gi/pkg/types"
+)
+
+// Support for :type, :describe and :doc, which
+// ask the type checker about what has been entered so far.
+
+// mainQualifier writes names from package main
+// unqualified, as they are typed at the prompt.
+func (ic *IncrState) mainQualifier() types.Qualifier {
+	var mainPkg *types.Package
+	if ic.CurPkg.Arch != nil {
+		mainPkg = ic.CurPkg.Arch.Pkg
+	}
+	return func(p *types.Package) string {
+		if p == mainPkg {
+			return ""
+		}
+		return p.Name()
+	}
+}
+
+func (ic *IncrState) eval(expr string) (types.TypeAndValue, error) {
+	var pkg *types.Package
+	if ic.CurPkg.Arch != nil {
+		pkg = ic.CurPkg.Arch.Pkg
+	}
+	tv, err := types.Eval(ic.CurPkg.fileSet, pkg, token.NoPos, expr)
+	if err == nil && (tv.Type == nil || tv.Type == types.Typ[types.Invalid]) {
+		// the checker does not always say why.
+		err = fmt.Errorf("could not type '%s'; is everything in it declared?", expr)
+	}
+	return tv, err
+}
+
+// TypeOf describes the static type of expr, as :type shows it.
+func (ic *IncrState) TypeOf(expr string) (string, error) {
+	expr = strings.TrimSpace(expr)
+	tv, err := ic.eval(expr)
+	if err != nil {
+		return "", err
+	}
+	qual := ic.mainQualifier()
+	switch {
+	case tv.IsType():
+		return fmt.Sprintf("%s is a type: %s", expr, types.TypeString(tv.Type.Underlying(), qual)), nil
+	case tv.IsBuiltin():
+		return fmt.Sprintf("%s is a builtin function", expr), nil
+	case tv.Value != nil:
+		return fmt.Sprintf("%s: %s = %s", expr, types.TypeString(tv.Type, qual), tv.Value), nil
+	}
+	return fmt.Sprintf("%s: %s", expr, types.TypeString(tv.Type, qual)), nil
+}
+
+// Describe shows the underlying type of the type
+// named by expr, and the method sets of T and *T.
+func (ic *IncrState) Describe(expr string) (string, error) {
+	expr = strings.TrimSpace(expr)
+	tv, err := ic.eval(expr)
+	if err != nil {
+		return "", err
+	}
+	if !tv.IsType() {
+		return "", fmt.Errorf("%s is not a type; try :type %s", expr, expr)
+	}
+	qual := ic.mainQualifier()
+	typ := tv.Type
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "type %s %s\n", types.TypeString(typ, qual), types.TypeString(typ.Underlying(), qual))
+
+	show := func(t types.Type) {
+		ms := types.NewMethodSet(t)
+		fmt.Fprintf(&b, "\nmethod set of %s:", types.TypeString(t, qual))
+		if ms.Len() == 0 {
+			fmt.Fprintf(&b, " (empty)\n")
+			return
+		}
+		fmt.Fprintf(&b, "\n")
+		for i := 0; i < ms.Len(); i++ {
+			fmt.Fprintf(&b, "    %s\n", types.ObjectString(ms.At(i).Obj(), qual))
+		}
+	}
+	show(typ)
+	if _, isIface := typ.Underlying().(*types.Interface); !isIface {
+		show(types.NewPointer(typ))
+	}
+	return b.String(), nil
+}
+
+// Doc shows the documentation for sym, one of pkg,
+// pkg.Name, or pkg.Type.Method, where pkg names a package
+// imported at the prompt. The comments are read from
+// the package source, found as `go build` would.
+func (ic *IncrState) Doc(sym string) (string, error) {
+	parts := strings.Split(strings.TrimSpace(sym), ".")
+	if len(parts) > 3 || parts[0] == "" {
+		return "", fmt.Errorf("usage: :doc pkg, :doc pkg.Name, or :doc pkg.Type.Method")
+	}
+	path := ""
+	if ic.CurPkg.Arch != nil {
+		if pn, ok := ic.CurPkg.Arch.Pkg.Scope().Lookup(parts[0]).(*types.PkgName); ok {
+			path = pn.Imported().Path()
+		}
+	}
+	if path == "" {
+		for p, pkg := range ic.CurPkg.importContext.Packages {
+			if pkg != nil && pkg.Name() == parts[0] {
+				path = p
+				break
+			}
+		}
+	}
+	if path == "" {
+		return "", fmt.Errorf("'%s' is not an imported package", parts[0])
+	}
+	// binary imports are type checked as their shadow
+	// package; the source is that of the real one.
+	path = omitAnyShadowPathPrefix(path, false)
+
+	cwd, _ := os.Getwd()
+	bp, err := build.Default.Import(path, cwd, 0)
+	if err != nil {
+		return "", fmt.Errorf("could not find the source of package '%s': '%v'", path, err)
+	}
+	fset := token.NewFileSet()
+	files := make(map[string]*ast.File)
+	for _, fn := range bp.GoFiles {
+		full := bp.Dir + string(os.PathSeparator) + fn
+		f, err := parser.ParseFile(fset, full, nil, parser.ParseComments)
+		if err != nil {
+			return "", err
+		}
+		files[full] = f
+	}
+	dpkg := doc.New(&ast.Package{Name: bp.Name, Files: files}, path, 0)
+
+	var b bytes.Buffer
+	if len(parts) == 1 {
+		fmt.Fprintf(&b, "package %s // import %q\n\n", dpkg.Name, path)
+		doc.ToText(&b, dpkg.Doc, "", "    ", 80)
+		return b.String(), nil
+	}
+
+	name := parts[1]
+	decl := func(node ast.Node, text string) {
+		printer.Fprint(&b, fset, node)
+		fmt.Fprintf(&b, "\n")
+		if text != "" {
+			doc.ToText(&b, text, "    ", "\t", 80)
+		}
+	}
+	funcDecl := func(f *doc.Func) {
+		f.Decl.Doc = nil
+		f.Decl.Body = nil
+		decl(f.Decl, f.Doc)
+	}
+	for _, t := range dpkg.Types {
+		if t.Name != name {
+			continue
+		}
+		if len(parts) == 3 {
+			for _, m := range t.Methods {
+				if m.Name == parts[2] {
+					funcDecl(m)
+					return b.String(), nil
+				}
+			}
+			return "", fmt.Errorf("no method %s on %s.%s", parts[2], parts[0], name)
+		}
+		t.Decl.Doc = nil
+		decl(t.Decl, t.Doc)
+		for _, f := range t.Funcs {
+			b.WriteString("\n")
+			funcDecl(f)
+		}
+		for _, m := range t.Methods {
+			b.WriteString("\n")
+			funcDecl(m)
+		}
+		return b.String(), nil
+	}
+	if len(parts) == 2 {
+		funcs := dpkg.Funcs
+		var values []*doc.Value
+		values = append(values, dpkg.Consts...)
+		values = append(values, dpkg.Vars...)
+		for _, t := range dpkg.Types {
+			funcs = append(funcs, t.Funcs...)
+			values = append(values, t.Consts...)
+			values = append(values, t.Vars...)
+		}
+		for _, f := range funcs {
+			if f.Name == name {
+				funcDecl(f)
+				return b.String(), nil
+			}
+		}
+		for _, v := range values {
+			for _, nm := range v.Names {
+				if nm == name {
+					v.Decl.Doc = nil
+					decl(v.Decl, v.Doc)
+					return b.String(), nil
+				}
+			}
+		}
+	}
+	return "", fmt.Errorf("no documentation for %s", strings.TrimSpace(sym))
+}
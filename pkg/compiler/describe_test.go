@@ -0,0 +1,91 @@
+package compiler
+
+import (
+	"testing"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1640TypeDescribeAndDoc(t *testing.T) {
+
+	cv.Convey(`:type should show the static type of an expression, :describe the method sets of a type, and :doc the documentation of an imported name`, t, func() {
+
+		code := `
+import "github.com/gijit/gi/pkg/compiler/spkg_tst4"
+type Point struct { X, Y int }
+func (p *Point) Move(dx int) { p.X += dx }
+pt := Point{}
+const big = 1 << 40
+r := spkg_tst4.NewR()
+`
+		vm, err := NewLuaVmWithPrelude(nil)
+		panicOn(err)
+		defer vm.Close()
+		inc := NewIncrState(vm, nil)
+		translation := inc.trMust([]byte(code))
+		LuaRunAndReport(vm, string(translation))
+
+		typeOf := func(expr string) string {
+			s, err := inc.TypeOf(expr)
+			panicOn(err)
+			return s
+		}
+		cv.So(typeOf(`pt`), cv.ShouldEqual, `pt: Point`)
+		cv.So(typeOf(`pt.X + 1`), cv.ShouldEqual, `pt.X + 1: int`)
+		cv.So(typeOf(`big`), cv.ShouldEqual, `big: untyped int = 1099511627776`)
+		cv.So(typeOf(`r`), cv.ShouldEqual, `r: *spkg_tst4.R`)
+		cv.So(typeOf(`r.Get1()`), cv.ShouldEqual, `r.Get1(): string`)
+		cv.So(typeOf(`Point`), cv.ShouldEqual, `Point is a type: struct{X int; Y int}`)
+		_, err = inc.TypeOf(`nosuch + 1`)
+		cv.So(err, cv.ShouldNotBeNil)
+
+		d, err := inc.Describe(`Point`)
+		panicOn(err)
+		cv.So(d, cv.ShouldContainSubstring, "method set of Point: (empty)")
+		cv.So(d, cv.ShouldContainSubstring, "method set of *Point:\n    func (*Point).Move(dx int)")
+		_, err = inc.Describe(`pt`)
+		cv.So(err, cv.ShouldNotBeNil)
+
+		doc, err := inc.Doc(`spkg_tst4.NewR`)
+		panicOn(err)
+		cv.So(doc, cv.ShouldContainSubstring, "func NewR() *R")
+		cv.So(doc, cv.ShouldContainSubstring, "NewR returns an R holding hello and world.")
+
+		doc, err = inc.Doc(`spkg_tst4.R.Get1`)
+		panicOn(err)
+		cv.So(doc, cv.ShouldContainSubstring, "Get1 returns the second slice as a string.")
+
+		doc, err = inc.Doc(`spkg_tst4.R`)
+		panicOn(err)
+		cv.So(doc, cv.ShouldContainSubstring, "R holds two byte slices.")
+		cv.So(doc, cv.ShouldContainSubstring, "func (r *R) Get1() string")
+
+		doc, err = inc.Doc(`spkg_tst4`)
+		panicOn(err)
+		cv.So(doc, cv.ShouldContainSubstring, "Package spkg_tst4 is a source package")
+
+		_, err = inc.Doc(`nosuch.Thing`)
+		cv.So(err, cv.ShouldNotBeNil)
+	})
+}
+
+func Test1641DocOfShadowedPackage(t *testing.T) {
+
+	cv.Convey(`:doc of a binary import, which is type checked as its shadow package, should show the documentation of the real package`, t, func() {
+
+		vm, err := NewLuaVmWithPrelude(nil)
+		panicOn(err)
+		defer vm.Close()
+		inc := NewIncrState(vm, nil)
+		LuaRunAndReport(vm, string(inc.trMust([]byte(`import "math"`))))
+
+		doc, err := inc.Doc(`math.Sqrt`)
+		panicOn(err)
+		cv.So(doc, cv.ShouldContainSubstring, "func Sqrt(x float64) float64")
+		cv.So(doc, cv.ShouldContainSubstring, "Sqrt returns the square root of x.")
+
+		doc, err = inc.Doc(`math`)
+		panicOn(err)
+		cv.So(doc, cv.ShouldContainSubstring, `package math // import "math"`)
+	})
+}
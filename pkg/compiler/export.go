@@ -0,0 +1,382 @@
+package compiler
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/gijit/gi/pkg/ast"
+	"github.com/gijit/gi/pkg/compiler/astutil"
+	"github.com/gijit/gi/pkg/format"
+	"github.com/gijit/gi/pkg/parser"
+	"github.com/gijit/gi/pkg/printer"
+	"github.com/gijit/gi/pkg/token"
+	"github.com/gijit/gi/pkg/types"
+)
+
+// Exporting the session as a Go program.
+//
+// Prototype at the prompt, then move to compiled Go:
+// :export writes a package main made of what
+// survives of the session. Types, funcs and methods
+// come as last entered (see redef.go), consts
+// likewise, and each user global becomes a package
+// level var of its current type. The statements
+// typed at the top level run in order in main(), with
+// their := turned into = on those vars. Expressions
+// that were only typed to see their value are left
+// out, unless they call something. A statement that
+// sets a global since redeclared as another type is
+// kept as a comment. The imports are the packages
+// that the program names.
+
+// exportStmt renders top-level statement node of an
+// input as it goes in main(), with the globals it
+// declares. It returns "" for statements that don't.
+func (pk *IncrPkg) exportStmt(node ast.Node) (stmt string, sets []*types.Var) {
+	info := pk.Arch.TypesInfo
+	defined := func(ids ...*ast.Ident) {
+		for _, id := range ids {
+			if v, ok := info.Defs[id].(*types.Var); ok {
+				sets = append(sets, v)
+			}
+		}
+	}
+	switch s := node.(type) {
+	case *ast.GenDecl:
+		if s.Tok != token.VAR {
+			return "", nil
+		}
+		var lines []string
+		for _, spec := range s.Specs {
+			vs := spec.(*ast.ValueSpec)
+			defined(vs.Names...)
+			if len(vs.Values) == 0 {
+				continue
+			}
+			as := &ast.AssignStmt{Tok: token.ASSIGN, Rhs: vs.Values}
+			for _, id := range vs.Names {
+				as.Lhs = append(as.Lhs, id)
+			}
+			lines = append(lines, pk.nodeSrc(as))
+		}
+		return strings.Join(lines, "\n"), sets
+
+	case *ast.AssignStmt:
+		if len(s.Lhs) == 1 && isIdent(s.Lhs[0], "__gijit_ans") {
+			return pk.exportAns(s), nil
+		}
+		if s.Tok == token.DEFINE {
+			for _, e := range s.Lhs {
+				if id, ok := e.(*ast.Ident); ok {
+					defined(id)
+				}
+			}
+			cp := *s
+			cp.Tok = token.ASSIGN
+			node = &cp
+		}
+	case ast.Stmt:
+	default:
+		return "", nil
+	}
+	if usesHelpers(node) {
+		return "", nil
+	}
+	return pk.nodeSrc(node), sets
+}
+
+// exportAns keeps, of an expression typed to see its
+// value, a call for its effects. Conversions and
+// most builtins can't stand as statements.
+func (pk *IncrPkg) exportAns(as *ast.AssignStmt) string {
+	lit, ok := as.Rhs[0].(*ast.CompositeLit)
+	if !ok || len(lit.Elts) != 1 {
+		return ""
+	}
+	call, ok := lit.Elts[0].(*ast.CallExpr)
+	if !ok || usesHelpers(call) {
+		return ""
+	}
+	tv := pk.Arch.TypesInfo.Types[call.Fun]
+	if tv.IsType() {
+		return ""
+	}
+	if tv.IsBuiltin() {
+		switch astutil.RemoveParens(call.Fun).(*ast.Ident).Name {
+		case "copy", "recover":
+		default:
+			return ""
+		}
+	}
+	return pk.nodeSrc(&ast.ExprStmt{X: call})
+}
+
+func (pk *IncrPkg) nodeSrc(node ast.Node) string {
+	var by bytes.Buffer
+	if printer.Fprint(&by, pk.fileSet, node) != nil {
+		return ""
+	}
+	return by.String()
+}
+
+func isIdent(e ast.Expr, name string) bool {
+	id, ok := e.(*ast.Ident)
+	return ok && id.Name == name
+}
+
+// usesHelpers tells if node names one of our own
+// __ prefixed helpers, as the lines we generate do.
+func usesHelpers(node ast.Node) (uses bool) {
+	ast.Inspect(node, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && strings.HasPrefix(id.Name, "__") {
+			uses = true
+		}
+		return !uses
+	})
+	return
+}
+
+// ExportProgram writes the session to dir/main.go as
+// a Go program, and a go.mod beside it if dir has none.
+func (ic *IncrState) ExportProgram(dir string) error {
+	src, err := ic.CurPkg.exportSource()
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(dir, 0755)
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(filepath.Join(dir, "main.go"), src, 0644)
+	if err != nil {
+		return err
+	}
+	gomod := filepath.Join(dir, "go.mod")
+	if _, err := os.Stat(gomod); !os.IsNotExist(err) {
+		return err
+	}
+	return ioutil.WriteFile(gomod, []byte("module "+modulePathFor(dir)+"\n"), 0644)
+}
+
+var modulePathChars = regexp.MustCompile(`^[A-Za-z0-9_.~-]+$`)
+
+// modulePathFor names the module of a program
+// exported to dir after dir.
+func modulePathFor(dir string) string {
+	abs, err := filepath.Abs(dir)
+	if err == nil {
+		base := filepath.Base(abs)
+		if modulePathChars.MatchString(base) && base != "." {
+			return base
+		}
+	}
+	return "giexport"
+}
+
+// exportSource gives the gofmt'ed source of the
+// program that :export writes.
+func (pk *IncrPkg) exportSource() ([]byte, error) {
+	if pk.Arch == nil {
+		return format.Source([]byte("package main\n\nfunc main() {}\n"))
+	}
+	scope := pk.Arch.Pkg.Scope()
+	mainPkg := pk.Arch.Pkg
+	qual := func(p *types.Package) string {
+		if p == mainPkg {
+			return ""
+		}
+		return p.Name()
+	}
+	var body bytes.Buffer
+
+	// consts, as last declared.
+	lastConst := make(map[string]int)
+	for i, e := range pk.declLog {
+		for _, nm := range e.consts {
+			lastConst[nm] = i
+		}
+	}
+	for i, e := range pk.declLog {
+		for _, nm := range e.consts {
+			if _, isConst := scope.Lookup(nm).(*types.Const); isConst && lastConst[nm] == i {
+				body.WriteString(e.src + "\n\n")
+				break
+			}
+		}
+	}
+
+	// types, funcs and methods.
+	prefix := mainPkg.Path() + "."
+	for _, d := range pk.topDecls {
+		if strings.HasPrefix(d.id, prefix) {
+			switch scope.Lookup(strings.TrimPrefix(d.id, prefix)).(type) {
+			case *types.Func, *types.TypeName:
+			default:
+				// since redeclared as a var or const.
+				continue
+			}
+		}
+		body.WriteString(d.src + "\n\n")
+	}
+
+	// the user globals, in order of appearance.
+	var vars bytes.Buffer
+	seen := make(map[string]bool)
+	for _, e := range pk.declLog {
+		for _, nm := range e.vars {
+			if seen[nm] {
+				continue
+			}
+			seen[nm] = true
+			if v, ok := scope.Lookup(nm).(*types.Var); ok {
+				fmt.Fprintf(&vars, "\t%s %s\n", nm, types.TypeString(v.Type(), qual))
+			}
+		}
+	}
+	if vars.Len() > 0 {
+		body.WriteString("var (\n" + vars.String() + ")\n\n")
+	}
+
+	// the statements. main() is the user's own, if
+	// they wrote one.
+	fn := "main"
+	if _, ok := scope.Lookup("main").(*types.Func); ok {
+		fn = "init"
+	}
+	body.WriteString("func " + fn + "() {\n")
+	for _, e := range pk.declLog {
+		if e.stmt == "" {
+			continue
+		}
+		if nm := staleSet(scope, e.sets); nm != "" {
+			fmt.Fprintf(&body, "// '%s' was since redeclared as another type:\n", nm)
+			for _, line := range strings.Split(e.stmt, "\n") {
+				body.WriteString("// " + line + "\n")
+			}
+			continue
+		}
+		body.WriteString(e.stmt + "\n")
+	}
+	body.WriteString("}\n")
+
+	imports, err := pk.exportImports(body.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	var prog bytes.Buffer
+	prog.WriteString("package main\n\n")
+	if len(imports) > 0 {
+		prog.WriteString("import (\n" + strings.Join(imports, "\n") + "\n)\n\n")
+	}
+	prog.Write(body.Bytes())
+	return format.Source(prog.Bytes())
+}
+
+// staleSet names the first of sets that scope now
+// declares as something other than a var of its type.
+func staleSet(scope *types.Scope, sets []*types.Var) string {
+	for _, v := range sets {
+		cur, ok := scope.Lookup(v.Name()).(*types.Var)
+		if !ok || !types.Identical(cur.Type(), v.Type()) {
+			return v.Name()
+		}
+	}
+	return ""
+}
+
+// exportImports gives the import specs body needs:
+// for each package name it selects from and doesn't
+// declare, the package imported under that name at
+// the prompt or, failing that, a package of that name
+// known to the import context. Blank and dot imports
+// entered at the prompt are kept as they were.
+func (pk *IncrPkg) exportImports(body []byte) ([]string, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", append([]byte("package main\n\n"), body...), 0)
+	if err != nil {
+		return nil, fmt.Errorf("export made a program that does not parse: '%v'", err)
+	}
+	unresolved := make(map[*ast.Ident]bool)
+	for _, id := range file.Unresolved {
+		unresolved[id] = true
+	}
+	named := make(map[string]bool)
+	ast.Inspect(file, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok && unresolved[id] {
+				named[id.Name] = true
+			}
+		}
+		return true
+	})
+
+	// what was imported at the prompt, by local name.
+	byName := make(map[string]string)
+	var specs []string
+	for _, e := range pk.declLog {
+		for _, is := range e.imports {
+			path := strings.Trim(is.Path.Value, "`\"")
+			switch {
+			case is.Name == nil:
+				byName[pk.importedName(path)] = path
+			case is.Name.Name == "_" || is.Name.Name == ".":
+				specs = append(specs, is.Name.Name+" "+is.Path.Value)
+			default:
+				byName[is.Name.Name] = path
+			}
+		}
+	}
+
+	for nm := range named {
+		path, ok := byName[nm]
+		if !ok {
+			path = pk.knownPackage(nm)
+			if path == "" {
+				continue
+			}
+		}
+		if pk.importedName(path) == nm {
+			specs = append(specs, fmt.Sprintf("%q", path))
+		} else {
+			specs = append(specs, fmt.Sprintf("%s %q", nm, path))
+		}
+	}
+	sort.Strings(specs)
+	return specs, nil
+}
+
+// importedName gives the name the package at path
+// declares for itself.
+func (pk *IncrPkg) importedName(path string) string {
+	if p, ok := pk.importContext.Packages[path]; ok && p != nil {
+		return p.Name()
+	}
+	return filepath.Base(path)
+}
+
+// knownPackage finds the path of a package called nm,
+// preferring those the session imports.
+func (pk *IncrPkg) knownPackage(nm string) string {
+	for _, p := range pk.Arch.Pkg.Imports() {
+		if p.Name() == nm {
+			return p.Path()
+		}
+	}
+	var paths []string
+	for path, p := range pk.importContext.Packages {
+		if p != nil && p.Name() == nm && !strings.Contains(path, "/internal/") {
+			paths = append(paths, path)
+		}
+	}
+	if len(paths) == 0 {
+		return ""
+	}
+	sort.Strings(paths)
+	return paths[0]
+}
@@ -0,0 +1,103 @@
+package compiler
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1740ExportSessionAsProgram(t *testing.T) {
+
+	cv.Convey(`:export should write the surviving declarations as a package main, with the top-level statements run in order in main(), that go build takes unchanged`, t, func() {
+
+		in, err := NewInterpreter(nil)
+		panicOn(err)
+		defer in.Close()
+		ctx := context.Background()
+
+		for _, src := range []string{
+			`const K = 10`,
+			`type Pt struct{ X, Y int }`,
+			`func (p Pt) Sum() int { return 0 }`,
+			`func (p Pt) Sum() int { return p.X + p.Y }`,
+			`total := 0`,
+			`func add(n int) { total += n }`,
+			`add(K)`,
+			`p := Pt{1, 2}`,
+			`total += p.Sum()`,
+			`p.Sum()`,
+			`total`,
+			`s := "str"`,
+			`s := 3`,
+			`for i := 0; i < 3; i++ { total += i }`,
+			`var w = total * 2`,
+			`println(total, w, s)`,
+			`int64(w)`,
+		} {
+			_, err = in.Eval(ctx, src)
+			panicOn(err)
+		}
+
+		dir, err := ioutil.TempDir("", "gi-export")
+		panicOn(err)
+		defer os.RemoveAll(dir)
+		panicOn(in.inc.ExportProgram(dir))
+
+		by, err := ioutil.ReadFile(filepath.Join(dir, "main.go"))
+		panicOn(err)
+		cv.So(string(by), cv.ShouldEqual, `package main
+
+const K = 10
+
+type Pt struct{ X, Y int }
+
+func (p Pt) Sum() int { return p.X + p.Y }
+
+func add(n int) { total += n }
+
+var (
+	total int
+	p     Pt
+	s     int
+	w     int
+)
+
+func main() {
+	total = 0
+	add(K)
+	p = Pt{1, 2}
+	total += p.Sum()
+	p.Sum()
+	// 's' was since redeclared as another type:
+	// s = "str"
+	s = 3
+	for i := 0; i < 3; i++ {
+		total += i
+	}
+	w = total * 2
+	println(total, w, s)
+}
+`)
+		_, err = os.Stat(filepath.Join(dir, "go.mod"))
+		cv.So(err, cv.ShouldBeNil)
+
+		gobin, err := exec.LookPath("go")
+		if err != nil {
+			return
+		}
+		build := exec.Command(gobin, "build", "-o", "prog")
+		build.Dir = dir
+		out, err := build.CombinedOutput()
+		cv.So(string(out), cv.ShouldEqual, "")
+		cv.So(err, cv.ShouldBeNil)
+		out, err = exec.Command(filepath.Join(dir, "prog")).CombinedOutput()
+		panicOn(err)
+		cv.So(strings.TrimSpace(string(out)), cv.ShouldEqual, "16 32 3")
+	})
+}
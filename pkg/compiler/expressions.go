@@ -9,7 +9,6 @@ import (
 	"github.com/gijit/gi/pkg/token"
 	"github.com/gijit/gi/pkg/types"
 	"runtime/debug"
-	"sort"
 	"strconv"
 	"strings"
 
@@ -21,7 +20,6 @@ import (
 
 var _ = debug.Stack
 
-var debugNilCount int
 var pp = verb.PP
 var vv = verb.VV
 var p1 = verb.P
@@ -86,8 +84,9 @@ func (c *funcContext) translateExpr(expr ast.Expr, desiredType types.Type) (xprn
 			return c.formatExpr("%s", strconv.FormatBool(constant.BoolVal(value)))
 		case isInteger(basic):
 
-			// jea: for now, all int types are 64-bit
-			// jea: TODO: come back and handle int32, uint32, int16, uint16, int8, uint8
+			// jea: all int types are kept in 64 bits; int32, uint32,
+			// int16, uint16, int8 and uint8 are wrapped back to
+			// their width by fixNumber after arithmetic.
 			//if is64Bit(basic) {
 			k := basic.Kind()
 			if desiredType != nil {
@@ -163,12 +162,6 @@ func (c *funcContext) translateExpr(expr ast.Expr, desiredType types.Type) (xprn
 		}
 	}
 	pp("obj is '%#v'", obj)
-	if obj == nil {
-		debugNilCount++
-		if debugNilCount > 2 {
-			//panic("where obj nil?")
-		}
-	}
 
 	// jea important location! here is
 	// where the magic happens that
@@ -291,7 +284,7 @@ func (c *funcContext) translateExpr(expr ast.Expr, desiredType types.Type) (xprn
 					// special case the shadow structs
 					tn := t.Field(i).Type().String()
 					//vv("tn = '%s'", tn)
-					isShad, shortPkgAndTyp := isShadowStruct(tn)
+					isShad, shortPkgAndTyp := c.p.isShadowStruct(tn)
 					if isShad {
 						elements[i] = fmt.Sprintf("__type__.%s()", shortPkgAndTyp)
 					} else {
@@ -330,15 +323,6 @@ func (c *funcContext) translateExpr(expr ast.Expr, desiredType types.Type) (xprn
 	case *ast.FuncLit:
 		pp("expressions.go:213 we have a *ast.FuncLit: '%#v'", e)
 		_, fun, _ := translateFunction(e.Type, nil, e.Body, c, exprType.(*types.Signature), c.p.FuncLitInfos[e], "", false)
-		if len(c.p.escapingVars) != 0 {
-			names := make([]string, 0, len(c.p.escapingVars))
-			for obj := range c.p.escapingVars {
-				names = append(names, c.p.objectNames[obj])
-			}
-			sort.Strings(names)
-			list := strings.Join(names, ", ")
-			return c.formatExpr("(function(%s) { return %s; })(%s)", list, fun, list)
-		}
 		return c.formatExpr("(%s)", fun)
 
 	case *ast.UnaryExpr:
@@ -375,10 +359,6 @@ func (c *funcContext) translateExpr(expr ast.Expr, desiredType types.Type) (xprn
 				return c.formatExpr("__newDataPointer(%e, %s)", x, c.typeName(c.p.TypeOf(e), nil))
 			case *ast.Ident:
 				obj := c.p.Uses[x].(*types.Var)
-				if c.p.escapingVars[obj] {
-					return c.formatExpr("(%2s(function() return this.__target[0]; end, function(__v) this.__target[0] = __v; end, %1s))", c.p.objectNames[obj], c.typeName(exprType, nil))
-					// return c.formatExpr("(%1s.__ptr || (%1s.__ptr = new %2s(function() { return this.__target[0]; }, function(__v) { this.__target[0] = __v; }, %1s)))", c.p.objectNames[obj], c.typeName(exprType))
-				}
 
 				// basic taking address of value to get pointer. See ptr_test.go, test 099.
 				//return c.formatExpr(`__ptrType(function() return %1s; end, function(v) %2s; end, "%s")`, c.objectName(obj), c.translateAssign(x, c.newIdent("v", exprType), false), starToAmp(exprType.String()))
@@ -452,17 +432,16 @@ func (c *funcContext) translateExpr(expr ast.Expr, desiredType types.Type) (xprn
 				return c.formatExpr("-(%1e)", e.X)
 				//return c.formatExpr("-(%1r+%1i)", e.X)
 				//return c.formatExpr("%1s(-%2r, -%2i)", c.typeName(0, t), e.X)
-			case isUnsigned(basic):
-				return c.fixNumber(c.formatExpr("-%e", e.X), basic)
 			default:
-				return c.formatExpr("-%e", e.X)
+				// -x overflows for the most negative x.
+				return c.fixNumber(c.formatExpr("-%e", e.X), basic)
 			}
 		case token.XOR:
 			if is64Bit(basic) {
-				return c.formatExpr("__bit.bxor(%e)", e.X)
+				return c.formatExpr("__bit.bnot(%e)", e.X)
 				//return c.formatExpr("%1s(~%2h, ~%2l >>> 0)", c.typeName(0, t), e.X)
 			}
-			return c.fixNumber(c.formatExpr("~%e", e.X), basic)
+			return c.fixNumber(c.formatExpr("__bit.bnot(%e)", e.X), basic)
 		case token.NOT:
 			return c.formatExpr(" not %e", e.X)
 		default:
@@ -527,8 +506,9 @@ func (c *funcContext) translateExpr(expr ast.Expr, desiredType types.Type) (xprn
 					//	shift = ">>>"
 					//}
 
-					// jea:
-					return c.formatExpr(`__integerByZeroCheck(%1e / %2e)`, e.X, e.Y)
+					// jea: fixNumber for the overflow of
+					// the most negative value divided by -1.
+					return c.fixNumber(c.formatExpr(`__integerQuo(%1e, %2e)`, e.X, e.Y), basic)
 					// return c.formatExpr(`(%1s = %2e / %3e, (%1s == %1s && %1s ~= 1/0 && %1s ~= -1/0) ? %1s %4s 0 : error("integer divide by zero"))`, c.newVariable("_q"), e.X, e.Y, shift)
 				}
 				if basic.Kind() == types.Float32 {
@@ -536,9 +516,10 @@ func (c *funcContext) translateExpr(expr ast.Expr, desiredType types.Type) (xprn
 				}
 				return c.formatExpr("((%e) / (%e))", e.X, e.Y)
 			case token.REM:
-				return c.formatExpr(`__integerByZeroCheck(%1e %% %2e)`, e.X, e.Y)
+				return c.formatExpr(`__integerRem(%1e, %2e)`, e.X, e.Y)
 			case token.SHL, token.SHR:
 				op := e.Op.String()
+				arith := e.Op == token.SHR && !isUnsigned(basic)
 				if e.Op == token.SHR {
 					if isUnsigned(basic) {
 						op = "__bit.rshift"
@@ -551,10 +532,26 @@ func (c *funcContext) translateExpr(expr ast.Expr, desiredType types.Type) (xprn
 				if v := c.p.Types[e.Y].Value; v != nil {
 					i, _ := constant.Uint64Val(constant.ToInt(v))
 					if i >= 64 {
+						// Go shifts everything out; the bit
+						// library would take the count mod 64.
+						if arith {
+							return c.formatExpr("__bit.arshift(%e, 63)", e.X)
+						}
+						if isUnsigned(basic) {
+							return c.formatExpr("0ULL")
+						}
 						return c.formatExpr("0LL")
 					}
 					return c.fixNumber(c.formatExpr("%s(%e, %s)", op, e.X, strconv.FormatUint(i, 10)), basic)
 				}
+				// likewise for a count known only at run time.
+				op = "__lshift"
+				if e.Op == token.SHR {
+					op = "__rshift"
+					if arith {
+						op = "__arshift"
+					}
+				}
 				return c.fixNumber(c.formatExpr("%s(%e, %e)", op, e.X, e.Y), basic)
 
 				//if e.Op == token.SHR && !isUnsigned(basic) {
@@ -1358,20 +1355,15 @@ func (c *funcContext) translateConversion(expr ast.Expr, desiredType types.Type)
 					return c.formatExpr("%s(%e)", c.typeName(desiredType, nil), expr)
 				}
 				return c.formatExpr("%s(%e)", c.typeName(desiredType, nil), expr)
-			case is64Bit(basicExprType):
-				if !isUnsigned(t) && !isUnsigned(basicExprType) {
-					return c.formatParenExpr("%e", expr)
-				}
-				return c.formatExpr("%s", c.translateExpr(expr, nil), t)
 			case isFloat(basicExprType):
 				// jea
 				//return c.formatParenExpr("%e >> 0", expr)
-				return c.formatParenExpr("int(%e)", expr)
+				return c.convertInteger(c.formatParenExpr("int(%e)", expr), types.Typ[types.Int], t)
 			case types.Identical(exprType, types.Typ[types.UnsafePointer]):
 				return c.translateExpr(expr, nil)
 			default:
 				// 201 not here
-				return c.fixNumber(c.translateExpr(expr, nil), t)
+				return c.convertInteger(c.translateExpr(expr, nil), basicExprType, t)
 			}
 		case isFloat(t):
 			if t.Kind() == types.Float32 && exprType.Underlying().(*types.Basic).Kind() == types.Float64 {
@@ -1491,7 +1483,7 @@ func (c *funcContext) translateImplicitConversionWithCloning(expr ast.Expr, desi
 			typName, isAnon, anonType, createdNm, isShadow := c.typeNameWithAnonInfo(desiredType, nil)
 			pp("debug __clone arg: c.typeName(desiredType, nil)='%s'; createdNm='%s'; isAnon='%v', anonType='%#v', isShadow=%v", typName, createdNm, isAnon, anonType, isShadow)
 			if isShadow {
-				_, shortTyp := isShadowStruct(desiredType.String())
+				_, shortTyp := c.p.isShadowStruct(desiredType.String())
 				_ = shortTyp
 				return c.formatExpr(`%e --[[ isShadow true, expressions.go:1494 --]]`, expr)
 				//return c.formatExpr(`%e = __type__.%s() --[[ isShadow true, expressions.go:1494 --]]`, expr, shortTyp)
@@ -1643,6 +1635,11 @@ func (c *funcContext) fixNumber(value *expression, basic *types.Basic) (xprn *ex
 	defer func() {
 		pp("returning from fixNumber with xprn='%s'", x2s(xprn))
 	}()
+	if wrap, isNarrow := narrowIntWrap[basic.Kind()]; isNarrow {
+		// all integers are kept in 64 bits, so
+		// wrap to the Go width, as compiled Go would.
+		return c.formatExpr("%s(%s)", wrap, value)
+	}
 	switch basic.Kind() {
 	case types.Float32, types.Float64:
 		return value
@@ -1651,6 +1648,34 @@ func (c *funcContext) fixNumber(value *expression, basic *types.Basic) (xprn *ex
 	}
 }
 
+// narrowIntWrap names the prelude/int64.lua functions
+// that wrap to the integer types narrower than 64 bits.
+// The kind, not the name, since byte and rune are aliases.
+var narrowIntWrap = map[types.BasicKind]string{
+	types.Int8:   "__wrapInt8",
+	types.Int16:  "__wrapInt16",
+	types.Int32:  "__wrapInt32",
+	types.Uint8:  "__wrapUint8",
+	types.Uint16: "__wrapUint16",
+	types.Uint32: "__wrapUint32",
+}
+
+// convertInteger converts value, an integer of type from,
+// to the integer type to.
+func (c *funcContext) convertInteger(value *expression, from, to *types.Basic) *expression {
+	if _, isNarrow := narrowIntWrap[to.Kind()]; isNarrow {
+		return c.fixNumber(value, to)
+	}
+	if isUnsigned(from) == isUnsigned(to) {
+		return c.formatParenExpr("%s", value)
+	}
+	// int and uint are 64 bits, like int64 and uint64.
+	if isUnsigned(to) {
+		return c.formatExpr("uint64(%s)", value)
+	}
+	return c.formatExpr("int64(%s)", value)
+}
+
 func (c *funcContext) asFloat64(value *expression, basic *types.Basic) (xprn *expression) {
 	pp("top of asFloat64 with value='%s'", x2s(value))
 	defer func() {
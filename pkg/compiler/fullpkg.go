@@ -64,7 +64,6 @@ func FullPackageCompile(importPath string, files []*ast.File, fileSet *token.Fil
 		},
 		Sizes: sizes64,
 		Error: func(err error) {
-			panic(fmt.Sprintf("where error? err = '%v'", err))
 			if previousErr != nil && previousErr.Error() == err.Error() {
 				return
 			}
@@ -147,12 +146,13 @@ func FullPackageCompile(importPath string, files []*ast.File, fileSet *token.Fil
 			pkgVars:      make(map[string]string),
 			objectNames:  make(map[types.Object]string),
 			varPtrNames:  make(map[*types.Var]string),
-			escapingVars: make(map[*types.Var]bool),
 			indentation:  1,
 			dependencies: make(map[types.Object]bool),
 			minify:       minify,
 			fileSet:      fileSet,
 			files:        files,
+
+			binaryPackage: importContext.Binary,
 		},
 		allVars:     make(map[string]int),
 		flowDatas:   map[*types.Label]*flowData{nil: {}},
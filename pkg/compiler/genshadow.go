@@ -2,11 +2,14 @@ package compiler
 
 import (
 	"fmt"
+	"io"
+	"math"
 	"os"
 
 	"path/filepath"
 
 	"github.com/gijit/gi/pkg/ast"
+	"github.com/gijit/gi/pkg/constant"
 	"github.com/gijit/gi/pkg/importer"
 	"github.com/gijit/gi/pkg/types"
 )
@@ -15,10 +18,14 @@ import (
 //GenShadowImport: create a map from string (pkg.FuncName) -> function pointer
 //  that can be used inside the "shadow" REPL environment that Luar can call.
 //
+// Exported objects that cannot be shadowed are
+// not fatal: they are left out, a warning is
+// printed to stderr, and the skip list is written
+// as a comment at the end of the generated file.
+//
 func GenShadowImport(importPath, dirForVendor, residentPkg, outDir string) error {
 	var pkg *types.Package
 
-	structs := []string{}
 	base := filepath.Base(residentPkg)
 	imp := importer.Default()
 	imp2, ok := imp.(types.ImporterFrom)
@@ -40,10 +47,34 @@ func GenShadowImport(importPath, dirForVendor, residentPkg, outDir string) error
 	if err != nil {
 		return err
 	}
+	defer o.Close()
+
+	skipped := genShadowPackage(o, pkg, importPath, base)
+	for _, s := range skipped {
+		fmt.Fprintf(os.Stderr, "genshadow warning: skipped %s\n", s)
+	}
+	return nil
+}
+
+// genShadowPackage writes the shadow_ package source for pkg
+// to o. It returns the "Name: reason" list of exported
+// objects that were skipped.
+func genShadowPackage(o io.Writer, pkg *types.Package, importPath, base string) (skipped []string) {
+
+	pkgName := pkg.Name()
+
+	// structs and other named types both get a
+	// native (copy/convert) constructor.
+	structs := []string{}
+	named := []string{}
 
 	fmt.Fprintf(o, `package shadow_%s
 
-import "%s"
+import (
+	"%s"
+
+	"github.com/gijit/gi/pkg/compiler/shadow"
+)
 
 var Pkg = make(map[string]interface{})
 var Ctor = make(map[string]interface{})
@@ -68,74 +99,51 @@ func init() {
 
 		pp("oty = '%#v', under='%#v'", oty, under)
 
-		switch oty.(type) {
-		default:
-			panic(fmt.Sprintf("genshadow: "+
-				"unhandled type! what oty type? '%T' for nm='%v'", oty, nm))
-		case *types.Map:
-			switch obj.(type) {
-			case *types.Var:
-				direct(o, nm, pkgName)
-			default:
-				panic(fmt.Sprintf("genshadow: "+
-					"unhandled type! what oty type? '%T' for nm='%v'", oty, nm))
-			}
-
-		case *types.Slice:
-			//fmt.Printf("Slice: nm = '%s', obj='%#v', oty='%#v', under='%#v'\n", nm, obj, oty, under)
-			// ex: os.Args
-			switch obj.(type) {
-			case *types.Var:
-				direct(o, nm, pkgName)
-			default:
-				panic(fmt.Sprintf("genshadow: "+
-					"unhandled type! what oty type? '%T' for nm='%v'", oty, nm))
-			}
-		case *types.Pointer:
-			//fmt.Printf("Pointer: nm = '%s', obj='%#v', oty='%#v', under='%#v'\n", nm, obj, oty, under)
-			// ex: os.Stderr, os.Stdin, os.Stdout
-			switch obj.(type) {
-			case *types.Var:
-				direct(o, nm, pkgName)
-			default:
-				panic(fmt.Sprintf("genshadow: "+
-					"unhandled type! what oty type? '%T' for nm='%v'", oty, nm))
-			}
-		case *types.Basic:
-			// these all compile
+		switch x := obj.(type) {
+		case *types.Var, *types.Func:
+			// ex: os.Args, os.Stdin, fmt.Sprintf;
+			// vars of any type, including chan
+			// and func typed ones, compile as is.
 			direct(o, nm, pkgName)
 
-		case *types.Signature:
-			// these all compile
-			direct(o, nm, pkgName)
+		case *types.Const:
+			reason := constTemplate(o, x, nm, pkgName)
+			if reason != "" {
+				skipped = append(skipped, nm+": "+reason)
+			}
 
-		case *types.Named:
-			pp("oty is types.Named...")
+		case *types.TypeName:
 			switch under.(type) {
 			case *types.Interface:
-				switch obj.(type) {
-				case *types.TypeName:
-					ifaceTemplate(o, obj, nm, pkgName, oty, under, &atEnd)
-				case *types.Var:
-					direct(o, nm, pkgName)
-				default:
-					panic(fmt.Sprintf("genshadow: "+
-						"unhandled type! what oty type? '%T' for nm='%v'", oty, nm))
-				}
+				ifaceTemplate(o, obj, nm, pkgName, oty, under, &atEnd)
 			case *types.Struct:
-				// none of these in "io"
 				structTemplate(o, obj, nm, pkgName, oty, under, &atEnd)
 				structs = append(structs, nm)
+			case *types.Basic, *types.Signature, *types.Chan,
+				*types.Map, *types.Slice, *types.Array, *types.Pointer:
+				// ex: time.Duration, os.FileMode, http.HandlerFunc.
+				// Values come back from Luar as proxies, so
+				// their methods are callable from the REPL.
+				namedTemplate(o, obj, nm, pkgName, &atEnd)
+				named = append(named, nm)
+			default:
+				skipped = append(skipped, fmt.Sprintf(
+					"%s: unhandled underlying type %T", nm, under))
 			}
+
+		default:
+			skipped = append(skipped, fmt.Sprintf(
+				"%s: unhandled object kind %T", nm, obj))
 		}
 	}
-	fmt.Fprintf(o, "\n}")
+	// register ourselves so the REPL can import us.
+	fmt.Fprintf(o, "\n    shadow.Register(%q, %q, Pkg, Ctor, InitLua)\n}", importPath, pkgName)
 
 	for _, s := range atEnd {
 		fmt.Fprintf(o, "%s\n", s)
 	}
 
-	pp("structs = '%#v'", structs)
+	pp("structs = '%#v', named = '%#v'", structs, named)
 
 	// write the InitLua() function that
 	// sets up the native struct (copy) constructors.
@@ -143,9 +151,52 @@ func init() {
 	for _, r := range structs {
 		fmt.Fprintf(o, "%s", perStructInitLua(pkgName, r))
 	}
+	for _, r := range named {
+		fmt.Fprintf(o, "%s", perNamedInitLua(pkgName, r))
+	}
 	fmt.Fprintf(o, "%s", genInitLuaFinish(pkgName))
 
-	return nil
+	if len(skipped) > 0 {
+		fmt.Fprintf(o, "\n\n// gen-gijit-shadow-import skipped:\n//\n")
+		for _, s := range skipped {
+			fmt.Fprintf(o, "//   %s\n", s)
+		}
+	}
+	return
+}
+
+// constTemplate emits a constant, returning
+// a non-empty reason if it had to be skipped.
+func constTemplate(o io.Writer, c *types.Const, nm, pkgName string) (reason string) {
+	b, ok := c.Type().(*types.Basic)
+	if !ok || b.Info()&types.IsUntyped == 0 {
+		// typed constants, like time.Second, carry their type.
+		direct(o, nm, pkgName)
+		return ""
+	}
+	// untyped constants default to int, float64, etc.
+	// when stored in an interface{}, so big ones
+	// need an explicit conversion to compile.
+	val := c.Val()
+	switch b.Kind() {
+	case types.UntypedInt, types.UntypedRune:
+		if _, exact := constant.Int64Val(val); exact {
+			direct(o, nm, pkgName)
+			return ""
+		}
+		if _, exact := constant.Uint64Val(val); exact {
+			fmt.Fprintf(o, "    Pkg[\"%s\"] = uint64(%s.%s)\n", nm, pkgName, nm)
+			return ""
+		}
+		return "untyped integer constant overflows uint64"
+	case types.UntypedFloat:
+		f, _ := constant.Float64Val(val)
+		if math.IsInf(f, 0) {
+			return "untyped float constant overflows float64"
+		}
+	}
+	direct(o, nm, pkgName)
+	return ""
 }
 
 /* make a function like:
@@ -181,11 +232,11 @@ func %s(x interface{}) %s.%s {
 	return
 }
 
-func direct(o *os.File, nm, pkgName string) {
+func direct(o io.Writer, nm, pkgName string) {
 	fmt.Fprintf(o, "    Pkg[\"%s\"] = %s.%s\n", nm, pkgName, nm)
 }
 
-func ctor(o *os.File, nm, pkgName string) {
+func ctor(o io.Writer, nm, pkgName string) {
 	fmt.Fprintf(o, "    Ctor[\"%[1]s\"] = GijitShadow_NewStruct_%[1]s\n", nm)
 }
 
@@ -198,7 +249,7 @@ func GijitShadow_CtorStruct_Time(src *time.Time) *time.Time {
 	return &a
 }
 */
-func structTemplate(o *os.File, obj types.Object, nm, pkgName string, oty, under types.Type, atEnd *[]string) {
+func structTemplate(o io.Writer, obj types.Object, nm, pkgName string, oty, under types.Type, atEnd *[]string) {
 	// example from "io":
 	/*
 		type PipeReader struct {
@@ -223,7 +274,24 @@ func GijitShadow_NewStruct_%[2]s(src *%[1]s.%[2]s) *%[1]s.%[2]s {
 
 }
 
-func ifaceTemplate(o *os.File, obj types.Object, nm, pkgName string, oty, under types.Type, atEnd *[]string) {
+/* make a function like:
+func GijitShadow_NewNamed_Duration(src time.Duration) time.Duration {
+	return src
+}
+*/
+// for named types that are neither structs nor interfaces.
+// Luar does the conversion from Lua on the way in, and
+// hands back a proxy that carries the type's methods.
+func namedTemplate(o io.Writer, obj types.Object, nm, pkgName string, atEnd *[]string) {
+	*atEnd = append(*atEnd, fmt.Sprintf(`
+func GijitShadow_NewNamed_%[2]s(src %[1]s.%[2]s) %[1]s.%[2]s {
+    return src
+}
+`, pkgName, nm))
+	fmt.Fprintf(o, "    Ctor[\"%[1]s\"] = GijitShadow_NewNamed_%[1]s\n", nm)
+}
+
+func ifaceTemplate(o io.Writer, obj types.Object, nm, pkgName string, oty, under types.Type, atEnd *[]string) {
 
 	//pp("ifaceTemplate:: we see Named '%s'\n. oty:'%#v',\n under:'%#v',\n, obj='%#v', \n", nm, oty, under, obj)
 
@@ -264,3 +332,25 @@ setmetatable(__type__.%[1]s.%[2]s, __type__.%[1]s.%[2]s);
 
 `, shortPkg, structName, ast.IsExported(structName))
 }
+
+func perNamedInitLua(shortPkg, typeName string) string {
+
+	return fmt.Sprintf(`
+-----------------
+-- named type %[2]s
+-----------------
+
+__type__.%[1]s.%[2]s = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "%[2]s",
+ __str = "%[2]s",
+ exported = %v,
+ __call = function(t, src)
+   return __ctor__%[1]s.%[2]s(src)
+ end,
+};
+setmetatable(__type__.%[1]s.%[2]s, __type__.%[1]s.%[2]s);
+
+`, shortPkg, typeName, ast.IsExported(typeName))
+}
@@ -0,0 +1,101 @@
+package compiler
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/gijit/gi/pkg/compiler/shadow"
+	"github.com/gijit/gi/pkg/constant"
+	"github.com/gijit/gi/pkg/token"
+	"github.com/gijit/gi/pkg/types"
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test067GenShadowCoversNamedTypesAndConstants(t *testing.T) {
+
+	cv.Convey(`GenShadowImport should not panic on named basic types, func types, chan vars, typed and big untyped constants; and should emit a converter for the named types`, t, func() {
+
+		pkg := types.NewPackage("example.com/tm", "tm")
+		scope := pkg.Scope()
+
+		// type Duration int64, with a String method.
+		durName := types.NewTypeName(token.NoPos, pkg, "Duration", nil)
+		str := types.NewFunc(token.NoPos, pkg, "String",
+			types.NewSignature(nil, nil, types.NewTuple(types.NewVar(token.NoPos, pkg, "", types.Typ[types.String])), false))
+		dur := types.NewNamed(durName, types.Typ[types.Int64], []*types.Func{str})
+		scope.Insert(durName)
+
+		// type HandlerFunc func(int)
+		hfName := types.NewTypeName(token.NoPos, pkg, "HandlerFunc", nil)
+		types.NewNamed(hfName, types.NewSignature(nil, types.NewTuple(types.NewVar(token.NoPos, pkg, "", types.Typ[types.Int])), nil, false), nil)
+		scope.Insert(hfName)
+
+		// const Second Duration = 1e9
+		scope.Insert(types.NewConst(token.NoPos, pkg, "Second", dur, constant.MakeInt64(1e9)))
+
+		// const MaxU = 1<<64 - 1
+		scope.Insert(types.NewConst(token.NoPos, pkg, "MaxU", types.Typ[types.UntypedInt], constant.MakeUint64(1<<64-1)))
+
+		// const Huge = 1 << 100
+		huge := constant.Shift(constant.MakeInt64(1), token.SHL, 100)
+		scope.Insert(types.NewConst(token.NoPos, pkg, "Huge", types.Typ[types.UntypedInt], huge))
+
+		// var Ticks chan Duration
+		scope.Insert(types.NewVar(token.NoPos, pkg, "Ticks", types.NewChan(types.RecvOnly, dur)))
+
+		var buf bytes.Buffer
+		skipped := genShadowPackage(&buf, pkg, "example.com/tm", "tm")
+		out := buf.String()
+
+		cv.So(strings.Contains(out, `Pkg["Second"] = tm.Second`), cv.ShouldBeTrue)
+		cv.So(strings.Contains(out, `Pkg["MaxU"] = uint64(tm.MaxU)`), cv.ShouldBeTrue)
+		cv.So(strings.Contains(out, `Pkg["Ticks"] = tm.Ticks`), cv.ShouldBeTrue)
+		cv.So(strings.Contains(out, `Ctor["Duration"] = GijitShadow_NewNamed_Duration`), cv.ShouldBeTrue)
+		cv.So(strings.Contains(out, `func GijitShadow_NewNamed_HandlerFunc(src tm.HandlerFunc) tm.HandlerFunc`), cv.ShouldBeTrue)
+		cv.So(strings.Contains(out, `shadow.Register("example.com/tm", "tm", Pkg, Ctor, InitLua)`), cv.ShouldBeTrue)
+
+		cv.So(skipped, cv.ShouldResemble, []string{"Huge: untyped integer constant overflows uint64"})
+		cv.So(strings.Contains(out, "//   Huge: untyped integer constant overflows uint64"), cv.ShouldBeTrue)
+	})
+}
+
+func Test071GenShadowRegistersThePackageClauseName(t *testing.T) {
+
+	cv.Convey(`when the last element of the import path is not the package name, as in gopkg.in/yaml.v2, the generated Register call should pass the name from the package clause`, t, func() {
+
+		pkg := types.NewPackage("example.com/go-tm.v2", "tm")
+		pkg.Scope().Insert(types.NewConst(token.NoPos, pkg, "Second", types.Typ[types.Int64], constant.MakeInt64(1e9)))
+
+		var buf bytes.Buffer
+		genShadowPackage(&buf, pkg, "example.com/go-tm.v2", "tm")
+		out := buf.String()
+
+		cv.So(strings.Contains(out, "package shadow_tm"), cv.ShouldBeTrue)
+		cv.So(strings.Contains(out, `shadow.Register("example.com/go-tm.v2", "tm", Pkg, Ctor, InitLua)`), cv.ShouldBeTrue)
+	})
+}
+
+func Test072ShippedShadowsHaveTheirNamedTypes(t *testing.T) {
+
+	cv.Convey(`the shipped time and os shadows, regenerated, should carry the named types the old generator choked on, and time.Duration should work at the prompt`, t, func() {
+
+		tm := shadow.Lookup("time")
+		cv.So(tm.Ctor["Duration"], cv.ShouldNotBeNil)
+		cv.So(tm.Pkg["Minute"], cv.ShouldNotBeNil)
+		cv.So(shadow.Lookup("os").Ctor["FileMode"], cv.ShouldNotBeNil)
+
+		in, err := NewInterpreter(nil)
+		panicOn(err)
+		defer in.Close()
+		ctx := context.Background()
+		_, err = in.Eval(ctx, `import "time"`)
+		panicOn(err)
+		_, err = in.Eval(ctx, `d := 90 * time.Minute`)
+		panicOn(err)
+		res, err := in.Eval(ctx, `d.String()`)
+		panicOn(err)
+		cv.So(res.Text, cv.ShouldEqual, `"1h30m0s"`)
+	})
+}
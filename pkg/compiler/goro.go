@@ -106,6 +106,7 @@ const (
 	GetInt64 GetType = iota
 	GetString
 	GetChan
+	GetGo // by luar.LuaToGo, into the pointer in varname
 )
 
 func NewGoro(lvm *LuaVm, cfg *GoroConfig) (*Goro, error) {
@@ -159,10 +160,19 @@ func (r *Goro) Start() {
 
 	func() {
 		defer func() {
+			luar.ForgetCallbacks(r.lvm.vm)
 			r.lvm.vm.Close()
 			r.halt.MarkDone()
 		}()
 
+		// Go funcs made from Lua functions queue their
+		// calls for us; see luar's callback.go. When we
+		// own the vm, serve them here while it is idle.
+		var callbacks <-chan *luar.Callback
+		if reserveMainThread {
+			callbacks = luar.Callbacks(r.vm)
+		}
+
 		//fmt.Printf("\n r.beat is %v on r = %p\n", r.beat, r)
 		//var heartbeat <-chan time.Time
 		close(r.Ready)
@@ -187,6 +197,8 @@ func (r *Goro) Start() {
 				return
 			case t := <-r.doticket:
 				r.handleTicket(t)
+			case cb := <-callbacks:
+				cb.Run(r.vm)
 			}
 		}
 	}()
@@ -222,7 +234,7 @@ func (r *Goro) handleTicket(t *ticket) {
 				continue
 			}
 			r.vm.GetGlobal(key)
-			if r.vm.IsNil(-1) {
+			if r.vm.IsNil(-1) && t.gettyp != GetGo {
 				r.vm.Pop(1)
 				t.getErr = fmt.Errorf("not found: '%s'", t.varname)
 				break
@@ -235,6 +247,12 @@ func (r *Goro) handleTicket(t *ticket) {
 				case GetChan:
 					r.vm.Pop(1)
 					t.varname[key], t.getErr = getChannelFromGlobal(r.lvm, key, true)
+				case GetGo:
+					// a nil leaves the zero value. luar wants
+					// the absolute index, for a slice.
+					if !r.vm.IsNil(-1) {
+						t.getErr = luaToGoNoPanic(r.vm, r.vm.GetTop(), t.varname[key])
+					}
 				}
 				if !t.leaveOnTop {
 					r.vm.Pop(1)
@@ -242,9 +260,24 @@ func (r *Goro) handleTicket(t *ticket) {
 			}
 		}
 	}
+	// calls back into Lua from Go that came in while the
+	// vm ran pure Lua, or sat idle, wait no longer than this.
+	luar.ServeCallbacks(r.vm)
 	close(t.done)
 }
 
+// luaToGoNoPanic is luar.LuaToGo, with the panic
+// of a type mismatch made an error.
+func luaToGoNoPanic(L *golua.State, idx int, ptr interface{}) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	_, err = luar.LuaToGo(L, idx, ptr)
+	return err
+}
+
 func (r *Goro) do(t *ticket) {
 	if reserveMainThread {
 		r.doticket <- t
@@ -295,7 +328,7 @@ func (goro *Goro) privateRun(run []byte, useEvalCoroutine bool) error {
 		//fmt.Printf("good: found __eval (0x%x). it is at -2 of the stack, our running code at -1. running '%s'\n", eval, s)
 		if verb.VerboseVerbose {
 			fmt.Printf("before vm.Call(1,0), stacks are:")
-			showLuaStacks(vm)
+			showLuaStacks(vm, nil)
 		}
 
 		vm.Call(1, 0)
@@ -305,7 +338,7 @@ func (goro *Goro) privateRun(run []byte, useEvalCoroutine bool) error {
 
 		if verb.VerboseVerbose {
 			fmt.Printf("\nafter vm.Call(1,0), stacks are:\n")
-			showLuaStacks(vm)
+			showLuaStacks(vm, nil)
 		}
 
 		return nil
@@ -2,12 +2,15 @@ package compiler
 
 import (
 	//"fmt"
+	"runtime"
+	"strings"
+	"sync"
 	"testing"
 
 	//"github.com/gijit/gi/pkg/token"
 	//"github.com/gijit/gi/pkg/types"
 	cv "github.com/glycerine/goconvey/convey"
-	//"github.com/glycerine/luar"
+	"github.com/glycerine/luar"
 )
 
 func Test707ReplGoroVsBackendGoro(t *testing.T) {
@@ -210,3 +213,59 @@ func Test701StartTwoGoroutines(t *testing.T) {
 	})
 }
 */
+
+func Test709NativeGoroutinesCallBackIntoInterpretedFuncs(t *testing.T) {
+
+	cv.Convey(`an interpreted func handed to Go should be callable from goroutines that Go starts, while Go waits on them with a sync.WaitGroup`, t, func() {
+
+		vm, err := NewLuaVmWithPrelude(nil)
+		panicOn(err)
+		defer vm.Close()
+
+		// stand-ins for binary packages that take callbacks.
+		luar.Register(vm.vm, "", luar.Map{
+			"__parSum": func(n int, f func(int) int) int {
+				var wg sync.WaitGroup
+				var mut sync.Mutex
+				sum := 0
+				for i := 0; i < n; i++ {
+					wg.Add(1)
+					go func(i int) {
+						defer wg.Done()
+						r := f(i)
+						mut.Lock()
+						sum += r
+						mut.Unlock()
+					}(i)
+				}
+				wg.Wait()
+				return sum
+			},
+			"__twice": func(f func(int) int, x int) int {
+				return f(f(x))
+			},
+			"__goroutine": goroutineID,
+		})
+
+		inc := NewIncrState(vm, nil)
+		translation, err := inc.Tr([]byte(`sq := func(i int) int { return i * i }`))
+		panicOn(err)
+		LuaRunAndReport(vm, string(translation))
+
+		LuaRunAndReport(vm, `sum = __parSum(10, sq); four = __twice(sq, 2)`)
+		LuaMustInt64(vm, "sum", 285)
+		LuaMustInt64(vm, "four", 16)
+
+		// a Go func that takes no func still runs right
+		// on the goroutine that runs the vm.
+		LuaRunAndReport(vm, `g = __goroutine()`)
+		LuaMustString(vm, "g", goroutineID())
+	})
+}
+
+func goroutineID() string {
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+	// "goroutine 18 [running]:..."
+	return strings.Fields(string(buf))[1]
+}
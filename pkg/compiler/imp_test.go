@@ -3,7 +3,9 @@ package compiler
 import (
 	//"fmt"
 	"testing"
+	"unicode/utf8"
 
+	"github.com/gijit/gi/pkg/compiler/shadow"
 	//"github.com/gijit/gi/pkg/verb"
 	cv "github.com/glycerine/goconvey/convey"
 )
@@ -319,3 +321,37 @@ func Test087ShadowRegexp(t *testing.T) {
 		LuaMustInt64(vm, "a1", 5)
 	})
 }
+
+func Test066RegisterShadowPackage(t *testing.T) {
+
+	cv.Convey(`a package added with RegisterShadowPackage, rather than compiled into the import switch, should be importable from the REPL`, t, func() {
+
+		RegisterShadowPackage("unicode/utf8", "utf8", map[string]interface{}{
+			"RuneLen":   utf8.RuneLen,
+			"UTFMax":    utf8.UTFMax,
+			"ValidRune": utf8.ValidRune,
+		}, nil, nil)
+
+		e := shadow.Lookup("unicode/utf8")
+		cv.So(e, cv.ShouldNotBeNil)
+		cv.So(e.Name, cv.ShouldEqual, "utf8")
+
+		src := `import "unicode/utf8"
+	a := utf8.RuneLen('x')
+	b := utf8.RuneLen('世')
+`
+		vm, err := NewLuaVmWithPrelude(nil)
+		panicOn(err)
+		defer vm.Close()
+		inc := NewIncrState(vm, nil)
+
+		translation, err := inc.Tr([]byte(src))
+		panicOn(err)
+		pp("go:'%s'  -->  '%s' in lua\n", src, string(translation))
+
+		LoadAndRunTestHelper(t, vm, translation)
+
+		LuaMustInt64(vm, "a", 1)
+		LuaMustInt64(vm, "b", 3)
+	})
+}
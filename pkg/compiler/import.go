@@ -15,38 +15,43 @@ import (
 
 	golua "github.com/glycerine/golua/lua"
 
-	// shadow_ imports: available inside the REPL
-
-	shadow_bytes "github.com/gijit/gi/pkg/compiler/shadow/bytes"
-	shadow_encoding_binary "github.com/gijit/gi/pkg/compiler/shadow/encoding/binary"
-	shadow_errors "github.com/gijit/gi/pkg/compiler/shadow/errors"
-	shadow_fmt "github.com/gijit/gi/pkg/compiler/shadow/fmt"
-	shadow_io "github.com/gijit/gi/pkg/compiler/shadow/io"
-	shadow_io_ioutil "github.com/gijit/gi/pkg/compiler/shadow/io/ioutil"
-	shadow_math "github.com/gijit/gi/pkg/compiler/shadow/math"
-	shadow_math_rand "github.com/gijit/gi/pkg/compiler/shadow/math/rand"
-	shadow_os "github.com/gijit/gi/pkg/compiler/shadow/os"
-	shadow_reflect "github.com/gijit/gi/pkg/compiler/shadow/reflect"
-	shadow_regexp "github.com/gijit/gi/pkg/compiler/shadow/regexp"
-	shadow_runtime "github.com/gijit/gi/pkg/compiler/shadow/runtime"
-	shadow_runtime_debug "github.com/gijit/gi/pkg/compiler/shadow/runtime/debug"
-	shadow_strconv "github.com/gijit/gi/pkg/compiler/shadow/strconv"
-	shadow_strings "github.com/gijit/gi/pkg/compiler/shadow/strings"
-	shadow_sync "github.com/gijit/gi/pkg/compiler/shadow/sync"
-	shadow_sync_atomic "github.com/gijit/gi/pkg/compiler/shadow/sync/atomic"
-	shadow_time "github.com/gijit/gi/pkg/compiler/shadow/time"
+	"github.com/gijit/gi/pkg/compiler/shadow"
+
+	// shadow_ imports: available inside the REPL.
+	// Each registers itself with the shadow package
+	// from its init().
+
+	_ "github.com/gijit/gi/pkg/compiler/shadow/bytes"
+	_ "github.com/gijit/gi/pkg/compiler/shadow/encoding"
+	_ "github.com/gijit/gi/pkg/compiler/shadow/encoding/binary"
+	_ "github.com/gijit/gi/pkg/compiler/shadow/errors"
+	_ "github.com/gijit/gi/pkg/compiler/shadow/fmt"
+	_ "github.com/gijit/gi/pkg/compiler/shadow/io"
+	_ "github.com/gijit/gi/pkg/compiler/shadow/io/ioutil"
+	_ "github.com/gijit/gi/pkg/compiler/shadow/math"
+	_ "github.com/gijit/gi/pkg/compiler/shadow/math/rand"
+	_ "github.com/gijit/gi/pkg/compiler/shadow/os"
+	_ "github.com/gijit/gi/pkg/compiler/shadow/reflect"
+	_ "github.com/gijit/gi/pkg/compiler/shadow/regexp"
+	_ "github.com/gijit/gi/pkg/compiler/shadow/runtime"
+	_ "github.com/gijit/gi/pkg/compiler/shadow/runtime/debug"
+	_ "github.com/gijit/gi/pkg/compiler/shadow/strconv"
+	_ "github.com/gijit/gi/pkg/compiler/shadow/strings"
+	_ "github.com/gijit/gi/pkg/compiler/shadow/sync"
+	_ "github.com/gijit/gi/pkg/compiler/shadow/sync/atomic"
+	_ "github.com/gijit/gi/pkg/compiler/shadow/time"
 
 	// gonum
-	shadow_blas "github.com/gijit/gi/pkg/compiler/shadow/gonum.org/v1/gonum/blas"
-	shadow_fd "github.com/gijit/gi/pkg/compiler/shadow/gonum.org/v1/gonum/diff/fd"
-	shadow_floats "github.com/gijit/gi/pkg/compiler/shadow/gonum.org/v1/gonum/floats"
-	shadow_graph "github.com/gijit/gi/pkg/compiler/shadow/gonum.org/v1/gonum/graph"
-	shadow_integrate "github.com/gijit/gi/pkg/compiler/shadow/gonum.org/v1/gonum/integrate"
-	shadow_lapack "github.com/gijit/gi/pkg/compiler/shadow/gonum.org/v1/gonum/lapack"
-	shadow_mat "github.com/gijit/gi/pkg/compiler/shadow/gonum.org/v1/gonum/mat"
-	shadow_optimize "github.com/gijit/gi/pkg/compiler/shadow/gonum.org/v1/gonum/optimize"
-	shadow_stat "github.com/gijit/gi/pkg/compiler/shadow/gonum.org/v1/gonum/stat"
-	shadow_unit "github.com/gijit/gi/pkg/compiler/shadow/gonum.org/v1/gonum/unit"
+	_ "github.com/gijit/gi/pkg/compiler/shadow/gonum.org/v1/gonum/blas"
+	_ "github.com/gijit/gi/pkg/compiler/shadow/gonum.org/v1/gonum/diff/fd"
+	_ "github.com/gijit/gi/pkg/compiler/shadow/gonum.org/v1/gonum/floats"
+	_ "github.com/gijit/gi/pkg/compiler/shadow/gonum.org/v1/gonum/graph"
+	_ "github.com/gijit/gi/pkg/compiler/shadow/gonum.org/v1/gonum/integrate"
+	_ "github.com/gijit/gi/pkg/compiler/shadow/gonum.org/v1/gonum/lapack"
+	_ "github.com/gijit/gi/pkg/compiler/shadow/gonum.org/v1/gonum/mat"
+	_ "github.com/gijit/gi/pkg/compiler/shadow/gonum.org/v1/gonum/optimize"
+	_ "github.com/gijit/gi/pkg/compiler/shadow/gonum.org/v1/gonum/stat"
+	_ "github.com/gijit/gi/pkg/compiler/shadow/gonum.org/v1/gonum/unit"
 
 	// actuals
 	"gonum.org/v1/gonum/blas"
@@ -61,8 +66,24 @@ import (
 	"gonum.org/v1/gonum/unit"
 )
 
-// distinguish binary imports from source imports
-var binaryPackage = make(map[string]bool)
+// RegisterShadowPackage makes the Go package at importPath
+// importable from the REPL as a binary (Luar-called) package.
+// name is the package's own name, from its package clause,
+// which need not be the last element of importPath.
+//
+// pkgMap maps each exported name to its Go value; ctorMap
+// holds struct copy-constructors and may be nil; initLua
+// returns any Lua to run at import time and may be nil.
+// The shadow_* packages generated by gen-gijit-shadow-import
+// call this from their init(), so a blank import of a
+// generated package is enough to register it.
+//
+// The type information for type checking is read from the
+// compiled package, so importPath must also be linked into
+// (or installed alongside) the running binary.
+func RegisterShadowPackage(importPath, name string, pkgMap, ctorMap map[string]interface{}, initLua func() string) {
+	shadow.Register(importPath, name, pkgMap, ctorMap, initLua)
+}
 
 func init() {
 	a := 1
@@ -72,7 +93,6 @@ func init() {
 }
 
 var _ = ioutil.Discard
-var _ = shadow_blas.GijitShadow_InterfaceConvertTo2_Float64
 var _ = fd.Backward
 var _ = floats.Add
 var _ = graph.Copy
@@ -83,11 +103,15 @@ var _ = optimize.ArmijoConditionMet
 var _ = stat.CDF
 var _ = unit.Atto
 
-func registerLuarReqs(vm *golua.State) {
+func registerLuarReqs(vm *golua.State) error {
 	// channel ops need reflect, so import it always.
-
-	luar.Register(vm, "reflect", shadow_reflect.Pkg)
-	luar.Register(vm, "fmt", shadow_fmt.Pkg)
+	for _, path := range []string{"reflect", "fmt"} {
+		e := shadow.Lookup(path)
+		if e == nil {
+			return fmt.Errorf("the %s shadow package is not linked in; blank import github.com/gijit/gi/pkg/compiler/shadow/%s", path, path)
+		}
+		luar.Register(vm, path, e.Pkg)
+	}
 	//fmt.Printf("reflect/fmt registered\n")
 
 	// give goroutines.lua something to clone
@@ -99,7 +123,7 @@ func registerLuarReqs(vm *golua.State) {
 	})
 
 	registerBasicReflectTypes(vm)
-
+	return nil
 }
 
 func (ic *IncrState) EnableImportsFromLua() {
@@ -126,12 +150,13 @@ func (ic *IncrState) EnableImportsFromLua() {
 	}
 
 	stacksClosure := func() {
-		showLuaStacks(ic.goro.vm)
+		showLuaStacks(ic.goro.vm, ic.srcMaps.goPositions)
 	}
 	luar.Register(ic.goro.vm, "", luar.Map{
 		"__go_run_import":     goRunImportFromLua,
 		"__go_compile_import": goCompileImportFromLua,
 		"__stacks":            stacksClosure,
+		"__goPositions":       ic.srcMaps.goPositions,
 	})
 
 	// Enable __zygo() calls. Type checking established
@@ -190,123 +215,26 @@ func (ic *IncrState) RunTimeGiImportFunc(path, pkgDir string, depth int) error {
 			return err
 		}
 
-	case "bytes":
-		t0.regmap["bytes"] = shadow_bytes.Pkg
-		t0.regmap["__ctor__bytes"] = shadow_bytes.Ctor
-		t0.run = append(t0.run, shadow_bytes.InitLua()...)
-
-	case "encoding/binary":
-		t0.regmap["binary"] = shadow_encoding_binary.Pkg
-		t0.regmap["__ctor__binary"] = shadow_encoding_binary.Ctor
-		t0.run = append(t0.run, shadow_encoding_binary.InitLua()...)
-
-	case "errors":
-		t0.regmap["errors"] = shadow_errors.Pkg
-		t0.regmap["__ctor__errors"] = shadow_errors.Ctor
-		t0.run = append(t0.run, shadow_errors.InitLua()...)
-
-	case "fmt":
-		pp("RunTimeGiImportFunc sees 'fmt', known and shadowed.")
-		t0.regmap["fmt"] = shadow_fmt.Pkg
-		t0.regmap["__ctor__fmt"] = shadow_fmt.Ctor
-		t0.run = append(t0.run, shadow_fmt.InitLua()...)
-	case "io":
-		t0.regmap["io"] = shadow_io.Pkg
-		t0.regmap["__ctor__io"] = shadow_io.Ctor
-		t0.run = append(t0.run, shadow_io.InitLua()...)
-	case "math":
-		t0.regmap["math"] = shadow_math.Pkg
-		t0.regmap["__ctor__math"] = shadow_math.Ctor
-		t0.run = append(t0.run, shadow_math.InitLua()...)
-	case "math/rand":
-		t0.regmap["rand"] = shadow_math_rand.Pkg
-		t0.regmap["__ctor__math_rand"] = shadow_math_rand.Ctor
-		t0.run = append(t0.run, shadow_math_rand.InitLua()...)
-	case "os":
-		t0.regmap["os"] = shadow_os.Pkg
-		t0.regmap["__ctor__os"] = shadow_os.Ctor
-		t0.run = append(t0.run, shadow_os.InitLua()...)
-
-	case "reflect":
-		t0.regmap["reflect"] = shadow_reflect.Pkg
-		t0.regmap["__ctor__reflect"] = shadow_reflect.Ctor
-		t0.run = append(t0.run, shadow_reflect.InitLua()...)
-
-	case "regexp":
-		t0.regmap["regexp"] = shadow_regexp.Pkg
-		t0.regmap["__ctor__regexp"] = shadow_regexp.Ctor
-		t0.run = append(t0.run, shadow_regexp.InitLua()...)
-
-	case "sync":
-		t0.regmap["sync"] = shadow_sync.Pkg
-		t0.regmap["__ctor__sync"] = shadow_sync.Ctor
-		t0.run = append(t0.run, shadow_sync.InitLua()...)
-
-	case "sync/atomic":
-		t0.regmap["atomic"] = shadow_sync_atomic.Pkg
-		t0.regmap["__ctor__atomic"] = shadow_sync_atomic.Ctor
-		t0.run = append(t0.run, shadow_sync_atomic.InitLua()...)
-
-	case "time":
-		t0.regmap["time"] = shadow_time.Pkg
-		t0.regmap["__ctor__time"] = shadow_time.Ctor
-		t0.run = append(t0.run, shadow_time.InitLua()...)
-
-	case "runtime":
-		t0.regmap["runtime"] = shadow_runtime.Pkg
-		t0.regmap["__ctor__runtime"] = shadow_runtime.Ctor
-		t0.run = append(t0.run, shadow_runtime.InitLua()...)
-
-	case "runtime/debug":
-		t0.regmap["debug"] = shadow_runtime_debug.Pkg
-		t0.regmap["__ctor__debug"] = shadow_runtime_debug.Ctor
-		t0.run = append(t0.run, shadow_runtime_debug.InitLua()...)
-
-	case "strconv":
-		t0.regmap["strconv"] = shadow_strconv.Pkg
-		t0.regmap["__ctor__strconv"] = shadow_strconv.Ctor
-		t0.run = append(t0.run, shadow_strconv.InitLua()...)
-
-	case "strings":
-		t0.regmap["strings"] = shadow_strings.Pkg
-		t0.regmap["__ctor__strings"] = shadow_strings.Ctor
-		t0.run = append(t0.run, shadow_strings.InitLua()...)
-
-	case "io/ioutil":
-		t0.regmap["ioutil"] = shadow_io_ioutil.Pkg
-		t0.regmap["__ctor__ioutil"] = shadow_io_ioutil.Ctor
-		t0.run = append(t0.run, shadow_io_ioutil.InitLua()...)
-
-		// gonum:
-	case "gonum.org/v1/gonum/blas":
-		t0.regmap["blas"] = shadow_blas.Pkg
-	case "gonum.org/v1/gonum/fd":
-		t0.regmap["fd"] = shadow_fd.Pkg
-	case "gonum.org/v1/gonum/floats":
-		t0.regmap["floats"] = shadow_floats.Pkg
-	case "gonum.org/v1/gonum/graph":
-		t0.regmap["graph"] = shadow_graph.Pkg
-	case "gonum.org/v1/gonum/integrate":
-		t0.regmap["integrate"] = shadow_integrate.Pkg
-	case "gonum.org/v1/gonum/lapack":
-		t0.regmap["lapack"] = shadow_lapack.Pkg
-	case "gonum.org/v1/gonum/mat":
-		t0.regmap["mat"] = shadow_mat.Pkg
-	case "gonum.org/v1/gonum/optimize":
-		t0.regmap["optimize"] = shadow_optimize.Pkg
-	case "gonum.org/v1/gonum/stat":
-		t0.regmap["stat"] = shadow_stat.Pkg
-	case "gonum.org/v1/gonum/unit":
-		t0.regmap["unit"] = shadow_unit.Pkg
-
 	default:
+		if e := shadow.Lookup(path); e != nil {
+			// registered binary package, see RegisterShadowPackage.
+			pp("RunTimeGiImportFunc sees '%s', known and shadowed.", path)
+			t0.regmap[e.Name] = e.Pkg
+			if e.Ctor != nil {
+				t0.regmap["__ctor__"+e.Name] = e.Ctor
+			}
+			if e.InitLua != nil {
+				t0.run = append(t0.run, e.InitLua()...)
+			}
+			break
+		}
 		// source import
 		srcImport = true
 		// don't need to compile again, just call pkg.__init()
 		t0.run = []byte(fmt.Sprintf("%s.__init();", omitAnyShadowPathPrefix(path, true)))
 	}
 	if !srcImport {
-		binaryPackage[path] = true
+		ic.binaryPackage[path] = true
 	}
 	err := t0.Do()
 	pp("RunTimeGiImportFunc executed t0.Do() to run: '%s', got back err='%v'", string(t0.run), err)
@@ -336,47 +264,15 @@ func (ic *IncrState) CompileTimeGiImportFunc(path, pkgDir string, depth int) (*A
 	*/
 	pp("no cache hit for path '%s'", path)
 
-	code := []byte(fmt.Sprintf("\t __go_run_import(\"%[1]s\");\n\t __type__.%[2]s = __type__.%[2]s or {};\n", omitAnyShadowPathPrefix(path, false), omitAnyShadowPathPrefix(path, true)))
+	name := omitAnyShadowPathPrefix(path, true)
+	if e := shadow.Lookup(omitAnyShadowPathPrefix(path, false)); e != nil {
+		name = e.Name
+	}
+	code := []byte(fmt.Sprintf("\t __go_run_import(\"%[1]s\");\n\t __type__.%[2]s = __type__.%[2]s or {};\n", omitAnyShadowPathPrefix(path, false), name))
 	//code := []byte(fmt.Sprintf("\t __go_run_import(\"%[1]s\");\n\t __type__.%[2]s = __type__.%[2]s or {};\n\t local %[2]s = _G.%[2]s;\n", omitAnyShadowPathPrefix(path, false), omitAnyShadowPathPrefix(path, true)))
 
 	switch path {
 
-	// all these are shadowed, see below for the load of type checking info.
-
-	// gen-gijit-shadow outputs to pkg/compiler/shadow/...
-	case "bytes":
-	case "encoding/binary":
-	case "errors":
-	case "fmt":
-	case "io":
-	case "math":
-	case "math/rand":
-	case "os":
-	case "reflect":
-	case "regexp":
-	case "sync":
-	case "sync/atomic":
-	case "time":
-	case "runtime":
-	case "runtime/debug":
-	case "strconv":
-	case "strings":
-	case "io/ioutil":
-		// gonum:
-	case "gonum.org/v1/gonum/blas":
-	case "gonum.org/v1/gonum/fd":
-	case "gonum.org/v1/gonum/floats":
-	case "gonum.org/v1/gonum/graph":
-	case "gonum.org/v1/gonum/integrate":
-	case "gonum.org/v1/gonum/lapack":
-	case "gonum.org/v1/gonum/mat":
-	case "gonum.org/v1/gonum/optimize":
-	case "gonum.org/v1/gonum/stat":
-	case "gonum.org/v1/gonum/unit":
-
-		// we need to load the type-checking info into arch.Pkg
-		// now so that the compile can complete.
-
 	case "gitesting":
 		// test only:
 		fmt.Printf("ic.cfg.IsTestMode = %v\n", ic.cfg.IsTestMode)
@@ -415,6 +311,13 @@ func (ic *IncrState) CompileTimeGiImportFunc(path, pkgDir string, depth int) (*A
 		}
 
 	default:
+		if shadow.Lookup(path) != nil {
+			// registered binary package, see RegisterShadowPackage.
+			// We need to load the type-checking info into
+			// arch.Pkg now so that the compile can complete.
+			break
+		}
+
 		// try a source import?
 
 		p1("should we source import path='%s'? depth=%v", path, depth)
@@ -422,7 +325,7 @@ func (ic *IncrState) CompileTimeGiImportFunc(path, pkgDir string, depth int) (*A
 
 		if depth > 7 {
 			// not allowed
-			return nil, fmt.Errorf("deep source imports forbidden for performance reasons. problem with import of package '%s' (not shadowed? [1]) depth=%v ... [footnote 1] To shadow it, run gen-gijit-shadow-import on the package, add a case and import above, and recompile gijit.", path, depth)
+			return nil, fmt.Errorf("deep source imports forbidden for performance reasons. problem with import of package '%s' (not shadowed? [1]) depth=%v ... [footnote 1] To shadow it, run gen-gijit-shadow-import on the package, and import it (or call RegisterShadowPackage) before starting gijit.", path, depth)
 		}
 
 		archive, err := ic.ImportSourcePackage(path, pkgDir, depth+1)
@@ -457,15 +360,13 @@ func (ic *IncrState) CompileTimeGiImportFunc(path, pkgDir string, depth int) (*A
 			return archive, nil
 		}
 		// source import failed.
-		fmt.Printf("source import of package '%s' failed: '%v'", path, err)
-
 		// need to run gen-gijit-shadow-import
-		return nil, fmt.Errorf("error on import: problem with package '%s' (not shadowed? [1]): '%v'. ... [footnote 1] To shadow it, run gen-gijit-shadow-import on the package, add a case and import above, and recompile gijit.", path, err)
+		return nil, fmt.Errorf("error on import: problem with package '%s' (not shadowed? [1]): '%v'. ... [footnote 1] To shadow it, run gen-gijit-shadow-import on the package, and import it (or call RegisterShadowPackage) before starting gijit.", path, err)
 	}
 
 	// successfully match path to a shadow package, bring in its
 	// type info now.
-	shadowPath := "github.com/gijit/gi/pkg/compiler/shadow/" + path
+	shadowPath := shadow.PathPrefix + path
 	a, err := ic.ActuallyImportPackage(path, "", shadowPath, depth+1)
 	if err != nil {
 		return nil, err
@@ -476,7 +377,7 @@ func (ic *IncrState) CompileTimeGiImportFunc(path, pkgDir string, depth int) (*A
 }
 
 func omitAnyShadowPathPrefix(pth string, base bool) string {
-	const prefix = "github.com/gijit/gi/pkg/compiler/shadow/"
+	const prefix = shadow.PathPrefix
 	if strings.HasPrefix(pth, prefix) {
 		if base {
 			return path.Base(pth[len(prefix):])
@@ -601,6 +502,21 @@ func getFunFor__st(pkg *types.Package) *types.Func {
 	return fun
 }
 
+// __gijit_goLiteral renders a value as Go source, for :save.
+func getFunFor__gijit_goLiteral(pkg *types.Package) *types.Func {
+	// func __gijit_goLiteral(a interface{}, kind string) string
+	var recv *types.Var
+	str := types.Typ[types.String]
+	results := types.NewTuple(types.NewVar(token.NoPos, pkg, "", str))
+	emptyInterface := types.NewInterface(nil, nil)
+	params := types.NewTuple(types.NewVar(token.NoPos, pkg, "a", emptyInterface),
+		types.NewVar(token.NoPos, pkg, "kind", str))
+	variadic := false
+	sig := types.NewSignature(recv, params, results, variadic)
+	fun := types.NewFunc(token.NoPos, pkg, "__gijit_goLiteral", sig)
+	return fun
+}
+
 // and __ls, __gls, __lst, __glst; all functions with no arguments
 // and no results, just side effect of displaying info.
 func getFunFor__replUtil(cmd string, pkg *types.Package) *types.Func {
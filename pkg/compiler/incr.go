@@ -36,6 +36,7 @@ func addPreludeToNewPkg(pkg *types.Package) {
 	// allow tostring from Go, to call the Lua builtin.
 	scope.Insert(getFunFor__tostring(pkg))
 	scope.Insert(getFunFor__st(pkg))
+	scope.Insert(getFunFor__gijit_goLiteral(pkg))
 	for _, cmd := range []string{"__ls", "__gls", "__lst", "__glst", "__stacks"} {
 		scope.Insert(getFunFor__replUtil(cmd, pkg))
 	}
@@ -55,6 +56,26 @@ func IncrementallyCompile(a *Archive, importPath string, files []*ast.File, file
 
 	var newCodeText [][]byte
 	var funcSrcCache map[string]string
+	var declDeps map[string][]string
+	// a's maps are shared with any snapshot of a, see
+	// archiveSnapshot, so own copies them before the
+	// first write.
+	shared := false
+	own := func() {
+		if !shared {
+			return
+		}
+		shared = false
+		fsc := make(map[string]string, len(funcSrcCache)+1)
+		for k, v := range funcSrcCache {
+			fsc[k] = v
+		}
+		dd := make(map[string][]string, len(declDeps)+1)
+		for k, v := range declDeps {
+			dd[k] = v
+		}
+		funcSrcCache, declDeps = fsc, dd
+	}
 
 	var typesInfo *types.Info
 	if a == nil {
@@ -67,9 +88,12 @@ func IncrementallyCompile(a *Archive, importPath string, files []*ast.File, file
 			Scopes:     make(map[ast.Node]*types.Scope),
 		}
 		funcSrcCache = make(map[string]string)
+		declDeps = make(map[string][]string)
 	} else {
 		typesInfo = a.TypesInfo
 		funcSrcCache = a.FuncSrcCache
+		declDeps = a.DeclDeps
+		shared = true
 		//pp("typesInfo.Types = '%#v'", typesInfo.Types)
 	}
 
@@ -84,22 +108,23 @@ func IncrementallyCompile(a *Archive, importPath string, files []*ast.File, file
 			AllowOverShadowedNakedReturns: true, // jea add
 			DisableUnusedImportCheck:      true, // jea add
 			AllowUnusedVar:                true, // jea add
-			Importer: packageImporter{
-				importContext: importContext,
-				importError:   &importError,
-			},
 			//Sizes: sizes32,
 			Sizes: sizes64,
-			Error: func(err error) {
-				panic(fmt.Sprintf("where error? err = '%v'", err))
-				if previousErr != nil && previousErr.Error() == err.Error() {
-					return
-				}
-				errList = append(errList, err)
-				previousErr = err
-			},
 		}
 	}
+	// the config lives as long as the Archive, so
+	// point its callbacks at this call's variables.
+	config.Importer = packageImporter{
+		importContext: importContext,
+		importError:   &importError,
+	}
+	config.Error = func(err error) {
+		if previousErr != nil && previousErr.Error() == err.Error() {
+			return
+		}
+		errList = append(errList, err)
+		previousErr = err
+	}
 	pp("about to call config.Check")
 	var pkg *types.Package
 	var check *types.Checker
@@ -189,12 +214,13 @@ func IncrementallyCompile(a *Archive, importPath string, files []*ast.File, file
 			pkgVars:      make(map[string]string),
 			objectNames:  make(map[types.Object]string),
 			varPtrNames:  make(map[*types.Var]string),
-			escapingVars: make(map[*types.Var]bool),
 			indentation:  1,
 			dependencies: make(map[types.Object]bool),
 			minify:       minify,
 			fileSet:      fileSet,
 			files:        files,
+
+			binaryPackage: importContext.Binary,
 		},
 		allVars:      make(map[string]int),
 		flowDatas:    map[*types.Label]*flowData{nil: {}},
@@ -270,13 +296,7 @@ func IncrementallyCompile(a *Archive, importPath string, files []*ast.File, file
 		f()
 		var deps []string
 		for o := range c.p.dependencies {
-			qualifiedName := o.Pkg().Path() + "." + o.Name()
-			if f, ok := o.(*types.Func); ok && f.Type().(*types.Signature).Recv() != nil {
-				//deps = append(deps, qualifiedName+"~")
-				deps = append(deps, qualifiedName+"___tilde_")
-				continue
-			}
-			deps = append(deps, qualifiedName)
+			deps = append(deps, declKey(o))
 		}
 		sort.Strings(deps)
 		return deps
@@ -328,6 +348,7 @@ func IncrementallyCompile(a *Archive, importPath string, files []*ast.File, file
 				var by bytes.Buffer
 				err = printer.Fprint(&by, fileSet, d)
 				panicOn(err)
+				own()
 				funcSrcCache[d.Name.Name] = by.String()
 				pp("stored in c.p.funcSrcCache['%s'] the value '%s'", d.Name.Name, funcSrcCache[d.Name.Name])
 
@@ -397,6 +418,8 @@ func IncrementallyCompile(a *Archive, importPath string, files []*ast.File, file
 						de.DeclCode = c.translateToplevelFunction(fun, funcInfo)
 					})
 					funcDecls = append(funcDecls, &de)
+					own()
+					declDeps[declID(o)] = mergeDeps(de.DceDeps, usedDecls(fun, typesInfo, pkg))
 					pp("place3, appending to newCodeText: de.DeclCode='%s'", string(de.DeclCode))
 					newCodeText = append(newCodeText, de.DeclCode)
 
@@ -427,7 +450,8 @@ func IncrementallyCompile(a *Archive, importPath string, files []*ast.File, file
 				case token.TYPE:
 					pp("we're in the token.TYPE!")
 					for _, spec := range d.Specs {
-						o := c.p.Defs[spec.(*ast.TypeSpec).Name].(*types.TypeName)
+						ts := spec.(*ast.TypeSpec)
+						o := c.p.Defs[ts.Name].(*types.TypeName)
 						c.p.typeNames = append(c.p.typeNames, o)
 						c.objectName(o) // register toplevel name
 
@@ -437,6 +461,8 @@ func IncrementallyCompile(a *Archive, importPath string, files []*ast.File, file
 						decl, by := c.oneNamedType(collectDependencies, o)
 						newCodeText = append(newCodeText, by)
 						typeDecls = append(typeDecls, decl)
+						own()
+						declDeps[declID(o)] = mergeDeps(decl.DceDeps, usedDecls(ts, typesInfo, pkg))
 						pp("named type codegen for '%s' generated: '%s'", o, string(by))
 					}
 				case token.VAR:
@@ -474,7 +500,7 @@ func IncrementallyCompile(a *Archive, importPath string, files []*ast.File, file
 										// test 923, name='tm'
 										var x string
 										typStr := o.Type().String()
-										if isShad, typShortName := isShadowStruct(typStr); isShad {
+										if isShad, typShortName := c.p.isShadowStruct(typStr); isShad {
 											//vv("type '%s' is a binary struct", typStr)
 											// binary, call the ctor
 											// ex: __type__.time.Time()
@@ -757,12 +783,14 @@ func IncrementallyCompile(a *Archive, importPath string, files []*ast.File, file
 			Check:        check,
 			Pkg:          pkg,
 			FuncSrcCache: funcSrcCache,
+			DeclDeps:     declDeps,
 		}, nil
 	} else {
 		a.Pkg = pkg
 		a.Check = check
 		a.NewCodeText = newCodeText
 		a.FuncSrcCache = funcSrcCache
+		a.DeclDeps = declDeps
 	}
 	return a, nil
 }
@@ -0,0 +1,270 @@
+package compiler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gijit/gi/pkg/front"
+	"github.com/gijit/gi/pkg/muse"
+	"github.com/gijit/gi/pkg/types"
+)
+
+// Interpreter is gijit for embedding in a Go program:
+// one Lua state and one type checker, evaluating Go
+// source and writing what it prints to its own Stdout
+// and Stderr. A process may hold as many as it likes;
+// each is independent of the others, and of the package
+// globals the gi command uses (LuajitMain, the verbose
+// flags). Its methods are safe to call from any goroutine,
+// one Eval at a time.
+//
+// Its notes and warnings, a package reloading, say, or
+// what a load skipped, go to Stderr. Output from binary
+// packages, fmt.Println say, still goes to the process's
+// os.Stdout, as do the startup notes of a cfg that isn't
+// Quiet.
+type Interpreter struct {
+	// Stdout and Stderr receive what the code prints and
+	// the report of a failed eval. nil means os.Stdout
+	// and os.Stderr. Set them before the first Eval.
+	Stdout io.Writer
+	Stderr io.Writer
+
+	cfg *GIConfig
+	lvm *LuaVm
+	inc *IncrState
+
+	mut   sync.Mutex
+	lines int // how many lines of source Eval has seen
+}
+
+// Result is what an Eval gives back.
+type Result struct {
+	// Text shows the value of an expression, as the
+	// prompt would; it is empty for a statement.
+	Text string
+
+	// Values are the values of the expression, made Go
+	// values of the Go types that muse.Pun gives for
+	// Types, their static types; both are nil for a
+	// statement. Reflect cannot make named types, so a
+	// value of a named type comes as its underlying type,
+	// and one of an interface type as interface{}. A value
+	// with no Go form, a func say, or a struct with
+	// unexported fields, is left nil.
+	Values []interface{}
+	Types  []types.Type
+
+	// Elapsed is how long the Lua took to run.
+	Elapsed time.Duration
+}
+
+var errInterpreterClosed = errors.New("gi: Eval on a closed Interpreter")
+
+// NewInterpreter starts an Interpreter with its own Lua
+// state and prelude. cfg may be nil, for the defaults; in
+// raw Lua mode, Eval runs Lua rather than Go.
+func NewInterpreter(cfg *GIConfig) (*Interpreter, error) {
+	if cfg == nil {
+		cfg = NewGIConfig()
+		cfg.Quiet = true
+	}
+	lvm, err := NewLuaVmWithPrelude(cfg)
+	if err != nil {
+		return nil, err
+	}
+	in := &Interpreter{
+		cfg: cfg,
+		lvm: lvm,
+		inc: NewIncrState(lvm, cfg),
+	}
+	in.inc.warn = in.writeErr
+
+	// see kernel.lua
+	t := lvm.goro.newTicket(`__gijit_kernelCapture(); __gijit_errCapture()`, false)
+	t.regmap["__gijit_kernelWrite"] = in.writeOut
+	t.regmap["__gijit_errWrite"] = in.writeErr
+	err = t.Do()
+	if err != nil {
+		in.Close()
+		return nil, err
+	}
+	return in, nil
+}
+
+// Eval translates src and runs it, as if typed at the
+// prompt: declarations and variables persist from one
+// Eval to the next. A compile error, or a panic that
+// src doesn't recover, is returned as the error.
+//
+// If ctx is done while src is blocked, on a receive,
+// a select or a sleep, Eval abandons it and returns
+// ctx.Err(). Go code that computes without blocking
+// runs to completion.
+func (in *Interpreter) Eval(ctx context.Context, src string) (res Result, err error) {
+	in.mut.Lock()
+	defer in.mut.Unlock()
+
+	if in.lvm == nil {
+		return res, errInterpreterClosed
+	}
+	if err = ctx.Err(); err != nil {
+		return res, err
+	}
+
+	use := src
+	if !in.cfg.RawLua {
+		eof, syntaxErr, empty, _ := front.TopLevelParseGoSource([]byte(src))
+		if empty {
+			return res, nil
+		}
+		if eof && !syntaxErr {
+			return res, fmt.Errorf("incomplete input: '%s'", strings.TrimSpace(src))
+		}
+
+		lua, err := in.inc.ReloadChanged()
+		if len(lua) > 0 {
+			if err := in.run(ctx, string(lua)); err != nil {
+				return res, err
+			}
+		}
+		if err != nil {
+			return res, err
+		}
+
+		in.inc.histLine = in.lines + 1
+		in.lines += strings.Count(strings.TrimRight(src, "\n"), "\n") + 1
+		use, err = TranslateAndCatchPanic(in.inc, []byte(src))
+		if err != nil {
+			return res, err
+		}
+	}
+
+	t0 := time.Now()
+	err = in.run(ctx, use)
+	res.Elapsed = time.Since(t0)
+	if err != nil {
+		return res, err
+	}
+	if !in.cfg.RawLua {
+		res.Text, err = in.takeResult()
+		if err == nil && in.inc.ansTypes != nil {
+			res.Types = in.inc.ansTypes
+			res.Values = in.takeValues(res.Types)
+		}
+	}
+	return res, err
+}
+
+// Close stops the Interpreter and frees its Lua state.
+// Eval after Close returns an error.
+func (in *Interpreter) Close() error {
+	in.mut.Lock()
+	defer in.mut.Unlock()
+
+	if in.lvm == nil {
+		return nil
+	}
+	if s := in.inc.Session; s != nil && s.Watcher != nil {
+		s.Watcher.Close()
+	}
+	in.lvm.Close()
+	in.lvm = nil
+	return nil
+}
+
+// run runs use on the Lua state, giving up on it
+// if ctx is done while it blocks.
+func (in *Interpreter) run(ctx context.Context, use string) error {
+	// forget any Interrupt left over from a late cancel.
+	in.lvm.takeInterrupt()
+
+	ran := make(chan struct{})
+	defer close(ran)
+	go func() {
+		select {
+		case <-ctx.Done():
+			in.lvm.Interrupt()
+		case <-ran:
+		}
+	}()
+
+	err := LuaRun(in.lvm, use, !in.cfg.RawLua)
+	if err == nil && !in.cfg.RawLua {
+		// the eval coroutine leaves a failure in __lastEvalErr.
+		t := in.lvm.goro.newTicket(`__lastEvalErr = tostring(__lastEvalErr)`, false)
+		t.varname["__lastEvalErr"] = nil
+		t.gettyp = GetString
+		err = t.Do()
+		if msg, _ := t.varname["__lastEvalErr"].(string); err == nil && msg != "" {
+			err = errors.New(msg)
+		}
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if err != nil {
+		return errors.New(in.inc.srcMaps.goPositions(err.Error()))
+	}
+	return nil
+}
+
+// takeResult gives what __gijit_printQuoted showed
+// of the last expression.
+func (in *Interpreter) takeResult() (string, error) {
+	t := in.lvm.goro.newTicket(`__gijit_kernelTakeResult()`, false)
+	t.varname["__gijit_kernelResult"] = nil
+	t.gettyp = GetString
+	if err := t.Do(); err != nil {
+		return "", err
+	}
+	text, _ := t.varname["__gijit_kernelResult"].(string)
+	return strings.TrimSuffix(text, "\n"), nil
+}
+
+// takeValues converts the values in __gijit_ans to Go,
+// as their static types ts say.
+func (in *Interpreter) takeValues(ts []types.Type) []interface{} {
+	m := muse.NewMuse()
+	vals := make([]interface{}, len(ts))
+	for i, tt := range ts {
+		rt, err := m.Pun(tt)
+		if err != nil {
+			continue
+		}
+		p := reflect.New(rt)
+		t := in.lvm.goro.newTicket(fmt.Sprintf(`__gijit_ansElem = __gi_GetRangeCheck(__gijit_ans, %d)`, i), false)
+		t.varname["__gijit_ansElem"] = p.Interface()
+		t.gettyp = GetGo
+		if t.Do() != nil {
+			continue
+		}
+		vals[i] = p.Elem().Interface()
+	}
+	return vals
+}
+
+// writeOut is __gijit_kernelWrite.
+func (in *Interpreter) writeOut(s string) {
+	w := in.Stdout
+	if w == nil {
+		w = os.Stdout
+	}
+	io.WriteString(w, s)
+}
+
+// writeErr is __gijit_errWrite.
+func (in *Interpreter) writeErr(s string) {
+	w := in.Stderr
+	if w == nil {
+		w = os.Stderr
+	}
+	io.WriteString(w, s)
+}
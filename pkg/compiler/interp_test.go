@@ -0,0 +1,166 @@
+package compiler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1700InterpretersAreIndependent(t *testing.T) {
+
+	cv.Convey(`several Interpreters in one process should each keep their own variables and output, and Eval should return results and errors rather than print them`, t, func() {
+
+		const n = 3
+		var wg sync.WaitGroup
+		texts := make([]string, n)
+		outs := make([]string, n)
+		errs := make([]error, n)
+		for k := 0; k < n; k++ {
+			wg.Add(1)
+			go func(k int) {
+				defer wg.Done()
+				in, err := NewInterpreter(nil)
+				panicOn(err)
+				defer in.Close()
+				var out bytes.Buffer
+				in.Stdout = &out
+
+				ctx := context.Background()
+				_, errs[k] = in.Eval(ctx, fmt.Sprintf("a := %d\nprintln(\"a is\", a)", k))
+				if errs[k] == nil {
+					var res Result
+					res, errs[k] = in.Eval(ctx, `a * 10`)
+					texts[k] = res.Text
+				}
+				outs[k] = out.String()
+			}(k)
+		}
+		wg.Wait()
+		for k := 0; k < n; k++ {
+			cv.So(errs[k], cv.ShouldBeNil)
+			cv.So(texts[k], cv.ShouldEqual, fmt.Sprintf("%d", k*10))
+			cv.So(outs[k], cv.ShouldEqual, fmt.Sprintf("a is\t%dLL\n", k))
+		}
+
+		in, err := NewInterpreter(nil)
+		panicOn(err)
+		var stderr bytes.Buffer
+		in.Stderr = &stderr
+		ctx := context.Background()
+
+		_, err = in.Eval(ctx, `b := undefinedName + 1`)
+		cv.So(err, cv.ShouldNotBeNil)
+
+		_, err = in.Eval(ctx, "s := []int{1}\n_ = s[3]")
+		cv.So(err, cv.ShouldNotBeNil)
+		cv.So(err.Error(), cv.ShouldStartWith, "gi>:#3: runtime error: index out of range [3] with length 1")
+		cv.So(strings.Contains(stderr.String(), "index out of range"), cv.ShouldBeTrue)
+
+		// nobody will ever send.
+		_, err = in.Eval(ctx, "ch := make(chan int)\nc := <-ch")
+		cv.So(err, cv.ShouldNotBeNil)
+		cv.So(err.Error(), cv.ShouldEqual, "all goroutines are asleep - deadlock!")
+
+		// a ticking goroutine keeps that from being a
+		// deadlock; the context must end the wait.
+		LuaRunAndReport(in.lvm, `__tt = {Now = function() return 1 end}; __installTimers(__tt)`)
+		_, err = in.Eval(ctx, `var sleep func(int64)`)
+		panicOn(err)
+		LuaRunAndReport(in.lvm, `sleep = __tt.Sleep`)
+		tctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		_, err = in.Eval(tctx, "go func() { for { sleep(1000000) } }()\nc = <-ch")
+		cancel()
+		cv.So(err == context.DeadlineExceeded, cv.ShouldBeTrue)
+
+		res, err := in.Eval(ctx, `len(s) + 1`)
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(res.Text, cv.ShouldEqual, "2")
+
+		in.Close()
+		_, err = in.Eval(ctx, `1`)
+		cv.So(err == errInterpreterClosed, cv.ShouldBeTrue)
+	})
+}
+
+func Test1701EvalReturnsGoValues(t *testing.T) {
+
+	cv.Convey(`Eval of an expression should return its value as a Go value, along with its static Go type, so the host can use it`, t, func() {
+
+		in, err := NewInterpreter(nil)
+		panicOn(err)
+		defer in.Close()
+		ctx := context.Background()
+		eval := func(src string) Result {
+			res, err := in.Eval(ctx, src)
+			panicOn(err)
+			return res
+		}
+
+		res := eval(`1 + 2`)
+		cv.So(res.Values, cv.ShouldResemble, []interface{}{3})
+		cv.So(res.Types[0].String(), cv.ShouldEqual, "int")
+
+		cv.So(eval(`2.5`).Values, cv.ShouldResemble, []interface{}{2.5})
+		cv.So(eval(`"hi"`).Values, cv.ShouldResemble, []interface{}{"hi"})
+		cv.So(eval(`uint8(200)`).Values, cv.ShouldResemble, []interface{}{uint8(200)})
+
+		eval(`s := []int{1, 2, 3}`)
+		cv.So(eval(`s[1:]`).Values, cv.ShouldResemble, []interface{}{[]int{2, 3}})
+
+		eval(`m := map[string]int{"a": 1, "b": 2}`)
+		cv.So(eval(`m`).Values, cv.ShouldResemble, []interface{}{map[string]int{"a": 1, "b": 2}})
+
+		// reflect can't make named types, so a T comes
+		// back as its underlying struct.
+		eval(`type T struct { A int; B []string; M map[int]bool }`)
+		res = eval(`T{A: 4, B: []string{"x"}, M: map[int]bool{7: true}}`)
+		cv.So(res.Types[0].String(), cv.ShouldEqual, "main.T")
+		cv.So(res.Values, cv.ShouldResemble, []interface{}{struct {
+			A int
+			B []string
+			M map[int]bool
+		}{4, []string{"x"}, map[int]bool{7: true}}})
+
+		// no Go form for a func.
+		eval(`f := func() {}`)
+		res = eval(`f`)
+		cv.So(res.Types[0].String(), cv.ShouldEqual, "func()")
+		cv.So(res.Values, cv.ShouldResemble, []interface{}{nil})
+
+		// statements have no values.
+		res = eval(`x := 1`)
+		cv.So(res.Values, cv.ShouldBeNil)
+		cv.So(res.Types, cv.ShouldBeNil)
+	})
+}
+
+func Test1702WarningsGoToStderr(t *testing.T) {
+
+	cv.Convey(`the warnings an Interpreter prints along the way, here about a value whose type was redefined, should go to its Stderr, not the process's stdout`, t, func() {
+
+		in, err := NewInterpreter(nil)
+		panicOn(err)
+		defer in.Close()
+		var out, errOut bytes.Buffer
+		in.Stdout = &out
+		in.Stderr = &errOut
+
+		ctx := context.Background()
+		for _, src := range []string{
+			`type T struct{ A int }`,
+			`var t T`,
+			`type T struct{ B string }`,
+		} {
+			_, err = in.Eval(ctx, src)
+			panicOn(err)
+		}
+		cv.So(errOut.String(), cv.ShouldContainSubstring, "warning: 't' holds a value of type 'T' from before that type was redefined")
+		cv.So(out.String(), cv.ShouldEqual, "")
+	})
+}
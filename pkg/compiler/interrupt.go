@@ -0,0 +1,54 @@
+package compiler
+
+import (
+	"os"
+	"os/signal"
+	"sync/atomic"
+)
+
+// loopCheckEvery is how many iterations a loop
+// runs between calls to __gijitLoopCheck. Each loop
+// counts down in a Lua local, so the check costs
+// next to nothing until the count runs out.
+const loopCheckEvery = 10000
+
+// Interrupt asks the Lua side to give up on
+// whatever the code from the prompt is blocked
+// on: a receive, a select, or a sleep. The
+// scheduler in chan.lua polls for it while it
+// waits. Interrupt is safe to call from any goroutine.
+func (lvm *LuaVm) Interrupt() {
+	atomic.StoreInt32(&lvm.interrupted, 1)
+}
+
+// takeInterrupt reports whether Interrupt was
+// called since the last takeInterrupt, and resets it.
+// Lua calls it as __gijit_takeInterrupt().
+func (lvm *LuaVm) takeInterrupt() bool {
+	return atomic.SwapInt32(&lvm.interrupted, 0) == 1
+}
+
+// catchInterrupts turns Ctrl-C into lvm.Interrupt()
+// until the returned stop function is called.
+func (lvm *LuaVm) catchInterrupts() (stop func()) {
+	// forget any Ctrl-C from before we started.
+	lvm.takeInterrupt()
+
+	sigs := make(chan os.Signal, 1)
+	done := make(chan struct{})
+	signal.Notify(sigs, os.Interrupt)
+	go func() {
+		for {
+			select {
+			case <-sigs:
+				lvm.Interrupt()
+			case <-done:
+				return
+			}
+		}
+	}()
+	return func() {
+		signal.Stop(sigs)
+		close(done)
+	}
+}
@@ -0,0 +1,78 @@
+package compiler
+
+import (
+	"testing"
+	"time"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1610InterruptRunawayLoop(t *testing.T) {
+
+	cv.Convey(`Interrupt() should stop a runaway loop at the prompt, run its defers, and leave earlier globals intact`, t, func() {
+
+		vm, err := NewLuaVmWithPrelude(nil)
+		panicOn(err)
+		defer vm.Close()
+		inc := NewIncrState(vm, nil)
+
+		interruptSoon := func() {
+			go func() {
+				time.Sleep(50 * time.Millisecond)
+				vm.Interrupt()
+			}()
+		}
+
+		code := `a := 1; func f() { defer func() { a = 2 }(); for { } }`
+		LuaRunAndReport(vm, string(inc.trMust([]byte(code))))
+
+		interruptSoon()
+		LuaRunAndReport(vm, string(inc.trMust([]byte(`c := 1; f()`))))
+		LuaMustInt64(vm, "a", 2)
+
+		interruptSoon()
+		LuaRunAndReport(vm, string(inc.trMust([]byte(`n := 0; for { n++ }`))))
+		LuaMustInt64(vm, "a", 2)
+
+		LuaRunAndReport(vm, string(inc.trMust([]byte(`b := a + 1`))))
+		LuaMustInt64(vm, "b", 3)
+	})
+}
+
+func Test1611InterruptNotSwallowedByRecover(t *testing.T) {
+
+	cv.Convey(`a deferred recover() inside the interrupted loop's function should not swallow the interrupt; the defer still runs`, t, func() {
+
+		vm, err := NewLuaVmWithPrelude(nil)
+		panicOn(err)
+		defer vm.Close()
+		inc := NewIncrState(vm, nil)
+
+		code := `r := 0; after := 0; func f() { defer func() { recover(); r = 1 }(); for { } }`
+		LuaRunAndReport(vm, string(inc.trMust([]byte(code))))
+
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			vm.Interrupt()
+		}()
+		LuaRunAndReport(vm, string(inc.trMust([]byte(`f(); after = 1`))))
+		LuaMustInt64(vm, "r", 1)
+		// f() did not return normally, so after was never set.
+		LuaMustInt64(vm, "after", 0)
+	})
+}
+
+func BenchmarkLoopCheck(b *testing.B) {
+	vm, err := NewLuaVmWithPrelude(nil)
+	panicOn(err)
+	defer vm.Close()
+	inc := NewIncrState(vm, nil)
+
+	LuaRunAndReport(vm, string(inc.trMust([]byte(`func spin(n int) int { s := 0; for i := 0; i < n; i++ { s += i }; return s }`))))
+	lua := string(inc.trMust([]byte(`x := spin(10000000)`)))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		LuaRunAndReport(vm, lua)
+	}
+}
@@ -0,0 +1,181 @@
+package compiler
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"runtime"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gijit/gi/pkg/front"
+	"github.com/gijit/gi/pkg/kernel"
+)
+
+// ServeKernel runs r as a Jupyter kernel on the
+// sockets named in connFile, until the frontend
+// shuts it down. This is `gi -kernel <connection-file>`.
+func (r *Repl) ServeKernel(connFile string) error {
+	conn, err := kernel.ReadConnectionFile(connFile)
+	if err != nil {
+		return err
+	}
+	k, err := r.startKernel(conn)
+	if err != nil {
+		return err
+	}
+	defer k.Close()
+
+	// Jupyter interrupts by SIGINT unless the kernelspec
+	// says interrupt_mode "message". While code runs,
+	// runLua turns SIGINT into r.lvm.Interrupt(); in
+	// between, SIGINT must not kill the kernel.
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt)
+	defer signal.Stop(sigs)
+	go func() {
+		for range sigs {
+		}
+	}()
+
+	return k.Serve()
+}
+
+// startKernel binds the sockets in conn, ready to Serve.
+func (r *Repl) startKernel(conn *kernel.ConnectionInfo) (*kernel.Kernel, error) {
+	h := &kernelHandler{r: r}
+
+	// send print and io.write output to os.Stdout,
+	// where Execute captures it; and keep the value of
+	// an expression apart.
+	t := r.lvm.goro.newTicket(`__gijit_kernelCapture()`, false)
+	t.regmap["__gijit_kernelWrite"] = h.write
+	err := t.Do()
+	if err != nil {
+		return nil, err
+	}
+
+	goVersion := GoVersion
+	if goVersion == "" {
+		goVersion = runtime.Version()
+	}
+	return kernel.NewKernel(conn, h, kernel.KernelInfo{
+		Implementation:        "gijit",
+		ImplementationVersion: NearestGitTag,
+		Banner:                "gijit: a go interpreter, just-in-time.",
+		Language: kernel.LanguageInfo{
+			Name:          "go",
+			Version:       strings.TrimPrefix(goVersion, "go"),
+			Mimetype:      "text/x-go",
+			FileExtension: ".go",
+		},
+	})
+}
+
+// kernelHandler runs Jupyter cells through the
+// same translate-and-run path as the prompt.
+type kernelHandler struct {
+	r *Repl
+}
+
+// write is __gijit_kernelWrite.
+func (h *kernelHandler) write(s string) {
+	os.Stdout.WriteString(s)
+}
+
+func (h *kernelHandler) Interrupt() {
+	h.r.lvm.Interrupt()
+}
+
+func (h *kernelHandler) IsComplete(code string) string {
+	if h.r.cfg.RawLua {
+		return "unknown"
+	}
+	eof, syntaxErr, empty, _ := front.TopLevelParseGoSource([]byte(code))
+	switch {
+	case empty:
+		return "complete"
+	case eof && !syntaxErr:
+		return "incomplete"
+	case syntaxErr:
+		return "invalid"
+	}
+	return "complete"
+}
+
+// Execute runs code with os.Stdout redirected into a
+// pipe, whose contents go to stream as they arrive.
+func (h *kernelHandler) Execute(code string, stream func(name, text string)) (result string, err error) {
+	rd, wr, err := os.Pipe()
+	if err != nil {
+		return "", err
+	}
+	copied := make(chan struct{})
+	go func() {
+		defer close(copied)
+		var pending []byte
+		buf := make([]byte, 4096)
+		for {
+			n, err := rd.Read(buf)
+			pending = append(pending, buf[:n]...)
+			if err != nil {
+				stream("stdout", string(pending))
+				return
+			}
+			// don't split a UTF-8 sequence across messages.
+			cut := len(pending)
+			for i := len(pending) - 1; i >= 0 && i >= len(pending)-utf8.UTFMax; i-- {
+				if utf8.RuneStart(pending[i]) {
+					if !utf8.FullRune(pending[i:]) {
+						cut = i
+					}
+					break
+				}
+			}
+			stream("stdout", string(pending[:cut]))
+			pending = append([]byte(nil), pending[cut:]...)
+		}
+	}()
+
+	stdout := os.Stdout
+	os.Stdout = wr
+	result, err = h.run(code)
+	os.Stdout = stdout
+	wr.Close()
+	<-copied
+	rd.Close()
+	return
+}
+
+func (h *kernelHandler) run(code string) (result string, err error) {
+	r := h.r
+	use := code
+	if !r.cfg.RawLua {
+		eof, syntaxErr, empty, _ := front.TopLevelParseGoSource([]byte(code))
+		if empty {
+			return "", nil
+		}
+		if eof && !syntaxErr {
+			return "", fmt.Errorf("incomplete input: '%s'", strings.TrimSpace(code))
+		}
+		r.reloadChanged()
+		use, err = TranslateAndCatchPanic(r.inc, []byte(code))
+		if err != nil {
+			return "", err
+		}
+	}
+	err = r.runLua(use)
+	if err != nil {
+		return "", err
+	}
+
+	t := r.lvm.goro.newTicket(`__gijit_kernelTakeResult()`, false)
+	t.varname["__gijit_kernelResult"] = nil
+	t.gettyp = GetString
+	err = t.Do()
+	if err != nil {
+		return "", err
+	}
+	result, _ = t.varname["__gijit_kernelResult"].(string)
+	return strings.TrimSuffix(result, "\n"), nil
+}
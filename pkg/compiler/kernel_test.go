@@ -0,0 +1,99 @@
+package compiler
+
+import (
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/gijit/gi/pkg/kernel"
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1620JupyterKernel(t *testing.T) {
+
+	cv.Convey(`gi -kernel should run cells sent by a Jupyter frontend, returning printed output as stream messages and the value of an expression as an execute_result`, t, func() {
+
+		// don't mess up the user's regular ~/.gijit.hist file with our test.
+		origHome := os.Getenv("HOME")
+		tempdir, err := ioutil.TempDir("", "gijit-test")
+		panicOn(err)
+		defer os.RemoveAll(tempdir)
+		os.Setenv("HOME", tempdir)
+		defer os.Setenv("HOME", origHome)
+
+		myflags := flag.NewFlagSet("gi", flag.ExitOnError)
+		cfg := NewGIConfig()
+		cfg.DefineFlags(myflags)
+		err = myflags.Parse([]string{"-q", "-no-liner", "-d"})
+		panicOn(err)
+		panicOn(cfg.ValidateConfig())
+		r := NewRepl(cfg)
+		defer r.lvm.Close()
+
+		// port 0: let the kernel pick free ones.
+		conn := &kernel.ConnectionInfo{
+			Transport:       "tcp",
+			IP:              "127.0.0.1",
+			Key:             "gijit-test-key",
+			SignatureScheme: "hmac-sha256",
+		}
+		k, err := r.startKernel(conn)
+		panicOn(err)
+		defer k.Close()
+		go k.Serve()
+
+		c, err := kernel.Dial(conn)
+		panicOn(err)
+		defer c.Close()
+
+		content := func(m *kernel.Message) map[string]interface{} {
+			var c map[string]interface{}
+			panicOn(json.Unmarshal(m.Content, &c))
+			return c
+		}
+		// run code, and sort what comes back.
+		exec := func(code string) (status, stdout, result string) {
+			reply, pub, err := c.Execute(code)
+			panicOn(err)
+			status = content(reply)["status"].(string)
+			for _, m := range pub {
+				switch m.Header.MsgType {
+				case "stream":
+					stdout += content(m)["text"].(string)
+				case "execute_result":
+					result = content(m)["data"].(map[string]interface{})["text/plain"].(string)
+				}
+			}
+			return
+		}
+
+		status, stdout, result := exec(`a := 40`)
+		cv.So(status, cv.ShouldEqual, "ok")
+		cv.So(stdout, cv.ShouldEqual, "")
+		cv.So(result, cv.ShouldEqual, "")
+
+		status, stdout, result = exec(`println("a is", a)`)
+		cv.So(status, cv.ShouldEqual, "ok")
+		// same text as at the prompt, int64 suffix and all.
+		cv.So(stdout, cv.ShouldEqual, "a is\t40LL\n")
+		cv.So(result, cv.ShouldEqual, "")
+
+		status, stdout, result = exec(`a + 2`)
+		cv.So(status, cv.ShouldEqual, "ok")
+		cv.So(stdout, cv.ShouldEqual, "")
+		cv.So(result, cv.ShouldEqual, "42")
+
+		status, _, _ = exec(`a = "not an int"`)
+		cv.So(status, cv.ShouldEqual, "error")
+
+		reply, err := c.Request("is_complete_request", map[string]interface{}{"code": "func f() {"})
+		panicOn(err)
+		cv.So(content(reply)["status"], cv.ShouldEqual, "incomplete")
+
+		reply, err = c.ControlRequest("shutdown_request", map[string]interface{}{"restart": false})
+		panicOn(err)
+		cv.So(reply.Header.MsgType, cv.ShouldEqual, "shutdown_reply")
+	})
+}
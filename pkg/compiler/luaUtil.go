@@ -32,7 +32,9 @@ const reserveMainThread = false
 // to turn on -vv very verbose debug printing.
 var dbg = &verb.VerboseVerbose
 
+// nyc is loaded once, by the first vm to start.
 var nyc *time.Location
+var nycOnce sync.Once
 
 type LuaVm struct {
 	cfg *GIConfig
@@ -40,6 +42,9 @@ type LuaVm struct {
 
 	goro *Goro
 	mut  sync.Mutex
+
+	// set by Interrupt, see interrupt.go.
+	interrupted int32
 }
 
 func (lvm *LuaVm) Close() {
@@ -91,7 +96,14 @@ func NewLuaVmWithPrelude(cfg *GIConfig) (lvm *LuaVm, err error) {
 	}
 
 	vm = luar.Init() // does vm.OpenLibs() for us, adds luar. functions.
-	registerLuarReqs(vm)
+	err = registerLuarReqs(vm)
+	if err != nil {
+		vm.Close()
+		return nil, err
+	}
+	luar.Register(vm, "", luar.Map{
+		"__gijit_takeInterrupt": lvm.takeInterrupt,
+	})
 	lvm.vm = vm
 
 	// before any LuaRun, must setup the lvm.goro
@@ -138,12 +150,14 @@ func NewLuaVmWithPrelude(cfg *GIConfig) (lvm *LuaVm, err error) {
 			// also load timezone, for windows
 			if nm == "zoneinfo" {
 				//fmt.Printf("loading zoneinfo/\n")
-				f, err := preludeFiles.Open("zoneinfo/America/New_York")
-				panicOn(err)
-				nyctzdata, err := ioutil.ReadAll(f)
-				panicOn(err)
-				nyc, err = time.LoadLocationFromTZData("America/New_York", nyctzdata)
-				panicOn(err)
+				nycOnce.Do(func() {
+					f, err := preludeFiles.Open("zoneinfo/America/New_York")
+					panicOn(err)
+					nyctzdata, err := ioutil.ReadAll(f)
+					panicOn(err)
+					nyc, err = time.LoadLocationFromTZData("America/New_York", nyctzdata)
+					panicOn(err)
+				})
 				//fmt.Printf("nyc is '%s'\n", nyc)
 			} else {
 				if !fi.IsDir() && fi.Size() > 0 && strings.HasSuffix(nm, ".lua") {
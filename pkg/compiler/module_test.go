@@ -0,0 +1,94 @@
+package compiler
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1750ImportFromGoModule(t *testing.T) {
+
+	cv.Convey(`inside a module, source imports should resolve by its go.mod: the module's own packages, its requirements in the module cache, replace directives, and vendor/`, t, func() {
+
+		top, err := ioutil.TempDir("", "gi-module")
+		panicOn(err)
+		defer os.RemoveAll(top)
+		write := func(name, content string) {
+			name = filepath.Join(top, name)
+			panicOn(os.MkdirAll(filepath.Dir(name), 0755))
+			panicOn(ioutil.WriteFile(name, []byte(content), 0644))
+		}
+		write("ourlib/go.mod", `module example.com/ourlib
+
+go 1.12
+
+require (
+	example.com/Dep v1.2.0
+	example.com/rep v0.1.0 // indirect
+	example.com/gone v1.0.0
+)
+
+replace example.com/rep => ../rep
+`)
+		write("ourlib/stats/stats.go", "package stats\n\nfunc Double(x int) int { return 2 * x }\n")
+		write("cache/example.com/!dep@v1.2.0/go.mod", "module example.com/Dep\n")
+		write("cache/example.com/!dep@v1.2.0/tri/tri.go", "package tri\n\nfunc Triple(x int) int { return 3 * x }\n")
+		write("rep/go.mod", "module example.com/rep\n")
+		write("rep/rep.go", "package rep\n\nfunc Quad(x int) int { return 4 * x }\n")
+
+		write("vend/go.mod", "module example.com/vend\n\nrequire example.com/v v1.0.0\n")
+		write("vend/vendor/modules.txt", "# example.com/v v1.0.0\nexample.com/v\n")
+		write("vend/vendor/example.com/v/v.go", "package v\n\nfunc Five() int { return 5 }\n")
+
+		wd, err := os.Getwd()
+		panicOn(err)
+		defer os.Chdir(wd)
+		for k, v := range map[string]string{
+			"GO111MODULE": "on",
+			"GOMODCACHE":  filepath.Join(top, "cache"),
+			"GOFLAGS":     "",
+		} {
+			defer os.Setenv(k, os.Getenv(k))
+			os.Setenv(k, v)
+		}
+
+		// the prelude is found from the working directory.
+		in, err := NewInterpreter(nil)
+		panicOn(err)
+		defer in.Close()
+		in2, err := NewInterpreter(nil)
+		panicOn(err)
+		defer in2.Close()
+
+		ctx := context.Background()
+		panicOn(os.Chdir(filepath.Join(top, "ourlib")))
+		for _, src := range []string{
+			`import "example.com/ourlib/stats"`,
+			`import "example.com/Dep/tri"`,
+			`import "example.com/rep"`,
+		} {
+			_, err = in.Eval(ctx, src)
+			panicOn(err)
+		}
+		res, err := in.Eval(ctx, `stats.Double(1) + tri.Triple(10) + rep.Quad(100)`)
+		panicOn(err)
+		cv.So(res.Text, cv.ShouldEqual, "432")
+
+		// nothing is downloaded.
+		_, err = in.Eval(ctx, `import "example.com/gone/x"`)
+		cv.So(err, cv.ShouldNotBeNil)
+		cv.So(strings.Contains(err.Error(), "go mod download example.com/gone@v1.0.0"), cv.ShouldBeTrue)
+
+		panicOn(os.Chdir(filepath.Join(top, "vend")))
+		_, err = in2.Eval(ctx, `import "example.com/v"`)
+		panicOn(err)
+		res, err = in2.Eval(ctx, `v.Five()`)
+		panicOn(err)
+		cv.So(res.Text, cv.ShouldEqual, "5")
+	})
+}
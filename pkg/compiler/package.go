@@ -35,13 +35,15 @@ type pkgContext struct {
 	varPtrNames  map[*types.Var]string
 	anonTypes    []*types.TypeName
 	anonTypeMap  typeutil.Map
-	escapingVars map[*types.Var]bool
 	indentation  int
 	dependencies map[types.Object]bool
 	minify       bool
 	fileSet      *token.FileSet
 	files        []*ast.File
 	errList      ErrorList
+
+	// the binary packages imported, from the ImportContext.
+	binaryPackage map[string]bool
 }
 
 func (p *pkgContext) SelectionOf(e *ast.SelectorExpr) (selection, bool) {
@@ -113,6 +115,9 @@ type flowData struct {
 type ImportContext struct {
 	Packages map[string]*types.Package
 	Import   func(path, pkgDir string, depth int) (*Archive, error)
+
+	// distinguish binary imports from source imports
+	Binary map[string]bool
 }
 
 // packageImporter implements go/types.Importer interface.
@@ -375,7 +380,6 @@ func translateFunction(typ *ast.FuncType, recv *ast.Ident, body *ast.BlockStmt,
 	for k, v := range outerContext.allVars {
 		c.allVars[k] = v
 	}
-	prevEV := c.p.escapingVars
 	preComputedNamedNames := []string{}
 	preComputedZeroRet := []string{}
 
@@ -394,11 +398,6 @@ func translateFunction(typ *ast.FuncType, recv *ast.Ident, body *ast.BlockStmt,
 	}
 
 	bodyOutput := string(c.CatchOutput(1, func() {
-		if len(c.Blocking) != 0 {
-			c.p.Scopes[body] = c.p.Scopes[typ]
-			c.handleEscapingVars(body)
-		}
-
 		if c.sig != nil && c.sig.Results().Len() != 0 && c.sig.Results().At(0).Name() != "" {
 			c.resultNames = make([]ast.Expr, c.sig.Results().Len())
 			for i := 0; i < c.sig.Results().Len(); i++ {
@@ -529,7 +528,6 @@ func translateFunction(typ *ast.FuncType, recv *ast.Ident, body *ast.BlockStmt,
 		//functionWord = ""
 	}
 
-	c.p.escapingVars = prevEV
 
 	if c.HasDefer {
 		pp("jea TODO: prefix is '%s'... should we not discard?", prefix)
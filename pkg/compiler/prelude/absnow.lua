@@ -3,7 +3,10 @@
 local ffi = require("ffi")
 
 -- establish a fine-grained __abs_now()
--- that can be used for nanosecond timing.
+-- that can be used for nanosecond timing,
+-- and __sleep_ns(ns), which blocks the whole
+-- thread; see the timers in chan.lua for
+-- sleeping just one goroutine.
 
 if jit.os == "Windows" then
    ffi.cdef[[
@@ -31,6 +34,7 @@ if jit.os == "Windows" then
     int __stdcall QueryPerformanceCounter(
       LARGE_INTEGER *lpPerformanceCount
    );
+   void __stdcall Sleep(DWORD dwMilliseconds);
 
    ]]
 
@@ -44,6 +48,12 @@ if jit.os == "Windows" then
       ffi.C.QueryPerformanceCounter(now)
       return int64(now.QuadPart * nanoSecPerCount)
    end
+
+   -- Sleep only has millisecond resolution; round up
+   -- so we never wake before a timer is due.
+   __sleep_ns=function(ns)
+      ffi.C.Sleep(tonumber((ns + 999999LL) / 1000000LL))
+   end
    
 elseif jit.os == "OSX" then
 
@@ -56,6 +66,13 @@ elseif jit.os == "OSX" then
    typedef struct mach_timebase_info *mach_timebase_info_t;
    typedef struct mach_timebase_info mach_timebase_info_data_t;
    void mach_timebase_info(mach_timebase_info_t info);
+
+    typedef long time_t;
+    struct timespec {
+            time_t   tv_sec;
+            long     tv_nsec;
+    };
+    int nanosleep(const struct timespec *req, struct timespec *rem);
    ]]
    local info = ffi.new("mach_timebase_info_data_t")
    ffi.C.mach_timebase_info(info);
@@ -68,6 +85,13 @@ elseif jit.os == "OSX" then
    __abs_now=function()
       return int64(ffi.C.mach_absolute_time())
    end
+
+   __sleep_ns=function(ns)
+      local req = ffi.new("struct timespec")
+      req.tv_sec = ns / 1000000000LL
+      req.tv_nsec = ns % 1000000000LL
+      ffi.C.nanosleep(req, nil)
+   end
    
 else
    -- for linux, clock_gettime(CLOCK_MONOTONIC)
@@ -81,6 +105,7 @@ else
             long     tv_nsec;       /* nanoseconds */
     } nanotime;
     int clock_gettime(clockid_t clk_id, struct timespec *tp);
+    int nanosleep(const struct timespec *req, struct timespec *rem);
    ]]
 
    __abs_now=function()
@@ -91,4 +116,11 @@ else
       return int64(pnano[0].tv_sec * 1000000000 + pnano[0].tv_nsec)
    end
 
+   __sleep_ns=function(ns)
+      local req = ffi.new("nanotime")
+      req.tv_sec = ns / 1000000000LL
+      req.tv_nsec = ns % 1000000000LL
+      ffi.C.nanosleep(req, nil)
+   end
+
 end
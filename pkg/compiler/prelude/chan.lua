@@ -80,7 +80,11 @@ local TIMEOUT = {err = "TIMEOUT"}
 -- Global objects for scheduler
 local tasks_runnable = {}       -- list of coroutines ready to be resumed
 local tasks_to = {}             -- all the timeout tasks
+local timers = {}               -- pending timers, see start_timer
+local blocked_on = {}           -- coroutine -> alt_array it waits on
+local sleeping = {}             -- coroutine -> timer it waits on
 local altexec
+local cancel_task
 
 __all_coro = {} -- array
 
@@ -217,6 +221,102 @@ local CircularBuffer = {
    end,
 }
 
+----------------------------------------------------------------------------
+-- Timers
+--
+-- A timer is a table {when=, period=, f=}. Once __abs_now()
+-- reaches when, the scheduler calls f(timer) from the scheduler
+-- coroutine, so f must not block. A timer with a period is
+-- then re-armed; otherwise it is removed.
+
+local function start_timer(t)
+   t.active = true
+   table.insert(timers, t)
+   return t
+end
+
+-- stop_timer returns true if t was still pending.
+local function stop_timer(t)
+   if not t.active then
+      return false
+   end
+   t.active = false
+   for i, v in ipairs(timers) do
+      if v == t then
+         table.remove(timers, i)
+         break
+      end
+   end
+   return true
+end
+
+local function fire_timers(now)
+   local due = {}
+   local keep = {}
+   for _, t in ipairs(timers) do
+      if t.when <= now then
+         table.insert(due, t)
+      else
+         table.insert(keep, t)
+      end
+   end
+   timers = keep
+   for _, t in ipairs(due) do
+      if t.period ~= nil and t.period > 0 then
+         -- like Go's tickers, drop ticks we are too late for.
+         t.when = t.when + t.period
+         if t.when <= now then
+            t.when = now + t.period
+         end
+         table.insert(timers, t)
+      else
+         t.active = false
+      end
+      t.f(t)
+   end
+   return #due
+end
+
+-- next_deadline gives the earliest time at which a timer
+-- or a receive timeout is due, or nil if none is pending.
+local function next_deadline()
+   local when
+   for _, t in ipairs(timers) do
+      if when == nil or t.when < when then
+         when = t.when
+      end
+   end
+   for _, alt in pairs(tasks_to) do
+      if alt and alt.to and (when == nil or alt.to < when) then
+         when = alt.to
+      end
+   end
+   return when
+end
+
+-- eval_blocked is true while the code from the prompt is
+-- waiting on a channel or a sleep.
+local function eval_blocked()
+   local co = __gijitEvalCoro
+   return co ~= nil and coroutine.status(co) == "suspended"
+end
+
+-- sleep parks the current goroutine for d nanoseconds.
+-- The main and scheduler coroutines cannot yield, so
+-- there we have no choice but to sleep the whole thread.
+local function sleep(d)
+   local co, is_main = coroutine.running()
+   if co == nil or is_main or co == scheduler_co then
+      if d > 0 then
+         __sleep_ns(d)
+      end
+      return
+   end
+   sleeping[co] = start_timer({when = __abs_now() + d, f = function() __task_ready(co) end})
+   coroutine.yield()
+   sleeping[co] = nil
+end
+
 ----------------------------------------------------------------------------
 -- Scheduling
 --
@@ -250,42 +350,83 @@ local function scheduler()
    
    local i = 0
    while true do
-      local nr = #tasks_runnable
-      if nr == 0 then
-         --print("scheduler: no more runnable tasks")
-         break
+      while true do
+         local nr = #tasks_runnable
+         if nr == 0 then
+            --print("scheduler: no more runnable tasks")
+            break
+         end
+         -- jea: pick one at random
+         local k = __builtin_math.random(nr)
+         local co = table.remove(tasks_runnable, k)
+         tasks_to[co] = nil
+
+         -- and resume co
+         --print("scheduler: coroutine.resume about to be called on co="..__costring(co))
+         local back = {coroutine.resume(co, "scheduler")}
+         --print("scheduler: got back from resume of "..__costring(co)..": ", unpack(back))
+         
+         local okay, emsg = unpack(back)
+         if not okay then
+            if __gijitIsInterrupt(emsg) then
+               -- Ctrl-C stopped this goroutine; the rest go on.
+               print(__atGo(debug.traceback(co, "interrupted: goroutine "..__costring(co))))
+            else
+               print(__atGo(debug.traceback(emsg)))
+               error(emsg)
+            end
+         end
+         i = i + 1
+         --print("scheduler: resume was okay, i is now = ", i)      
       end
-      -- jea: pick one at random
-      local k = __builtin_math.random(nr)
-      local co = table.remove(tasks_runnable, k)
-      tasks_to[co] = nil
-
-      -- and resume co
-      --print("scheduler: coroutine.resume about to be called on co="..__costring(co))
-      local back = {coroutine.resume(co, "scheduler")}
-      --print("scheduler: got back from resume of "..__costring(co)..": ", unpack(back))
+
+      local now = __abs_now()
+      --print("scheduler: checking for timeouts, here is tasks_to: "..type(tasks_to))
       
-      local okay, emsg = unpack(back)
-      if not okay then
-         print(debug.traceback(emsg))
-         error(emsg)
+      --print("scheduler: just before pairs(tasks_to)")
+      for co, alt in pairs(tasks_to) do
+         --print("scheduler: top of tasks_to loop")
+         --print("scheduler: on tasks_to, on k=",k,"  we have co = ", co, " and alt=", alt)
+         if alt and now >= alt.to then
+            altexec(alt)
+            tasks_to[co] = nil
+            alt.c:_get_alts(RECV):remove(alt)
+         end
       end
-      i = i + 1
-      --print("scheduler: resume was okay, i is now = ", i)      
-   end
 
-   local now = __abs_now()
-   --print("scheduler: checking for timeouts, here is tasks_to: "..type(tasks_to))
-   
-   local k = 0
-   --print("scheduler: just before pairs(tasks_to)")
-   for co, alt in pairs(tasks_to) do
-      --print("scheduler: top of tasks_to loop")
-      --print("scheduler: on tasks_to, on k=",k,"  we have co = ", co, " and alt=", alt)
-      if alt and now >= alt.to then
-         altexec(alt)
-         tasks_to[co] = nil
-         alt.c:_get_alts(RECV):remove(alt)
+      fire_timers(now)
+
+      if #tasks_runnable == 0 then
+         -- Keep going while the code from the prompt
+         -- is blocked; otherwise return, and let the
+         -- repl have the thread back.
+         if not eval_blocked() then
+            break
+         end
+         if __gijit_takeInterrupt ~= nil and __gijit_takeInterrupt() then
+            print("interrupted: abandoning the blocked receive or select.")
+            __lastEvalErr = "interrupted"
+            cancel_task(__gijitEvalCoro)
+            __gijitEvalCoro = nil
+            break
+         end
+         local when = next_deadline()
+         if when == nil then
+            -- nothing can ever wake it.
+            print("all goroutines are asleep - deadlock! abandoning the blocked receive or select.")
+            __lastEvalErr = "all goroutines are asleep - deadlock!"
+            cancel_task(__gijitEvalCoro)
+            __gijitEvalCoro = nil
+            break
+         end
+         local wait = when - __abs_now()
+         if wait > 0 then
+            -- wake at least every 50 msec to check for Ctrl-C.
+            if wait > 50000000LL then
+               wait = 50000000LL
+            end
+            __sleep_ns(wait)
+         end
       end
    end
    
@@ -373,8 +514,13 @@ local function altcopy(a, b)
          r.alt_array.value = TIMEOUT
          r.alt_array.resolved = 1
          return true
-      elseif r.closed then
+      elseif r.closed or (c.closed and c._buf:len() == 0) then
+         -- closed and drained: the zero value, and ok is false.
          r.alt_array.value = nil
+         if c.__elemTyp ~= nil then
+            r.alt_array.value = c.__elemTyp.zero()
+         end
+         r.alt_array.closed = true
          r.alt_array.resolved = 1
          return true
       else
@@ -400,6 +546,9 @@ end
 -- Can this Alt be execed without blocking?
 local function altcanexec(a)
    local c, op = a.c, a.op
+   if op == RECV and c.closed and c._buf:len() == 0 then
+      return true
+   end
    if c._buf.size == 0 then
       if op ~= NOP then
          return c:_get_other_alts(op):len() > 0
@@ -528,6 +677,9 @@ select_inner = function(alt_array)
              "op field must be RECV, SEND or NOP in alt")
       assert(type(a.c) == "table" and a.c.__index == __M.Channel,
              "pass valid channel to a c field of alt")
+      if a.op == SEND and a.c.closed then
+         __throwRuntimeError("send on closed channel", true)
+      end
       if altcanexec(a) == true then
          table.insert(list_of_canexec_i, i)
       elseif a.to then
@@ -590,7 +742,9 @@ select_inner = function(alt_array)
    local current_co, is_main = coroutine.running()  
    --print("about to yield from (is_main? ",is_main," co=", current_co, " / ", __costring(current_co))
    
+   blocked_on[current_co] = alt_array
    local who = coroutine.yield()
+   blocked_on[current_co] = nil
    --print("select: resumed by who='"..who.."'")
    
    assert(alt_array.resolved > 0)
@@ -603,6 +757,33 @@ select_inner = function(alt_array)
 end
 
 
+-- cancel_task withdraws co from whatever it is blocked on,
+-- and forgets it, so that it is never resumed.
+cancel_task = function(co)
+   local alt_array = blocked_on[co]
+   if alt_array ~= nil then
+      altalldequeue(alt_array)
+      blocked_on[co] = nil
+   end
+   local t = sleeping[co]
+   if t ~= nil then
+      stop_timer(t)
+      sleeping[co] = nil
+   end
+   tasks_to[co] = nil
+   task_park(co)
+   for i, c in ipairs(__all_coro) do
+      if c == co then
+         table.remove(__all_coro, i)
+         break
+      end
+   end
+   __coro2notes[co] = nil
+   for i, c in ipairs(__all_coro) do
+      __coro2notes[c].__loc = i
+   end
+end
+
 ----------------------------------------------------------------------------
 -- Channel object
 
@@ -640,6 +821,10 @@ local Channel = {
    end,
 
    close = function(self)
+      if self.closed then
+         __throwRuntimeError("close of closed channel", true)
+      end
+      self.closed = true
       local alts = self:_get_alts(RECV)
       for _, v in ipairs(alts.l) do
          v.closed = true
@@ -712,7 +897,7 @@ __resume_scheduler = function()
    --print("__task.resume_scheduler back from coroutine.resume(scheduler_co)")
    if not ok then
       print("error detected in __task.resume_scheduler!")
-      print(debug.traceback(err))
+      print(__atGo(debug.traceback(err)))
       __showco()
       __stacks()
       error(err)
@@ -736,6 +921,9 @@ __task.RECV      = RECV
 __task.SEND      = SEND
 __task.NOP       = NOP
 __task.Error     = {TIMEOUT = TIMEOUT}
+__task.start_timer = start_timer
+__task.stop_timer  = stop_timer
+__task.sleep       = sleep
 ----------------------------------------------------------------------------
 ----------------------------------------------------------------------------
 
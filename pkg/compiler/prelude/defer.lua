@@ -20,8 +20,30 @@ end
 -- can we have one global definition of panic and recover?
 -- This would be preferred to repeating them in every function.
 
--- string viewing of panic value
-__recovMT = {__tostring = function(v) return 'a-panic-value:' .. tostring(v[1]) end}
+-- string viewing of panic value; a runtime.Error
+-- panic carries its positioned message in text.
+__recovMT = {__tostring = function(v)
+                if v.text ~= nil then
+                   return v.text
+                end
+                return 'a-panic-value:' .. tostring(v[1])
+end}
+
+-- __luaFault turns err, when it is LuaJIT's report of
+-- indexing or calling nil, into the runtime.Error
+-- panic that Go gives for a nil dereference. Other
+-- errors come back unchanged.
+__luaFault = function(err)
+   if type(err) ~= "string" then
+      return err
+   end
+   local where, what = string.match(err, "^(.-:%d+: )attempt to (%a+) .*%(a nil value%)$")
+   if what ~= "index" and what ~= "call" then
+      return err
+   end
+   local val = __runtimeErrorString.ptrToNewlyConstructed("runtime error: invalid memory address or nil pointer dereference")
+   return __runtimePanicValue(val, where)
+end
 
 -- __recoverVal will be nill if no panic,
 --              or if panic happened and
@@ -46,6 +68,10 @@ recover = function()
    --print("debug `recover()`: was *direct* defer, returning __recoverVal=", __recoverVal)
    
    local cp = __recoverVal
+   if __gijitIsInterrupt(cp) then
+      -- leave the interrupt panicking; see interrupt.lua.
+      return nil
+   end
    __recoverVal = nil
    if cp ~= nil and type(cp) == "table" then
       local unwrap = cp[1]; -- unwrap from array, so raw value is returned
@@ -69,7 +95,7 @@ end
 -- prepare to handle panic/defer/recover
 __handler2 = function(err)
    --print(" __handler2 running with err =", err)
-   __recoverVal = err
+   __recoverVal = __luaFault(err)
    return err
 end
   
@@ -78,7 +104,7 @@ __panicHandler = function(err, defers)
    --print(debug.traceback())
    --print("__panicHandler running with defers:", tostring(defers))
    
-   __recoverVal = err
+   __recoverVal = __luaFault(err)
    if defers ~= nil then
       
       --print(debug.traceback(), " __panicHandler running with err =", err, " and #defer = ", #defers)      
@@ -36,6 +36,39 @@ byte = uint8
 float64 = ffi.typeof("double")
 float32 = ffi.typeof("float")
 
+-- every integer is an int64 or uint64 cdata. After
+-- arithmetic or a conversion, the narrower types
+-- are wrapped to their width, through the ffi types
+-- above, to get the overflow of compiled Go.
+
+function __wrapInt8(x) return int64(int8(x)) end
+function __wrapInt16(x) return int64(int16(x)) end
+function __wrapInt32(x) return int64(int32(x)) end
+function __wrapUint8(x) return uint64(uint8(x)) end
+function __wrapUint16(x) return uint64(uint16(x)) end
+function __wrapUint32(x) return uint64(uint32(x)) end
+
+-- shifts by a count known only at run time. The bit
+-- library takes the count mod 64; Go shifts everything out.
+
+function __lshift(x, n)
+   if n < 0 then error("negative shift amount") end
+   if n >= 64 then return x - x end
+   return __bit.lshift(x, n)
+end
+
+function __rshift(x, n)
+   if n < 0 then error("negative shift amount") end
+   if n >= 64 then return x - x end
+   return __bit.rshift(x, n)
+end
+
+function __arshift(x, n)
+   if n < 0 then error("negative shift amount") end
+   if n >= 64 then n = 63 end
+   return __bit.arshift(x, n)
+end
+
 -- to display floats, use: tonumber() to convert to float64 that lua can print.
 
 --MinInt64: -9223372036854775808
@@ -0,0 +1,26 @@
+-- interrupt.lua: let Ctrl-C stop runaway code.
+--
+-- A debug hook cannot do this by itself: LuaJIT
+-- does not call hooks from compiled traces, and
+-- a hot loop is exactly what gets compiled. So the
+-- compiler has every loop count down a local, which
+-- stays inside the trace, and call __gijitLoopCheck
+-- when it hits zero (see loopCheckEvery in interrupt.go).
+
+-- __gijitInterrupted is the panic value. recover()
+-- will not catch it, so user code cannot swallow a
+-- Ctrl-C; defers still run. tostring() gives "interrupted".
+__gijitInterrupted = setmetatable({}, {__tostring = function() return "interrupted" end})
+
+__gijitLoopCheck = function()
+   if __gijit_takeInterrupt ~= nil and __gijit_takeInterrupt() then
+      -- panic, so that defers run on the way out.
+      panic(__gijitInterrupted)
+   end
+end
+
+-- __gijitIsInterrupt tells if err, as caught
+-- by pcall, is from __gijitLoopCheck.
+__gijitIsInterrupt = function(err)
+   return type(err) == "table" and err[1] == __gijitInterrupted
+end
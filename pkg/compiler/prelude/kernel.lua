@@ -0,0 +1,68 @@
+-- kernel.lua: output capture for `gi -kernel`,
+-- and for an embedded Interpreter.
+--
+-- Under Jupyter, what the code prints and the
+-- value of an expression go back as different
+-- messages. __gijit_kernelCapture re-points print
+-- and io.write at __gijit_kernelWrite, a Go function,
+-- and has __gijit_printQuoted, which shows
+-- __gijit_ans, print into a separate buffer.
+-- __gijit_errCapture sends the report of a failed
+-- eval to __gijit_errWrite.
+
+__gijit_kernelCapture = function()
+   local inResult = false
+   local result = {}
+
+   local emit = function(s)
+      if inResult then
+         result[#result+1] = s
+      else
+         __gijit_kernelWrite(s)
+      end
+   end
+
+   print = function(...)
+      local n = select("#", ...)
+      local parts = {}
+      for i = 1, n do
+         parts[i] = tostring((select(i, ...)))
+      end
+      emit(table.concat(parts, "\t") .. "\n")
+   end
+
+   io.write = function(...)
+      for i = 1, select("#", ...) do
+         emit(tostring((select(i, ...))))
+      end
+      return io.stdout
+   end
+
+   local printQuoted = __gijit_printQuoted
+   __gijit_printQuoted = function(...)
+      inResult = true
+      local ok, err = pcall(printQuoted, ...)
+      inResult = false
+      if not ok then
+         error(err)
+      end
+   end
+
+   -- __gijit_kernelTakeResult leaves what __gijit_printQuoted
+   -- printed since the last call in __gijit_kernelResult.
+   __gijit_kernelTakeResult = function()
+      __gijit_kernelResult = table.concat(result)
+      result = {}
+   end
+end
+
+__gijit_errCapture = function()
+   __printEvalErr = function(...)
+      local n = select("#", ...)
+      local parts = {}
+      for i = 1, n do
+         parts[i] = tostring((select(i, ...)))
+      end
+      __gijit_errWrite(table.concat(parts, "\t") .. "\n")
+   end
+end
@@ -28,7 +28,7 @@ end
 
 __integerByZeroCheck = function(x)
    if not __builtin_math.finite(x) then
-      error("integer divide by zero")
+      __throwRuntimeError("integer divide by zero")
    end
    -- eliminate any fractional part
    if x >= 0 then
@@ -37,6 +37,31 @@ __integerByZeroCheck = function(x)
    return x + (-x % 1)
 end
 
+-- integer / and %, truncating toward zero as Go
+-- does. The ffi already does so for int64 and uint64
+-- cdata, but answers a zero divisor with the
+-- most negative int64 rather than an error, and
+-- __integerByZeroCheck can't tell that apart afterwards.
+__integerQuo = function(x, y)
+   if y == 0 then
+      __throwRuntimeError("integer divide by zero")
+   end
+   if type(x) == "cdata" or type(y) == "cdata" then
+      return x / y
+   end
+   return __truncateToInt(x / y)
+end
+
+__integerRem = function(x, y)
+   if y == 0 then
+      __throwRuntimeError("integer divide by zero")
+   end
+   if type(x) == "cdata" or type(y) == "cdata" then
+      return x % y
+   end
+   return __builtin_math.fmod(x, y)
+end
+
 function __max(a,b)
    if a > b then
       return a
@@ -11,7 +11,7 @@ function __gi_GetRangeCheck(x, i)
       error "where is x nil??"
    end
    if x == nil or i < 0 or i >= #x then
-      error("index out of range: i="..tostring(i).." vs #x is "..tostring(#x))
+      __throwRuntimeError("index out of range ["..tostring(tonumber(i)).."] with length "..tostring(#x))
    end
    --print("range check on x = "..tostring(x).." at i = "..tostring(i).." with #x="..tostring(#x).." looks okay, returning value: ", x[i])
    --__st(x, "x")
@@ -21,7 +21,7 @@ end;
 function __gi_SetRangeCheck(x, i, val)
   --print("SetRangeCheck. x=".. __st(x) .." i="..tostring(i).." val=", val)
   if x == nil or i < 0 or i >= #x then
-     error("index out of range; i="..tostring(i)..". #x is "..tostring(#x))
+     __throwRuntimeError("index out of range ["..tostring(tonumber(i)).."] with length "..tostring(#x))
   end
   x[i] = val
   return val
@@ -0,0 +1,378 @@
+-- pretty.lua: show REPL results in Go syntax.
+--
+-- __gijit_pretty renders a value as a Go composite
+-- literal, []int{1, 2, 3} or T{A: 1, B: "x"}, rather than
+-- the Lua tables within. It walks the type descriptors
+-- of tsys.lua: the __typ of the value, and from there the
+-- fields of a struct type, the key and elem types of a
+-- map, and the elem type of a slice, array or pointer.
+--
+-- Integers in Lua don't know their Go type, and a T looks
+-- just like a *T, so for the top level the compiler gives
+-- the static types in __gijit_ansTypes; see TrWithPrepend
+-- in translate.go.
+
+-- the limits on what is shown; :set at the prompt changes
+-- them. 0 means no limit.
+__gijit_prettyCfg = {
+   depth = 6,   -- levels of nesting before {...}
+   elems = 100, -- elements of a slice, array or map
+   width = 80,  -- a longer literal gets a line per element
+}
+
+local prettyIndent = "    "
+
+local function prettyTypeName(typ)
+   local s = string.gsub(typ.__str, "%f[%w_]main%.", "")
+   return s
+end
+
+local function prettyQuote(s)
+   local q = string.gsub(s, '[%c"\\]', function(c)
+      if c == '"' then return '\\"' end
+      if c == "\\" then return "\\\\" end
+      if c == "\n" then return "\\n" end
+      if c == "\t" then return "\\t" end
+      if c == "\r" then return "\\r" end
+      return string.format("\\x%02x", string.byte(c))
+   end)
+   return '"' .. q .. '"'
+end
+
+local function prettyNumber(v)
+   if type(v) == "cdata" then
+      local s = string.gsub(tostring(v), "U?LL$", "")
+      if string.sub(s, -1) == "i" then
+         -- complex
+         return "(" .. s .. ")"
+      end
+      return s
+   end
+   if v ~= v then
+      return "NaN"
+   elseif v == math.huge then
+      return "+Inf"
+   elseif v == -math.huge then
+      return "-Inf"
+   end
+   return tostring(v)
+end
+
+-- the address of v, for values with no literal.
+local function prettyAddr(v)
+   local s
+   if type(v) == "table" then
+      -- past any __tostring.
+      local mt = getmetatable(v)
+      setmetatable(v, nil)
+      s = tostring(v)
+      setmetatable(v, mt)
+   else
+      s = tostring(v)
+   end
+   return string.match(s, "0x%x+") or s
+end
+
+-- prettyIsNil says if v is a nil pointer, map, func or
+-- chan, none of which has a descriptor. The nil of a
+-- pointer to struct is a table holding only itself.
+local function prettyIsNil(v)
+   if v == nil or v == false or v == __chanNil or v == __throwNilPointerError then
+      return true
+   end
+   return type(v) == "table" and rawget(v, "__get") == nil and rawget(v, "__target") == nil and
+      (next(v) == nil or (rawget(v, "__val") == v and next(v, next(v)) == nil))
+end
+
+-- the descriptor of a value's dynamic type, if it has one.
+local function prettyDynType(v)
+   if type(v) == "table" then
+      return rawget(v, "__typ")
+   end
+   return nil
+end
+
+-- prettyBasic shows a value of unknown type.
+local function prettyBasic(v)
+   local tv = type(v)
+   if tv == "string" then
+      return prettyQuote(v)
+   elseif tv == "number" or tv == "cdata" then
+      return prettyNumber(v)
+   elseif tv == "nil" then
+      return "nil"
+   elseif tv == "function" then
+      return "(func)(" .. prettyAddr(v) .. ")"
+   end
+   return tostring(v)
+end
+
+-- prettyJoin makes the literal of a composite value: on one
+-- line if it fits in the width, else a line per element.
+local function prettyJoin(head, parts, indent)
+   local flat = head .. "{" .. table.concat(parts, ", ") .. "}"
+   local width = __gijit_prettyCfg.width
+   if #parts == 0 or not ((width > 0 and #indent + #flat > width) or string.find(flat, "\n", 1, true)) then
+      return flat
+   end
+   local inner = indent .. prettyIndent
+   local lines = {head .. "{"}
+   for _, p in ipairs(parts) do
+      lines[#lines+1] = inner .. p .. ","
+   end
+   lines[#lines+1] = indent .. "}"
+   return table.concat(lines, "\n")
+end
+
+-- prettyCap caps the element count n, adding a last part
+-- to say how many were left out.
+local function prettyCap(n)
+   local max = __gijit_prettyCfg.elems
+   if max > 0 and n > max then
+      return max, "...(" .. tostring(n - max) .. " more)"
+   end
+   return n, nil
+end
+
+-- prettyKey turns a map key, made a string by keyFor,
+-- back into Go.
+local function prettyKey(k, kt)
+   local kind = kt.kind
+   if kind == __kindString then
+      return prettyQuote(k)
+   elseif kind >= __kindInt and kind <= __kindUintptr then
+      return (string.gsub(k, "U?LL$", ""))
+   end
+   return k
+end
+
+local prettyVal
+
+-- composite kinds, whose type a literal may elide inside
+-- a literal of their container.
+local function prettyElidable(typ)
+   local kind = typ.kind
+   return kind == __kindStruct or kind == __kindSlice or
+      kind == __kindArray or kind == __kindMap
+end
+
+local function prettyElems(raw, off, n, elem, depth, indent, seen)
+   local show, more = prettyCap(n)
+   local parts = {}
+   local elide = prettyElidable(elem)
+   for i = 0, show-1 do
+      parts[#parts+1] = prettyVal(raw[off+i], elem, depth+1, indent .. prettyIndent, seen, not elide)
+   end
+   if more then
+      parts[#parts+1] = more
+   end
+   return parts
+end
+
+-- prettyVal shows v, of the type described by typ. When
+-- showType is false, a composite literal leaves off its
+-- type, as inside a literal of its container.
+prettyVal = function(v, typ, depth, indent, seen, showType)
+   if typ == nil or typ.kind == __kindInterface then
+      if v == nil or v == __ifaceNil then
+         return "nil"
+      end
+      local dyn = prettyDynType(v)
+      if dyn == nil then
+         return prettyBasic(v)
+      end
+      return prettyVal(v, dyn, depth, indent, seen, true)
+   end
+
+   local kind = typ.kind
+   local name = prettyTypeName(typ)
+   local head = ""
+   if showType then
+      head = name
+   end
+
+   -- a named basic type may come wrapped.
+   if (kind <= __kindComplex128 or kind == __kindString) and type(v) == "table" and
+      rawget(v, "__val") ~= nil then
+      v = v.__val
+   end
+
+   if kind == __kindBool then
+      return tostring(v)
+   elseif kind == __kindString then
+      return prettyQuote(v)
+   elseif kind >= __kindInt and kind <= __kindComplex128 then
+      return prettyNumber(v)
+
+   elseif kind == __kindFunc then
+      if v == nil or v == __throwNilPointerError then
+         return "(" .. name .. ")(nil)"
+      end
+      return "(" .. name .. ")(" .. prettyAddr(v) .. ")"
+
+   elseif kind == __kindChan then
+      if v == nil or v == __chanNil then
+         return "(" .. name .. ")(nil)"
+      end
+      return "(" .. name .. ")(" .. prettyAddr(v) .. ")"
+
+   elseif kind == __kindPtr then
+      if v == nil or v == typ.__nil or type(v) ~= "table" then
+         return "(" .. name .. ")(nil)"
+      end
+      local elem = typ.elem
+      if elem.kind ~= __kindStruct then
+         return "(" .. name .. ")(" .. prettyAddr(v) .. ")"
+      end
+      local target = rawget(v, "__target")
+      if target == nil then
+         return "(" .. name .. ")(nil)"
+      end
+      if seen[target] then
+         -- a cycle
+         return "&" .. prettyTypeName(elem) .. "{...}"
+      end
+      return "&" .. prettyVal(target, elem, depth, indent, seen, true)
+   end
+
+   -- composites
+   if v == nil or v == false or v == typ.__nil then
+      if showType then
+         return name .. "(nil)"
+      end
+      return "nil"
+   end
+   local maxDepth = __gijit_prettyCfg.depth
+   if maxDepth > 0 and depth >= maxDepth then
+      return head .. "{...}"
+   end
+
+   if kind == __kindStruct then
+      if rawget(v, "__target") ~= nil then
+         v = v.__target
+      end
+      seen[v] = true
+      local parts = {}
+      for _, f in ipairs(typ.fields) do
+         parts[#parts+1] = f.__name .. ": " ..
+            prettyVal(v[f.__prop], f.__typ, depth+1, indent .. prettyIndent, seen, true)
+      end
+      seen[v] = nil
+      return prettyJoin(head, parts, indent)
+
+   elseif kind == __kindSlice or kind == __kindArray then
+      local raw, off, n = v, 0, typ.len
+      if rawget(v, "__array") ~= nil then
+         raw, off, n = v.__array, v.__offset, v.__length
+      end
+      local parts = prettyElems(raw, tonumber(off), tonumber(n), typ.elem, depth, indent, seen)
+      return prettyJoin(head, parts, indent)
+
+   elseif kind == __kindMap then
+      local kt, et = typ.key, typ.elem
+      local keys = {}
+      for k, _ in pairs(v.__val) do
+         keys[#keys+1] = k
+      end
+      local numeric = kt.kind >= __kindInt and kt.kind <= __kindFloat64
+      table.sort(keys, function(a, b)
+         if numeric then
+            local x = tonumber((string.gsub(a, "U?LL$", "")))
+            local y = tonumber((string.gsub(b, "U?LL$", "")))
+            if x ~= nil and y ~= nil then
+               return x < y
+            end
+         end
+         return a < b
+      end)
+      local show, more = prettyCap(#keys)
+      local elide = prettyElidable(et)
+      local parts = {}
+      if v.nilKeyStored then
+         parts[#parts+1] = "nil: " .. prettyVal(v.nilValue, et, depth+1, indent .. prettyIndent, seen, not elide)
+      end
+      for i = 1, show do
+         local e = v.__val[keys[i]]
+         if e == __intentionalNilValue then
+            e = nil
+         end
+         parts[#parts+1] = prettyKey(keys[i], kt) .. ": " ..
+            prettyVal(e, et, depth+1, indent .. prettyIndent, seen, not elide)
+      end
+      if more then
+         parts[#parts+1] = more
+      end
+      return prettyJoin(head, parts, indent)
+   end
+
+   return tostring(v)
+end
+
+-- __gijit_pretty shows v in Go syntax. static, if given,
+-- is the Go static type of v, as the compiler wrote it.
+function __gijit_pretty(v, static)
+   local dyn = prettyDynType(v)
+   if dyn == nil then
+      if static == nil then
+         return prettyBasic(v)
+      end
+      local c = string.sub(static, 1, 1)
+      local ref = c == "*" or string.sub(static, 1, 4) == "func" or string.find(static, "^<?%-?chan")
+      -- a nil pointer, map, func or chan has no descriptor.
+      if prettyIsNil(v) then
+         if ref then
+            return "(" .. static .. ")(nil)"
+         elseif string.sub(static, 1, 2) == "[]" or string.sub(static, 1, 4) == "map[" then
+            return static .. "(nil)"
+         end
+      elseif ref and (type(v) == "table" or type(v) == "function") then
+         return "(" .. static .. ")(" .. prettyAddr(v) .. ")"
+      end
+      return prettyBasic(v)
+   end
+   -- a T is held as a pointer to it.
+   if dyn.kind == __kindPtr and dyn.elem.kind == __kindStruct and
+      static ~= nil and string.sub(static, 1, 1) ~= "*" then
+      dyn = dyn.elem
+   end
+   return prettyVal(v, dyn, 0, "", {}, true)
+end
+
+-- __gijit_printQuoted prints the values of an expression
+-- typed at the prompt, one to a line.
+function __gijit_printQuoted(...)
+   local static = __gijit_ansTypes or {}
+   __gijit_ansTypes = nil
+
+   local vals = {...}
+   local n = select("#", ...)
+   if n == 1 and type(vals[1]) == "table" and rawget(vals[1], "__name") == "__lazy_ellipsis_instance" then
+      -- __gijit_printQuoted(__gijit_ans...)
+      local s = vals[1]()
+      vals, n = {}, tonumber(s.__length)
+      for i = 1, n do
+         vals[i] = s.__array[s.__offset + i - 1]
+      end
+   end
+   if #static > n then
+      -- a nil at the end leaves no trace in the slice.
+      n = #static
+   end
+   for i = 1, n do
+      print(__gijit_pretty(vals[i], static[i]))
+   end
+end
+
+-- __gijit_prettySet sets one of the limits, and
+-- __gijit_prettyShow lists them, for :set.
+function __gijit_prettySet(name, n)
+   if __gijit_prettyCfg[name] == nil then
+      error("unknown setting '" .. name .. "'; have depth, elems and width")
+   end
+   __gijit_prettyCfg[name] = n
+end
+
+function __gijit_prettyShow()
+   local c = __gijit_prettyCfg
+   return string.format("depth %d\nelems %d\nwidth %d", c.depth, c.elems, c.width)
+end
@@ -0,0 +1,298 @@
+-- session.lua: support for :save.
+--
+-- __gijit_goLiteral renders a Go value back
+-- into Go source, so that :load can re-declare
+-- user globals with the values they had.
+
+-- __gijit_quoteGo returns s as a Go interpreted string literal.
+-- Control characters, quotes, and non-ASCII bytes are escaped,
+-- so the result is valid Go source whatever s holds.
+__gijit_quoteGo = function(s)
+   local r = string.gsub(s, '[%c"\\\128-\255]', function(c)
+      if c == '"' then return '\\"' end
+      if c == '\\' then return '\\\\' end
+      if c == '\n' then return '\\n' end
+      if c == '\t' then return '\\t' end
+      if c == '\r' then return '\\r' end
+      return string.format('\\x%02x', string.byte(c))
+   end)
+   return '"' .. r .. '"'
+end
+
+-- type names as seen from inside package main.
+__gijit_goTypeStr = function(typ)
+   local r = string.gsub(typ.__str, "%f[%w_]main%.", "")
+   return r
+end
+
+local __gijit_intLit = function(x)
+   local s = tostring(x)
+   local d = string.match(s, "^(%-?%d+)U?LL$")
+   if d ~= nil then
+      return d
+   end
+   return string.format("%.0f", tonumber(x))
+end
+
+local __gijit_floatLit = function(x)
+   x = tonumber(x)
+   if x ~= x or x == math.huge or x == -math.huge then
+      -- no literal for NaN or Inf.
+      return nil
+   end
+   return string.format("%.17g", x)
+end
+
+-- map keys are stored under keyFor(k), which
+-- for the integer kinds is tostring(k).
+local __gijit_mapKeyLit = function(ks, keyType)
+   local k = keyType.kind
+   if k == __kindString then
+      return __gijit_quoteGo(ks)
+   end
+   if k >= __kindInt and k <= __kindUintptr then
+      return string.match(ks, "^(%-?%d+)U?L?L?$")
+   end
+   if k == __kindBool then
+      return ks
+   end
+   return nil
+end
+
+local __gijit_isBasicKind = function(k)
+   return k == __kindBool or k == __kindString or
+      (k >= __kindInt and k <= __kindComplex128)
+end
+
+-- __gijit_dynType finds the type of a value held
+-- in an interface. Basic values are stored unboxed,
+-- so for them we can only go by their Lua type:
+-- an int64 cdata reads back as int, a number as float64.
+__gijit_dynType = function(v)
+   local ty = type(v)
+   if ty == "table" then
+      return v.__typ
+   elseif ty == "string" then
+      return __type__.string
+   elseif ty == "boolean" then
+      return __type__.bool
+   elseif ty == "number" then
+      return __type__.float64
+   elseif ty == "cdata" then
+      local k = __basicValue2kind(v)
+      if k == __kindInt then
+         return __type__.int
+      elseif k == __kindUint64 then
+         return __type__.uint64
+      end
+   end
+   return nil
+end
+
+__gijit_unwrap = function(v, typ)
+   if type(v) == "table" and __gijit_isBasicKind(typ.kind) and v.__val ~= nil then
+      return v.__val
+   end
+   return v
+end
+
+-- __gijit_litSeen holds the pointers being rendered,
+-- so that a cycle gives no literal rather than
+-- endless recursion.
+local __gijit_litSeen = {}
+
+-- __gijit_lit returns Go source for v, of type typ,
+-- or nil if v has no literal form (a func or chan that
+-- is not nil, a pointer to a basic value, ...). When
+-- elide is true, a composite literal leaves off its
+-- type, as Go allows inside an enclosing composite literal.
+__gijit_lit = function(v, typ, elide)
+   local k = typ.kind
+   if k == __kindBool then
+      return tostring(v)
+   end
+   if k >= __kindInt and k <= __kindUintptr then
+      return __gijit_intLit(v)
+   end
+   if k == __kindFloat32 or k == __kindFloat64 then
+      return __gijit_floatLit(v)
+   end
+   if k == __kindString then
+      return __gijit_quoteGo(v)
+   end
+
+   if k == __kindInterface then
+      if v == nil then
+         return "nil"
+      end
+      local dyn = __gijit_dynType(v)
+      if dyn == nil then
+         return nil
+      end
+      local s = __gijit_lit(__gijit_unwrap(v, dyn), dyn, false)
+      if s == nil then
+         return nil
+      end
+      if dyn.kind == __kindBool or dyn.kind == __kindString or
+      (dyn.kind >= __kindInt and dyn.kind <= __kindFloat64) then
+         -- keep the dynamic type, not the default one.
+         return __gijit_goTypeStr(dyn) .. "(" .. s .. ")"
+      end
+      return s
+   end
+
+   -- nil is the one literal of a pointer, chan or
+   -- func, and the zero value of a map.
+   if (k == __kindPtr and (v == nil or v == typ.__nil)) or
+      (k == __kindChan and (v == nil or v == __chanNil)) or
+      (k == __kindFunc and (v == nil or v == __throwNilPointerError)) or
+      (k == __kindMap and (v == nil or v == false)) then
+      return "nil"
+   end
+
+   if k == __kindPtr then
+      -- a pointer to a composite value is written &T{...}.
+      local et = typ.elem
+      local ek = et.kind
+      if ek ~= __kindStruct and ek ~= __kindArray and
+      ek ~= __kindSlice and ek ~= __kindMap then
+         return nil
+      end
+      if __gijit_litSeen[v] then
+         return nil
+      end
+      -- a pointer to an array is the array itself.
+      local target = v
+      if ek ~= __kindArray then
+         if type(v) ~= "table" or v.__get == nil then
+            return nil
+         end
+         target = v.__get()
+      end
+      __gijit_litSeen[v] = true
+      local s = __gijit_lit(target, et, false)
+      __gijit_litSeen[v] = nil
+      if s == nil then
+         return nil
+      end
+      return "&" .. s
+   end
+
+   local prefix = ""
+   if not elide then
+      prefix = __gijit_goTypeStr(typ)
+   end
+   local elemElide = function(et)
+      local ek = et.kind
+      return ek == __kindStruct or ek == __kindArray or
+         ek == __kindSlice or ek == __kindMap
+   end
+
+   if k == __kindSlice or k == __kindArray then
+      if k == __kindSlice and v == typ.__nil then
+         return "nil"
+      end
+      local parts = {}
+      local et = typ.elem
+      for i = 0, #v - 1 do
+         local s = __gijit_lit(v[i], et, elemElide(et))
+         if s == nil then
+            return nil
+         end
+         parts[#parts+1] = s
+      end
+      return prefix .. "{" .. table.concat(parts, ", ") .. "}"
+   end
+
+   if k == __kindMap then
+      if v.nilKeyStored then
+         return nil
+      end
+      local keys = {}
+      for ks, _ in pairs(v.__val) do
+         keys[#keys+1] = ks
+      end
+      table.sort(keys)
+      local parts = {}
+      local et = typ.elem
+      for _, ks in ipairs(keys) do
+         local kl = __gijit_mapKeyLit(ks, typ.key)
+         if kl == nil then
+            return nil
+         end
+         local e = v.__val[ks]
+         if e == __intentionalNilValue then
+            e = nil
+         end
+         local s = __gijit_lit(e, et, elemElide(et))
+         if s == nil then
+            return nil
+         end
+         parts[#parts+1] = kl .. ": " .. s
+      end
+      return prefix .. "{" .. table.concat(parts, ", ") .. "}"
+   end
+
+   if k == __kindStruct then
+      local parts = {}
+      for _, fld in ipairs(typ.fields) do
+         local ft = fld.__typ
+         local s = __gijit_lit(v[fld.__prop], ft, elemElide(ft))
+         if s == nil then
+            return nil
+         end
+         parts[#parts+1] = fld.__name .. ": " .. s
+      end
+      return prefix .. "{" .. table.concat(parts, ", ") .. "}"
+   end
+
+   -- channels, funcs, complex, unsafe.Pointer.
+   return nil
+end
+
+-- __gijit_sameFunc gives the index, from 1, of the
+-- first of fns[1], fns[2], ... that is the same
+-- function as fns[0], or nil if none is.
+local __gijit_sameFunc = function(fns)
+   for i = 1, #fns - 1 do
+      if rawequal(fns[0], fns[i]) then
+         return tostring(i)
+      end
+   end
+   return nil
+end
+
+-- __gijit_goLiteral(a interface{}, kind string) string, callable from Go.
+-- kind names the basic type of a when a is a basic value,
+-- since those are not boxed, or its type when a is a struct
+-- of package main; it is "" otherwise.
+-- As a special case, kind "func" takes a []interface{}
+-- of funcs and gives __gijit_sameFunc of it.
+-- The result is also left in __gijit_lastLiteral,
+-- for the Go side to fetch; nil there means no literal.
+__gijit_goLiteral = function(a, kind)
+   __gijit_lastLiteral = nil
+   __gijit_litSeen = {}
+   if a == nil then
+      return ""
+   end
+   if kind == "func" then
+      __gijit_lastLiteral = __gijit_sameFunc(a)
+      return __gijit_lastLiteral or ""
+   end
+   local typ
+   if kind ~= nil and kind ~= "" then
+      typ = __type__[kind]
+   else
+      typ = __gijit_dynType(a)
+   end
+   if typ == nil then
+      return ""
+   end
+   local ok, s = pcall(__gijit_lit, __gijit_unwrap(a, typ), typ, false)
+   if not ok or s == nil then
+      return ""
+   end
+   __gijit_lastLiteral = s
+   return s
+end
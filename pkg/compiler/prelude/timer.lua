@@ -0,0 +1,80 @@
+-- timer.lua: Lua-native versions of the blocking
+-- parts of package time.
+--
+-- The shadowed time.After, time.Sleep, etc. would
+-- block the OS thread, and with it every goroutine.
+-- __installTimers swaps them, on the imported
+-- time table, for versions built on the timers in
+-- chan.lua, which park only the calling coroutine.
+
+__installTimers = function(pkg)
+   local now = pkg.Now
+   local elem = __type__.time and __type__.time.Time
+
+   -- send the time without blocking: like Go, a
+   -- timer channel holds one value and drops the rest.
+   local sendTime = function(c)
+      __task.select({{c = c, op = __task.SEND, p = now()}, {}})
+   end
+
+   local newTimer = function(d, f, period)
+      local t = {}
+      local tm = {when = __abs_now() + d, period = period, f = f}
+      t.Stop = function()
+         return __task.stop_timer(tm)
+      end
+      -- Go code may call this as t.Reset(d) or
+      -- t:Reset(d); drop the receiver if we got one.
+      t.Reset = function(a, b)
+         local d2 = a
+         if a == t then
+            d2 = b
+         end
+         local active = __task.stop_timer(tm)
+         tm.when = __abs_now() + d2
+         if period ~= nil then
+            tm.period = d2
+         end
+         __task.start_timer(tm)
+         return active
+      end
+      __task.start_timer(tm)
+      return t
+   end
+
+   pkg.Sleep = function(d)
+      __task.sleep(d)
+   end
+
+   pkg.NewTimer = function(d)
+      local c = __task.Channel:new(1, elem)
+      local t = newTimer(d, function() sendTime(c) end)
+      t.C = c
+      return t
+   end
+
+   pkg.After = function(d)
+      return pkg.NewTimer(d).C
+   end
+
+   pkg.AfterFunc = function(d, f)
+      return newTimer(d, function() __task.spawn(f, {}) end)
+   end
+
+   pkg.NewTicker = function(d)
+      if d <= 0 then
+         error("non-positive interval for NewTicker")
+      end
+      local c = __task.Channel:new(1, elem)
+      local t = newTimer(d, function() sendTime(c) end, d)
+      t.C = c
+      return t
+   end
+
+   pkg.Tick = function(d)
+      if d <= 0 then
+         return nil
+      end
+      return pkg.NewTicker(d).C
+   end
+end
@@ -576,7 +576,44 @@ end
 
 --
 __flushConsole = function() end;
-__throwRuntimeError = function(...) error(...) end
+-- __goCaller gives the "chunk:line: " of the nearest
+-- translated Go on the stack, or "" if there is none,
+-- so that a fault is placed there and not in the prelude.
+__goCaller = function()
+   local level = 2
+   while true do
+      local info = debug.getinfo(level, "Sl")
+      if info == nil then
+         return ""
+      end
+      if string.find(info.short_src, "-- gi chunk", 1, true) ~= nil then
+         return info.short_src..":"..tostring(info.currentline)..": "
+      end
+      level = level + 1
+   end
+end
+
+-- __runtimePanicValue wraps the runtime.Error val as
+-- a panic value, shown as where and then its message.
+__runtimePanicValue = function(val, where)
+   local pv = {val, text = where..val:Error()}
+   setmetatable(pv, __recovMT)
+   return pv
+end
+
+-- __throwRuntimeError panics with a runtime.Error, as
+-- the Go runtime does on a fault. Unless plain, msg gets
+-- the "runtime error: " prefix that Go gives it.
+__throwRuntimeError = function(msg, plain)
+   local val
+   if plain then
+      val = __runtimePlainError.ptrToNewlyConstructed(msg)
+   else
+      val = __runtimeErrorString.ptrToNewlyConstructed("runtime error: "..msg)
+   end
+   __recoverVal = __runtimePanicValue(val, __goCaller())
+   error(__recoverVal)
+end
 __throwNilPointerError = function()  __throwRuntimeError("invalid memory address or nil pointer dereference"); end;
 __call = function(fn, rcvr, args)  return fn(rcvr, args); end;
 __makeFunc = function(fn)
@@ -698,8 +735,18 @@ __subslice = function(slice, low, high, max)
    if high == nil then
       
    end
-   if low < 0  or  (high ~= nil and high < low)  or  (max ~= nil and high ~= nil and max < high)  or  (high ~= nil and high > slice.__capacity)  or  (max ~= nil and max > slice.__capacity) then
-      __throwRuntimeError("slice bounds out of range");
+   local cap = slice.__capacity
+   if max ~= nil and max > cap then
+      __throwRuntimeError("slice bounds out of range [::"..tostring(tonumber(max)).."] with capacity "..tostring(cap));
+   end
+   if max ~= nil and high ~= nil and high > max then
+      __throwRuntimeError("slice bounds out of range [:"..tostring(tonumber(high))..":"..tostring(tonumber(max)).."]");
+   end
+   if high ~= nil and high > cap then
+      __throwRuntimeError("slice bounds out of range [:"..tostring(tonumber(high)).."] with capacity "..tostring(cap));
+   end
+   if low < 0  or  (high ~= nil and high < low) then
+      __throwRuntimeError("slice bounds out of range ["..tostring(tonumber(low))..":"..tostring(tonumber(high or slice.__length)).."]");
    end
    
    local s = slice.__constructor.tfun(slice.__array);
@@ -899,8 +946,11 @@ end;
 --
 
 __substring = function(str, low, high)
-   if low < 0  or  high < low  or  high > #str then
-      __throwRuntimeError("string slice bounds out of range");
+   if high > #str then
+      __throwRuntimeError("slice bounds out of range [:"..tostring(tonumber(high)).."] with length "..tostring(#str));
+   end
+   if low < 0  or  high < low then
+      __throwRuntimeError("slice bounds out of range ["..tostring(tonumber(low))..":"..tostring(tonumber(high)).."]");
    end
    return string.sub(str, low+1, high); -- high is inclusive, so no +1 needed.
 end;
@@ -1001,8 +1051,7 @@ __valueArrayMT = {
       end
       --__st(t.__val)
       if k < 0 or k >= #t then
-         print(debug.traceback())
-         error("read from array error: access out-of-bounds; "..tostring(k).." is outside [0, "  .. tostring(#t) .. ")")
+         __throwRuntimeError("index out of range ["..tostring(k).."] with length "..tostring(#t))
       end
       --print("array access bounds check ok.")
       --__st(t.__array, "t.__array")
@@ -1090,8 +1139,7 @@ __valueSliceMT = {
       local w = t.__offset + k
       --print("index slice with w = "..type(w).." value: "..tostring(w))
       if k < 0 or k >= t.__capacity then
-         print(debug.traceback())
-         error("slice error: access out-of-bounds, k="..tostring(k).."; cap="..tostring(t.__capacity))
+         __throwRuntimeError("index out of range ["..tostring(k).."] with length "..tostring(t.__capacity))
       end
       --print("slice access bounds check ok: w = ", w)
       --__st(t.__array, "t.__array")
@@ -1851,6 +1899,21 @@ __newType = function(size, kind, str, named, pkg, exported, constructor)
          --   constructor(typ.ptr.__nil)
          --end
          typ.ptr.__nil.__val = typ.ptr.__nil;
+         -- reading or writing a field through the nil
+         -- pointer is a nil dereference.
+         setmetatable(typ.ptr.__nil, {
+                         __index = function(t, k)
+                            if properties[k] ~= nil then
+                               __throwNilPointerError()
+                            end
+                         end,
+                         __newindex = function(t, k, v)
+                            if properties[k] ~= nil then
+                               __throwNilPointerError()
+                            end
+                            rawset(t, k, v)
+                         end,
+         })
          -- /* methods for embedded fields */
          __addMethodSynthesizer(function()
                local synthesizeMethod = function(target, m, f)
@@ -2259,7 +2322,7 @@ function __Chan(elem, capacity, elemReflectType)
 
    -- gopherJS stuff below
    if capacity < 0  or  capacity > 2147483647 then
-      __throwRuntimeError("makechan: size out of range");
+      __throwRuntimeError("makechan: size out of range", true);
    end
    this.elem = elem;
    this.__capacity = capacity;
@@ -2276,7 +2339,7 @@ end;
 function __Chan_GopherJS(elem, capacity)
    local this = {}
    if capacity < 0  or  capacity > 2147483647 then
-      __throwRuntimeError("makechan: size out of range");
+      __throwRuntimeError("makechan: size out of range", true);
    end
    this.elem = elem;
    this.__capacity = capacity;
@@ -2389,6 +2452,42 @@ __type__.emptyInterface = __interfaceType({});
 __ifaceNil = {};
 __error = __newType(8, __kindInterface, "error", true, "", false, nil);
 __error.init({{__prop= "Error", __name= "Error", __pkg= "", __typ= __funcType({}, {__type__.string}, false) }});
+-- the compiler names it by its universe name, like int.
+__type__.error = __error;
+
+-- the concrete types of the runtime.Error values that
+-- faults panic with: errorString for those Go reports
+-- as "runtime error: ...", and plainError for the rest,
+-- like "close of closed channel". Both hold the full
+-- message, and implement runtime.Error on the pointer.
+__runtimeErrorType = function(name)
+   local typ = __newType(0, __kindStruct, "runtime."..name, true, "runtime", false, nil)
+   local methods = {
+      {prop= "Error", __name= "Error", __pkg="", __typ= __funcType({}, {__type__.string}, false)},
+      {prop= "RuntimeError", __name= "RuntimeError", __pkg="", __typ= __funcType({}, {}, false)},
+   }
+   typ.__methods_desc = methods
+   __ptrType(typ)
+   typ.ptr.__methods_desc = methods
+   typ.init("runtime", {{__prop= "s", __name= "s", __anonymous= false, __exported= false, __typ= __type__.string, __tag= ""}})
+   typ.__constructor = function(...)
+      local self = {}
+      self.s = ...
+      self.s = self.s or ""
+      return self
+   end
+   typ.ptr.prototype.Error = function(e) return e.s end
+   typ.prototype.Error = function(this) return typ.ptr.prototype.Error(this.__val) end
+   typ.ptr.prototype.RuntimeError = function(e) end
+   typ.prototype.RuntimeError = function(this) return typ.ptr.prototype.RuntimeError(this.__val) end
+   for _, m in ipairs(methods) do
+      typ.__addToMethods(m)
+      typ.ptr.__addToMethods(m)
+   end
+   return typ
+end
+__runtimeErrorString = __runtimeErrorType("errorString")
+__runtimePlainError = __runtimeErrorType("plainError")
 
 __mapTypes = {};
 __mapType = function(key, elem, mType)
@@ -2761,10 +2860,10 @@ __makeSlice = function(typ, length, capacity)
       capacity = tonumber(capacity)
    end
    if length < 0  or  length > 9007199254740992 then -- 2^53
-      __throwRuntimeError("makeslice: len out of range");
+      __throwRuntimeError("makeslice: len out of range", true);
    end
    if capacity < 0  or  capacity < length  or  capacity > 9007199254740992 then
-      __throwRuntimeError("makeslice: cap out of range: "..tostring(capacity));
+      __throwRuntimeError("makeslice: cap out of range", true);
    end
    local array = __newAnyArrayValue(typ.elem, capacity)
    local slice = typ(array);
@@ -2908,13 +3007,38 @@ __interfaceIsEqual = function(a, b)
 end;
 
 
+-- __assertionError is Go's message for the failed
+-- type assertion of value to typ.
+__assertionError = function(value, typ, missingMethod)
+   if value == __ifaceNil then
+      return "interface conversion: interface is nil, not "..typ.__str
+   end
+   local have
+   if type(value) == "table" and value.__typ ~= nil then
+      have = value.__typ.__str
+   else
+      have = string.lower(string.sub(__kind2str[__basicValue2kind(value)] or "__kindUnknown", 7))
+   end
+   if missingMethod ~= "" then
+      return "interface conversion: "..have.." is not "..typ.__str..": missing method "..missingMethod
+   end
+   return "interface conversion: interface {} is "..have..", not "..typ.__str
+end
+
 __assertType = function(value, typ, returnTuple)
 
    local isInterface = (typ.kind == __kindInterface)
    local ok = false
    local missingMethod = "";
-   if value == __ifaceNil then
+   if value == __ifaceNil or value == nil then
+      value = __ifaceNil
       ok = false;
+   elseif isInterface and (type(value) ~= "table" or value.__typ == nil) then
+      -- a basic value has no methods.
+      ok = (#typ.methods == 0)
+      if not ok then
+         missingMethod = typ.methods[1].__name
+      end
    elseif  not isInterface then
       local knd = __basicValue2kind(value)
       if knd ~= __kindUnknown then
@@ -2972,19 +3096,16 @@ __assertType = function(value, typ, returnTuple)
    end
    --print("__assertType: after matching loop, ok = ", ok)
    
+   -- returnTuple is 0 for x.(T), which panics on failure.
    if not ok then
-      if returnTuple then
+      if returnTuple ~= 0 then
          if isInterface then
             return nil, false
          end
          return typ.zero(), false
       end
-      local msg = ""
-      if value ~= __ifaceNil then
-         msg = value.__typ.__str
-      end
       --__panic(__packages["runtime"].TypeAssertionError.ptr("", msg, typ.__str, missingMethod));
-      error("type-assertion-error: could not '"..msg.."' -> '"..typ.__str.."', missing method '"..missingMethod.."'")
+      __throwRuntimeError(__assertionError(value, typ, missingMethod), true)
    end
    
    if not isInterface then
@@ -3101,34 +3222,6 @@ function __lazy_ellipsis(t)
    return r
 end
 
-function __printHelper(v)
-
-   local tv = type(v)
-   if tv == "string" then
-      print("\""..v.."\"") -- used to be backticks
-   elseif tv == "table" then
-      if v.__name == "__lazy_ellipsis_instance" then
-         local expand = v()
-         for _,c in pairs(expand) do
-            __printHelper(c)
-         end
-         return
-      end
-   end
-   print(v)
-end
-
-function __gijit_printQuoted(...)
-   local a = {...}
-   --print("__gijit_printQuoted called, a = " .. tostring(a), " len=", #a)
-   if a[0] ~= nil then
-      __printHelper(a[0])
-   end
-   for _,v in ipairs(a) do
-      __printHelper(v)
-   end
-end
-
 -- last thing, so we store all types/vars defined so far
 __storeBuiltins()
 
@@ -3256,10 +3349,27 @@ __throw = function(err)  error(err); end;
 
 __lastEvalErr = ""
 
+-- __goPositions, when gi has registered it, rewrites
+-- the chunk:line references in a Lua error message or
+-- traceback to the Go file:line they came from.
+__atGo = function(s)
+   if __goPositions ~= nil and type(s) == "string" then
+      return __goPositions(s)
+   end
+   return s
+end
+
+-- __printEvalErr shows a failed eval. An embedded
+-- Interpreter points it at its own Stderr.
+__printEvalErr = function(...)
+   print(...)
+end
+
 __errHandlerForEval = function(err)
+   err = __luaFault(err)
    __lastEvalErr = err
-   print("error! __errHandlerForEval sees err =", err)
-   print(debug.traceback(coroutine.running(), err))
+   __printEvalErr("error! __errHandlerForEval sees err =", __atGo(tostring(err)))
+   __printEvalErr(__atGo(debug.traceback(coroutine.running(), tostring(err))))
    return err
 end
 
@@ -124,7 +124,7 @@ end;
 
 __block = function() 
    if __curGoroutine == __noGoroutine then
-      __throwRuntimeError("cannot block in JavaScript callback, fix by wrapping code in goroutine");
+      __throwRuntimeError("cannot block in JavaScript callback, fix by wrapping code in goroutine", true);
    end
    __curGoroutine.asleep = true;
 end;
@@ -156,7 +156,7 @@ end;
 
 __close = function(chan) 
    if chan.__closed then
-      __throwRuntimeError("close of closed channel");
+      __throwRuntimeError("close of closed channel", true);
    end
    chan.__closed = true;
    while true do
@@ -177,7 +177,7 @@ end;
 
 __send = function(chan, value) 
    if chan.__closed then
-      __throwRuntimeError("send on closed channel");
+      __throwRuntimeError("send on closed channel", true);
    end
    local queuedRecv = chan.__recvQueue.shift();
    if queuedRecv ~= nil then
@@ -200,7 +200,7 @@ __send = function(chan, value)
    return {
       __blk= function() 
          if closedDuringSend then
-            __throwRuntimeError("send on closed channel");
+            __throwRuntimeError("send on closed channel", true);
          end
       end
    };
@@ -226,7 +226,7 @@ __select = function(comms)
       elseif comm_len == 2 then
          -- send --
          if chan.__closed then
-            __throwRuntimeError("send on closed channel");
+            __throwRuntimeError("send on closed channel", true);
          end
          if #chan.__recvQueue ~= 0 or #chan.__buffer < chan.__capacity then
             ready.push(i-1);
@@ -287,7 +287,7 @@ __select = function(comms)
          -- send --
          local queueEntry = function() 
             if comm[1].__closed then
-               __throwRuntimeError("send on closed channel");
+               __throwRuntimeError("send on closed channel", true);
             end
             f.selection = {i};
             removeFromQueues();
@@ -30,17 +30,17 @@ var preludeFiles = func() http.FileSystem {
 		},
 		"/absnow.lua": &vfsgen۰CompressedFileInfo{
 			name:             "absnow.lua",
-			modTime:          time.Date(2018, 3, 11, 7, 1, 22, 0, time.UTC),
-			uncompressedSize: 2376,
+			modTime:          time.Date(2026, 10, 17, 4, 17, 25, 0, time.UTC),
+			uncompressedSize: 3354,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\x5d\x6f\xe2\x46\x14\x7d\x5e\xff\x8a\x23\x9e\x80\x02\x59\xda\xaa\x1f\x42\x68\xd5\xb2\x69\xba\x2a\x1b\xd8\x24\xd5\xb6\x8a\x90\x35\x8c\xaf\x61\xba\x66\xc6\x3b\x33\x0e\x8d\x56\xf9\xef\xd5\xcc\xd8\xc6\x06\xd2\xe6\xa1\x79\x88\xf1\xbd\xe7\x9e\xfb\x31\xe7\x8e\x87\x43\x48\x66\xc5\x03\xc1\x8a\x1d\xe1\x73\x41\x5a\x90\x89\xa2\x4c\x71\x96\x21\x4d\x05\xa6\xd0\xf4\xb9\x10\x9a\xba\x9d\x34\x15\x9d\x5e\x14\x0d\x87\x20\x63\xd9\x3a\x13\x66\x0b\x86\x54\x48\x1a\x6e\x34\x13\x92\x12\xc4\x31\x5b\x9b\x58\xaa\x7d\xb7\xe7\x70\x76\xcb\x2c\x38\x93\x58\x13\x0a\x43\x09\x52\xa5\x21\x99\x54\x86\xb8\x92\x89\x4b\x2a\xe4\x66\x14\x45\x22\xc5\x5f\xc2\x8e\x94\xc1\x74\x8a\xce\x47\x21\x13\xb5\x37\x1d\xd8\x2d\xc9\x08\x70\x85\x8c\x78\x42\xe9\xfd\x7d\xe4\x5e\x61\x1f\x73\x4a\x28\x45\x21\xa4\xfd\x21\xb6\xf8\xf9\xcf\xbb\xcb\xc9\x89\xe7\x9b\xaf\x63\x8b\xb7\x1f\x17\x37\x6f\xdb\xbe\xca\x35\x5f\x5c\x5f\x9d\x78\xbe\xfb\xb6\xf4\x1c\xbc\x6d\x62\x29\x94\x44\x3c\xff\xe9\xe6\xea\x32\x7e\x77\x7d\x77\x79\x75\x79\x83\x2f\x1e\x02\x18\xab\x0b\x6e\xeb\x57\x84\xec\x98\xab\xfd\x92\x69\x3b\xa9\xcd\x8e\x1b\xf8\x55\x6c\xb6\x4d\xfb\xd3\xe4\xff\xa1\x41\x51\xfd\xac\xfa\xc0\x87\x82\x25\x07\xcc\x13\x5a\xe5\x0f\xd0\x5f\xb6\x0c\x8d\xb6\x85\xb4\x88\x63\x63\x13\xce\xb2\x0c\x1f\x0a\xd2\x8f\x4b\xd2\xa9\xd2\x3b\x26\x39\xfd\xe2\xb4\x41\x92\x3f\x76\x0f\x35\xb5\x06\xd3\xcf\xf2\x1a\xe3\x21\xbd\xc9\x0b\x68\x67\xaa\x90\x96\x74\x45\x7a\x42\x79\x0c\x8d\x02\xb1\x7b\xac\x56\xfe\x11\xf4\x6b\x77\x39\xa6\x5e\x3c\x92\xf6\xdd\x4e\x8b\xa7\x13\x2a\x71\xce\xd9\xe8\xf9\xbe\xec\x2e\x0f\xc0\xc0\xc8\x5d\xba\x25\xe9\x5b\xe2\x98\x3a\xfe\x51\x6b\xb2\x01\xe4\x04\x7e\x4b\x7c\x49\xda\x57\x87\x29\xc6\xaf\xab\xbf\xdf\xe7\xf3\x8b\x06\x49\x54\xce\xb9\xde\x9b\x69\x5a\x48\x6e\x85\x92\xdd\x5e\xd9\x7d\xc9\xa9\xf6\xff\xd2\x4a\x09\x3d\xdf\x4c\x35\x4d\xa9\xf6\x15\x50\x93\x2d\xb4\x0c\x72\x77\xf6\xba\x0b\xf4\x8f\xab\xf7\x21\x24\x13\x5f\x29\x65\x86\xda\xab\xba\xb8\xfd\xa3\x5c\xd3\xa3\x3d\x05\xfc\x0e\xfa\x75\xda\x31\xbe\x75\x0d\xaa\xac\xb0\x14\xbb\x9b\xa6\xfb\xa0\x44\x12\x06\x5b\x8a\xdd\x63\x9c\x6b\xcd\x0c\xc5\x42\xa6\x0a\x5f\xa2\x57\xd5\x1a\xbf\x92\xc5\x8e\xf4\xa4\x61\x48\x48\xaa\x9d\x27\x08\x6b\x53\xed\xe7\xf3\x74\xfd\x53\x5b\x6c\x5f\x18\x7b\x26\x34\x61\x96\x95\xf1\xae\x99\x33\x90\xee\xb9\x84\x70\x8f\xd0\xf9\x6a\x75\xd0\x8c\xcf\xd2\x38\xe0\x67\x13\x86\xc3\x0e\x27\x7d\x26\xe5\x81\x7d\x38\xcc\xb5\x90\xb6\xdb\x71\xa6\x91\x9f\x1f\xa6\x9d\x01\x0e\xaf\x3d\x0c\x87\x18\x9f\x62\xfd\x68\x0f\x58\xff\x5a\x62\x03\xb8\xd4\x8f\x01\x3b\xba\xcc\x09\xc6\xb2\x5d\x3e\xc0\xba\xb0\x90\xca\x96\x70\x23\x24\x27\x50\xae\xf8\x16\x2a\xc5\xf8\xc7\xef\x5f\x8f\xf0\x9e\x3d\xae\xa9\x74\x65\xcc\xd8\x9a\x7a\xad\x94\x7d\x03\x53\xac\xad\x66\xdc\xc2\xee\x15\xac\xc2\x86\xac\xfb\x84\xa4\x45\xd6\xc8\x69\x46\xff\xb1\x3c\x2d\xa1\x37\x86\xd6\x16\x63\xef\x44\xe4\x65\x31\xee\x73\x95\x09\x59\xfc\x3d\x00\xcf\x14\xff\x14\x6f\xc8\xfa\x88\xd9\x7c\x31\xfb\x2d\x7e\xbf\xb8\x5e\xdc\x2d\xae\xdf\xcd\x7a\x67\xd4\x5f\xab\x2a\x53\x72\xe3\x87\x53\xaa\xa5\xf9\xb5\x09\xb4\x22\x71\xae\x96\xaf\x94\xa2\x0b\x33\x39\xf1\xc6\xb7\xc0\xa3\x3c\x9b\xfb\xf1\x10\x1b\xe2\x93\xca\x7e\xd1\x47\x39\x18\xf4\x2f\x5a\x11\xbe\x08\x84\x08\xd9\x08\xb9\xe8\x37\xc7\x59\x45\x3d\x79\xa3\xcb\x72\xb8\xae\xdb\x03\xa8\xeb\x06\xcf\x3e\xc5\x22\x19\x9c\x54\xdc\xb7\x79\x2d\xf3\x17\x5d\x71\xb9\xcb\x89\x29\x98\x31\xa4\x6d\xb7\x5e\x85\xaa\x94\xfb\x37\xab\xce\x00\xe3\x5e\x18\x76\x38\xa0\xa3\x73\x70\x57\x6d\xeb\x2e\x6c\x17\x3d\x1e\x84\x24\x67\xd5\xe1\x3d\xf7\xaf\x57\xa3\x30\x53\xf4\x1b\xb7\x36\xbe\x42\xd3\xed\x06\x58\x4b\x26\x72\xff\xfe\x09\x00\x00\xff\xff\x37\x9b\xda\x64\x48\x09\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x56\x7b\x6b\x1b\xc7\x17\xfd\x3b\xfb\x29\x0e\x82\x1f\xec\x2a\x92\x1c\xff\x5a\xda\x06\x21\x42\xeb\xb8\x69\xa8\x62\x39\x71\x4a\x5a\x8c\x59\x46\xbb\x77\xa5\x49\x56\x33\xf2\x3c\xac\x9a\xe0\xef\x5e\x66\x66\x9f\x96\x9c\xba\x34\x50\x41\x22\xef\xdc\x33\xf7\x79\xce\x5d\x8d\xc7\x10\xcc\xf0\x1b\x82\xe1\x1b\xc2\xb5\x25\xc5\x49\x47\x51\x29\x33\x56\xa2\x28\x38\x66\x50\x74\x6d\xb9\xa2\x78\x50\x14\x7c\x90\x44\xd1\x78\x0c\xd2\x86\x2d\x4b\xae\xd7\x60\x28\xb8\xa0\xf1\x4a\x31\x2e\x28\x47\x9a\xb2\xa5\x4e\x85\xdc\xc5\x89\xc3\x99\x35\x33\xc8\x98\xc0\x92\x60\x35\xe5\x28\xa4\x82\x60\x42\x6a\xca\xa4\xc8\x5d\x50\x2e\x56\x23\x07\x65\xc2\xdd\xd6\x25\xd1\x36\x15\x3a\x16\x3a\x19\x61\xb7\xe6\xd9\x1a\xcb\x52\x66\x9f\x34\xcc\x9a\xb0\x5b\xcb\x92\x82\x63\x45\x2c\x9f\x42\x13\x79\x83\xcb\x5e\x69\x70\x81\x6c\xcd\xc4\xa4\xb4\xcc\x85\x72\x48\xef\x91\x8b\x15\x3e\x5a\x6d\x20\x05\x61\x25\x95\xb4\x86\x0b\x9a\x44\x11\x2f\xf0\x91\x9b\x89\xd4\x98\xcd\x30\xf8\xc0\x45\x2e\x77\x7a\xe0\x5c\x8a\x08\x70\x0d\x98\x64\x39\x15\x97\x97\x91\x7b\x84\xb9\xdd\x52\x4e\x05\x2c\x17\xe6\x87\xd4\xe0\xa7\x3f\xde\x9f\x4e\xf7\x2c\xdf\xfc\x3f\x35\x78\xf9\x61\xf1\xee\x65\xdf\x56\x9b\xe6\x8b\xb3\x57\x7b\x96\xef\xbe\xad\x2c\xad\xb5\xef\x58\x70\x29\x90\xce\x7f\x7c\xf7\xea\x34\x7d\x7d\xf6\xfe\xf4\xd5\xe9\x3b\x7c\xf6\x10\x40\x1b\x65\x33\xd3\x3c\x22\x44\xc7\x5c\xee\xce\x99\x32\xd3\xe6\xd8\xf9\x06\x7e\xe1\xab\x75\xf7\xfc\x6e\xfa\x75\xdc\xc0\x4e\xa3\x16\xe0\xfe\xe1\xad\x65\x79\x8b\xb9\x43\x2f\xfd\x11\x86\xe7\xbd\x83\x4e\xd9\x5c\x18\x47\x07\x93\x67\xac\x2c\xf1\xd6\x92\xba\x3d\x27\x55\x48\xb5\x61\x22\xa3\x9f\x1d\x27\x49\x64\xb7\x71\x9b\x53\xd7\x11\x86\xe5\xb6\xc1\x78\x48\x32\x7d\x84\xdb\x13\x69\x85\x21\x15\x47\x0f\xb8\xbc\x0f\x8d\x1a\xc7\x37\x92\xe7\x1d\xc7\x17\x8e\x74\x71\xe8\x5e\xbe\x7b\xc3\xcb\x92\x07\xc6\xeb\x64\xea\x99\x74\x75\xe5\xbf\x82\xcc\xcc\x66\x8b\x99\xe7\x9a\xa0\x5d\x3c\xe8\x85\x1d\x04\xff\xce\x78\x32\x79\xb8\x0d\x66\xb3\x4d\xa6\xad\xc7\xcc\x65\x77\x4e\xea\x82\x32\xcc\x9c\xff\x49\x6f\x10\x01\xe4\x74\x78\x41\xd9\x39\x29\x5f\x0c\x66\x38\x7e\x56\x7f\x7e\x9b\xcf\x8f\x3a\x4e\xa2\x6a\x2c\x8d\xbc\x67\x85\x15\x99\xe1\x52\xc4\x49\xd5\xac\xca\xa7\xdc\x7d\xa1\x94\x0a\x7a\xb8\x98\xba\xf9\x42\xee\x6a\xa0\x22\x63\x95\x08\xea\x70\xe7\x4d\x15\x18\xde\xcf\xde\x5f\x21\x91\xfb\xb6\x8e\xc7\x61\x02\x90\xa2\xbc\xc5\x9a\x69\x6c\xda\x11\x40\x91\x96\xa5\x75\xc9\x4f\xa1\xa4\x15\x39\xec\xb6\xba\xa5\x25\x76\x04\x41\x37\xa4\xb0\x63\x9f\x08\x4b\x2a\xa4\x22\xb0\xb0\x60\xc0\x35\x72\x4b\x93\xd0\x8a\x7a\x57\xb5\xbd\x10\xba\x5f\x62\xa0\x81\x91\xc2\x6e\x96\xa4\xe2\x58\x68\x3c\xc5\x73\xff\x99\xcf\x13\x1c\xd5\x0d\x9f\xcf\x93\x26\x7f\x00\x11\x95\x9a\xfa\x9b\x69\x71\xf1\x7b\xb5\x95\xee\xad\x25\x00\xb6\xde\x1e\x1b\x96\xad\xdd\x80\x5c\x75\x94\xba\x8c\x63\xc7\xcb\x40\x8c\x4a\xdb\x1e\xe3\x4c\x4b\xa6\x29\xe5\xa2\x90\xf8\x1c\x3d\xa9\xb7\xd6\x13\x61\x37\xa4\xa6\x9d\x83\x9c\x84\xdc\x4c\xa3\x66\x4b\xd4\xeb\xe8\x61\x77\xc3\xfd\xb3\xd4\x3c\xf2\xee\x81\xab\x39\x33\xac\xba\xef\x8a\x39\x00\x89\x0f\x05\x84\xfb\xaa\xc4\xd6\x04\x2e\xa5\x58\xf9\x49\x56\x0e\xeb\x4c\xdc\x91\xde\x52\xd6\xd9\x7c\xee\x13\x90\xee\x8f\x9b\x54\x53\x36\xed\x19\xbd\x2f\x04\xa3\x68\xac\x77\xed\x9a\xf1\xaf\x39\x4f\x80\x4c\x0a\x6d\xf6\x62\x0d\x15\x5d\x8f\x0e\x9d\x6e\xc2\xc0\xae\xae\x5a\xa9\xfa\xe6\x74\x74\xf5\x60\x9f\x82\xc6\x02\xfb\x0e\x74\xaa\x6a\x8a\x27\xfb\x56\x71\x61\xe2\x81\x3b\x9a\xf8\xb1\x63\x36\x18\xa1\x7d\x4c\x9c\x20\x8e\xf7\xb1\x9e\x11\x2d\xd6\x3f\x56\xd8\x00\xae\x64\xab\xc1\xee\xbd\xea\x09\xda\xb0\xcd\x76\x84\xa5\x35\x10\xd2\xd4\xa2\xe3\x22\x23\xd0\x56\x66\x6b\xc8\x02\xc7\xcf\xbf\x7f\x36\xc1\x1b\x76\xbb\xa4\xca\x54\x32\x6d\x1a\xd7\x4b\x29\xcd\x0b\x68\xbb\x34\x8a\xb9\xc6\xed\x24\x8c\xc4\x8a\x0c\xac\xa6\xc2\x96\x9d\x98\x7a\xf2\x37\x3b\xab\xb7\x5f\x3a\x4d\xeb\x6b\x28\xe9\xed\x96\x2f\xeb\x3e\x8c\x4b\xd1\x75\x77\x5a\xf7\x66\x3c\x68\xc3\x5f\x4f\x02\xb5\x30\x83\xd0\xed\x3e\xf0\x2b\xa1\x0f\x12\x0d\xea\x7f\x87\x50\x21\xf9\x96\x72\x9e\x5b\x82\x97\x7b\x6b\xa5\xea\x63\x21\x15\x4a\x2e\xec\x9f\x23\x64\xee\xe7\x55\xba\x22\xe3\x8b\x3d\x99\x2f\x4e\x7e\x4d\xdf\x2c\xce\x16\xef\x17\x67\xaf\x4f\x92\x03\xfb\xe6\x61\x39\x75\x7e\xce\x04\xb7\x3c\x77\xa6\x9e\xed\x9f\x49\xae\x3e\x3f\x1a\xa2\x9a\x29\x86\x47\x5f\xd6\x61\x7b\xa3\xc3\x84\xfa\xd6\x9d\x3f\x74\x51\x5a\xa1\xf6\x1b\xd0\xe4\x8d\xac\xfc\x94\xf2\xfc\x80\x44\xcd\x36\xf9\xaa\x32\x7f\xd4\x9b\x75\xeb\x02\x61\x06\xa6\x35\x29\x13\x37\xe4\xaa\xeb\xb9\x7c\x71\x35\x18\xe1\x38\x09\x13\x0b\x53\xbe\x37\x4c\xf7\x86\xef\xf1\xa5\x5f\xf9\xf1\x28\x04\x39\xa8\x0e\x6f\xb9\x7c\x76\x55\x13\x76\xd8\x61\x21\x9e\xa2\x6b\x76\x53\xf8\xb7\x92\xa9\xab\xfa\x4f\xb4\x12\xb9\xff\xfe\x1a\x00\x07\x0d\x36\x42\x1a\x0d\x00\x00"),
 		},
 		"/chan.lua": &vfsgen۰CompressedFileInfo{
 			name:             "chan.lua",
-			modTime:          time.Date(2018, 3, 11, 7, 1, 22, 0, time.UTC),
-			uncompressedSize: 21822,
+			modTime:          time.Date(2026, 10, 17, 6, 27, 29, 0, time.UTC),
+			uncompressedSize: 27469,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x7c\x6d\x8f\xdb\x36\xd6\xe8\x77\xff\x8a\x53\x05\x45\x2c\x54\x56\x32\x59\x3c\xf7\x83\xbb\x4a\xb0\x9b\x66\x7b\x0b\x34\x69\xb1\xe9\x6e\x71\x31\x18\x68\x69\x89\xb6\x19\xcb\xa4\x96\xa4\xec\x78\x83\xe9\x6f\xbf\x38\x7c\x91\x48\x49\x9e\x49\xfb\x64\x07\x68\x23\x8b\xe4\xe1\xe1\xe1\x79\xe7\xa1\x56\x2b\xa8\xf6\x84\xe7\x4d\x47\x16\xab\x15\x7c\x47\x25\x3b\xd1\x1a\xb6\x52\x1c\xa1\xe9\xc8\x0a\x1b\x39\x6d\x14\x76\xc8\xe1\x67\x21\x35\x13\x5c\x61\xd7\xd7\xa2\xbd\x48\xb6\xdb\x6b\x58\x56\x29\xbc\x78\x7e\xf3\x27\x78\x4b\x24\x3d\xc0\x5b\xf2\xe1\x20\xce\xea\xc0\xb0\x57\xa7\x68\x0d\x1d\xaf\xa9\x04\xbd\xa7\xf0\xf6\x87\x5f\xa0\x61\x15\xe5\x8a\x02\xe1\x35\x28\x76\x64\x0d\x91\x6e\x3e\xb6\xd1\x44\x1d\xa0\x6b\x95\x96\x94\x1c\x33\x50\x94\x22\x90\x1d\xd3\xfb\x6e\x93\x57\xe2\xf8\x6c\xc7\x3e\x30\xfd\x6c\xc7\x9e\x9d\x28\xaf\x85\x7c\x16\x34\x1d\xc9\x07\x7a\x78\x16\x22\xfd\xec\xc7\x1f\x5e\xbf\x79\xf7\xfe\xcd\xea\xed\x0f\xbf\xac\xc2\x86\xc5\x6a\xb5\x58\x7d\xc1\x3f\x44\xf2\x7b\x01\x4a\x5f\x1a\x0a\xaf\xdd\x24\xb0\x15\x12\x7e\x34\x74\xc5\xf6\x5f\xf6\x4c\x41\x25\x6a\x0a\x4c\x41\x1d\xd1\xd9\xad\xbb\x61\x1b\x49\xe4\x05\x36\x17\xf8\x7b\xa7\x14\xbc\x16\x1f\x33\x38\x12\xc6\x9b\x8b\xe9\xb8\x70\x9b\xc5\x69\x93\x57\x39\xbc\xa7\x47\xc2\x35\xab\x48\xd3\x5c\xfc\x7b\x05\x44\x01\x3b\xb6\x0d\x3d\x52\xae\x69\x0d\x7b\x2a\x29\x10\x49\xe1\xdf\x1d\xd3\x86\x98\x9e\xe4\x5a\x0c\x83\x0c\x1a\xb8\x3f\xdf\x0b\x68\x08\xdf\x75\x64\x47\x73\x87\xf7\x3f\x14\xd9\x51\x58\x9e\xe9\x53\x49\xa1\x53\x8c\xef\xa0\xe3\x9b\x6e\xbb\xa5\x92\xd6\x1e\x84\x99\x27\x5d\xbb\x21\x8d\xa8\x48\x03\x65\x69\x56\x55\x80\xa4\xff\xee\x98\xa4\xcb\xa7\xd8\xf9\x69\x1a\x75\xda\x76\xbc\x42\x96\x82\x4a\x74\x5c\x53\xb9\x74\x00\xb1\x17\x00\xb8\x5e\x0c\x0a\xb8\x71\x6f\xce\x7b\xd6\x50\xd0\xb2\xa3\x50\x0b\xf7\x0e\xff\xdc\xc0\xb5\xa2\xbc\x5e\xb2\x34\x68\xc1\xd1\x0c\xbe\xe9\x21\x50\x5e\xe3\x93\xfd\x67\x06\x15\x24\xf9\xb2\x07\x60\x1b\xfd\x3a\x0b\xb7\xac\xdc\xed\xf2\x9a\xd3\xf3\xd0\xd7\xb5\xa9\x96\x9c\xf9\xd2\xad\x28\x83\xd1\x92\x80\x28\x45\xa5\xf6\x2b\x5d\x4b\x5a\x9d\x96\x29\x14\x05\xdc\x3c\xde\xe5\xc5\xe3\x5d\xfe\x94\xc6\xab\x8b\x90\xc2\xb5\xa5\xe1\xdb\x6a\x4f\xeb\xae\xa1\x72\xe9\xf6\xa5\x67\xd5\xa3\xc0\xf7\x40\x3f\xb6\x42\x51\xe5\xb7\x36\x86\xb6\xed\x78\x06\xb7\x79\x9e\xdf\xa5\xb0\x02\xd9\x71\x24\x22\xb2\x20\x81\x4a\x48\xd1\x69\xc6\x29\x9c\x99\xde\xc3\x8e\x9d\x28\x0f\xf6\x64\xf2\xd7\x12\x49\x8e\x54\x53\xa9\x72\xf8\x7f\xa2\x03\xb5\x17\x5d\x53\xa3\xfe\x00\x8d\xe8\x30\xae\x34\x25\x35\x88\xed\x43\x50\xfa\x59\xf3\x4a\x52\xa2\xa9\x5f\x15\xcc\xac\x17\x56\x50\x11\x0e\x1b\x6a\x10\x17\x5e\xca\x8c\x1c\x20\x99\x40\xef\x25\x25\x75\x06\xf4\x23\xad\x3a\x4d\xd5\xb5\x89\x49\xd3\x98\x41\x4a\x77\xdb\x6d\x06\x92\xaa\xee\x48\x95\x79\xd5\xe3\x83\x3f\x89\x46\x49\xbc\x06\x65\xd3\x88\xea\x40\x6b\x40\x59\xf0\x72\x69\xc6\x6c\x68\x45\x8e\x14\xc8\x89\xb0\x86\x6c\x1a\x6a\xe8\x73\x0d\x0a\xae\xc8\x2c\xa5\x16\xc0\x05\x5f\x19\xa8\x28\xb3\x28\x16\x0a\x9e\x81\xa4\x15\x65\x27\xaa\x7a\x8d\x32\xf7\x37\x22\x41\x3e\x22\x62\xc8\xfb\xb7\x56\x15\x80\x62\xff\xa1\x86\x0b\x2c\xe1\x81\x00\xa7\xe7\x5e\x6c\x06\x1e\x30\x1d\xc7\x9b\x42\x1b\x5a\xe9\x25\x69\xb4\xca\x70\x05\xa5\xc1\xda\xb3\x14\x69\x34\x3c\x03\xdb\x07\x9e\xc1\xb1\x6b\x34\x6b\x1b\xfa\x11\xc4\x89\xca\x87\x98\x21\x5a\x0e\x02\x07\xa5\x65\x57\xe9\x4e\xd2\x1c\xfe\x26\x24\xd0\x8f\x04\x55\xe5\x7a\x24\x28\x16\x9b\x4f\x9f\x2a\x28\xfc\x02\xca\x9b\x0c\x44\x3b\x48\xff\xdf\xdf\xbc\xfe\xe7\x7d\x36\x9d\x3c\x1a\xf3\x22\x1e\xf3\xfe\xcd\xbb\xef\x32\xc0\x17\xc9\x9e\x36\x8d\x48\xee\xef\x33\xa3\xc7\xd2\x50\xec\xce\xac\x69\x2c\x2f\x40\xd5\x49\x49\xb9\x0e\x44\xa9\xe3\x9a\x35\xc0\xf4\x53\x05\xad\x50\x8a\x6d\x50\x13\x0a\xbf\xa7\x08\xc3\x70\x70\x8f\x34\x08\x69\x36\x3e\x50\xf6\xe5\x8b\xdc\xd3\x52\x52\xdd\x49\x8e\xc2\xca\xbb\xe3\x86\x4a\x27\x5b\x4a\x13\x6d\xcc\x87\x05\x66\x08\x67\x18\x51\x75\x55\x45\x69\x4d\x6b\x58\x1a\xc8\x2f\xac\xd6\x37\x86\x9c\x78\x24\x8c\x6a\x3d\x91\xa6\xa3\xc0\xb6\x5e\x74\xea\x00\xe8\x99\x28\x40\xf2\x79\xa6\xfa\x1b\xe3\x68\xc1\x32\xec\xae\xcf\xc2\x6c\x77\xdf\x5b\x79\x11\xdd\x76\xcd\x96\x35\x0d\xad\x81\x68\x2b\x6c\x28\x13\x9a\x1d\xa9\xd9\x85\x33\x35\x9a\xa2\x2c\x37\x1d\x6b\x34\xe3\xe5\x91\xe8\x7d\x2e\x09\xaf\xc5\x71\x99\xe2\xf2\x6b\x5a\xb1\x9a\xa2\xf5\xa8\xf6\x20\x38\xf5\x0a\x66\x27\x60\xcb\xa4\xd2\x39\xbc\x17\xc0\x34\x02\x3b\x92\x03\x55\x48\x37\x65\xa8\xcb\x38\xd3\x8c\x34\xec\x3f\x14\xfd\x91\xda\xf2\xb2\x12\x47\xaa\xf7\x28\x58\x76\x92\x1c\x7e\xd8\xc2\x45\x74\x50\x0b\xfe\xd4\x40\xd9\x93\x13\x05\x52\x55\x54\x29\x84\x42\x38\x50\xae\xa5\x68\x2f\xa0\x44\x27\x2b\x6a\x7a\xe3\xea\x6a\xb1\xb6\x8c\x34\x8b\x3d\x4e\xb9\x14\x2a\xc7\xa5\x2e\x53\xa3\xba\x37\x1d\x2a\x85\x33\x91\x34\x33\xa4\x40\x85\x83\x9b\x24\xb6\xd0\xaf\xd8\xb0\x51\x2b\x69\xcd\x2a\x4d\x1c\x9b\x10\x20\x5a\x93\xea\x40\x65\xfe\x65\xbd\x9f\xc5\xc2\x5b\xfc\xb7\x50\xc0\xa7\xfb\x85\xf5\x0f\xb9\xd2\x84\x6b\xe5\x1a\x71\xcf\x91\xf7\xd1\x50\x25\xb0\x5a\xc1\xf3\x8f\x37\xae\x09\x25\x03\x9b\x90\x55\x5d\xd3\x0b\xd7\xf4\xee\xa7\x9f\x01\x9b\xb8\x68\x13\xb0\x4d\x7f\x72\x4d\xbf\xfc\xf0\xf6\xcd\x4f\xff\xf8\x05\x67\xa4\x52\x62\x27\xf7\x26\xb1\x08\x7c\xdf\x88\x0d\x69\x40\x6c\x3e\xd0\x4a\x5b\x6f\xac\xd7\xfe\x0e\x04\xca\xa5\x2a\x65\xc7\xb9\xa1\x11\xe2\xee\x04\x19\xbd\x02\xa6\x34\xd2\x34\xd0\xe1\xa8\x0c\x2f\x48\xca\x0d\x75\x6a\xbe\x8e\x20\x69\x11\xc2\xe8\x21\x79\x03\x81\x7b\x28\x3a\x6d\x3b\xbb\x81\xa4\xd1\x28\x24\x8b\x45\x59\x92\xa6\x29\x71\x32\x0b\x03\xc7\x49\x49\x2e\xd8\x52\x35\x94\xf0\xae\xfd\x8e\x92\xfa\xb5\xed\xe0\x9d\x95\x65\xba\xe8\x7d\x94\x03\xa5\x2d\x95\x0a\xe1\xd8\x6d\x98\xb4\x70\xa1\xa9\xea\xdb\x90\x22\x2c\xab\x90\xc3\x81\xb5\x84\x49\xb5\x1c\x90\x48\xd1\xbb\xb2\x2b\x60\x01\x0d\x72\x14\xcd\x4e\x2d\x2b\x91\xc2\x6f\x05\x24\x35\x25\x75\x82\x8b\xe3\x8b\x41\xdd\x1a\x2b\xc5\xb8\xf1\x4f\x02\xa4\x32\xa8\x44\x3a\x74\xb3\xa8\x9d\x8c\x82\x44\xf8\x2f\x0c\x76\xb7\x95\xb8\x1b\xfa\x9c\xf2\xb2\x6c\x04\x2a\xd5\x27\x01\xa0\xa1\x3d\x5a\x18\x0e\x85\x02\x4e\xae\x19\x3d\xa0\xe1\x9f\x88\xbc\x23\x58\xe1\xfc\x41\xab\xf9\xbd\xc0\xf1\x8b\x5e\xa9\x39\x7c\x76\xc6\x84\xe2\x0a\x8c\xc7\xc8\x78\x08\xdf\x6c\x5b\x1e\x0e\xe1\xa8\xac\x90\x79\x0c\x9b\xe1\xaf\xc5\x68\xce\x4f\xf7\x60\x26\xb1\x3a\x39\x50\xf9\x96\xde\xd6\xa7\x52\x5a\x32\xbe\x33\x43\xed\x63\xd1\xb3\x81\xa3\xac\xa3\x69\x31\x47\x51\xb6\x45\x62\x17\xc0\x59\x13\x6e\x98\x9b\x31\xf9\x33\x95\x52\xc8\x15\xe3\xab\x01\xfe\xaa\x12\x2b\x2e\xf4\x6a\x2b\x3a\x5e\xfb\x26\x0f\xf7\x65\x12\x90\xb7\x87\x92\xe4\xb9\x76\xa3\x97\x6e\xf7\xd2\x3c\x4f\x20\xc9\xf3\x93\xa7\x04\xfe\xb6\xeb\x5a\x27\x79\x3e\xc7\x5b\x79\x9e\xbc\x4c\x2c\xe9\x0d\x36\x7b\x71\x2e\x62\x96\x6f\x25\xe3\x7a\x99\x3c\x31\x6b\x30\x50\x43\xf7\xcf\x81\x4f\x52\xcf\xe7\x87\xec\x84\xbb\xe4\xb9\x7c\x58\x45\xc0\xe7\x16\xe4\xb0\xfa\xe5\x21\x4d\xfd\x12\xf1\xbf\xb2\x44\x3c\x2a\x51\x78\x94\xbc\xde\x43\x57\xc9\x80\xcc\x80\xa9\xd2\x38\x4e\x45\x20\x32\xa8\x5f\x10\x5c\xba\x60\x5b\xe0\x42\xf7\x9d\xfc\x2e\x18\xca\x2f\x13\x1f\x88\xc3\xb1\x53\xa8\xe1\xa1\x11\xa4\xa6\x75\x66\x16\xc0\xc5\x39\xc3\xc8\xd0\x0c\xec\x61\x27\xa9\x25\x52\x24\x72\x03\x2b\x66\x03\x6a\x69\xc4\x71\xb7\xfd\xfb\xbb\xe2\x93\xd9\xa4\xe2\x49\x38\xcc\x6e\x54\x91\x60\x37\x54\xa7\x76\x9d\xbd\xfa\x2c\x2b\xd1\xab\x7c\xab\x07\xcb\x39\xd5\x5a\xb6\x44\x1e\xbe\x74\xa0\xbd\x82\xff\x4b\x1b\x94\x4f\x8f\x55\x1f\xb7\x59\xe3\x57\x56\x7b\xc1\x2a\xba\x24\x52\xa6\x8e\xed\x9f\x10\x29\xe1\x25\xdc\x84\x6c\x6f\xc7\x4a\x5e\x1b\xfd\x33\xe7\x36\x3c\xf1\x10\x8c\x12\x77\xfc\x16\xcd\x81\x91\x7c\xb5\x17\xa2\x46\x3f\x20\xc9\x10\xda\x30\xa0\x2c\x95\x46\x24\x32\x48\x70\x7a\x36\x87\x5f\x92\xc6\x42\x48\xa4\xbc\x95\xbc\x36\xe2\x4a\x1b\x45\xa7\xad\x37\x77\x21\x47\xa2\xc6\x78\xdf\xd2\x0a\xdd\x13\x45\x6b\x78\x4f\x35\xd4\x44\x93\xc1\xd1\x85\xa5\x71\x57\xec\xd4\x40\x6d\x5e\xc0\x39\x80\x4c\xf0\xd4\x5b\x60\xaa\x51\x09\x21\x6c\x74\xdb\x03\xfb\xa2\x68\xb3\x4d\x23\x9a\x19\xfb\x44\x8c\xce\xca\xc0\x5a\x9a\xfb\x6f\x41\x51\x7d\xa4\x9a\x18\x46\x5c\x8a\x0c\xcc\xb8\x6f\xcd\x3f\x79\x59\x32\x5e\xd3\x8f\x50\x98\x9f\xf1\xa2\x84\x5b\x4f\xb6\xc0\x07\x52\xd7\xe3\xc9\x33\x38\xc5\xf3\x13\x3b\xab\x81\x4c\xec\x44\x79\x33\x98\x2a\x72\x7b\xba\x9b\x51\x73\x63\xbb\xd4\x04\x70\x71\x62\x33\x0a\x9e\x04\xb6\xc5\x21\x88\x1e\xfa\xc4\xa2\x58\x6c\x25\x3d\x8a\x13\xfd\x5f\x21\x3c\xe4\x37\x10\x83\x61\x15\x0c\x5e\xc2\xf3\x11\xfe\x4e\xb0\xa0\x80\xe6\xf6\x49\x73\x17\x22\xaf\xef\x32\x68\x6e\x19\x2e\x81\x65\xa0\xc3\x26\x66\x9a\x9e\x34\xd8\xc6\x59\x93\xe1\xff\x7e\xd7\x22\x2d\xeb\x4c\x16\xa9\x7b\x5b\x8e\x8e\xbb\x98\xc5\x95\x18\xbf\xcc\x7a\x1b\xf6\xcf\xf8\x1c\x50\xc0\x4d\x06\x4f\x2c\x21\x06\xfd\xdb\x43\xb3\x0d\xb7\xec\x2e\x77\x70\xe3\xad\x33\x42\xd5\xf7\x49\x3d\xc6\x11\xfa\xd1\xea\xe6\x15\x43\xd4\x79\xb6\xa7\x9d\x23\x8d\xc8\xd1\x50\x7e\x4d\x3c\x1c\x8c\x27\xc3\x06\x9b\x51\xf7\x0b\xeb\x3f\xbc\x66\xb2\xea\x1a\x22\xe1\xaf\x36\x62\x8e\x05\x35\xb3\xa9\x52\xa4\x4f\x1f\xfe\x1b\xd1\xb5\xf1\xb5\xca\x9d\xa4\x7a\x28\x0e\xc8\x75\xa1\xcd\x4c\xa4\x3d\x23\xba\x1b\x27\xba\xaa\x11\x1a\x5d\x0f\xec\x06\xdf\xe0\x8e\x98\x27\xfb\xc2\xb2\xec\xf3\x0c\x70\x8a\xe7\x7e\x03\xbf\x8c\x90\x3f\x4e\x42\x4b\x79\x09\x2b\xb7\xcd\x29\x7c\x6d\x9f\x0c\xce\x11\xb0\xd6\x84\xd8\xb3\xc0\x5c\x86\xcc\xb1\xd9\x6f\x4e\x02\x65\x4c\x92\x93\x97\xcc\xcd\xad\xed\x78\xd7\xaf\xd5\x0c\x2b\xc0\x03\xf8\x06\x6e\xa6\x78\x0c\x38\x9f\x62\xb4\x3a\xb5\x7f\x40\x31\x84\x33\xca\xd0\x69\x75\x0b\x2f\x7a\x12\x5c\x9b\xf5\xa1\xc5\x79\xb6\xfb\xc2\x96\x17\xde\x5b\x1b\x8f\x3e\xa8\xcb\x58\x60\x20\x13\x47\x45\x1d\x37\xe9\xe6\xb6\x21\x95\x4d\x66\x21\x8f\x93\xea\x60\xb2\x04\xe3\xcc\x85\x4b\x37\x48\x8c\x94\x03\x87\x69\x6c\xd8\xc3\x24\x65\x68\x8c\xb5\x68\x31\x40\xeb\x9b\xad\x39\xb5\x5d\xfa\xec\x06\xda\x01\xb6\x05\xe7\x84\x21\x46\x7d\x76\xab\x9f\x31\x03\xa1\xf7\x54\x9e\x99\x35\xb9\xc1\x68\x13\x5f\xbb\xa1\xd8\x3d\x1f\xbc\x6c\x24\xf8\x67\x79\x7d\x0e\xe4\xaf\x14\x48\xa5\x3b\x93\xae\x37\x59\x02\xa8\x90\x52\x2c\x58\x00\x3a\x14\x26\x8b\x1a\xe6\x21\xdd\xf0\x01\x32\xfc\xb5\xd3\x70\xa6\x26\xc5\x47\xa9\x49\xee\x1c\xc9\x81\x82\x42\x7b\x6f\x32\x35\x9d\x42\xfd\x22\xa8\xc2\x59\xac\x7e\x5d\xad\xa0\xcf\x05\x8a\x96\x4a\x1b\xb8\x98\x89\x98\xce\xcc\xb1\x01\x22\x84\x03\x2e\x8c\x36\x75\xee\xd1\xfe\x40\xc9\xda\x25\x4b\xb0\xd1\xe4\x5e\x7a\x7c\x3f\xa0\xa7\x4a\x9a\x33\xb9\x28\xb7\xfb\xb8\x66\x37\xd2\x7a\xb8\xb0\x21\xd5\x61\x27\x31\x82\x78\x05\xbf\xa2\x46\xc3\x97\xe8\xe6\x06\xde\xfa\x45\x69\x7a\x74\xc3\x70\x27\xe8\x53\x65\xd3\x98\x82\x53\x9f\x84\x84\x5f\x8d\x25\xd8\x0f\x5b\xd4\x36\x48\xb0\x33\x61\x1a\x57\x65\x4c\x0b\x6f\x3b\x9d\xd9\x2c\xa8\xf4\x29\x9e\x33\xfd\x2c\xdc\x02\xde\xf9\x2b\x85\x4a\x1c\x5b\xa2\x0d\x9f\x1a\x35\xfc\x3f\xf9\x8d\x61\xe1\xff\xc9\x5f\xd8\x4e\x4e\x00\xb9\xb0\x42\x68\x43\xbf\xdf\xac\xdf\x61\x78\xdd\xf1\xc4\x6f\x85\x4d\xf2\x65\x0e\x76\xf2\xbe\xa7\x9e\xf7\xf3\x27\x5b\x1e\x6c\x76\xc8\xd3\x8e\xed\x7b\xf2\xaf\x07\x1e\x44\x42\x24\xd9\xf0\x3b\xbd\x36\xc2\xa3\x65\xfb\xbb\x5f\x0f\xce\xd1\x12\xdc\x63\xb3\xda\x01\x99\xc1\x6f\x79\xbe\x98\x1c\xca\x84\xfa\x95\xa3\x32\x7b\x12\x27\x5a\x06\xbf\x01\x5b\x8b\x89\xa3\x33\x87\x05\x17\x70\x14\xd2\x6e\xa3\x4d\x68\x21\xc8\x24\x70\xe1\x36\x92\x92\xc3\xc4\xb0\x7b\x0e\x6e\x59\x75\x30\xdc\x44\xb4\xb3\xf2\x11\x9a\x87\xab\x61\x00\x1f\x19\x8c\x0a\x8d\xa8\xf5\x47\xac\xff\xb7\x8c\x17\x97\xc1\xc1\x0f\xf0\x49\x21\x97\x98\x40\xc7\x6b\xc0\x0a\x79\xc4\xc6\x4e\x50\x89\x71\x98\x11\x2c\x3c\xd0\x28\xb6\x37\xd9\x98\x1c\x92\x51\xb7\x15\x31\x39\x51\x73\x7e\x56\x24\x79\x1e\x04\xaf\x95\x48\x63\xc4\x91\xd7\xd1\xf4\x8f\x01\x2e\x2b\x91\x41\x12\x28\xd1\xfb\x07\xb0\xd9\x09\x6d\x01\x19\x7e\x75\x18\x89\x2d\x4c\xe6\xce\xf3\x64\x8d\x1c\xd6\xf1\x96\x54\x87\x25\x8e\xe9\xf1\x89\x7d\x92\x03\xb9\x64\x40\x8f\x6a\x07\x45\xd4\x3b\xe0\x12\xa1\x4d\xb7\x11\x9b\x58\xec\x6a\xba\xe9\x76\xb9\x96\xa4\xa2\x38\x6c\x89\x90\xd2\x80\x2d\x6c\x70\x6d\xde\x4e\x98\x63\x38\x19\xbc\xbe\x62\xb7\xc6\x33\x51\x0e\x55\x86\xb2\xc3\x05\x3a\x5c\x28\x41\xe9\xb0\x26\x13\x92\x0d\x9c\x6f\xba\x94\x25\xd9\xa8\x92\x8b\xf3\xf2\xaa\x48\x56\x7b\x6a\xf5\x32\x6a\x30\x97\x23\x54\x99\x3d\xba\x65\xaa\xe7\xa2\x35\x12\x59\x5f\x5a\xcf\x70\xda\x6d\xf0\x22\x62\xe2\xe7\xd7\x66\xf9\x60\x95\xcd\x16\x85\xc8\xa6\x41\x7a\x30\x43\x9a\x04\x59\x81\x34\x7a\x48\x95\xf4\x7d\x06\xb9\x9e\x03\xee\x2c\x71\x9f\x05\x6d\x84\x68\x93\x49\xf0\x1c\x0c\x40\x33\xec\x3a\x67\xf8\xe3\x50\x24\xd9\x21\x4b\x00\x95\xb5\xc9\x9c\x1b\x31\x4b\x32\x83\x51\x62\x8f\x18\x1a\x5d\x24\x06\xbd\x80\x35\x10\x59\x6c\x44\x62\xbf\x2c\xf0\x67\x3e\x89\x45\x5c\x8a\x75\x19\x8c\x9c\x17\xce\x70\x44\x5e\xad\xcb\x1d\xd5\x25\x69\xb4\x5a\xfe\xfd\xcd\xeb\x7f\xa6\x6b\x27\xee\x01\x98\x38\xc5\x18\x51\x9e\xf2\x3a\xf2\x4d\x32\x5c\x99\x24\x1c\x98\x9d\x39\x73\x2e\x06\xee\x3b\x2b\x3c\x23\x2d\x06\x7f\x92\xd9\x08\xbf\x77\x82\xec\x29\x52\x69\x9c\x2d\x9f\xee\xeb\x67\x0b\x1b\xd1\x19\x30\x07\x13\xe6\x07\xea\x85\x3e\xf1\x1a\x85\x51\x63\xb5\x85\x7d\x7c\x52\xc1\xf8\x13\x95\x30\x92\xe7\x0c\x94\x3d\x7b\xda\x76\x12\xed\x33\x36\xb0\x8a\x2e\xfa\x2c\x4f\xe8\xeb\x46\xb9\x48\x4e\xcf\x38\x3a\xcc\x39\x97\x19\x9c\x82\x9c\x73\x8c\x47\x9c\x77\x3e\xa1\xf9\xac\xe6\x82\x40\x0b\x17\x5d\xea\xd1\x2e\x4c\x72\xfa\xb6\xa7\x5d\xda\xd8\xb1\x1c\x4e\xb1\x89\xdc\x29\x47\x53\x1f\xbb\xee\x4c\x62\x36\xcf\xf3\xfb\x40\xaa\xb7\x93\xe4\xfb\xbc\x26\x6b\x51\x35\x5b\xd0\x4e\xa9\x99\x19\x1e\xd7\x6a\x7e\x4f\xff\xb0\x5e\x73\xff\x04\xd6\x6a\x72\x2a\xbe\x9d\x72\x43\x98\xfd\x8b\x37\x30\xcc\x0c\x2e\x46\xd9\x72\x14\x1e\x9f\x40\xe4\x43\xda\xd0\xd0\x15\x9e\x84\xb9\x60\x6e\xcd\xca\xa2\xaf\x92\x88\x39\xd9\x52\xfe\xf6\x36\xd6\x5e\x06\x0c\xa9\x6b\xeb\xe4\x5a\x86\xfe\x77\x47\x3b\xba\x0e\xf4\x43\x2c\x09\xbd\x75\x34\x5e\x2c\x3e\x04\x22\x88\x1e\x52\x90\xc3\x84\x2c\xf9\xb6\x57\xb3\x36\xb7\xbb\xb6\x5a\xcb\xa7\x7a\xc3\xe3\x95\xea\x51\x47\xdf\xa5\x6e\x5c\x1f\xe4\xf4\x11\xa9\x7c\x02\xbc\x28\x20\xd1\x7b\xba\xa2\x27\xd2\xac\xb0\x4b\x74\x84\xb2\x5a\x85\x8e\xb8\x51\x1c\x18\x28\x90\xc6\x12\xc0\x55\xa8\x20\x7c\x1c\xef\x06\x4d\x13\xb1\x16\xa1\x20\x8d\x38\x47\xf7\xa8\x66\xc2\xcc\xb7\x4c\x11\x81\x9d\xb0\x66\x3e\xa4\x5f\xc0\x5c\xab\xd5\xdd\x9d\x57\x16\x5f\x36\xc6\xec\xab\xa7\x56\xfe\x98\x1a\xd5\xfb\xde\xa7\x7c\x57\x2b\xf8\xde\x94\x11\xe8\xb3\x80\xbf\x34\xda\xd5\x2e\x11\x50\x8c\xef\x1a\xda\x17\x1c\xd0\x8f\xf8\xb4\xa3\x36\xcb\xb2\xa1\xfa\x4c\x6d\xfd\x89\xde\xd3\x63\x0e\x3f\x60\x20\xca\x8e\x6d\xc3\x90\xb5\x30\x64\x42\xd7\x90\xd9\x93\x69\x6b\x6d\xb8\x09\x09\xf1\xdd\xfb\x37\xef\xbe\xcb\x3d\x62\x56\x3b\x5e\x50\x2b\xfa\x22\xa8\x49\xbc\x4a\x1a\x5d\x89\xf6\xb2\x24\x19\x6c\x66\x23\x56\xd7\x21\x09\xb8\x4b\x66\xa0\x32\xa8\xa0\x00\x1c\x95\x01\xc9\x2b\xc7\x4f\x32\x17\x2d\x72\x8c\x39\x25\x0d\x0f\x6e\x32\x30\xe9\x9b\x0c\x64\xe8\x7c\xf8\xc4\x80\xcf\x81\x62\x1c\x14\x40\x48\x83\x3e\x32\xe8\xe3\x67\x31\xa6\x6e\x11\x21\xed\xd1\x5d\x83\x2a\x12\xb7\x1e\x93\xdd\x56\x59\xa2\x92\xf4\x4a\x5f\x19\xf7\x95\x59\x82\xf1\xb9\x0b\x82\x1c\x31\x91\xba\xf4\xd8\xea\x0b\x62\x30\x54\x95\xa1\x54\xb7\x17\xa8\x99\xa4\x95\x6e\x2e\x8e\x0e\x2a\x8c\xae\xa4\xf9\x7f\x95\x97\x9b\x6e\xbb\x6e\x28\xb7\xa5\x4f\xcf\x63\x31\xf2\x2a\xc1\xa3\x84\xff\x47\xcb\xe8\x01\x0f\xe9\xf7\x9c\x34\xba\xb4\xe7\x76\xb6\x76\xa1\x00\x95\xb7\x51\x82\x27\xa4\xf1\x6a\x05\x3f\xf9\x84\x81\x4d\x6a\xb8\x18\xd8\xea\xf3\xbe\x22\xc3\x20\x69\x6c\x97\xa2\xbc\xce\xfd\x86\xfa\x85\x04\xc8\x9a\x7d\x9e\x78\x2e\x73\x78\xb9\x43\xee\xf9\x4e\x92\x2a\xd1\x9c\x68\x6d\xea\xe5\x86\x1e\xd3\x24\x6f\xa3\xa8\x99\xb2\x6a\x84\xa2\xf5\x67\x4c\x1b\x67\x8d\xff\xe0\x94\x0f\x4f\xe1\x76\xb3\x15\xed\x72\xde\xa4\x85\x4c\x10\x60\xec\xc7\x75\x6a\xbf\x54\x79\x1b\x9d\xd9\x0d\x0a\x83\x72\x63\x39\x8c\x1f\x69\x27\xee\x55\x87\xd5\x33\x43\x89\x8a\xcb\xeb\x93\xc6\x1c\xcf\xab\xbe\xae\xc6\xd4\x11\x29\x25\x2a\x46\xf4\x50\xfb\xa8\xe6\xe4\x9f\x34\x4d\x4d\xcd\x84\xcb\x7e\xbe\xde\xcb\xf6\x09\xf0\x01\x93\x51\xd0\x4c\xa0\x18\xd0\xbc\x65\xc1\xb9\x00\x09\xc4\x14\x85\x86\x5c\x51\x0e\x28\xe4\x91\x0b\x8b\x1d\x07\x17\x76\x86\xbe\x9e\x5a\xaf\x09\xb7\x15\x77\x7f\x69\x8c\xef\x87\xae\xb3\xab\x7a\x41\xcb\xea\x93\x48\xaf\xe6\x94\x1e\xe1\xd6\xd1\x0e\xad\xa6\x2b\x82\x22\x79\x95\x19\x6c\xdd\x46\xda\x4d\xcb\x6d\xca\x79\x24\xb9\x6c\x8b\x63\x7e\x2b\x4c\xf5\xc7\x88\x35\xdd\xf9\xb8\x5d\x99\x51\xd1\x76\x7d\xb8\x3a\xab\x07\x5e\xda\x18\x28\x58\xdd\xc0\x79\x16\xf2\x3c\xbd\x3c\xe8\x50\xa7\xfc\x39\xc4\x33\x96\x9d\x60\x1f\x1e\x87\x33\xc5\x29\xa0\x38\x12\xda\x95\x39\x39\x62\x2b\x01\x5b\x66\xaa\xaa\x5c\x11\x69\x4b\xa4\x36\xfd\x50\xa1\x60\x27\x60\xfa\xab\x85\x0b\x6c\x02\x8f\x14\x1e\xa1\xfd\x15\x63\x84\x50\x32\x20\xb1\xc6\x26\x59\x42\x92\x6b\xe6\xcb\x8e\x10\x6d\x3c\x44\xb4\x19\x24\x3e\xf2\x1b\xf0\x18\xb6\x09\xa5\x7c\x6e\xeb\x42\x18\x7d\x03\xc2\xea\x7f\x84\xb6\xd2\xbd\x85\x22\x80\xbc\x76\xe9\x1a\x92\x6b\x31\x03\x6e\x80\x15\xa6\x2f\x82\x21\x7e\x1d\x3d\x70\x67\xe4\x9d\xde\x73\xf9\x2e\x53\x4c\x57\x84\x06\xde\x75\x8f\xe9\x74\x64\x75\xdd\xd0\x88\x54\x66\xa8\x89\xf3\xf0\x21\x40\x87\xb3\xe6\x55\xd2\xc3\x71\xea\xad\x27\x20\x32\x5a\xd4\x32\x6b\xe1\x66\xe6\xf3\xa3\x4c\xae\x42\xe3\xc8\x3c\x08\xc8\xe1\x3b\x44\x63\x47\x76\x34\xaa\x18\x54\xf6\x10\x6a\x63\x62\x12\x0b\xa2\xe7\x3a\x13\x12\x32\x6d\xbd\xf0\xdc\x41\x8a\x35\x9d\x9b\x33\x8f\x35\x1e\x00\x4c\x1a\x42\xab\x11\x36\x9a\x23\xa4\x39\x77\x75\x0a\x01\x1b\x7b\x0f\xd7\xb8\xdd\x94\xd7\xd7\xec\xbf\x15\x19\x15\x85\xc6\x6b\x18\x83\x2b\x92\xf1\xf1\xfd\xa8\x43\x06\xc9\xf8\x55\x3f\x24\x42\x77\x1e\x51\x2f\xf3\x23\xcd\x59\x96\xdb\xa6\xae\xb8\x5e\x6a\x1f\x42\xd8\x2c\x8f\xad\xb0\x32\x51\x5a\x32\x53\xb1\xf3\x7c\x12\xec\xf5\xf9\x1f\x1b\x65\x97\x41\x1a\x07\xc3\x6a\x38\x14\x87\x6f\x6e\xbe\x1d\x95\xec\x1c\x42\x9c\xac\x29\x2c\x19\xe7\xd6\xdd\xb7\x65\xa9\x2e\x29\x4d\xb9\x96\x17\x68\x05\xe3\x3a\x87\xd7\x68\x1d\x99\x86\x7f\x91\x46\xff\x0b\x2d\xd1\xbf\xec\x58\xf3\x6c\x8f\x05\x8c\xab\xec\xab\x75\x4d\x0d\xb2\xb7\xb0\xb9\xad\x75\x65\xca\x9e\x54\x6c\x49\x65\xd2\x5f\x7d\x58\x1e\x1c\x68\x38\x9f\x3d\xa8\x0f\x87\x16\xdd\xd7\xda\xc4\x44\x8a\xcc\x1d\x17\xf5\xe5\xc4\x01\x17\x3a\x2f\xdb\x16\x5b\x85\xcb\x0c\xfa\xdd\x7b\xbd\x11\xc5\x49\x93\x38\xcf\xc9\xfa\xef\x8a\x9b\x1c\xb1\x5d\x2a\x40\x52\xe5\x72\x2d\x21\x26\x61\x66\x21\x46\x7e\xbd\xd6\xa2\x5d\xaf\xe7\x74\xb1\x05\x90\x05\x5e\x0d\xc6\xb2\x68\x55\x93\xd0\xc3\x88\xf4\xd3\x38\xb5\x90\x46\x6a\xdf\x0f\x41\x66\xf7\xcf\x43\x86\x90\x59\xb6\x72\xb9\x9b\x01\x7e\x98\x1f\x8c\xe1\x98\x0a\x84\x01\xd4\x6d\x92\xe7\x2c\xcf\x93\xbb\x24\x83\xff\x13\x96\x10\x20\xcf\x87\x83\x52\x1b\x26\x5b\xf6\x37\x8e\xf4\xb8\xc7\xed\x4d\xdc\x69\x9c\x48\x99\xe0\x71\x7b\x33\x8f\xca\xed\x0d\x62\x73\xf3\xfc\x7a\x5e\xcf\xb2\x4f\x4d\xb7\xa4\x6b\xf4\xcf\x92\x2a\xf4\x13\x8b\xd8\x3a\x54\x84\xdb\xc2\xed\xa2\xf7\x7b\x9d\x4d\xa9\xa9\x46\x0f\x13\xf9\xd8\x81\xb0\xa7\x5b\x9f\x3e\xdd\xdf\x43\x45\x14\xf5\xa1\xc1\xb0\x61\x45\x61\xcf\x9b\x7a\xe5\x30\x60\xed\x56\x3d\x0a\x76\x1c\x27\x7c\xf2\x33\xac\x61\xc8\xe3\xdb\xd9\xc2\xe9\x9d\xc2\x77\x3d\x26\xeb\x0a\xfc\x76\xd7\xf6\xae\x3b\xa2\x76\xf9\xf1\x47\xef\x74\xf7\x8b\xdd\x92\xc1\xbf\x1a\x52\x37\x06\x99\xb5\x5d\x61\xb4\x66\x5c\x2e\x28\x4a\xf9\x57\x49\x78\x1e\xe0\xb4\x78\x48\x80\xf1\x02\xb9\xf0\x90\x32\x7c\x46\x40\x6a\x0d\x6e\xaa\x4f\xf7\x49\x3e\x74\xb5\xa8\x19\x37\x76\xd7\x9f\x32\x6e\x85\xa4\x27\x2b\x8e\xbf\xff\x10\xd7\x0e\x72\xab\x3b\x13\x93\xb8\x5d\x7b\x9a\xdf\xa3\xd0\xf5\xc7\xab\x48\xe7\x61\xd6\x65\x7c\x34\x32\x9c\xd0\xe5\x79\x92\x7a\x9c\xf2\x3c\x87\xd0\x3c\x1b\x05\xaa\xa8\x06\xd1\x49\x45\x9b\x13\x35\x35\xe4\x46\x7f\x02\x17\xf2\x48\x9a\x57\xe6\xec\x27\x3e\x8e\x7d\xb5\x98\x70\xc3\xfd\x1a\x7e\x45\xe5\xd9\x29\x6a\x32\x5d\x99\x9f\x31\xf3\x1e\xfd\x30\xc4\x9f\x73\x13\x7e\x41\x42\x9b\xa2\x93\x88\x58\x48\xcf\xd7\xe2\x41\x02\xf5\x09\xe1\xa5\xed\xfc\x87\x93\x4c\x8b\x39\x6e\x6a\x84\x68\x41\xef\xa5\xe8\x76\x7b\x7f\xab\xc3\x59\x59\x43\xcd\x50\x56\x1b\xa6\x74\x29\xb6\xa5\x0b\x4a\x4a\x16\xd7\x3d\x5f\x0f\xc1\x66\xbc\x5d\xd7\x05\xa7\xcf\xcc\x50\x93\xaf\x7f\x38\x64\x1b\x34\xdb\x20\xc2\x53\xb9\x9d\x5b\x65\x2f\xa3\x28\x29\x62\xa3\xa8\x3c\xd9\x04\xe8\x86\x42\x6b\x45\x34\x0f\x0f\x3e\x57\xab\x5e\xe4\x45\x8b\xd6\x63\x68\x7a\x48\xb0\x23\x21\x86\x50\x8a\xc7\x52\x8f\xd8\xb1\x74\x15\x84\xf7\xac\x60\xdf\x04\x3f\x77\x42\x0b\xf8\x4f\x25\xb8\x66\x7c\x5c\x48\x36\xb7\x42\x2e\x78\xb4\xca\xaf\xcc\x11\x08\x1b\x9d\x0f\x06\x4e\x54\x48\xdc\xa8\xd5\xd7\x1c\xb1\x89\xd3\x87\x81\xb1\x3d\xe4\xc6\xc7\x0c\x12\x73\x40\x75\x69\x69\x7f\x8c\x66\x42\xe2\x51\xb1\xd0\xd0\x60\x0c\x8b\x15\x5a\x63\x7e\x86\xd5\xba\xbf\xe5\x83\xb1\x78\xf0\xfb\xdd\x4f\x3f\xdb\xd3\xff\xe1\x2f\x11\x2d\x6c\x4d\x82\xda\xd7\x00\x20\x90\xac\x1f\x8a\x81\x2f\x33\x31\x75\x32\x8f\x60\x35\xb1\x8e\x24\xaf\x86\x1a\xac\x02\xca\xf2\xad\xbf\xa7\x35\x9e\x1b\xfd\x27\x38\x91\x86\x0d\xd7\x37\xcd\xed\x90\xca\xa1\x64\x79\x3e\x89\x4f\xd9\x86\xd8\x1e\xa1\x9b\x93\xfe\x87\xaa\x2b\xc7\xc2\x17\xc8\x8b\xd3\xf4\xa4\x4f\x77\x0d\x30\x7c\x99\xf1\x83\x2a\x66\x38\x3d\xe9\x8f\xf0\x54\x75\x37\xc2\x66\xca\x75\xc8\x0c\xa4\x36\xa5\xba\xaa\x72\x05\x8b\xc3\x81\xa5\xbd\x24\x43\x14\x1c\xe8\x25\x77\xf7\x97\x48\x28\x64\xe1\x89\x21\x4e\x57\x00\x19\x1a\x07\x56\x1f\x9e\xd6\xeb\x5e\x20\xac\xe7\xd6\xa7\xce\xc7\xe2\x2e\xfc\x25\xc9\x58\xd3\x24\x29\x2c\xbc\x5b\x30\x55\x66\x2f\x27\x29\x93\xd9\x4e\x37\x8f\x29\x1b\xef\xa0\x83\xad\x79\x54\xfd\xa5\x2f\xef\x04\x56\x84\x43\x2b\x45\x45\x69\x8d\x56\xaa\xda\x0b\xa1\x6c\x21\x55\x50\x4b\x91\x84\x9b\xfb\xc0\x6c\x38\xc8\xd5\x4e\xc7\xf3\x44\xd3\x24\xd3\x93\xfa\xa1\xde\x24\x2e\xd1\x9c\xac\x39\x1d\x22\x53\x7f\xe8\x3b\x78\x94\x11\x30\x17\x0b\x18\xe5\xb6\xba\x49\x33\xf8\x34\x4a\x49\x06\x4e\xb5\xcf\x93\xda\x7c\xf9\xfd\xb4\x42\x22\xbc\x51\x67\x23\x4c\x75\xfb\xe2\x0e\xc8\x56\x53\x39\xd0\x2c\xd2\x72\x3e\x31\x6e\x7a\x66\x90\x48\xaa\xc6\xf5\xe0\x92\xaa\x51\x02\x6b\x46\x95\x5a\x47\x08\x39\xda\xdd\xb6\x73\xf4\xbb\x6a\x45\x27\x46\x21\xc9\x46\xef\xd2\x21\xfb\x30\xea\x3c\x17\x5f\xbb\xc5\x0f\x9c\xd1\xc9\xc1\x09\x1d\x2f\xe9\x93\x0d\x40\xbc\x75\x41\xb2\x5b\x72\x5e\x13\x8f\xc1\xeb\xf3\xc6\x2f\x83\x9d\xf0\xa5\x7c\xc2\xba\x5d\xc6\xfc\xc7\xbe\xfa\xef\xf1\xec\xe2\x60\xdd\x55\x84\xf6\x07\x9e\x03\x31\x50\xe9\xcc\x57\x7b\x05\xd7\x3a\xd2\x98\x48\x16\x1b\x77\x43\xed\x1f\xdc\x5f\xc6\xb3\x76\x77\xfe\xc2\xb1\xec\x82\x9a\xc7\x3c\xb9\xe6\x42\xb9\xf7\xc6\xf8\x6a\xd1\x8e\xb6\x65\x72\x7e\x2c\x65\x3a\xb0\x9e\x3b\x3d\x96\x7d\xc1\xa8\x27\xde\x97\xc8\x4f\xcf\x66\x6e\xe7\xd2\xd1\xa4\xae\x67\x73\xd1\x2e\x6c\x7a\xdb\x57\x35\xda\xaf\x0f\x20\x91\xcf\xe2\x40\x39\x6c\x2e\xe6\x06\xa6\xd1\x9c\x7b\xe1\x93\x5c\x91\x33\x9c\xc7\x1b\x1b\x24\x9c\x7c\x1d\xd6\x6a\x05\xe7\xfd\x05\x2a\x49\x94\xb9\xc9\x49\xdc\x89\xeb\x32\x7d\x15\x04\x75\xf6\x12\x6e\xf9\xe8\xe9\xef\xa8\xfe\x63\x74\x0e\x6d\x36\x7a\xe9\x00\xbc\x82\x24\x73\x8f\x59\xe2\x2b\x34\x82\x79\x12\x78\x86\x32\x19\x16\x54\xf5\xad\xe3\x9a\x1f\x5c\x7e\x31\xcf\x1b\x13\x51\x72\x57\x16\x91\x7a\xe7\xbd\x28\x9e\x26\x79\x7e\xde\x8b\x3c\x4f\x9e\x0e\xc2\xe3\xdc\x8c\x19\xc2\xbd\x84\xe7\x69\x50\x08\x21\x43\x1e\xe8\x7b\x4d\x52\x2d\x88\x80\xfc\x23\xea\x75\xac\x5e\x88\x36\x75\xcd\xb1\x8e\x5d\xc7\x67\x8c\x54\x85\x7a\x34\x50\xa2\xfe\x12\xdf\x7f\xe5\xac\xda\x5d\x31\xf5\x39\xb4\xd7\xfd\xa7\x22\xae\x97\xe6\x6f\xba\x6d\x69\xcb\xec\x69\x43\x8f\xbf\x5c\xda\x99\x3a\xfd\xb2\x74\x6d\x85\xfb\x77\xa8\xaf\x28\xcb\x13\x69\xdc\x3c\xc9\xfd\xb7\x6e\xec\x7c\x75\x7e\xdf\x78\xad\x46\x5f\x98\x83\x0a\x28\x46\x57\x0b\xcc\xed\x7e\x8f\x27\xfa\xa4\x7d\x76\x44\xe4\xa5\xa4\xd5\xc9\xe5\xe8\x45\x5e\x2a\xca\x6b\x9f\xde\x7f\x4f\xb5\xfd\x26\x46\x36\x3c\xc6\x16\x20\xbe\x0c\xe0\x32\xea\x23\xfa\x04\x55\x2d\x4e\x9b\x17\x10\xdd\xcb\xb7\xdd\xcc\xe9\xc6\x70\xaf\xfe\xa8\x76\xc3\x9d\xfa\xc8\xc8\xaa\x2c\x51\x41\xe2\xdf\x7b\x84\xf6\x5c\x76\x6c\xa2\xe2\x0b\x06\xb8\xd4\x87\x6e\xbf\xf4\xd7\x78\x0d\xbb\x8f\x91\xb3\x6e\xbd\xb9\x17\x6c\x2e\x81\xd7\x61\x41\x20\x7c\x83\x2f\x85\x9c\xf7\x29\xcc\xc4\xbd\x1a\xa1\x1c\x3d\x09\x8b\x78\x32\x72\x64\xa0\x80\xe8\x13\x0a\x31\x01\x42\x70\x83\xf0\x04\x74\x18\x67\xbd\xa5\xf3\x47\xd0\x81\x61\xdc\x10\x20\x9b\x10\x6f\x4c\x34\x9f\xd9\xbc\x7d\x71\x17\x5f\x98\xe1\x9b\x47\xb7\xd8\xd3\xfd\x73\x37\xd8\xc4\xaa\xe3\x59\xe6\xf6\xe9\x33\x27\x30\x5f\x73\x98\x87\x6b\x14\xd3\xc3\xf7\xe1\xdc\xd6\x63\xc3\xb8\x64\xd0\xf5\x9b\x29\x7a\xc3\x3e\x79\x93\x46\x97\x9f\x4e\xbd\x1a\x1c\x85\xe9\xde\x97\x3d\x4d\x4d\xa5\xc5\xb2\x9f\x76\x4a\x66\xd1\x06\x71\xdc\x63\x67\x96\x56\x49\xf4\xc2\x3d\xf1\xc2\xa3\x6e\xbd\xd4\x4f\x51\x89\x0e\xfc\x1e\x47\xe8\xfa\x61\xec\x97\x40\xa8\xf4\x35\x68\x8f\x71\x87\xe9\x94\x6f\x85\x3c\x12\xbd\x4c\xfe\xec\xf5\x38\xea\xbf\xe2\x6b\xf6\xec\x6b\x06\x7e\x86\xe2\x6b\x06\x1e\xa9\xe2\x6b\xf6\x32\xc9\x26\xd9\x81\xe0\xcf\x62\xd7\x1f\x06\x67\xc3\x8b\xdc\xda\x80\x11\xfa\xae\xdb\xe3\x20\x7b\xba\xd8\x11\xe9\x68\xdd\x95\xfd\x10\xc0\x03\xac\xdb\x1f\x99\x6c\x97\x2a\xbe\x0f\x19\xd4\x6f\x58\xfc\xdc\x07\x86\xae\xed\xc0\x36\x8b\x2e\x17\xf6\x17\xdf\x86\xd2\x73\x7b\xcd\x62\x28\x4f\x9b\xd4\x51\xce\xdd\x21\x18\x15\xb3\x5d\xf3\x82\xfb\x93\xb5\xa8\xba\x6f\xa6\xf0\x71\x0e\x91\xf4\xfa\xed\xe9\x10\xdc\xe8\x02\x75\xd8\xf4\xf0\x1d\xea\xa1\xb2\xfe\x7e\xb1\x98\x96\xe9\x4d\xe8\x30\x2a\xea\xcd\x27\x03\x6c\xd1\xff\x57\x71\x29\x23\x53\xeb\x51\x11\x7e\x84\x7c\x54\xb6\x15\x34\x84\x95\xff\x65\x25\x86\x0a\xad\xf1\xb5\x24\x5f\x7d\xe8\xfc\xdd\xcc\x26\xd7\xdd\x0d\x9b\x8d\xf9\xde\x8f\x58\x89\xf6\x5b\x37\xfc\xc7\x8e\xc0\xd9\x5c\x72\x6a\xa8\x86\x4e\xf9\x32\x7a\x02\x4f\x6d\xfe\xfa\xa9\xcb\x66\x0f\x97\x80\x08\xbf\x9c\xc9\xc5\x9f\x84\x4c\xbe\x0a\x10\x2d\xc7\x24\xbf\x2c\xa0\x24\x4a\x22\x85\xa5\x9a\x6f\x1f\xcb\xe6\x7f\x36\xa5\xc3\x2f\xf1\x58\x4a\xc7\xde\xb9\x48\xd1\x69\xb7\x33\xae\xc3\xaa\x57\xfb\x2a\x8d\x0c\x6c\x50\x58\xda\xef\x09\xa9\x0e\x6a\x39\x4a\x7f\xf6\xd8\xf5\xa7\x0d\x73\x35\x9e\x96\xec\xeb\x78\xb3\x18\x77\xa7\x04\xa1\xbb\x4c\x89\x6c\x2e\xfe\x3b\x5a\x93\xe2\xfc\x71\xb8\x98\x4c\x27\xbb\x36\x49\x12\xac\x2f\xd2\x1b\xd7\x22\x56\xaf\x33\x7c\xca\x79\xae\x7e\xbe\x77\x7c\x88\xd6\xf4\xd8\x6a\x18\x63\x33\x13\xf2\x8b\x43\xe6\x02\xed\xc9\x1d\x97\x11\xcf\x8f\x81\x25\x9f\x27\x79\x83\x13\xf4\xe0\x04\xae\x98\xa4\xaf\xf1\x0e\x6d\x9b\xbf\x1e\x80\xf1\xb7\x3b\x17\xa4\xb5\xfd\x80\xc8\xec\x9c\xc3\x29\xd9\xe3\x61\xfd\x84\xb9\x26\xac\x35\x1b\xf6\x7b\xbf\xdc\x52\xef\xbf\x14\x2b\xfd\xdc\x6d\x1a\x56\x01\x43\x37\x76\x4b\x2a\x8a\xaa\xd0\x25\x5b\xca\xf2\xad\xff\x35\x25\x79\x31\xf7\x89\x89\xc5\xf8\x6b\x6e\xe8\x7f\xf5\xad\xe1\x27\xea\xcc\xaa\x0b\x5b\xe8\xbf\x88\x3f\x5f\x66\x1a\x7c\x55\x6f\xf4\xed\x2f\x37\xc6\x3c\x2f\x82\x2f\x7e\x81\x83\x86\xcf\x8b\xe0\xab\x5e\xfe\x3d\x3e\xfb\xf7\xe6\xc3\x45\xee\xfd\xbb\x9f\x7e\xf6\xaf\xdf\x98\x7d\xb7\xaf\x3f\x0d\x5f\x30\x72\x4f\xf7\x5f\xfc\x63\x19\x5f\x0e\xd8\xa2\x2c\xc7\x8e\x7c\xb5\x27\x3c\xb3\xc9\xf2\x30\xe0\xc6\xd7\xf6\x33\x8c\xae\xc9\x7d\xc7\x65\xec\xa1\x63\x3f\x5f\x75\xc5\x05\x34\x82\xef\xa8\x84\xb3\x24\xad\x39\xfb\x00\xdd\xb5\x0d\xf5\x1f\x3f\xf9\xd6\x94\x69\x3f\x55\x70\xde\x13\x0d\x1f\x14\xd4\xcc\xe5\x0b\xed\xb1\xf2\x86\x56\xe6\x90\x93\x69\x68\x90\xe5\x87\xc4\x36\x51\x8a\xed\xb8\x29\x3b\x19\x23\xe9\x7c\x1a\xff\x9d\x99\xb1\xaf\xdf\x23\x18\x8e\x31\xbd\xdc\xa0\xff\x1f\x00\x00\xff\xff\xd5\x57\x85\x6a\x3e\x55\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x7d\xff\x8f\xdb\x36\xb2\xf8\xef\xfe\x2b\xa6\x0a\x8a\x58\xa8\xac\x64\x73\xe8\xe7\x07\xa7\x4a\x71\x97\xe6\xfa\x29\x5e\xd3\x16\x97\xde\x3b\x3c\x2c\x16\x3e\x5a\xa2\xd7\x8c\x65\x52\x47\x52\xeb\x6c\x83\xed\xdf\xfe\x30\xfc\x26\x52\x92\xbd\xb9\xbb\x1c\x9e\x81\x36\x92\x48\x0e\x87\xc3\xe1\x70\xbe\x91\xbb\x5a\x41\xbd\x27\xbc\x6c\x7b\xb2\x58\xad\xe0\x3b\x2a\xd9\x1d\x6d\x60\x27\xc5\x11\xda\x9e\xac\xb0\x90\xd3\x56\x61\x85\x12\x7e\x11\x52\x33\xc1\x15\x56\x7d\x2d\xba\x7b\xc9\x6e\xf7\x1a\x96\x75\x0e\x2f\x9e\x5f\xfd\x01\xde\x12\x49\x0f\xf0\x96\xbc\x3f\x88\x93\x3a\x30\xac\xd5\x2b\xda\x40\xcf\x1b\x2a\x41\xef\x29\xbc\xfd\xe1\x57\x68\x59\x4d\xb9\xa2\x40\x78\x03\x8a\x1d\x59\x4b\xa4\xeb\x8f\x6d\x35\x51\x07\xe8\x3b\xa5\x25\x25\xc7\x02\x14\xa5\x08\xe4\x96\xe9\x7d\xbf\x2d\x6b\x71\x7c\x76\xcb\xde\x33\xfd\xec\x96\x3d\xbb\xa3\xbc\x11\xf2\x59\x54\x74\x24\xef\xe9\xe1\x59\x8c\xf4\xb3\x1f\x7f\x78\xfd\xe6\xa7\x77\x6f\x56\x6f\x7f\xf8\x75\x15\x17\x2c\x56\xab\xc5\xea\x33\xfe\x10\xc9\xef\x05\x28\x7d\xdf\x52\x78\xed\x3a\x81\x9d\x90\xf0\xa3\xa1\x2b\x96\xff\xba\x67\x0a\x6a\xd1\x50\x60\x0a\x9a\x84\xce\x6e\xdc\x2d\xdb\x4a\x22\xef\x61\x7b\x0f\x7f\xe9\x95\x82\xd7\xe2\x43\x01\x47\xc2\x78\x7b\x6f\x2a\x2e\xdc\x64\x71\xda\x96\x75\x09\xef\xe8\x91\x70\xcd\x6a\xd2\xb6\xf7\xfe\xbb\x02\xa2\x80\x1d\xbb\x96\x1e\x29\xd7\xb4\x81\x3d\x95\x14\x88\xa4\xf0\x8f\x9e\x69\x43\x4c\x4f\x72\x2d\x86\x46\x06\x0d\x9c\x9f\xef\x05\xb4\x84\xdf\xf6\xe4\x96\x96\x0e\xef\xbf\x2a\x72\x4b\x61\x79\xa2\x4f\x25\x85\x5e\x31\x7e\x0b\x3d\xdf\xf6\xbb\x1d\x95\xb4\xf1\x20\x4c\x3f\xf9\xda\x35\x69\x45\x4d\x5a\xd8\x6c\xcc\xa8\x2a\x90\xf4\x1f\x3d\x93\x74\xf9\x14\x2b\x3f\xcd\x93\x4a\xbb\x9e\xd7\xc8\x52\x50\x8b\x9e\x6b\x2a\x97\x0e\x20\xd6\x02\x00\x57\x8b\x41\x05\x57\xee\xcb\x69\xcf\x5a\x0a\x5a\xf6\x14\x1a\xe1\xbe\xe1\xcf\x35\x5c\x2b\xca\x9b\x25\xcb\xa3\x12\x6c\xcd\xe0\xab\x00\x81\xf2\x06\x9f\xec\x3f\x33\xa8\x20\xc9\x97\x01\x80\x2d\xf4\xe3\xac\xdc\xb0\x4a\x37\xcb\x6b\x4e\x4f\x43\x5d\x57\xa6\x3a\x72\xe2\x4b\x37\xa2\x02\x46\x43\x02\xa2\x14\x95\xda\x8f\x74\x2d\x69\x7d\xb7\xcc\xa1\xaa\xe0\xea\xf1\x2a\x2f\x1e\xaf\xf2\x87\x3c\x1d\x5d\x82\x14\x8e\x2d\x8f\xbf\xd6\x7b\xda\xf4\x2d\x95\x4b\x37\x2f\x81\x55\x8f\x02\xbf\x03\xfd\xd0\x09\x45\x95\x9f\xda\x14\xda\xae\xe7\x05\x5c\x97\x65\x79\x93\xc3\x0a\x64\xcf\x91\x88\x40\x14\x10\xa8\x85\x14\xbd\x66\x9c\xc2\x89\xe9\x3d\xdc\xb2\x3b\xca\xa3\x39\x99\xfc\x3a\x22\xc9\x91\x6a\x2a\x55\x09\xff\x23\x7a\x50\x7b\xd1\xb7\x0d\xca\x0f\xd0\x88\x0e\xe3\x4a\x53\xd2\x80\xd8\x5d\x82\x12\x7a\x2d\x6b\x49\x89\xa6\xcb\x7c\x8c\xf7\x30\x5e\x58\x41\x4d\x38\x6c\xa9\x41\x5c\xf8\x55\x66\xd6\x01\x92\x09\xf4\x5e\x52\xd2\x14\x40\x3f\xd0\xba\xd7\x54\x9d\xeb\x98\xb4\xad\x69\xa4\x74\xbf\xdb\x15\x20\xa9\xea\x8f\x54\x99\x4f\x01\x1f\x7c\x25\x1a\x57\xe2\x39\x28\xdb\x56\xd4\x07\xda\x00\xae\x05\xbf\x2e\x4d\x9b\x2d\xad\xc9\x91\x02\xb9\x23\xac\x25\xdb\x96\x1a\xfa\x9c\x83\x52\x13\x37\x94\x46\x00\x17\x7c\x65\xa0\xe2\x9a\xc5\x65\xa1\xe0\x19\x48\x5a\x53\x76\x47\x55\x90\x28\x73\xbf\x11\x09\xca\x11\x11\x63\xde\xbf\xb6\xa2\x00\x14\xfb\x8d\x1a\x2e\xb0\x84\x07\x02\x9c\x9e\xfc\x48\x22\x1e\x30\x15\xc7\x93\x42\x5b\x5a\xeb\x25\x69\xb5\x2a\x70\x04\x1b\x83\xb5\x67\x29\xd2\x6a\x78\x06\xb6\x0e\x3c\x83\x63\xdf\x6a\xd6\xb5\xf4\x03\x88\x3b\x2a\x2f\x31\x43\x32\x1c\x04\x0e\x4a\xcb\xbe\xd6\xbd\xa4\x25\xfc\x59\x48\xa0\x1f\x08\x8a\xca\xf5\x68\xa1\x58\x6c\x3e\x7e\xac\xa1\xf2\x03\xd8\x5c\x15\x20\xba\x61\xf5\xff\xe5\xcd\xeb\xff\x7e\x28\xa6\x9d\x27\x6d\x5e\xa4\x6d\xde\xbd\xf9\xe9\xbb\x02\xf0\x43\xb6\xa7\x6d\x2b\xb2\x87\x87\xc2\xc8\xb1\x3c\x5e\x76\x27\xd6\xb6\x96\x17\xa0\xee\xa5\xa4\x5c\x47\x4b\xa9\xe7\x9a\xb5\xc0\xf4\x53\x05\x9d\x50\x8a\x6d\x5b\x0a\x5a\xf8\x39\x45\x18\x86\x83\x03\xd2\x20\xa4\x99\xf8\x48\xd8\x6f\x5e\x94\x9e\x96\x92\xea\x5e\x72\x05\x04\x78\x7f\xdc\x52\xe9\xd6\x96\xd2\x44\x9b\xed\xc3\x02\x33\x84\x33\x8c\xa8\xfa\xba\xa6\xb4\xa1\x0d\x2c\x0d\xe4\x17\x56\xea\x9b\x8d\x9c\x78\x24\x8c\x68\xbd\x23\x6d\x4f\x81\xed\xfc\xd2\x69\x22\xa0\x27\xa2\x00\xc9\xe7\x99\xea\xcf\x8c\xe3\x0e\x56\x60\x75\x7d\x12\xd8\xdf\x50\x5b\xf9\x25\xba\xeb\xdb\x1d\x6b\x5b\xda\x00\xd1\x76\xb1\xe1\x9a\xd0\xec\x48\xcd\x2c\x9c\xa8\x91\x14\x9b\xcd\xb6\x67\xad\x66\x7c\x73\x24\x7a\x5f\x4a\xc2\x1b\x71\x5c\xe6\xa0\x05\x34\xb4\x66\x0d\xc5\xdd\xa3\xde\x83\xe0\xd4\x0b\x98\x5b\x01\x3b\x26\x95\x2e\xe1\x9d\x00\xa6\x11\xd8\x91\x1c\xa8\x42\xba\x29\x43\x5d\xc6\x99\x66\xa4\x65\xbf\x51\x50\x94\x36\x96\x97\x95\x38\x52\xbd\xc7\x85\x65\x3b\x29\xe1\x87\x1d\xdc\x8b\x1e\x1a\xc1\x9f\x1a\x28\x7b\x72\x47\x81\xd4\x35\x55\x0a\xa1\x10\x0e\x94\x6b\x29\xba\x7b\x50\xa2\x97\x35\x35\xb5\x71\x74\x8d\x40\x06\x04\x98\xc7\x1e\xbb\x5c\x0a\x55\xe2\x50\x97\xb9\x11\xdd\xdb\x1e\x85\xc2\x89\x48\x5a\x18\x52\xa0\xc0\xc1\x49\x12\x3b\x08\x23\x36\x6c\xd4\x49\xda\xb0\x5a\x13\xc7\x26\x04\x88\xd6\xa4\x3e\x50\x59\x7e\x5e\xed\x67\xb1\xf0\x3b\xfe\x5b\xa8\xe0\xe3\xc3\xc2\xea\x87\x5c\x69\xc2\xb5\x72\x85\x38\xe7\xc8\xfb\xb8\x51\x65\xb0\x5a\xc1\xf3\x0f\x57\xae\x08\x57\x06\x16\x21\xab\xba\xa2\x17\xae\xe8\xa7\x9f\x7f\x01\x2c\xe2\xa2\xcb\xc0\x16\xfd\xc1\x15\xfd\xfa\xc3\xdb\x37\x3f\xff\xf5\x57\xec\x91\x4a\x89\x95\xdc\x97\xcc\x22\xf0\x7d\x2b\xb6\xa4\x05\xb1\x7d\x4f\x6b\x6d\xb5\xb1\x20\xfd\x1d\x08\x5c\x97\x6a\x23\x7b\xce\x0d\x8d\x10\x77\xb0\xbf\xd5\x0a\x5a\xa6\x34\xd2\x34\x92\xe1\x28\x0c\xef\x41\x0b\xb3\x69\x18\x31\xdf\x24\x90\xb4\x88\x61\x04\x48\x7e\x83\xc0\x39\x14\xbd\xb6\x95\x7d\x43\x76\xa4\x52\x4d\x9b\x99\x86\x1d\xe5\x0d\xf2\x98\xad\x64\xf4\x61\x5c\x1b\x52\x6f\xcc\x17\x07\xc2\xed\x1c\x1b\xc1\xc7\x60\x56\xab\x01\x7b\x58\xbd\xc2\xb5\xb5\x21\x52\x92\x7b\x60\xb8\x10\x19\x32\x0d\x77\x50\x54\x4b\x69\x87\x9d\xcd\x8d\x20\x81\x62\xfa\x9e\x81\x40\x5a\x8d\xeb\xdd\xbd\xd5\x84\xd7\xb4\x35\xa2\x6f\xb1\xd8\x6c\x48\xdb\x6e\x10\x8a\x05\x8f\x44\x41\x3c\xb0\xa4\x6e\x29\xe1\x7d\xf7\x1d\x25\xcd\x6b\x5b\xc1\x6b\x62\xcb\x7c\x11\x14\xb0\x03\xa5\x1d\x95\x0a\xe1\x58\x1e\x9b\x94\x70\xa1\xa9\x0a\x65\x38\xdd\xac\xa8\x71\xf9\x02\xeb\x08\x93\x6a\x39\x20\x91\xa3\xea\x08\xe6\xc7\xa2\x09\x2e\x51\xee\xf4\x6a\x59\x8b\x1c\x7e\xaf\x20\x6b\x28\x69\x32\x9c\x39\xbe\x18\xf6\x12\xb3\x05\x33\x6e\x94\xaf\x08\xa9\x02\x6a\x91\x0f\xd5\x2c\x6a\x77\x46\xfa\x23\xfc\x17\x06\xbb\xeb\x5a\xdc\x0c\x75\xee\xca\xcd\xa6\x15\x35\x54\xf0\x24\x02\x34\x94\x27\x03\xc3\xa6\x50\xc1\x9d\x2b\x46\xf5\x6e\xf8\x27\x21\xef\x08\x56\xdc\x7f\x54\x6a\xde\x17\xd8\x7e\x11\x24\xb6\xc3\xe7\xd6\xe8\x07\x38\x02\x9c\x04\x60\x3c\x86\x6f\xa6\xad\x8c\x9b\x70\x94\xc4\xb8\x32\xcc\x1a\xc2\xb7\xc5\xa8\xcf\x8f\x0f\x60\x3a\xb1\x1b\xce\x40\x6f\xb0\xf4\xb6\x0a\xa3\xd2\x92\xf1\x5b\xd3\xd4\x3e\x56\x81\x0d\x1c\x65\x1d\x4d\xab\x39\x8a\xb2\x1d\x12\xbb\x02\xce\xda\x78\xc2\x5c\x8f\xd9\x37\x54\x4a\x21\x57\x8c\xaf\x06\xf8\xab\x5a\xac\xb8\xd0\xab\x9d\xe8\x79\xe3\x8b\x3c\xdc\x57\x59\x44\xde\x00\x25\x2b\x4b\xed\x5a\x2f\xdd\xec\xe5\x65\x99\x41\x56\x96\x77\x9e\x12\xf8\x6e\xc7\xb5\xce\xca\x72\x8e\xb7\xca\x32\x7b\x95\x59\xd2\x1b\x6c\xf6\xe2\x54\xa5\x2c\xdf\x49\xc6\xf5\x32\x7b\x02\xf8\x33\x50\x63\xdd\xd6\x81\xcf\x72\xcf\xe7\x87\xe2\x0e\x18\x07\xcf\xe5\xc3\x28\x22\x3e\xb7\x20\x87\xd1\x2f\x0f\x79\xee\x87\x88\xff\x6d\x36\x88\x47\x2d\x2a\x8f\x92\x17\xea\xa8\x07\x1a\x90\x05\x30\xb5\xc1\x37\xa8\xa2\x25\x83\xc2\x13\xc1\xe5\x0b\xb6\x03\x2e\x74\xa8\xe4\x67\xc1\x50\x7e\x99\x79\x2f\x03\x1c\x7b\x85\xdb\x17\xb4\x82\x34\xb4\x29\xcc\x00\xb8\x38\x15\x68\xf6\x9a\x86\x01\x76\x96\x5b\x22\x25\x4b\x6e\x60\xc5\x62\x40\x2d\x4f\x38\xee\x3a\x7c\xbf\xa9\x3e\x9a\x49\xaa\x9e\xc4\xcd\xec\x44\x55\x19\x56\xcb\x1e\xfc\x38\xc3\xde\xb0\xa9\x45\xd8\xcf\xac\x90\xdf\xcc\xed\x1b\x9b\x8e\xc8\xc3\xe7\xf6\x22\xac\xe0\xff\xd3\x16\xd7\xa7\xc7\xca\xf3\x85\xdb\xd9\x37\xf5\x5e\xb0\x9a\x2e\x89\x94\xb9\x63\xfb\x27\x44\x4a\x78\x05\x57\x31\xdb\xdb\xb6\x92\x37\x50\xcd\x6b\x15\xcb\x27\x1e\x02\x00\xac\x56\x8e\xdf\x92\x3e\x80\x29\xa8\xf7\x42\x98\x0d\x28\x2b\x10\xda\xd0\x60\xb3\x51\x1a\x91\x28\x20\xc3\xee\xd9\x1c\x7e\x59\x9e\x2e\x42\x22\xe5\xb5\xe4\x8d\x59\xae\xb4\x55\x74\x5a\x7a\x75\x13\x73\xe4\x62\xb5\x82\x77\x1d\xad\x51\xf7\x52\xb4\x81\x77\x54\x43\x43\x34\x19\xb4\x78\x58\x1a\x5d\xcc\x76\x0d\xd4\x3a\x3d\x9c\x76\xcb\x04\xcf\xbd\x7a\x41\x35\x0a\xa1\x05\x80\xb1\x49\xa2\xfd\x45\xd1\x76\x97\x27\x34\x33\xfb\x13\x31\x32\xab\x00\xbb\xd3\x3c\xbc\x04\x45\xf5\x91\x6a\x62\x18\x71\x29\x70\x1f\x6e\x77\xf9\x4b\xf3\x4f\xb9\xd9\x30\xde\xd0\x0f\x50\x99\xd7\x74\x50\xc2\x8d\xa7\x58\xe0\x03\x69\x9a\x71\xe7\x05\xdc\xa5\xfd\x13\xdb\xab\x81\x4c\x6c\x47\x65\x3b\x6c\x55\xe4\xfa\xee\x66\x46\xcc\x8d\xf7\xa5\x36\x82\x0b\xe0\x5a\xc1\x93\x68\x6f\x71\x08\xa2\xf9\x31\xd9\x51\x2c\xb6\x92\x1e\xc5\x1d\xfd\xb7\x10\x1e\x9c\x37\x88\xc1\x30\x0a\x06\xaf\xe0\xf9\x08\x7f\x5b\x57\x43\x05\xed\xf5\x93\xf6\x26\x46\x5e\xdf\x14\xd0\x5e\x33\x1c\x02\x2b\x40\xc7\x45\xcc\x14\x3d\x69\xb1\x8c\xb3\xb6\xc0\xff\xfd\x53\x83\xb4\xac\x33\x19\xa4\x0e\x7b\x39\xdb\x81\x16\xb3\xb8\x12\x29\x83\xb6\x61\x7f\x46\xe7\x40\x57\x55\x01\x4f\x2c\x21\x06\xf9\x1b\xa0\xd9\x82\x6b\x76\x53\x3a\xb8\xe9\xd4\x99\x45\x15\xea\xe4\x1e\xe3\x04\xfd\x64\x74\xf3\x82\x21\xa9\x3c\x5b\xd3\xf6\x91\x27\xe4\x68\x29\x3f\xb7\x3c\x1c\x8c\x27\xc3\x04\x9b\x56\x0f\x0b\xab\x3f\xbc\x66\xb2\xee\x5b\x22\xe1\x4f\xd6\x1d\x90\x2e\xd4\xc2\xfa\x81\x91\x3e\xc1\xb7\x61\x96\xae\x75\x1e\xa8\xd2\xad\x54\x0f\xc5\x01\x39\xbf\x68\x0b\xe3\x46\x98\x59\xba\x5b\xb7\x74\x55\x2b\xb4\x82\xca\x54\x43\xd7\x9f\x6d\xe0\x3e\x58\x96\x7d\x5e\x00\x76\xf1\xdc\x4f\xe0\xe7\x59\xe4\x8f\x93\xd0\x52\x5e\xc2\xca\x4d\x73\x0e\x5f\xda\x27\x83\x73\x02\xac\x13\xdd\x39\x60\xce\xfd\x67\x41\xa0\xb6\x6a\xa1\xe6\x8b\xb1\xfe\x69\xbe\x6f\xaf\x6d\xc5\x9b\x30\x56\x7c\x83\x0a\x3c\x80\xaf\xe0\x6a\x8a\xc7\x80\xf3\x5d\x8a\x56\xaf\xf6\x17\x04\x43\xdc\xa3\x8c\x95\x56\xfb\x25\xf4\x2a\xcf\xf6\x7a\x69\x70\x9e\xed\x3e\xf3\xce\x0b\xbf\x1a\x1b\xcb\x39\x25\xfe\xe8\x8d\x1c\x05\xc4\xae\x4f\xf8\x78\xda\x53\x5e\x15\xd0\x51\xc9\x44\x53\x15\xb0\xab\x1e\x4a\xf8\x99\xd7\x14\xd5\xe3\x2d\x6a\xd4\xce\x13\x2c\x29\xa9\xf7\x54\x01\x36\xb0\x16\x7a\xd0\x1f\x00\xbd\xf5\x0a\x76\x4b\x03\x3e\x1f\x1c\x8e\xa1\xc6\x22\xb6\xb6\x0a\x50\x02\x76\x56\x65\xe2\x42\x5b\x4b\xaf\x0c\xd8\x99\x25\x44\x1c\x46\xc0\x10\x79\x2b\x51\x24\x5d\x11\x79\xa4\xcd\x4b\x10\x7a\x4f\xe5\x89\x29\x0a\x4c\xe3\x68\xac\x54\x6f\xca\x89\x7e\x11\x99\x95\x4b\x6d\x08\xad\x4b\x52\x6b\x66\xb6\x00\x2f\x41\x13\x49\xe5\x8d\x52\x5b\xdb\xcb\xda\xb0\x75\x2b\x2d\x3a\x0b\xcf\x95\x29\x03\xc6\x08\x54\xe3\x04\x52\xda\xf8\x28\xac\x89\x5b\x4e\xf1\x11\x5d\x82\x8e\x53\x2f\x03\x56\x53\x2d\x7f\x47\x9c\x56\xe1\x24\x5f\x34\x80\x50\x64\xcd\x42\xb8\x8b\xcc\x42\x3b\x8e\xd4\x24\x34\xb6\x84\x9e\xdd\x62\x2d\x05\xc3\xe8\x59\xb4\xcf\x6e\x25\x25\x87\x59\x03\x2d\xde\x89\x0c\x81\x46\xa3\xdd\x31\x49\xed\x68\xd5\x92\x8b\x53\x64\xee\x34\x3d\x9d\xb1\x77\x13\x33\x77\x53\x80\x7e\x64\x3c\xba\x44\x66\x84\x6f\x2a\x54\xb5\x2f\x69\x0e\x4d\x4f\xfd\x8c\xa6\x6a\xda\x9c\xe9\x1b\xd7\x4c\x86\x1b\x9c\x1a\x58\xeb\x0c\x9a\x4d\x4f\xc7\x38\x3a\x46\xfe\xdd\xea\x37\x84\x37\xc3\xb7\xa9\xc2\x60\x3c\x34\x07\x0c\x59\x3d\x55\xa0\x19\xba\xb5\x54\x01\x8d\x14\x9d\x79\x53\x70\xb2\x81\x2f\x2d\x04\xb4\x44\x53\xc4\xa1\x8c\x06\x63\x29\x52\xf9\x87\xaf\x42\x5f\x8b\x78\xb7\xbe\x44\xb8\x18\x0a\x96\xce\x81\x48\x76\xec\x0b\xcb\x67\x4a\xec\x19\xe6\x4d\xe0\xe9\x72\xe7\x56\x46\xca\x63\x4f\x1a\xcf\x63\xab\x15\x70\xfa\x41\x6f\xd0\x8d\xd1\x32\x4e\x9d\x61\xaf\xf7\x14\x28\x91\x2d\xa3\x4a\x9b\x99\x02\xa2\x9d\x5f\x94\xd8\x99\xc3\x96\x42\x0e\x3e\xdd\xe0\xb8\x62\x0a\x0c\x83\x08\x69\x66\xc8\x2c\x49\x6e\x2c\x85\x73\xcb\x38\x41\x20\xf6\xe7\x9c\x1c\x29\x3f\x91\x81\x2d\x99\x2d\x63\x08\x19\xa6\xc5\x7e\x4f\xa7\x25\x99\xd7\x59\xf6\x74\x7d\x92\x56\x0f\x66\xb3\x77\xe0\xa5\xdd\x62\x15\xe3\xde\x6e\x75\x69\x9c\xb8\x0d\x2c\x47\xa8\xb8\x22\x8b\x4a\x3e\x8f\x8b\xad\x73\x49\x32\x18\x5c\xfd\xac\xd1\x3b\xd2\x6e\x7c\x3c\x88\x39\xb9\xe9\xe2\x9e\x26\xa8\xd4\xd0\x61\xe7\xe8\xa4\x38\x76\xda\x49\x7f\xf4\xc8\xa1\xbd\x26\x38\x90\x10\x7c\x31\x53\x69\xdc\x7b\x93\xe9\x89\x7b\x8a\x67\xa7\x16\xc6\x72\x34\xd1\xf6\x37\x77\xa4\x45\xc7\x5c\x84\x6d\x2d\xe2\x45\x3a\xeb\x3f\xab\xd0\x9f\xdb\x2b\xe4\x0b\xda\x64\xc3\xae\x80\x68\x60\xb0\xef\xe0\x02\x64\x2e\xc6\x71\xeb\x61\x98\xc9\x69\x80\x13\x2e\x14\xad\x05\x6f\x54\x69\x63\x23\x2e\x22\x85\x1d\x46\xdb\xa9\x6f\x66\x02\x05\x5c\x68\xb8\x67\xb4\x6d\x70\xdf\x74\x9b\xa1\xa4\x70\xa2\xd6\x19\xcf\x05\x38\xd3\x16\x3d\xe8\x5a\x38\x64\x10\x8d\xd3\x5e\x18\xe2\xda\x68\xd7\x78\x2b\xc2\x6a\xcb\x26\x21\xcf\x63\xce\x10\xef\x59\x8c\x18\xc5\x37\x10\xd2\x7d\x8f\x3d\x0e\x31\xdf\xb0\x1d\xcc\x09\xbc\xcd\xc6\x20\xb2\xe1\x6a\xd9\x9c\xd3\xf1\x23\xc6\xf2\xfe\x5c\xe7\x38\x8c\xf7\xf7\x8f\x8e\x2d\x23\xbd\x05\xbe\x02\xf4\xc5\x24\xce\x57\x17\xb8\xda\x18\xaf\xb7\x99\x54\xca\x9b\x07\xd3\xf5\x30\x64\x43\xef\x65\x3e\xd3\x23\x9a\x62\x6e\xda\x3f\xaf\xa6\xf6\xce\xd2\x0d\xbd\x85\x4e\x75\xc3\xb5\x9b\x3a\xe7\x7b\x6e\x84\x7f\xd7\x92\xda\xc6\x54\x09\x52\xa0\x3e\x18\xfe\x19\x07\xd0\x5c\xd4\x4b\x62\xc0\x26\x1a\xda\x84\x0f\xa2\x58\x79\xec\x36\xd1\xa2\x03\xb1\x1b\x8a\xad\xe3\xc3\x56\x09\xba\x8f\x93\x97\x8e\x43\x40\xf0\x21\xc8\x1a\xa9\x7b\x41\x5d\x1b\xb5\xc6\xba\xbe\x29\x56\x2f\x07\x5e\x44\xd5\xf8\x93\xfc\x73\x0e\xe4\xdf\x28\x90\x5a\xf7\x26\x6b\xc4\x04\xab\xa0\x46\x4a\xb1\x68\x00\x46\x51\xec\x79\x1a\x0e\x5f\x8c\x22\x01\x25\xfc\xa9\xd7\xb8\xb6\x1a\x01\x9c\x52\x13\x63\xc4\xc8\x19\xa8\x5e\x52\x1b\x30\xec\x15\x95\xd0\x08\xaa\xb0\x17\x2b\x56\x57\x2b\x08\x21\x69\xd1\x51\x69\x5d\xcc\xa6\x23\xa6\x0b\x20\x0a\x18\x22\x84\x0d\x0c\x67\x95\x1e\xed\xf7\x94\xac\x5d\xcc\x0e\x0b\x53\xad\xfa\x7d\xaf\x34\x90\xf6\x44\xee\x95\x9b\x7d\x1c\xb3\x6b\x69\x7d\x91\xb0\x25\xf5\xe1\x56\xa2\xaf\xf7\x5b\xf8\x1b\xd3\x7b\xf3\xb1\xed\x49\xec\x57\xbd\x57\x9a\x1e\x5d\x33\x23\x3b\x9e\x2a\x1b\x4d\x17\x3c\x48\x07\xf8\x9b\xdb\x72\xc2\x14\x75\x2d\x30\x15\x44\xaf\xd1\x30\x79\xd7\xeb\xc2\x06\xe3\xa5\xc3\x1a\x49\xf5\x29\xb8\x45\xbc\xf3\x27\x0a\xb5\x38\x76\x44\x1b\x3e\x35\xda\xfe\xd7\xe5\x95\x61\xe1\xaf\xcb\x17\xb6\x92\x33\x95\xb8\xd0\xcb\xc0\x09\xb1\x70\xf6\x3c\xf1\xbb\xd5\xe2\xf3\xc2\xc1\xce\xde\x05\xea\x79\x8f\xec\x64\xca\xa3\xc9\x8e\x79\xda\xb1\x7d\x20\xff\x7a\xe0\x41\x60\x0a\xb2\x62\x78\xcf\xcf\xb5\xf0\x68\xd9\xfa\xee\xed\x62\x1f\x1d\xc1\x39\x36\xa3\x1d\x90\x19\x3c\x4c\xcf\x17\x93\xdc\x20\xbf\x05\x4f\xbf\x85\x96\x5c\xa2\x5f\x2c\x0d\x03\x26\xfa\x1f\x56\xa8\x26\xa2\xf8\x0c\x8a\x5c\xc0\x51\x48\x0a\x1e\x92\x0d\xf4\x65\x79\xd2\x30\x36\x12\xc6\x0a\xa2\xe7\xf3\x8e\xd5\x07\xc3\x73\x44\x3b\xaf\xcd\x18\xf1\xc3\x59\xcf\x2e\x97\x93\x30\x94\xd9\xcd\x53\xeb\x25\x19\x71\x01\x87\x3c\xd6\x52\xad\x26\x14\x89\xf1\x04\x43\xe4\x2a\xeb\x17\x87\x5a\x2c\x2e\x12\x24\x12\x43\xb6\x01\xd9\x0a\xbb\xf5\x6e\xa9\x31\x85\x5d\xbe\x8b\xa8\xb2\xb2\x8c\x62\x13\xb5\xc8\x27\x83\xc0\x35\x82\x66\xcf\x18\xe6\x12\x37\xe3\x2c\x12\xbe\x0f\x97\x71\xba\x15\xda\xc2\x32\xac\xee\xf0\x12\x3b\x98\x60\x50\x96\xd9\x1a\x99\xb3\xe7\x1d\xa9\x0f\x4b\x6c\x13\x63\x35\xc6\x4f\x1c\xc8\x7d\x01\xf4\xa8\x6e\xa1\x4a\xda\xa4\xfc\x24\xb4\xa9\x39\x65\x28\xb6\xf3\x0a\xd7\x0f\xea\x07\xae\xa9\x94\x7d\xa7\x97\x08\x2f\x9f\x56\xb6\x33\xf1\x5a\xcb\x76\xf5\xda\x98\xca\x1d\x6d\x6c\x9e\x54\x50\xa4\x5e\xfa\xdc\x00\x54\xae\x40\xf0\x72\x0c\xc0\x47\x84\x88\xfe\x5e\x2c\x1b\xba\xed\x6f\x4b\x2d\x49\x4d\x11\x69\x4b\x54\xe6\xd1\xa0\xcd\x7a\x00\x3c\xa5\x54\x9e\xa7\x2c\x9e\x5a\x32\x9f\xd0\x9b\x19\xe5\x08\x48\x08\x1d\x99\xc2\x14\xfe\x59\x9f\xe8\x90\xea\x77\x91\x07\xdc\xac\xa3\x17\xc2\x4e\x1b\x03\xa6\x8c\x0d\x57\x19\x71\x94\x27\x53\x8c\x3d\x24\x6e\x35\x5b\x31\xf6\xfa\x5c\x58\x02\x7b\x5a\x1f\xfc\xbe\xe0\xec\x28\x55\xd8\xbc\x4c\xa6\xc2\x62\x5b\x23\x55\xf5\x7d\x47\x07\x43\xc4\x43\xbd\x00\xfc\xbd\x95\xdc\x3b\x21\xe9\xd8\x8c\x09\x52\x67\x67\xf4\xcd\xc7\xad\x9d\x33\x5d\x38\xe5\xc6\x37\x80\x56\x88\x2e\xcb\x2f\xb7\x11\x3c\xd4\x2f\xf0\xe5\x50\x65\xc5\xa1\xc8\x20\x68\xe2\x46\x20\x65\x85\xc1\x2b\xf3\x06\x56\x95\x19\x24\xd3\xf5\xe2\x0d\x30\x24\xf9\x2b\x6f\x48\x4d\xd7\x83\x4b\x3d\x58\xa6\xed\xe7\x85\xd9\xa8\x5d\x59\xaf\x37\xb7\x54\x6f\x48\xab\xd5\x12\x93\x54\xf2\xb5\x13\x92\x29\xb0\x81\xcf\x22\x7e\x98\xf8\x6d\x06\x15\xfe\xc9\x38\xb9\xa4\x9a\x73\x61\xfc\x17\xa5\x1d\xdc\x0a\x64\x90\xcb\x06\x5e\xd2\x8a\x29\x9f\xf4\x11\x7b\xf8\xac\xb2\x58\x18\x7a\xb5\x54\x7b\x1d\x25\xb4\x32\x9a\x8a\xa1\xbf\xde\x7b\x75\xc6\x88\xc2\x72\x22\xa2\x52\xbb\x70\x4a\xef\x4b\x9b\xd8\x20\xc8\x36\x9a\x1c\x68\x90\x65\xb1\x56\x32\x5b\x61\xae\x23\xc7\x5c\x89\x24\x22\x5b\xdc\xe9\x8c\x2e\x6c\x94\x27\x8b\x66\x70\x56\x08\xe9\x42\x80\xe5\x68\xe7\xdd\x6c\x5a\xa2\x8c\x39\xfb\xc6\xe6\x0b\x45\x50\xb3\xc5\x28\x8b\xd2\xe7\xae\x2c\x47\x56\xf0\x18\x64\x52\x38\xc3\x61\x97\x28\x35\x78\x42\xa0\x9a\xf3\x94\xcc\x7a\x3f\x66\x34\x11\x9c\x32\x93\x8b\x56\x13\x34\xe9\xd1\x33\x8c\x8a\x38\xd3\xe5\x1c\x2d\x49\xdb\x0e\xc2\x5c\x19\x33\x89\x58\x4b\x78\x05\xa6\x7f\x51\x1f\xbe\xf8\x7c\x44\xfe\xa4\xee\xfe\x2f\xc9\x4f\x98\x86\xca\x92\x78\x35\x23\xd4\xdd\x0c\x60\xad\x57\xf3\x8a\xa0\xa5\x36\xd1\xd0\x52\xa2\xb4\x99\x80\x7b\xf8\xfa\x39\x1c\x15\xad\x6d\xe6\x25\x45\x75\x43\x48\xb7\x61\x97\xe3\x7d\xdf\x01\xff\xfa\xb9\xfd\xfd\xf8\xe3\xec\x86\xef\x10\x1d\x6a\x9d\xdf\x13\x53\x6f\x01\x36\x3c\x2b\xc8\x86\x7f\x12\xc5\x9b\xf2\x26\xb1\x69\x0b\x38\x51\x90\x84\x03\xb3\x22\xb5\x70\xd2\x06\xf9\x83\x55\x7e\xcf\x8c\x5c\x44\xcc\xda\xfe\xc1\x78\x1e\xfb\x12\x92\xde\xe2\x42\x34\x22\x11\xaa\x7d\x41\xd5\x30\xa4\x56\xa5\xfe\xd3\x91\x0a\x8b\x75\xbc\x97\xc9\xd8\xa1\xb5\xb0\x31\x13\x6b\xd8\xd8\xd4\xd9\x5d\x2f\x51\x5c\x62\x01\xab\xe9\x22\xe4\x71\xc4\x7e\x8f\x24\xdb\x88\xd3\x13\xb6\x1e\xb9\xdb\x93\xf0\x41\x82\xc7\x38\x8c\xf0\x3b\xda\xe2\x33\x61\x5e\x0b\x17\x83\x66\xa3\x59\x18\xef\x1a\x0e\x83\xd9\xa8\x41\x94\x84\x4f\xe4\xad\x72\x34\xf5\xd1\xe9\x5b\x93\x7a\x55\x96\xe5\xc3\x62\x18\xcf\x6e\x92\x5e\x37\xaf\xc0\x76\xa8\x9d\x5b\xd0\x4e\x97\x35\x3d\x44\x51\xf1\x33\x9a\xac\x9f\xd3\x79\x15\x6f\x71\x41\xb7\x4b\xe9\x10\x59\x2e\x93\xa4\xfe\xdd\x94\x1b\xe2\xfc\x9e\x74\x02\xe3\xdc\x9f\x71\x3e\xdc\x75\x3d\xa4\x08\xf1\x21\x31\xc8\xd0\x15\x9e\xc4\xd9\x5e\xdc\x9a\x15\x8b\x70\xc8\x23\xe5\x64\x4b\xf9\xeb\xeb\xd4\x78\x35\x60\x48\xd3\x58\xe7\x88\x69\x00\xff\xe8\x69\x4f\xd7\x91\x06\x94\xae\x84\x60\x20\x19\xef\x07\x3e\x44\x4b\x30\x2b\x86\xb7\x4d\x2d\xa0\xc8\x5e\x06\x45\xd2\x66\x6f\xad\xad\x34\xf6\xc9\x5c\xcb\x7f\xc1\x67\x19\xb9\x29\xc7\xa4\xf2\x29\x6e\xe8\xdb\xd5\x7b\xba\x42\x2d\x61\x85\x55\x92\x24\xc9\xd5\x2a\x76\xe0\x14\x3e\x0e\x43\x5a\x4b\x00\x77\xc0\x06\xe1\x63\xfb\x85\x17\x57\xe3\x54\x2b\x8b\x50\x64\x4a\xcc\xd1\x7d\xce\x15\x09\xab\x15\xdc\x0a\x6b\xe3\xc5\xf4\x8b\x98\x6b\xb5\xba\xb9\xf9\xcf\xf8\x26\xc3\xe1\xaf\x95\xdb\x1f\x8d\xba\xb3\xf7\x49\x5d\x98\x96\x6c\x4e\x41\xe8\x93\x80\x3f\xb6\xda\x1d\xbd\x22\x80\xe7\xaa\x5a\x1a\x5c\xf6\xf4\x03\x3e\xdd\x52\x9b\x47\xb1\xa5\xfa\x44\xed\xf1\x19\xbd\xa7\x98\x6b\xae\x9f\xda\x63\x5e\xcc\x98\x7d\x44\x83\x0b\xc3\xa0\xfe\x6a\x7a\x24\xdc\xe8\x85\xf8\x0d\xb3\xab\x4b\x8f\x98\x95\x8e\xf7\xb0\xa5\xe0\xcf\x70\x4d\xfc\x9c\xa4\xd5\xb5\xe8\xee\x97\xa4\x80\xed\xac\xa7\xd3\x55\xc8\x22\xee\x92\x05\xa8\x02\x6a\xa8\x00\x5b\x15\x40\xca\xda\xf1\x93\x2c\x31\x89\xa1\x32\x68\x24\x41\xdb\x02\x4c\x82\x46\x01\x61\x66\x16\x51\xe8\x3f\x72\x9c\xab\x08\x42\x1e\xd5\x91\x51\x1d\xdf\x8b\x51\xe0\x17\x09\xd2\x1e\xdd\x35\xa8\x2a\xcb\x17\x43\xfe\x9a\x2a\x32\x95\xe5\x67\xea\xca\xb4\xae\x2c\x32\xf4\xeb\x2e\x9c\xe1\xed\xa6\x89\x29\xa0\xc7\x4e\xdf\x23\x06\xc3\xa1\x38\x5c\xd5\xdd\x3d\x34\x4c\xd2\x5a\xb7\xf7\x8e\x0e\x2a\xd6\x7f\xa5\xf9\x7f\x5d\x6e\xb6\xfd\x6e\xdd\x52\x6e\x4f\x6e\x3d\x4f\x97\x51\xd0\xd7\x1c\x4a\xf8\x7f\xdc\x19\x3d\xe0\x21\xc1\xae\x0c\x89\xdd\xa5\x3d\x7a\x51\x81\x2a\xbb\xd9\xc8\x80\x1b\xc1\xcf\x51\x5e\xc0\x53\xe5\x7d\xa7\x56\x9e\x87\x03\x25\x06\x49\x44\xc9\x1c\x22\x29\xfd\x84\xfa\x81\xa4\x21\x0b\x39\x35\xcc\xe6\xf0\x72\x39\xfa\xf3\x95\x24\x55\xa2\xc5\x73\x91\x55\x6c\xc1\xcf\xa4\x71\xb5\x8a\x9a\x2e\xeb\x56\x28\xda\x80\x90\xb0\xac\xfd\xcb\x2c\x65\xf3\xa9\xe9\x15\x55\x6f\x24\x61\x1c\x4d\x0b\xbd\xa7\xf0\x1b\x95\xc2\x26\x37\x5b\x53\x4a\x1c\x80\x29\x1b\x84\x2d\x2f\x8f\x2d\x51\x3e\xd9\x0e\xb1\xd8\x60\x56\xe2\xaf\xf7\xdd\x0c\xcd\xce\xc3\x89\xda\x95\x88\xcd\x32\x3f\x97\x02\x16\x35\x76\xc3\xa9\x62\x3a\xfd\x1b\xe4\x5d\x3c\x86\x21\xd2\xb7\x13\xdd\x72\x7e\xfb\x8e\x19\x3e\x1a\xb3\x6f\xd7\xab\xfd\x52\x95\x5d\x3e\xce\xf7\xb4\xc2\x91\x72\xb3\x4b\x36\xd1\x79\x05\x2f\x26\xad\x4c\x1d\x4e\x13\x59\x53\x1d\x48\x6b\x8e\x1f\xa8\x70\x04\x0a\x27\x92\x28\x25\x6a\x46\xf4\x70\x4c\x55\xcd\xc9\x3a\xd2\xb6\x0d\x35\x1d\x2e\x43\x7f\x21\xb5\xda\xa7\xf3\x85\x92\x41\xbf\xb3\x90\x88\x0d\xef\xda\xc2\x6b\x16\x65\x39\x92\x48\x24\x99\x18\xec\x19\x41\x08\x00\x24\x71\x42\x60\xc5\xc1\x09\x31\x43\x5f\x4f\xad\xd7\x84\x5b\xa7\xdf\x1f\x5b\xa3\xe7\xa2\x0b\xc4\x1d\x50\x12\xbd\x0e\x81\x96\x6f\xe7\x04\x3c\xe1\xd6\x61\x12\x6b\x08\xee\xbc\x1a\x29\xeb\xc2\x60\xeb\x26\x32\x1a\x86\x5d\x5b\x97\x16\xda\x4c\x5e\x8e\xe7\xac\x81\x35\x6c\xab\xd2\xa6\xe4\x8d\x1a\xd9\x0e\x7f\xaf\xcc\xd1\x9f\x91\x3c\xb1\xf0\x1c\xad\xcc\x06\x67\x29\x86\xf4\xb2\x28\xbc\xb2\x81\x80\x88\x5e\x03\x2f\x87\xa1\xcc\xcc\x80\x07\x1d\x0f\xe7\x9b\x18\xcf\x54\xf2\x44\x24\x79\x1c\xce\x14\xa7\x68\x0e\x71\xea\xdc\x19\x37\x37\x7d\x4a\xc0\x8e\xe1\x16\xee\xcf\x44\x77\x44\x6a\x53\x0f\x09\x8e\x95\x80\xe9\x2f\x16\xce\xe5\x15\xe9\xf3\xf0\xc8\x6c\x9e\xd9\xca\x11\x4a\x01\x24\xdd\xef\x48\x91\x91\x2c\xbf\xd8\x42\x74\x69\x13\xd1\x15\x90\x79\xe7\xe0\x80\xc7\x30\x4d\x50\xcd\x4f\x5d\x0c\x23\x14\x20\xac\xf0\x12\x6b\x1a\xee\x2b\x54\x11\xe4\xb5\x8b\x7d\x90\x52\x8b\x19\x70\x03\xac\xd8\xf3\x1f\x35\xf1\xe3\x08\xc0\x9d\x8a\xe4\x04\xba\xed\x98\xe1\x26\x68\x57\xbb\x57\x8f\x5c\xf5\x94\x4e\x47\xd6\x34\x2d\x4d\x48\x65\x9a\x56\x99\x7b\x88\xd0\xe1\xac\xfd\x36\x0b\x70\x9c\xc0\x0c\x04\x64\xbb\x51\xc9\xac\x7e\x30\xd3\x9f\x6f\x65\x9c\xda\x1a\x5b\x0e\x6e\x9a\xd5\x0a\xbe\x43\x34\x6e\xc9\x2d\x4d\x8e\x8b\x2a\x9b\xa4\xbb\x35\x16\x9d\x05\x11\xb8\xee\x68\xfd\x49\xd6\x86\xf1\xbb\x60\x2a\x3b\x5d\x9f\x65\x2a\x43\x01\x60\x52\x10\xef\x43\x71\xa1\x49\xb1\x9d\x53\xf6\xa7\x10\xb0\x30\xd8\x07\x6c\xe7\xe6\xe6\x8c\xf6\x64\x97\x8c\x4a\x1c\x0b\x6b\x18\x83\xab\xb2\xf1\xf1\x86\x51\x05\x3c\xeb\x30\xfa\x94\xe5\x73\xe8\xce\x23\xea\xd7\xfc\x48\x16\x6f\x36\xbb\xb6\xa9\xb9\x1e\xb2\x1c\x6d\x14\xc0\x9e\x40\x33\x36\x6e\x36\x23\x53\x9f\x4f\x4c\xe5\x83\x0f\x82\x5a\x1f\xc5\x26\x72\xf6\xa3\x53\x02\x0e\xd5\xe1\xab\xab\x97\xa3\xb4\xa3\x43\x8c\x93\xdd\x5c\x37\x8c\x73\x6b\x2c\x25\x79\x37\x94\x6b\x79\x0f\x9d\x60\x5c\x97\xf0\x1a\xf7\x5b\xa6\xe1\xef\xa4\xd5\x7f\x07\x21\xe1\xef\xb6\xad\x79\xb6\xc1\x78\x63\x68\xf8\xa3\xda\x48\xf6\xb0\x67\x97\xf6\xa0\x33\x53\x36\x3f\x60\x47\x6a\x2c\x1e\x9c\x1a\x51\x1a\x81\xb3\x78\xa2\xcb\x01\x30\x0c\x6c\xf6\x1e\x49\x41\x91\xb9\x24\x8d\x70\x96\x3c\xe2\x42\x5b\x47\xda\xc3\x68\xf1\x30\xa3\x7a\x0f\x5e\x6e\x24\x56\xe6\xc4\x4a\x76\x6b\xfd\x9f\xb2\x3a\x1d\xb1\x9d\x23\x45\x52\xe5\x3c\x55\x31\x26\xb1\x5f\x26\x45\x7e\xbd\xd6\xa2\x5b\xaf\x67\x53\x4e\x0c\x80\x22\x3e\xd7\xa9\x6c\xa2\x7b\x16\xeb\x2c\xf9\xe2\x82\x63\x26\x4f\xc4\xbe\x6f\x82\xcc\xee\x9f\x87\x83\x66\xac\xd8\x44\x9e\xaf\x01\xfe\xa0\x14\x8d\xe1\x98\x13\x1a\x03\xa8\xeb\xac\x2c\x59\x59\x66\x37\x59\x01\xff\x2f\x3e\x62\x81\x3c\x1f\x37\xb2\x09\x64\x8e\xfd\x8d\x19\x32\xae\x71\x7d\x95\x56\x1a\xeb\xf7\x13\x3c\xae\xaf\xe6\x51\xb9\xbe\x42\x6c\xae\x9e\xe7\x67\xbd\xa2\x2e\x13\x97\xee\x48\xdf\xea\x5f\x24\x55\x94\xeb\x41\xdd\x0f\xc7\x5d\x8d\xbe\x15\x29\xe0\x96\xae\xd0\x50\x4d\x6b\x9b\xb1\xe2\x40\xd8\x9c\x92\x8f\x1f\x1f\x1e\xa0\x26\xce\xaa\x30\x27\xb9\x3c\x6e\x55\x75\xe5\xc2\x24\x4e\x38\x0c\x58\xbb\x51\x8f\x4c\x45\xc7\x09\x1f\x7d\x0f\x6b\x78\x18\xca\x4c\x6f\x71\xf7\x4e\xe0\xbb\x1a\x93\x71\x45\x96\x80\x2b\xfb\xa9\x3f\xa2\x74\x09\x2e\xe7\x61\xb0\x71\x6e\xea\xe0\xf8\x32\xc8\xac\xed\x08\x93\x31\xe3\x70\x41\x51\xca\xbf\xc8\xe2\xf0\xa6\x93\xe2\x31\x01\xc6\x03\xe4\xc2\x43\x2a\xf0\x19\x01\xa9\x35\xb8\xae\x3e\x3e\x64\xe5\x50\xd5\xa2\x96\x46\xc3\x91\x7d\xd1\x31\x9f\xe8\xee\x9f\x9c\x3a\x95\xc4\x4f\x4e\xc4\xb8\xbd\xd7\x9e\xe6\x0f\x21\x1c\xe7\x83\x25\x43\xaf\xcb\x34\x56\x1e\x3a\xc4\xe4\x82\xdc\xe3\x54\x96\x25\xc4\xdb\xb3\x11\xa0\x8a\x6a\x10\xbd\x54\xb4\x35\x39\xbb\xc2\xca\x4f\xe0\x42\x1e\x49\xfb\xad\x49\x9e\x48\x93\xa0\xbe\x5d\x4c\xb8\xe1\x61\x8d\xd9\x5e\x1d\xe9\x15\x35\x7e\xc2\xc2\xf7\x58\x78\x1b\x61\x68\xe2\x06\x0b\x84\xdf\x23\xa1\xcd\xa1\x9c\x84\x58\x48\xcf\xd7\xe2\x22\x81\x82\x3b\x7d\x69\x2b\xff\xcb\x2e\xba\xc5\x1c\x37\x61\xe4\x19\xf4\x5e\x8a\xfe\x76\xef\xaf\xf4\x70\xbb\xac\xa1\x66\xbc\x56\x5b\xa6\xf4\x46\xec\x36\xce\xcc\xd9\xb0\xf4\x5c\xf8\x79\xa3\x6e\x46\xdb\x75\x55\xb0\xfb\xc2\x34\xcd\xa2\xac\xff\x33\x46\xe0\x22\x0a\x85\xfa\x25\x9c\xcf\x87\x80\x47\xa3\x0c\x6b\x14\x57\x8a\xd8\x2a\x2a\xef\xac\xfb\x78\x4b\xa1\xb3\x4b\xb4\x4c\xe3\xef\x61\xc9\x8b\x0e\x77\x8f\xa1\xe8\xd2\xc2\x4e\x16\x71\x92\x61\x3e\x5e\xf5\x88\x1d\xcb\x57\x91\xc3\x80\x55\xec\xab\xe8\xf5\x56\x68\x01\xbf\xd5\x82\x6b\xc6\xfb\x69\x9e\xfa\x64\x84\x5c\xf0\x64\x94\x5f\x98\x00\x12\x1b\xa5\x3b\x44\x4a\x54\x4c\xdc\xa4\xd4\x9f\xc9\x62\xe3\xae\x8c\xa9\x6d\x53\xcb\xf0\xb1\x80\x0c\xe7\x12\xb7\x90\x90\x66\x81\xdf\xf3\xd1\x61\xaa\xa1\xc0\xa6\x2f\x9b\x45\x6b\xb6\x9f\x71\x2c\x0e\x96\x17\xad\xfb\xe8\xfd\xa7\x9f\x7f\xb1\x39\x77\xc3\x2f\x13\x1d\xec\x70\x21\x84\xcc\x3b\x04\x52\x84\xa6\x68\xf8\x32\x63\xa5\x67\xf3\x08\xd6\x93\xdd\x91\x94\xf5\x70\x46\x0d\x53\x53\xde\xfa\x4b\x7a\xc6\x7d\xa3\xfe\x84\xde\x2d\x16\x9c\x22\xa0\x05\x10\xa8\x1d\x4a\x62\x97\x74\x1c\xf9\x31\x0c\x7a\xbe\x33\xe7\x02\x18\x67\x28\xe3\xf2\x3c\xfd\x05\x63\x6d\x47\xfa\xc6\x1e\xfc\x36\x9a\xb9\xe0\xde\xdd\xe6\x3a\xcd\xfc\xd5\x37\x63\x76\xb1\x39\x1f\x83\x7b\x02\x7b\xc6\x9a\x17\x8f\xbb\x8e\x57\x7b\xb4\x40\xdd\xd6\x42\x82\x77\x72\x1c\xff\x55\xf5\x45\x99\x16\x9d\x3c\xf2\xa9\x24\xaa\xbe\xb9\x94\x0f\x68\xd8\x1c\x98\xc2\xc8\x8f\x51\x7c\x6b\x77\x82\x34\x40\xb0\xd8\x03\x51\x70\xa0\xf7\xa5\xf5\x35\x02\xc9\xce\x64\xae\x60\x77\x15\x90\x0b\x01\x5c\xa3\x24\x86\x15\x68\x55\x45\x57\x34\x95\x2f\xc2\x5f\xc9\x95\x8a\xb6\x2c\x87\x85\xd7\x43\x26\xf4\x1c\x47\xbe\xcf\x55\xba\x7a\x4c\xba\x79\x8b\xc0\x25\xe2\xab\x70\xc5\x90\xd7\x3a\x6b\xc2\xa1\x93\xa2\xa6\xb4\xc1\x6d\x11\x0f\xa0\x2b\x9b\x2f\x1d\x25\x43\x66\xf3\x47\x66\x26\xbd\x09\xee\x3b\x1a\xf5\x93\x74\x93\x4d\x99\x70\x48\x2b\x4d\xcf\xcc\x4e\xc6\x9c\x2f\x26\xf9\x47\x83\x0a\x9b\x00\x73\xc6\x87\x91\xa6\xab\xab\xbc\x80\x8f\x23\xaf\x6a\xa4\xc5\x07\x5f\xae\xd1\x31\x1f\x1e\xe6\x25\x69\x14\x81\x97\x54\x5d\xbf\xb8\x01\xb2\xd3\x54\x0e\x34\x4b\xc4\xaa\x8f\x63\x98\x9a\x05\x64\x92\xaa\xf1\x01\x7d\x49\xd5\xc8\x63\x36\x23\xbb\xad\xe6\x05\x5a\xf8\xbb\x9d\x1c\xfd\xce\x6e\xdb\x93\x5d\x28\x2b\x46\xdf\xf2\xc1\xdd\x31\xaa\x3c\x67\xd0\xbb\xc1\x0f\x9c\xd1\xcb\x41\xeb\x1d\x0f\xe9\xa3\xb5\x78\xfc\x76\x86\x64\x7f\x78\xf0\xe8\x9e\x19\xa0\xab\xee\x77\xdb\xc2\xa5\x67\x09\x6e\x76\x61\x73\x02\xb3\x2c\xc7\x5e\xae\x7f\x46\x95\x4c\xbd\x03\x50\x0d\x8d\xa3\x2d\xd0\x09\x9d\xf9\xa4\xee\xe8\x9e\x8d\x3c\x25\x92\xc5\xc6\xdd\x87\xf4\x57\xee\xaf\x7e\x32\x68\x9f\xb9\xde\x4e\xf6\xd1\xd1\x86\x32\x3b\xa7\xb3\x2d\xa2\xdd\x5e\x8b\x6e\xf1\x48\xb8\x5f\xca\x7c\x60\x3d\x17\xec\x97\x32\x1f\x59\x56\x9f\xc3\xc5\x3e\xeb\x2a\x9e\xf3\xa8\x93\xa6\x99\x75\xa7\x5b\x46\x80\xb7\xe1\xf0\x82\xbd\xeb\x12\x89\x7c\x12\x07\xca\x61\x7b\x6f\xee\xfb\x32\x92\x73\x2f\xbc\x57\x2d\xd1\xbe\xcb\x74\x62\x23\x0f\x97\xcf\x9f\x5e\xad\xe0\xb4\xbf\x87\x5a\x12\x65\x72\xb5\x88\x0b\x90\x2f\xf3\x6f\x23\x2b\xd2\x1e\x87\xda\x3c\x1a\xac\x07\xb8\x94\x36\x60\x26\x7a\xe9\x00\x7c\x0b\x59\xe1\x1e\x8b\xcc\x27\xd4\x44\xfd\x64\xf0\x0c\xd7\x64\x9c\xd2\x1b\x4a\xf3\xc0\xe6\xc3\x25\x53\xd7\x43\xf1\xcd\x58\x35\xf3\x19\x6d\xa9\x9d\x10\x71\xd0\x59\x30\xce\x98\x9e\x2c\x48\x77\xcd\x16\xce\xc1\x69\x2f\xaa\xa7\x59\x59\x9e\xf6\xa2\x2c\xb3\xa7\xc3\x12\x74\xda\xd1\x0c\xf9\x5f\xc1\xf3\x3c\xca\x7e\x91\x31\xbe\xa1\xd6\x62\x4e\x48\xcb\x7f\x45\x48\x8f\xb0\x07\xa2\xcd\x71\xf5\x54\x52\xaf\xd3\xc0\x32\x55\xb1\x34\x8e\x44\x71\xb8\x9b\x29\x4a\x87\x33\x3b\x77\x23\xc9\x49\x41\x2d\xec\x34\x9f\xf6\x44\xa3\x4d\xe7\x0e\x78\x0f\xd7\x48\x9a\x8b\xf1\x50\x5a\xec\x84\xbc\xa5\x5a\x99\x03\x36\x4a\xd8\x7c\x01\x5b\x99\x9b\x86\x8e\xc4\xe5\x22\xee\xe8\x5c\x42\x54\xac\x95\xc7\x93\x19\xae\x61\x1a\x2a\x4c\x7d\xd6\x97\xa2\x6d\x23\xe6\x88\x99\x22\xf1\x73\x6a\xa8\x92\x93\x66\xae\x5b\x3d\xd3\xdd\xe4\x0c\xf9\xfc\x21\xb5\x71\xf2\xd5\x38\x5b\x78\x30\x6b\x1d\x25\xdc\x21\xf2\xfa\x53\xee\x16\x83\x2a\x64\x80\x9d\x39\x49\x3e\x34\xfd\xd4\xd3\xe4\xe3\xbc\x9c\x01\xd5\x4f\xc5\x2c\x85\x70\x13\x2e\x22\x63\xa3\xf0\xd4\x7f\x24\x39\xc6\x5d\xc9\xe7\xdd\xce\xfe\xeb\xa5\xdb\x3e\xb6\xfd\x6e\x63\x6f\xee\x70\x71\xf1\x99\xab\x3f\x42\xcc\xbc\x72\xff\x0e\x09\x5d\x9b\x0d\x66\x88\x3a\xcb\xe3\xe1\xe5\xc5\x0b\x3f\xe2\xbb\x2a\x66\xaf\xfd\x10\x26\xb6\x07\xd5\xe8\xb6\x12\x73\x1b\xaa\xc7\x13\x84\x84\xe0\x50\x14\xe5\x06\x2f\x3d\x74\x61\x2d\x51\x6e\xd0\x24\xf2\x11\xb1\x77\x54\x9b\x96\x79\x31\x3c\x5e\xba\x5f\xc4\x05\xa1\x46\xf4\x89\xd2\xe8\x9c\x3e\x52\x41\x72\x8f\xa9\xad\x66\x02\x82\xc3\x3d\xa4\x47\x75\x3b\xdc\x41\x9a\xa8\x89\x98\x1a\x13\xc5\xca\xbc\x4d\x63\x13\x41\xc6\x4a\x96\x1a\xdd\x1b\x54\xdf\x5d\xba\x50\x27\x88\x11\x23\x6a\xc7\xc8\x59\x4b\x58\xe3\x84\xba\xf3\xd6\xe9\x21\x55\x2d\xdc\xe1\xf3\xa9\x56\x6c\x3a\x0e\x1b\x21\xe5\x9a\xfa\x1c\xe5\x2c\xed\x5b\x42\x05\xc9\x95\xb3\x29\x01\x62\x70\x83\xe0\x8e\xe8\x30\x0e\x14\x49\xa7\x51\xa3\x0a\xce\xb8\x21\x40\x31\x21\xde\x98\x68\x3e\x18\x70\xfd\xe2\x26\xbd\x83\x87\x6f\x1f\x9d\x62\x4f\xf7\x4f\x9d\x60\xe3\xde\x19\xf7\x32\x37\x4f\x9f\xd8\x81\xb9\xfd\x76\x1e\xae\xd9\x14\xcf\x81\xf5\xb7\x1f\x7d\xba\xf3\xc0\x82\x13\xbb\x4f\xf5\x1e\xc4\xe0\x13\x7f\x57\xc2\x75\x58\x6b\x7c\xe8\x23\x3a\x2d\x33\x4a\xf0\xc5\x3a\x65\x9b\x9e\x94\xb9\x9b\xed\x24\x32\x04\xef\xf2\x33\x37\x4e\x85\x6e\xa7\x33\x2c\xba\x7c\x9c\xa9\x70\x3e\xc3\xc0\xca\xa7\x20\x57\xce\xdd\xf9\x64\xab\x05\x81\x33\x45\x25\x09\xcf\x3f\x8e\xd0\xf9\xd4\x89\xcf\x81\xd0\xc6\xe7\xdb\x3e\xc6\x98\xa6\x52\xb9\x43\x67\xb8\x5e\x66\xdf\xf8\x2d\x04\x45\x6f\xf5\x25\x7b\xf6\x25\x03\xdf\x43\xf5\x25\x03\x8f\x54\xf5\x25\x7b\x95\x15\x13\x5f\x5e\xf4\xb3\xd8\x85\xd4\x8d\x62\xf8\x50\xda\xed\x67\x84\xbe\xab\xf6\x38\xc8\x40\x17\xdb\x22\x1f\x8d\xbb\xb6\xd7\x9a\x5e\xb8\x98\xce\x17\xc1\x6e\xa9\xd2\xdb\xdd\xa2\x2c\x1b\x8b\x9f\xbb\x0b\xfe\xdc\x0c\xec\x8a\xe4\xaa\xb4\x70\x8d\xd7\x70\xcc\xd2\x1e\x45\x1e\x52\x71\x27\x39\xe3\x73\x67\x6a\x47\x89\xbb\xe7\x4c\xc8\xa0\x5c\x24\x99\xcc\x33\x49\xde\x73\x88\xe4\xe7\xef\x82\x8c\xc1\x8d\xae\x83\x8c\x8b\x2e\xdf\x08\x19\x6a\xe2\xb5\x90\xd3\x94\xe4\x09\x1d\x46\x07\x18\xca\x49\x03\x7b\xc6\xf5\x8b\x04\x3b\x60\x6a\x3d\x3a\x43\x99\x20\x9f\xa4\xa8\x46\x05\xf1\x29\xd7\x4d\x2d\x86\x6c\xd4\xf1\xd1\x7d\x9f\x69\xed\x8c\xc5\xc2\x86\xc2\xdc\x29\xf4\x2d\x05\x02\x5c\xac\x44\xf7\xd2\x35\xff\xb1\x27\x70\x32\x17\x01\xb4\x54\x43\xaf\xc0\x9f\xd5\x85\xa7\x36\xda\xf4\xd4\xc5\x9e\xc2\x14\x01\xe1\xf7\x27\x72\x5f\x2e\xce\xdc\x9f\x9b\x0c\xc7\xb8\xaa\x2d\xa0\x2c\xf1\xc0\xc6\x69\xe9\x6f\x1f\x8b\xbd\x7d\x32\xa5\xe3\x4b\xd3\x2d\xa5\x53\xd3\x56\xe4\x68\xf1\xda\x1e\xd7\x71\x86\xbf\xfd\x94\x27\x7b\x7b\x94\x44\xef\x3f\xe1\x25\x12\x6a\x79\xee\x6c\x66\x88\x0d\xce\xe5\xb3\x5b\xb2\xaf\xd3\xc9\x62\xdc\xc5\xf4\x62\x2b\x91\x12\xd9\xde\xfb\x3f\x79\x90\xe5\x8f\xf8\x5a\xb2\x69\x67\xe7\x3a\xc9\xa2\xf1\x25\x72\xe3\x9c\xbb\xe7\xcc\xd5\x5a\xf3\xce\x07\xa2\x35\xa6\x43\xc3\x18\x9b\x19\x7f\x99\x38\x14\xce\x4b\x35\x39\xcf\x3d\xe2\xf9\x31\xb0\xec\xd3\x56\xde\xa0\x7f\x5d\xec\xc0\xa5\x7e\x85\xf3\x2c\xf1\xde\xe6\x7a\x30\xce\x2b\x17\xc5\xa7\x8d\xbd\x0e\x79\xb6\xcf\x21\xa6\x7d\xf9\xb0\x33\xba\xc6\x86\xc4\xa1\x11\x93\x4d\x58\x6c\xd6\x77\xe6\x4d\x03\x4b\xc5\xe0\x2a\xf8\xbc\xe6\xda\x2f\xfd\xb6\x65\x35\x98\x53\x92\x3b\x52\xd3\xc5\xc2\x0e\xdc\x9c\x7d\x7e\xbb\x58\x9c\x21\x83\x29\x1e\x7f\x0c\xb5\xe3\x6a\x43\x69\xfc\x57\x45\x00\x7f\x95\x3d\xdc\xb4\x48\xff\xe2\x84\x29\x70\xcf\x8b\xe4\xcf\x35\xb8\x36\xe6\x79\x11\xfd\x91\x06\x70\xd0\xf0\x79\x11\xfd\x21\x06\xff\x1d\x9f\xfd\x77\xf4\x22\xfa\xef\x3f\xfd\xfc\x8b\xff\x6c\x54\x51\xf7\xf9\xe3\x70\xe9\xbc\x7b\x7a\x08\x78\x0c\x17\xec\xa4\xd7\xed\x0c\x15\xbc\x37\xc2\xa0\x1a\xde\x42\xb9\x39\x14\xe9\x11\x30\x6f\x9f\x7b\x4a\x3f\xdf\x0f\xe7\x73\x6c\xa7\xa0\x72\x5e\xd8\x68\x56\xec\xcb\xc2\xcf\xf6\xaf\xf2\xb8\x22\x77\xf3\xf5\xd8\x00\xc1\x7a\x6e\x6d\x03\x17\xd0\x0a\x7e\x4b\x25\x9c\x24\xe9\x80\x71\x20\xa0\xfb\xae\xa5\xfe\xba\xe8\x97\xc6\x8d\xf5\x54\x19\xf7\x17\xbc\x57\xd0\x30\xe7\xd0\xb7\x89\x26\xf8\x47\x53\x7a\x7b\xeb\x61\x8b\xcb\x69\x88\x3c\x11\xa5\xd8\x2d\x37\x89\x68\x63\x24\x9d\xde\xe4\xf0\x9b\x98\x32\x01\xc1\xb8\x8d\xa9\xe5\x1a\xfd\xef\x00\xc7\x84\x07\x94\x4d\x6b\x00\x00"),
 		},
 		"/chan_test.lua": &vfsgen۰CompressedFileInfo{
 			name:             "chan_test.lua",
@@ -58,10 +58,10 @@ var preludeFiles = func() http.FileSystem {
 		},
 		"/defer.lua": &vfsgen۰CompressedFileInfo{
 			name:             "defer.lua",
-			modTime:          time.Date(2018, 3, 11, 7, 1, 22, 0, time.UTC),
-			uncompressedSize: 6646,
+			modTime:          time.Date(2026, 10, 17, 7, 10, 0, 0, time.UTC),
+			uncompressedSize: 7567,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x58\x4d\x8f\xe3\xb8\xd1\xbe\xfb\x57\x14\xb4\x87\xb6\xf6\x95\x35\xd3\x7b\xf4\x1b\xf5\x22\xc9\x04\x9b\xcb\x2e\x82\x64\x90\x1c\x3a\x0d\x2d\x2d\x95\x2d\xc2\x32\x29\x90\x94\x34\xce\x60\xf2\xdb\x83\x2a\x52\x9f\x76\xcf\xf4\x06\xe9\x43\x5b\x12\x8b\xf5\x5d\x4f\x15\xb9\xdb\x41\x89\x47\x34\x52\x49\x97\xd6\xad\x80\x3d\x9c\x6a\x7d\x10\x35\x58\x74\x6d\x03\x47\x6d\x3c\x01\x54\x42\x95\xb5\x54\xa7\xcd\x66\xb7\x83\xd6\xc9\x5a\xba\xeb\x1e\x9c\x38\xd4\x08\xb6\xd2\xfd\xe6\xd8\xaa\xc2\x49\xad\x20\xcf\x9d\xdd\xba\x78\x03\x00\xf2\x08\x0e\xb2\x0c\x94\xac\xc1\x55\xa8\xe8\x1b\x00\x18\x74\xad\x51\x10\xfd\x4e\xc9\xfa\x29\xa2\x8f\xa8\x4a\xfa\xa9\x75\x41\xa2\x21\xa3\x35\xad\x76\xbc\x8f\x44\xec\x9f\xfe\xa9\xa2\x89\xe2\x0c\x19\xbc\xa7\x57\xd2\x4f\x26\x1d\x48\x05\x8d\x90\x86\xe4\x42\xa9\x83\x18\xe2\x63\x21\x4d\x21\x3a\xe3\x75\x1f\xd1\x93\xd3\xd6\x19\xa9\x4e\x5b\x19\xf3\x02\xec\x9e\xa0\x13\xf5\x6a\xb1\xf3\x8b\x41\x24\x00\xcb\x3b\xc3\xff\x3d\xce\x54\x95\x47\x38\xc3\x13\xbc\xbf\x63\x97\x9d\x91\x4d\xa6\x06\x73\x0e\xad\x03\xbc\x34\xee\x1a\x7c\xd7\x4b\x57\xc1\x7b\x40\xe5\x8c\x44\xfb\xb4\x87\xa5\x2a\x2e\xde\x10\x27\x72\x7a\x21\x14\xf4\x08\x95\xe8\x10\xb4\xc2\x21\x50\x25\x1e\x29\x7a\xe4\x79\x7d\x84\x46\x28\x59\x80\x50\x25\x18\x2c\x74\x87\xe6\x47\xda\xfa\xb1\x92\x16\x7a\xdd\xd6\x25\x1c\x10\x1a\x43\x11\x35\x58\x82\xd3\x60\xb0\x41\xe1\xa4\x3a\x91\x21\x17\x72\x24\x76\x68\xae\x30\x84\x33\x65\xd9\x5e\x1b\xe8\x24\xf6\xf4\x3b\x0a\xea\x44\xdd\xe2\x26\xcf\x59\xd8\xcf\x1f\x21\x83\xcf\x79\x3e\x28\x0f\xd9\xc8\x85\x5c\x1a\x5c\xf1\x20\x76\xbc\x77\xc7\x7b\xf7\x0f\x4b\xcf\x3f\x3f\xbe\xc4\xe4\xbb\x2f\x2c\x36\x30\x46\xf3\x77\x51\x43\x2f\xeb\x9a\xd4\x57\xf4\x2b\x8f\xa0\xb4\x57\x22\x21\xca\xc5\x1f\x25\xc5\xa0\x61\x25\x9a\x06\x15\x96\xe4\x93\x1b\x42\x8a\x1d\xf4\xc2\x0e\xce\xc2\x32\x25\x1a\x47\xee\x92\x16\x44\xdd\x8b\xab\x05\x11\x42\xe5\x34\x88\x4e\x4b\xcf\xc6\x2b\x2c\x8f\xb2\x10\xec\xfb\xc6\xe8\x43\x8d\x17\x9b\xc2\xc7\x0a\xc1\xa0\xa8\x99\x6c\xe6\x26\xe2\x28\x95\x95\x25\x82\x70\xd0\x68\xeb\x83\xf6\xfc\xf8\x42\x42\x89\xfa\x97\x3f\x2c\x2d\x06\x85\x58\x5a\x92\x4b\x51\x43\xb3\x3b\x69\xa3\x5b\x27\x15\xa6\xf0\x7b\x0b\x28\x8a\x8a\x85\x14\x43\x64\x5b\xd5\x4b\x55\x92\xeb\xa5\x2a\xb1\x41\x55\xa2\x72\xf5\x95\xe4\x09\x75\xf5\x0a\x69\xa9\x1c\x85\xd9\xc9\x0b\xa6\x9b\xcd\x42\x20\x57\xea\x66\x13\xbe\xcc\xe3\xc7\xe5\xbc\xdb\x35\x46\x2a\xb7\x8d\x4a\x3c\xb4\xa7\x3d\x38\xdd\x50\x2a\x04\xf2\x6d\x1c\xc5\xb3\x22\x76\xa2\xa0\xb2\x61\xd2\xd4\x19\x51\xe0\x41\x14\xe7\xed\x80\x0b\x4a\x3b\xc8\x73\x69\x3f\x48\x83\x85\xfb\x40\x19\xb9\xe5\x3d\xf1\xbc\xa2\x96\x12\xe1\xd7\x51\xd4\xaf\x7b\x8e\x1b\x71\x29\x99\x83\x87\xa9\x04\x6a\x14\x1d\x39\x60\x6e\x57\x16\x25\x8b\xf7\x78\x59\xaf\x64\xf3\x54\xb1\xdf\x12\xf9\xbd\x97\xf7\xfd\x20\xd0\x33\x79\x93\xc8\xc9\x3b\x45\x03\xd9\x62\x9d\x96\xee\x84\xc2\xfb\xaa\x68\xe0\xdf\x1e\x44\xa9\xb0\xdd\xb5\xc1\x6d\xd1\xc4\x04\xac\x11\x67\x66\x34\x77\x99\x17\xd0\xaa\xde\x08\x12\x52\x34\xcf\x8f\x2f\xff\x0f\x04\xda\xfe\xd3\xd1\xe8\x0b\x08\x63\xc4\x35\x01\xab\xc1\x88\x7e\x4a\x4f\x6f\x0b\x96\x4b\xff\xf8\x8d\xb7\xa0\x56\x34\x1e\x9b\x7c\x8e\xcf\x92\x05\x8d\x59\xe6\x0b\x53\x6c\x63\x28\x44\x5d\x63\xe9\x31\x0f\x0d\x25\x58\x94\xc0\x44\x0d\xac\x20\x2d\x50\x7e\x0e\x35\xd7\x18\xec\x50\x39\x28\xb4\xea\xd0\x58\xaa\x19\xa7\x07\x44\x3a\x5c\x89\x5e\x1b\x9f\x57\x2b\x0f\x7e\x46\x63\xbe\x04\xd6\x84\xbb\xd6\x11\x74\x88\xba\xd6\x3d\x48\x17\xea\x8a\x30\x8d\x45\x49\x05\x22\xa4\x2d\xa7\xeb\x9e\x76\x5a\x74\x17\x74\x82\x95\xd9\xce\xd9\x8f\xe1\xfd\xf9\x23\x8b\xf6\x5a\x2c\x43\xce\xde\xd9\x78\xf1\x78\x92\x0a\x0e\x5a\xd6\x68\x9a\x5a\x38\x84\x46\x18\x07\x3f\x90\x10\xaa\xcb\xc6\x60\x23\x0c\xdb\xcb\x9d\x16\x3d\x72\xbc\xe3\x24\x7b\x17\x98\x6e\xf2\xdc\x2f\x9a\x1f\xbe\xea\x6e\x98\xd1\x99\x56\x71\x72\x4e\x3e\x9f\xb9\x7c\xe5\x2f\x34\x66\x16\x5e\x7a\xf3\x01\xdf\xe4\x39\x6b\xf3\x67\xcf\x74\x25\x3b\xf1\x95\x60\x97\x3a\xac\xb6\x7c\x55\x8d\x61\xd3\x0d\x56\xbc\x9d\xa5\x57\x61\x1f\x25\x53\x2f\x09\x5a\x8d\x95\x77\xdf\x58\x79\x0c\x7b\x87\x12\x9b\x95\xd2\x0a\x84\x6e\xd4\x4b\x80\x5c\xfd\x26\x3b\x89\x94\x8a\xf7\x3b\x3f\x4d\x71\xe2\x7f\x17\x34\xbc\x2b\xec\x7f\xc4\x39\x70\xa5\x39\x29\xcf\x25\x64\xc3\x52\x02\x8f\x09\xec\x1e\xa7\x61\x69\x44\x8e\x92\x8a\x94\x8a\xe7\x53\x43\x4f\xc1\x8d\xcf\x79\x2e\x5f\x92\x59\x62\xc5\x5f\xa6\x8d\x37\x53\x18\xf3\xa0\x49\x0c\xee\x86\x6e\x1f\xda\x62\x23\x86\xc8\x31\x32\x80\x41\xdb\xd6\x6e\x0f\x32\x8b\x12\x49\x76\x41\x97\x45\x49\x17\x0f\xb8\x33\x21\x10\xd6\x16\xef\xb6\x88\x3d\x1c\x75\xab\x4a\x1a\x0e\x42\x58\xa5\x5a\x79\xd2\x77\xa9\xc0\xe8\x15\xfd\x4a\x1a\xb0\xa6\xc4\xa2\xee\x5e\xa0\xb5\x52\x9d\xa2\xa1\x81\x2d\xd2\xe9\x36\x77\xd6\x6a\xa1\x2a\xa9\x57\x2e\x05\xbd\xd6\x3d\xf6\xf0\xf5\x8e\xb5\xee\x1c\x77\x5b\xd7\xab\x32\x49\xd3\x39\x87\x34\x9a\x86\xcc\x3c\x0f\xa6\x7e\xf0\xde\x33\xd8\x18\xb4\xa8\x9c\x25\xe3\x40\x69\x73\x09\x93\xcd\xa8\x0c\x45\x31\x61\x67\xe9\xd6\x81\xf0\xb1\x1d\x46\x1a\x00\xf8\x07\xf2\x1c\x43\xd0\xd6\x36\x25\x41\x1f\x73\x12\x17\x2c\x07\x16\xdc\x80\x2c\xc8\x63\xd8\xe2\x2a\x34\x08\x3d\xfd\xc3\x4f\x4d\x2d\x0b\xe9\x56\xa4\xdc\xc5\xf2\x5c\x14\xae\x15\xf5\x30\x01\x72\x77\xe4\x91\x6e\x12\xc9\x89\x45\x02\x7d\x3a\xcc\xf4\xca\x73\xd6\xe1\x17\x71\x41\x3f\xed\x29\xdf\x16\xc9\x65\xb4\xa1\x13\x46\x72\x63\x50\x4c\x11\xbe\x2e\xd4\xb8\x1d\x3d\xa9\x65\x68\x92\x7f\x56\xba\x87\x4a\xf7\x33\xb3\x45\xe1\xfe\xa4\x3a\xd6\x60\xed\xe6\x19\xa2\xf6\x95\x1e\x10\xd5\xe7\x00\xff\x4c\xaa\x26\x81\xcf\x02\x1b\x69\x53\xb4\xbf\x89\x9e\xd3\xcd\xde\xf3\x78\x7e\x7c\x01\x69\x39\xaf\x46\x80\x1c\x16\xe2\xdf\xc0\x6a\xe1\xb2\x90\xa6\xce\x6e\xe7\x0b\x71\x4c\x3d\x2f\x54\x08\x0b\xbe\x53\x16\xf7\xa5\xec\x7d\xb8\x2a\x51\x8e\xd3\x7d\x14\x8f\x3b\x6f\x17\x53\x42\xc5\xa1\xd0\xb9\x5c\x39\xb5\x64\x3d\xcc\xa4\x9b\xb0\x59\x1e\xe1\x3b\x56\x07\x9e\xe0\x71\xae\x0f\x33\x26\xfc\x3a\xcf\xf1\x8b\x49\x67\xf8\xc5\x31\x89\x6e\xb5\xf5\x2c\xcf\x84\xc4\x67\x22\xe8\xfc\xe0\x17\x10\x6b\x2e\x62\x9d\xc7\x3c\x7b\x1d\x65\xc8\x4d\x5f\x0c\x9d\xa8\x2d\x1c\xf0\xa8\xcd\x90\xad\x60\x91\xab\xe5\x92\xae\x51\xba\x55\x34\xdc\x7d\xe6\xb9\x24\x6d\x55\x43\xfd\x28\x24\xcb\x02\x9a\x47\x48\xa0\x0d\xeb\x04\x68\x55\x13\xc7\x6b\x18\x87\xf3\xdc\x0f\xb3\xb0\x2e\x7a\x05\x97\x1b\xe7\xe1\xf3\xf9\x05\x32\xd2\xe7\x59\xbe\x4c\xeb\x6b\xfb\xbf\xee\xc7\x46\x5b\xc7\xde\xd8\x93\x7b\xde\xfb\x26\x46\x4f\x43\x73\x33\xe8\x1e\x33\xff\xed\x31\xde\xdc\x88\x10\xd6\xa2\x71\xdb\x79\x7f\x67\x40\x8e\x7f\x43\xfb\xfb\x6f\xbb\xdf\xeb\xcd\x6f\xe1\xad\x45\xe2\xdf\x7a\xc0\x03\xeb\x9b\x3b\xe2\x66\xee\xe7\xf9\xd3\xd4\x18\xbf\xe5\xf3\xa2\xc2\xe2\x4c\x8d\x87\x0c\xf0\xfd\xd8\xcf\xc7\xad\xda\x15\xa2\x3d\x55\x2e\x4d\xd3\xfb\x6d\x68\xb7\x23\xbc\xf4\x20\x2d\xe8\x7c\xb0\x1b\xcf\xcf\x81\x93\xab\x84\x9b\xa3\xb0\x41\x57\x19\xdd\xff\xb8\x19\xaa\xf1\x1b\xdd\x73\xad\xfe\x8d\xf6\xad\x9a\xce\xec\x7e\xf6\x0e\xda\xe3\x27\x69\x9d\x4d\x06\x89\x64\xe0\x2b\xbd\xf4\xfe\xcc\x3e\xf7\xa5\x1f\x7e\x07\xf4\x98\x41\x5f\x96\x2d\x2e\x7e\xe6\x13\xea\x52\xcd\xe5\x36\x3a\x3e\xbe\x4f\x08\xbd\x3c\x08\xd8\x01\xdc\x16\x07\x51\x2f\x96\xce\x04\xad\x7b\xbd\x55\x2a\xd0\xa6\x44\x1a\x61\x7d\xe2\xf2\x1b\x96\x7f\xf5\x8c\xb3\xcf\x94\xa0\x6f\x2d\xe8\x9b\x11\x0a\x5d\x51\x71\x6a\x50\x97\x1d\x2f\xf6\x50\x75\x8c\x75\xe7\x24\x82\xbe\x92\x45\x45\x11\x26\x84\xaa\x84\x0d\x67\xc8\x28\x99\x50\x21\x81\x88\x8e\x54\xfc\x3a\x47\x9d\xd0\xbe\x82\xe9\x4b\xbd\x9f\x25\x81\xc9\xc8\x62\xf4\x46\x28\x4e\x52\x2f\x03\x67\x5a\xdc\x84\xc9\x9d\x8e\xe8\x53\x20\x82\x19\x4b\x9e\x94\xab\x35\x2a\x9e\x8b\x97\x2b\x41\x85\x9b\x0a\x5e\x51\xcd\x4b\xf9\xab\x45\xbc\xdc\xf7\x5a\xd5\xce\x93\x6b\x3c\x61\x33\x80\xaf\xb5\xf3\xe7\xc7\x61\xca\xa9\xaf\x7f\xf4\xd8\xb4\x1c\x15\xc6\x21\x68\x35\x25\xe4\xf9\xbf\xd0\x68\x83\x8e\x1e\xa7\x79\x42\x1b\x79\xe2\x06\xfd\xda\x6d\xce\x52\x5c\x1a\x8d\xe7\xa7\xdd\xce\x47\xc1\x47\x07\x32\x38\xa1\x3b\xa2\xea\xb6\xc3\x8e\xe1\x14\xff\x37\x7d\xbb\xc4\x77\xc4\x84\x05\x04\x0c\x9e\xc3\x70\xe6\x17\x7e\xb4\xcc\x7f\x1a\x6e\x34\x51\x75\x09\xdf\xec\x9c\xb4\x2e\xd3\x40\xf6\x91\xda\xe5\x27\xbe\x9a\x4b\x28\xef\x4e\xb2\x43\x38\x82\x74\x16\x74\xcf\xb9\x99\x04\x4a\xab\xbd\x94\x55\xd9\xf8\x61\xce\x42\x21\xd4\x70\x25\x80\xd0\x1b\xe9\x1c\xaa\x77\x06\x45\xe9\xb3\x9d\xef\xfe\x90\xc6\xb4\xc5\x85\xcd\x68\x34\x17\x56\xf8\x78\x71\xf4\x21\xe4\x46\x9e\x4b\x55\xe2\x27\xc8\x20\xff\x29\x21\xf6\xcc\x93\x40\xa8\x3d\x55\x84\x81\xde\x3a\x9b\x8e\xf4\x0a\xfb\xd5\x16\x52\x87\xef\x00\x8a\x5a\xdb\xd6\xe0\xae\x10\x8d\x6b\xcd\x70\xd7\x4b\x53\x98\xe6\xfd\x5f\x6e\xee\x26\xbc\x82\xc9\xc5\x5f\xbc\xdb\x95\xff\xa7\xa1\x71\x84\x85\xb7\xa3\x02\x35\x66\x42\x83\xa1\x2c\xb3\x87\x28\x4d\xc7\x72\x3e\xc7\x69\x1a\x3d\x50\xd9\x2e\x3e\x8f\x35\xcc\xcb\x7e\x38\x1b\x53\xf2\x59\x2e\x79\x48\xcf\x23\x7b\x88\x92\xd9\x74\x3a\x12\xbf\xc4\x49\xf4\x30\x60\xe5\x7c\xea\x98\xd3\xf8\x9a\xe2\x98\x85\xe8\x5c\xff\x72\xef\x62\x6a\x75\x1c\xf2\x37\x18\x43\x85\xc4\x0b\xbc\xf1\xe3\xdd\x34\x0c\x4c\xde\x0c\xbc\x13\x18\x47\x2f\xae\xab\xf8\x8b\xbf\xee\x59\x16\xd6\x41\x14\x67\x9f\x5c\x9e\x8f\x3f\x92\x2e\xaa\x6c\x14\xc5\x56\xee\x76\x79\x6e\x5d\x98\x42\xbd\xd5\x9b\xd9\xd9\x6f\x81\x3a\x03\x08\xac\x4e\x0c\xf7\x8f\x0c\x00\x8c\x29\xff\x09\x00\x00\xff\xff\xd3\x24\xc8\xea\xf6\x19\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x59\x4d\x93\xe3\xb6\xd1\xbe\xf3\x57\x74\xd1\xef\xd6\x88\x36\x45\xef\xf8\x28\xbf\x5a\x57\x62\x3b\x8e\x53\xb1\xe3\x8a\xa7\x9c\xc3\x64\x42\x43\x64\x4b\x44\x44\x01\x2c\x00\xa4\x56\xd9\xda\xfc\xf6\x54\x37\xc0\x4f\x69\x76\xc7\xa9\xe8\x30\x43\x11\x8d\xfe\xee\xa7\x1b\xd0\x7a\x0d\x25\xee\xd1\x48\x25\x5d\x56\xb7\x02\x36\x70\xa8\xf5\x4e\xd4\x60\xd1\xb5\x0d\xec\xb5\xf1\x04\x50\x09\x55\xd6\x52\x1d\xa2\x68\xbd\x86\xd6\xc9\x5a\xba\xcb\x06\x9c\xd8\xd5\x08\xb6\xd2\xe7\x68\xdf\xaa\xc2\x49\xad\x20\xcf\x9d\x5d\xb9\x24\x02\x00\xb9\x07\x07\xdb\x2d\x28\x59\x83\xab\x50\xd1\x3b\x00\x30\xe8\x5a\xa3\x20\xfe\x7f\x25\xeb\x37\x31\xbd\x44\x55\xd2\xbf\x5a\x17\x24\x1a\xb6\xb4\xa6\xd5\x9a\xf7\x91\x88\xcd\x9b\xbf\xab\x78\xa4\x38\xc2\x16\x5e\xd3\x57\xd2\x4f\xa6\x1d\x48\x05\x8d\x90\x86\xe4\x42\xa9\x83\x18\xe2\x63\x21\xcb\x20\x3e\xe2\x65\x13\xd3\x93\xd3\xd6\x19\xa9\x0e\x2b\x99\xf0\x02\xac\xdf\x40\x27\xea\xc5\x62\xe7\x17\x83\x48\x00\x96\x77\x84\xcf\xee\x27\xaa\xca\x3d\x1c\xe1\x0d\xbc\xbe\x61\x97\x9d\x90\x8d\xa6\x06\x73\x76\xad\x03\x3c\x35\xee\x12\x7c\x77\x96\xae\x82\xd7\x80\xca\x19\x89\xf6\xcd\x06\xe6\xaa\xb8\x24\x22\x4e\xe4\xf4\x42\x28\x38\x23\x54\xa2\x43\xd0\x0a\xfb\x40\x95\xb8\xa7\xe8\x91\xe7\xf5\x1e\x1a\xa1\x64\x01\x42\x95\x60\xb0\xd0\x1d\x9a\xaf\x68\xeb\x43\x25\x2d\x9c\x75\x5b\x97\xb0\x43\x68\x0c\x45\xd4\x60\x09\x4e\x83\xc1\x06\x85\x93\xea\x40\x86\x9c\x40\x2a\xc0\x0e\xcd\x05\xfa\x70\x66\x2c\xdb\x6b\x03\x9d\xc4\x33\xfd\x1f\x04\x75\xa2\x6e\xf1\x4b\x10\x60\x5a\xe5\xe4\x09\xb3\x6f\x8d\xd1\x86\x76\xf8\xf5\x42\x18\x32\x0b\xa4\xb3\xd0\x68\xcb\x6a\x62\x09\x27\xb4\x56\x1c\x90\xa4\x39\x7c\xeb\xb2\x28\xcf\x59\xdd\x1f\x1e\x60\x0b\xef\xf2\xbc\x37\x1f\xb6\x83\x1e\xab\x2e\x09\x5e\x1e\x3f\x72\x0f\x5d\x46\x0c\xe0\xdf\x57\x39\x36\xfb\x84\x28\x78\xe2\x2b\x82\x10\xaa\x5b\x3b\xee\xc4\x9a\x0d\x59\xb3\xa1\x9b\xbb\x79\x9a\x3c\xde\x3f\x71\x78\xde\xb3\x8f\xf2\xbc\x6e\xc5\x1f\x44\x5b\x3b\xa0\xbd\x16\xd0\x98\x14\xce\x15\x2a\x90\x0e\xa4\x85\x3f\xb7\xe2\x4f\xdf\x3f\xdc\x59\xf2\xb9\x36\x0e\xf4\x9e\xb6\x49\x55\xe2\x5b\x76\xaa\x81\x42\xd4\x54\x63\x64\x4b\x0a\x52\x39\x4d\x16\x3d\xe7\x5b\x57\x09\x07\xdf\x69\x38\xc8\x0e\x2d\x57\x82\xa0\x8d\x50\x22\xc7\x17\x55\x81\x19\xfc\xc5\x55\xc8\x7b\x90\x36\x5b\x28\xf4\x09\x61\x27\x8a\x23\xb4\xaa\xa8\x84\x3a\x60\x99\x45\x13\xcd\x27\x0e\x47\x63\x86\x22\xbe\x34\xc8\xdf\xc9\xd1\xb1\x37\x3f\xbe\x91\xf9\x68\xcc\x55\x35\x9f\x2b\x34\x48\x7e\x10\xc4\xdd\xef\xcd\x4e\xc2\x15\xd5\x8a\x1d\x14\xff\x63\x95\xad\x37\xaf\xca\xcf\x36\x90\x08\xe7\xa8\x36\xc0\x69\x58\xbd\x12\x9f\x25\x90\x7d\xfa\x6a\xe5\xad\xe2\x00\xbc\x4a\xfe\x2f\xee\x75\x62\x86\xa4\x0e\x3b\x30\xe6\x9c\x1f\xde\x91\x23\x5f\xaa\x60\x27\x6a\xd8\x42\x9e\x07\x37\xb3\x97\x7f\xf6\x6a\x36\xce\x3c\xe8\x1f\xf1\x5c\x5f\xbe\xd6\xca\x3a\xd3\x16\x0e\xcb\x55\x1c\x28\xbd\x4f\x37\x20\x55\x27\x6a\x49\x59\x7d\xd2\xe6\x02\xa2\x2c\x0d\x5a\x0b\xda\xb0\xe2\x8d\x96\xca\xa1\x99\x86\xc5\xdb\x10\x54\x1a\x04\xff\x44\x51\xfd\x85\xcc\x5c\x75\xa2\x4e\xbd\xe3\xc6\xf2\x0f\x25\x82\xe6\x17\x72\xaa\xac\x6b\xd8\x21\x09\xa8\xc9\x19\x4a\xfb\xa4\x48\x89\x72\xf6\xd1\x86\xd6\x79\x11\x2a\xd1\x34\xa8\xb0\x24\x5f\x5d\x11\x92\xb3\xe0\x2c\x6c\x0f\x1c\x94\x18\xeb\x35\x38\x82\x0e\x69\x41\xd4\x67\x71\xb1\x20\x02\x6c\x39\x0d\xa2\xd3\xd2\xb3\xf1\x41\x95\x7b\x59\x08\xc6\xa1\xc6\xe8\x5d\x8d\x27\x9b\xc1\x03\xa5\x2f\x8a\x9a\xc9\x26\x90\x41\x1c\xa5\xb2\xb2\x44\x10\x6e\x40\x06\x78\xbc\x7f\x22\xa1\x44\xfd\xe3\xef\xe7\x16\x83\x42\x2c\x2d\xc9\x25\x04\x43\xb3\x3e\x68\xa3\x5b\x27\x15\x66\xf0\x3b\x0b\x28\x8a\x8a\x85\x14\x3d\xca\xb5\xea\x2c\x55\x49\xd5\x44\x09\xd2\xa0\x2a\x51\xb9\xfa\x42\xf2\x84\xba\x78\x85\x28\x34\x0c\x42\x54\x5e\x51\x34\x13\xc8\x88\x12\x45\xe1\xcd\xb4\x30\x38\x7a\xeb\x75\x63\xa4\x72\xab\xb8\xc4\x5d\x7b\xd8\x80\xd3\x0d\xe8\x7d\xef\xbc\x55\x12\x27\x63\x86\x59\x47\x05\xb7\x05\x26\xcd\x9c\x11\x05\x52\x09\xae\xfa\x54\x56\xda\x41\x9e\x4b\xfb\x8d\x34\x58\xb8\x6f\x28\x4d\x56\xbc\x27\x99\xa6\xf0\x5c\x22\xfc\x3a\x88\xfa\x75\xc3\x71\x23\x2e\x25\x73\xf0\x2d\x3b\x85\x1a\x45\x47\x0e\x98\xda\xb5\x8d\xd3\xd9\xf7\x64\x5e\x20\x64\xf3\x58\x20\x1f\x13\xf9\xa9\x97\xf7\x69\x2f\xd0\x33\x79\x91\xc8\xd1\x3b\x45\x03\xdb\xd9\x7a\xf0\x4a\x9e\x1f\xe4\x3f\xa5\xfb\xde\x7e\x4f\xf5\x63\xda\xc6\xad\x8a\x66\xe1\x11\x36\x11\xe9\x1d\xc8\x9e\xca\xe7\xd9\x51\xaa\xc3\x97\x60\x71\xb2\x40\x03\x4e\xf6\x21\x73\x6f\xc4\xdf\xab\x52\x34\x7d\x87\x11\xaa\xf4\x68\x48\xaa\x6c\xb7\x10\x73\x39\xcc\xa0\xc6\x5b\xd5\xaa\xb3\x11\x64\x59\xd1\x3c\xde\x3f\x7d\x49\xba\x86\x57\x7b\xa3\x4f\x20\x8c\x11\x97\x14\xac\x06\x23\xce\x63\x4d\x78\xb5\xb0\x9c\x6b\xe9\x37\x5e\x4f\x15\x45\xe3\xd1\x81\x0d\xbe\x05\xdd\x43\xfc\x98\x62\x95\x70\x83\xc1\xd2\x0f\x1d\x68\x0c\x6c\x21\x4e\x61\xa4\x06\x56\x90\x16\xa8\x28\xfa\x42\x6f\x0c\x76\xa8\x1c\x14\x5a\x75\x68\x2c\x15\xaa\xd3\xa1\xe8\x61\x77\xf1\x30\xb8\x4a\x6e\x78\xf0\x1d\x1a\xf3\x3e\xb0\xa6\xc1\xc7\x3a\xc2\x2b\x51\xd7\xfa\x0c\xd2\x85\x62\xa6\xa1\x82\x45\x49\x05\x22\xd4\x0a\xd7\xc8\x26\x02\x00\x8b\xee\x84\x4e\xb0\x32\xab\x29\xfb\x21\xa7\x7e\x78\x60\xd1\x5e\x8b\x79\x9e\xb1\x77\x22\x2f\x1e\x0f\x52\xc1\x4e\xcb\x1a\x4d\x53\x0b\x87\xd0\x08\xe3\xe0\x0b\x12\xc2\x3d\xd5\x60\x23\x0c\xdb\xcb\xa3\x2e\xfa\x34\xfa\x9c\x33\xfb\xf3\xc0\x34\xca\x73\xbf\x68\xbe\xf8\xa0\xbb\x61\x42\x67\x5a\xc5\x15\x31\xfa\x7c\xe2\xf2\x85\xbf\xc6\x46\x3c\xf0\x9c\x34\x2e\x1f\xfc\x28\xcf\x59\xb3\x3f\x7a\x01\x0b\x3d\x52\x5f\x8a\x76\xae\xcf\x62\xcb\x07\x55\xea\x37\x5d\x81\xd5\xcb\x59\x7a\x15\x36\x71\x3a\xce\x4a\x41\xab\xa1\xf4\x3f\x6e\xb8\xdc\x07\x3e\x37\x86\xbb\x05\x22\x5e\xa9\x9a\x42\x0c\x1f\x52\x70\x62\x33\x91\x52\x51\x7f\xc2\xc2\x7c\x41\x7c\x12\xb4\xbd\x29\xec\x7f\xc4\x39\x70\xdd\x6b\x43\xd0\x0f\xdb\x7e\x29\x85\xfb\x14\xd6\xf7\xe3\x29\x66\x40\x94\x92\x8a\x97\x8a\xea\x6d\x43\x4f\xc1\xa5\x8f\x79\x2e\x9f\xd2\x49\xc2\x25\xef\xc7\x8d\x57\xc7\x23\xe6\x41\x47\x24\xb8\x19\xc6\x4d\xe8\xd1\x8d\xe8\xa3\xc8\x88\x01\x06\x6d\x5b\xbb\x0d\xc8\x6d\x9c\x4a\xb2\x0b\xba\x6d\x9c\x76\xc9\x64\x74\x0e\x4f\x58\x5b\xbc\xd9\xaf\x36\xb0\xd7\xad\x2a\x41\xe9\x3e\xac\x52\x2d\x3c\xe9\x5b\x66\x60\xf4\x8c\x7e\xa5\x56\x38\x49\x32\x68\x8c\x2e\xd0\x5a\x9a\x47\x93\xa1\x6f\x4c\x52\xeb\x3a\x77\x96\x6a\xa1\x2a\x41\xef\x17\xaa\x3c\xd7\xca\x36\xf0\xe1\xf6\xb9\x6c\x63\x37\xfb\xe8\xb3\x32\x49\xd3\x29\x87\x2c\x9e\x8e\x7f\xc1\xd4\x6f\xbc\xf7\x0c\x36\x06\x2d\x2a\x67\xc9\x38\x50\xda\x9c\xc2\x98\x35\x28\x43\x51\x4c\xd9\x59\xba\x75\x20\x7c\x6c\xfb\xf9\x0a\x00\xfe\x86\x3c\x54\x81\xd3\xd0\x36\xa5\x70\xbe\x8f\x2a\x71\xc2\x72\x38\x31\x51\x63\xb2\x20\xf7\x61\x0b\x9d\x26\x10\xce\xf4\x07\xdf\x36\xb5\x2c\xa4\x5b\x90\x72\x77\xcb\x73\x51\xb8\x56\xd4\xfd\x38\xca\x5d\x93\xe7\xcb\x51\x24\x27\x16\x09\xf4\xe9\x30\xd1\x2b\xcf\x59\x87\x1f\xc5\x09\xfd\xe8\xa9\x7c\xbb\x24\x97\xd1\x86\x4e\x18\xc9\x0d\x43\x31\x45\x78\x3b\x53\xe3\x7a\x0e\x06\x00\xab\x49\xfe\x51\xe9\x33\x54\xfa\x3c\x31\x5b\x14\xee\x5b\xd5\xb1\x06\x4b\x37\x4f\xd0\xf5\x5c\xe9\x1e\x5d\x7d\x0e\xf0\xbf\x51\xd5\x34\xf0\x99\xe1\x24\x6d\x8a\x37\x57\xd1\x73\xba\xd9\x78\x1e\x8f\xf7\x4f\x20\xed\x06\xa6\x60\xd9\x2f\x24\xbf\x81\xd5\xcc\x65\x21\x4d\x9d\x5d\x4d\x17\x92\x24\x8a\x86\x0a\x61\xc1\x37\xca\xe2\xb6\x94\x8d\x0f\x57\x25\xca\xe1\xa8\x11\x27\xc3\xce\xeb\xc5\x0c\x4c\xab\xfa\x42\xe7\x72\xe5\xd4\x92\x75\x3f\x20\x47\xd1\x70\x84\xff\x84\xd5\x81\x37\x70\xbf\x38\xbf\xaf\xd7\x84\x5f\xc7\x29\x7e\x31\xe9\x04\xbf\x38\x26\xf1\xb5\xb6\x9e\xe5\x91\x90\xf8\x48\x04\x9d\x9f\x42\x03\x62\x4d\x45\x2c\xf3\x98\x67\xb2\xbd\x0c\xb9\xe9\x8b\xa1\x13\xb5\x85\x1d\xee\xb5\xe9\xb3\x15\x2c\x72\xb5\x9c\xb2\x25\x4a\xb7\xaa\x21\x8c\xe6\x79\x25\x6b\x55\x43\xfd\x28\x24\xcb\x0c\x9a\x07\x48\xa0\x0d\xcb\x04\x68\x55\x93\x24\x4b\x18\x87\xe3\xd4\x0f\x93\xb0\xce\x7a\x05\x7d\x7c\x1e\x3e\x1e\x9f\x60\x4b\xfa\x3c\xca\xa7\x68\x76\xd9\x71\xad\xc5\x73\x7e\x6c\xb4\x75\xec\x8d\x0d\xb9\xe7\xb5\x6f\x62\xf4\xd4\x37\x37\x83\xee\x7e\xeb\xdf\xdd\x27\xd1\x95\x08\x61\x2d\x1a\xb7\x9a\xf6\x7a\x06\xe4\xe4\x37\xb4\xbf\xff\xb6\xfb\x3d\xdf\xfc\x66\xde\x9a\x25\xfe\xb5\x07\x3c\xb0\xbe\xb8\x23\x46\xd7\x97\x4a\xfe\x69\x6c\x8c\x1f\xf3\x79\x51\x21\x9f\x5e\xd8\x00\xdf\x8f\xfd\xdc\xdc\xaa\x75\x21\xda\x43\xe5\xb2\x2c\xbb\xdd\x86\xe8\x3a\xc9\x06\x90\x16\x8a\x36\x0c\x87\xf9\xe9\xa5\xd1\x04\x85\x0d\xba\xca\xe8\xf3\x57\x51\x5f\x8d\x1f\xe9\x9e\x4b\xf5\xaf\xb4\x6f\xd5\x78\x81\xe0\x67\xf2\xa0\x3d\xbe\x95\xd6\xd9\xb4\x97\x48\x06\xde\x36\xe2\x99\x59\x7e\xea\x4b\xfe\x1b\xf5\xe8\x31\x96\x02\x65\xd7\xf4\x46\x76\x3a\xad\xce\xd5\x9c\x6f\xa3\xb3\xec\xeb\x14\x94\x0e\x20\x60\x7b\x70\x9b\x1d\x13\xbd\x58\x3a\x2b\xb4\xee\xf9\x56\xa9\x40\x9b\x12\x4d\xd4\x27\x2e\x7f\xc3\xf2\xaf\x9e\xf1\xf6\x1d\x25\xe8\x4b\x0b\xfa\x6a\x84\x42\x57\x54\x9c\x1a\xd4\x65\xfb\xce\x04\xa8\x3a\xc6\xba\x63\x1a\xc3\xb9\x92\x45\x45\x11\xb6\x88\x50\x09\xeb\xf5\x22\x57\x0f\xa8\x90\x42\x2c\x55\xf8\x3a\x45\x1d\xff\xa6\x07\x9e\xb9\xde\x8f\x92\xc0\x64\x60\x31\x78\x23\x14\x27\xa9\xb7\x05\x67\x5a\x8c\xc2\xe4\x4e\xf7\x05\x63\x20\x82\x19\x73\x9e\x20\x2d\xd4\xa8\x78\x2e\x9e\xaf\x04\x15\xae\x2a\x78\x41\x35\x2d\xe5\x0f\x16\xf1\x7c\xdf\x73\x55\x3b\x4d\xae\xe1\xe4\xcd\x00\xbe\xd4\xce\x9f\x2b\xfb\x29\xa7\xbe\x7c\xed\xb1\x69\x3e\x2a\xf4\xcb\xcb\x29\x21\xcf\xff\x85\x46\x1b\x74\xf4\x38\xce\x13\xda\xc8\x03\x37\xe8\xe7\xae\x96\xe6\xe2\xb2\x78\x38\x4b\xad\xd7\x3e\x0a\x3e\x3a\xb0\x85\x03\xba\x3d\xaa\x6e\xd5\xef\xe8\x4f\xf7\x3f\xeb\xeb\x25\xfe\xf1\x06\x4b\x0f\x0c\x9e\x43\xa0\xa6\xa2\xa0\x2c\xcf\xbf\xeb\x7f\x6a\x40\xd5\x51\x91\x38\x38\x68\x5d\x66\x81\xec\x81\xda\xe5\x5b\xbe\x27\x4c\xe1\x8c\x7c\x1d\x0d\x7b\xbe\xf1\xd7\x67\xce\xcd\x34\x50\x5a\xed\xa5\x2c\xca\xc6\x0f\x73\x16\x0a\xa1\x02\xe1\x0e\xe1\x6c\xa4\x73\xa8\x3e\x37\x28\x4a\x9f\xed\x24\x80\xb8\x65\xf3\xdb\xa3\xc1\xe8\x77\xef\xc7\x97\x27\x47\x2f\x42\x6e\xe4\x39\xdf\x10\xc3\x16\xf2\xef\x52\x62\xcf\x3c\x09\x84\xda\x43\x05\x4e\x07\xeb\x6c\x36\xd0\x2b\x3c\x2f\xb6\x90\x3a\x48\xb4\x45\xad\x6d\x6b\x70\x5d\x88\xc6\xb5\xa6\xff\x11\xc6\x82\xd3\x9a\xf7\xbf\xbf\xba\xb3\xf0\x0a\xa6\x27\xff\x8b\x98\x5d\xf8\x7f\x1c\x1a\x07\x58\x78\x39\x2a\x50\x63\x26\x34\xe8\xcb\x72\x7b\x17\x67\xd9\x50\xce\xc7\x24\xcb\xe2\x3b\x2a\xdb\xd9\xeb\xa1\x86\x79\xd9\x0f\x67\x43\x4a\x3e\xca\x39\x0f\xe9\x79\x6c\xef\xe2\x74\x32\x9d\x0e\xc4\x4f\x49\x1a\xdf\xf5\x58\x39\x9d\x3a\xa6\x34\xbe\xa6\x00\x06\xb4\x38\x5d\x7e\xba\x75\x61\xb5\x38\x0e\xad\xf8\x08\xdd\x57\x48\x32\xc3\x1b\x3f\xde\x8d\xc3\xc0\xe8\xcd\xc0\x3b\x85\x61\xf4\xe2\xba\x4a\xde\x47\xd1\xc4\x71\xa1\xb0\xf8\x77\x10\x4e\x2e\xcf\xc7\x1f\x49\x67\x55\x36\x88\x62\x2b\xd7\xeb\x3c\xb7\x2e\x4c\xa1\x51\x7f\x3b\x30\x9c\xfd\x66\xa8\xd3\x83\xc0\xe2\xc4\x70\xfb\xc8\x00\xc0\x98\xf2\x9f\x01\x00\x39\xf8\x66\x5a\x8f\x1d\x00\x00"),
 		},
 		"/dfs.lua": &vfsgen۰CompressedFileInfo{
 			name:             "dfs.lua",
@@ -72,24 +72,45 @@ var preludeFiles = func() http.FileSystem {
 		},
 		"/int64.lua": &vfsgen۰CompressedFileInfo{
 			name:             "int64.lua",
-			modTime:          time.Date(2018, 3, 26, 6, 59, 41, 0, time.UTC),
-			uncompressedSize: 2665,
+			modTime:          time.Date(2026, 10, 17, 5, 42, 47, 0, time.UTC),
+			uncompressedSize: 3728,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x57\xdd\x8f\xe3\xb8\x0d\x7f\xf7\x5f\x41\xb8\x2f\x4e\x11\x7b\x76\x3e\x2e\x9b\xce\xd6\x05\xee\x5a\xe0\xb0\xc0\x16\xf7\xd0\x59\xf4\x61\x30\x30\x64\x9b\x8e\xd5\x91\x25\x9f\x44\x4f\x3e\x0e\xd7\xbf\xbd\xa0\x64\xe7\x63\x93\x9d\xd9\x2d\x50\x34\x0f\x49\x44\xf2\x47\xfe\x28\xd1\xa4\x9c\xa6\x20\x35\x2d\xee\x60\x08\x3f\x2d\xaa\x1e\xad\x8b\x22\x65\x2a\xa1\xa0\x69\x24\xe4\x60\xf1\xd7\x41\x5a\x4c\xe2\xa6\x91\xf1\x2c\x8a\x8a\xa2\x94\x74\x2c\x2f\x25\xb1\x5c\x36\xf0\x2f\x49\x99\x71\x90\xe7\x10\xff\x53\xea\xda\xac\x5d\x0c\xd4\xa2\x8e\x00\xd8\x59\x56\xd5\xd8\x3c\x3e\xf2\x4a\x19\xbd\x0a\x5f\x52\x13\x14\x82\x8c\x5c\xdc\x25\x95\xd1\x8e\xa0\x6a\x85\x85\x3f\xea\x9e\xec\xec\x43\x04\x00\x4f\x4f\xfc\x5d\xb0\x91\x52\x39\xfb\xf9\x6b\x36\x22\x22\x54\x0e\xdf\xf2\xee\x71\xdf\xe1\xdb\xff\x07\x80\x08\x75\x1d\x45\x69\x0a\xc2\xb9\xa1\x43\x58\xdc\xa5\x9c\xb9\x77\xa9\x6b\xbf\x67\x11\x2f\x72\x1f\x9d\xb6\x3d\x9a\x26\x79\xf7\xe9\xd3\x2c\x62\x55\x7e\x2c\xfc\xcc\xd2\xc8\x6f\xf2\xb1\x3c\xf6\x92\x82\xe2\x00\xf9\x42\x39\x1c\xb4\x0c\xbd\xbd\x39\x8d\x14\x7b\xd9\x1e\x7c\xa6\x1e\x0e\x7a\x86\x5f\x2f\xce\xe1\xd7\x8b\x3d\xfc\x4c\x3d\x1c\xf4\x0c\x5f\x9e\xa3\x97\x7b\xf0\xf2\x02\x36\x68\xcb\x2d\x21\xe4\x7e\xaf\x96\x51\xd4\x28\x23\xb8\xce\x4e\xad\x6b\x33\x94\x0a\xe3\x59\x50\x9f\xe5\xe1\xa5\xcc\x22\x4d\x01\x5f\xd0\x6e\xf9\x04\x70\x85\x16\xa4\x03\xa1\xc7\x0a\x36\x76\x2a\xe2\xaa\x16\x24\x32\xf8\xb1\x21\xb4\xfe\xf4\xac\xa4\xb6\x43\x92\x15\x1b\x09\xa8\x8c\x7e\x41\xeb\xa4\xd1\x73\x2e\x4e\xd0\xc2\x5a\xb3\x46\x0b\x1c\xd0\x05\x04\xc2\xda\x8a\xbe\xc7\x1a\xc8\xb0\x91\xb4\xb0\x96\x35\xb5\x8c\xb0\x66\x58\xb5\x1e\xc9\xcf\xc7\x01\x54\x9a\x17\x9c\xb3\xfd\x0a\xc9\xab\xcd\x0b\xda\x46\x99\x35\x98\x06\x2a\xd3\xf5\x52\x61\x0d\x3f\x9b\x2c\x8a\x9a\x41\x57\x24\x8d\x86\xa2\xe0\x38\x1f\x35\x2d\x93\xcd\x0c\x2c\xd2\x60\xc7\x84\x12\x19\x84\x33\xe0\x2a\x3c\x07\x5c\x2f\x2e\x21\xae\x17\xaf\x40\x6e\x6f\x2e\x41\x6e\x6f\xbe\x06\xf9\x2c\x4f\x79\x85\xfd\x4d\x86\xd7\x98\x7d\x96\x5f\x50\x3b\x02\x5d\x2f\x5e\x43\x9d\xb0\x3b\x42\x1d\xf1\xe3\x6d\x76\xad\x6c\xc8\x41\xb9\xf5\x27\x39\x68\x82\x67\x6d\xd6\x1a\x8c\x56\x5b\x10\x04\x76\xd0\x40\xb2\xc3\x0c\x1e\x5a\x84\x52\x12\x83\x94\x2c\xad\xb0\x5b\x20\xf1\x8c\xce\x9f\x4d\x80\x76\xa6\x86\xc5\xdd\x07\xf8\xd9\x4c\x7e\x7d\x81\x51\x2b\xf5\x0a\xcc\x40\xa7\x47\xa5\xbc\x4d\xb2\x99\x83\x9e\x45\x00\x20\x1b\xd0\xf0\x67\x78\xc7\x0e\x35\xa0\xb5\xc6\x26\xb1\xc6\x95\x20\xf9\x82\xc1\x21\x88\x8e\x03\xc5\x81\xff\x84\xf9\x4b\x0e\x8b\xbb\x80\x1a\xf3\xdd\x40\x0a\x9b\xc9\x66\x94\xf9\x3e\x9b\x9d\x04\xf5\x9b\x70\xc4\xc8\xfe\x3f\x18\xd9\xd7\x18\x89\xff\x05\x25\x0d\x39\x2c\x6e\x2f\x92\x11\xe7\x6c\xd2\x14\xc8\x40\x2d\x5d\xaf\xc4\x16\x7c\xf7\x70\x73\x18\x1c\xde\x03\x19\x3d\x74\x25\xda\x64\xc6\x26\xa1\x11\x10\xff\x9d\x1a\x13\xb5\x82\x40\x0d\x02\x2a\xa1\xa1\xb7\x52\x73\x09\xa4\xe9\xdf\xa5\xfe\xc8\x05\x79\x0f\xe9\x9f\x6e\x6e\x6e\x6f\xdf\xdf\xbc\xbb\x5d\x2c\x7f\xb8\x7b\xff\xfe\x87\xe5\xbb\x25\x1b\x88\xcd\x68\x70\xae\x7f\x1f\x66\x48\x2e\x35\x25\x97\xe0\x7e\x34\x04\xd2\x83\xc3\xd0\xbe\x40\x38\x68\x85\x6b\xe1\x19\xb7\x2e\xcb\x32\x20\xe3\xc8\x4a\xbd\x0a\xcc\x3b\xf1\x8c\xbc\x35\x1d\x04\xa9\x83\x46\x5a\x17\xb8\xbe\xf5\xf9\x36\x13\x26\xc4\xed\xad\xc6\x1e\x75\x8d\x9a\xc6\x48\x57\xbe\xa1\x3b\x1a\x9a\x26\xfa\x56\x5f\x6f\x9a\x70\xb0\xa2\xd0\xb8\xfe\x69\x4b\xf8\xa3\xb5\x62\x0b\xd2\x41\x65\x51\x10\xd6\xd0\x58\xd3\xc1\x8b\x50\x6e\x0e\xeb\x56\x56\x2d\x5b\xf3\xf1\x94\x08\x02\x48\x94\x0a\xe7\x20\x34\x60\xd7\xd3\x76\x5a\x9b\xd0\xf9\x47\xd2\x19\x7c\x6c\x40\x92\x9f\x17\xa3\x68\x1e\x2a\x8b\x0c\xdb\xfd\x3a\x18\xc2\x10\x67\x6a\xeb\xb5\xa9\x1c\x08\x82\x96\xa8\xbf\xbf\xba\x52\x83\xf0\x77\x1b\xbb\xba\xc2\x0d\x15\x4d\x23\x0b\x87\x9d\xd0\x24\x2b\x97\xb5\xd4\xa9\x71\xcb\x62\xce\x00\x04\xa7\xe0\xa0\x13\x5b\x10\xca\x19\x66\x2a\xb5\x24\x29\x94\xdc\x61\x0d\x6b\x49\x3e\x09\x10\xf0\x69\x38\x70\x7c\x68\x39\x69\xd3\xcb\xb1\x45\xad\x5b\xa3\x70\xd4\x7a\xf3\x5e\x0d\x9c\x00\xa1\xed\xa4\x16\xc4\x3d\x6a\x87\xd6\xa4\x7c\x24\xa1\xdd\x55\xa6\xdf\x82\x23\xd3\xfb\x89\x04\x28\xac\xda\x86\xce\x28\x1b\xef\xd3\x33\xe3\xca\x02\x11\xfa\xe6\x1c\x1a\xb9\xc1\x1a\x9c\xdc\x61\x16\x73\x16\x47\x0f\xf3\xf1\x89\x24\x7c\x02\xfe\x89\xe6\x3f\x90\x87\x1f\x63\xe1\xb7\xdf\xc3\x8d\x8b\x2f\x8c\x6e\x07\x39\xfc\x81\x35\x07\x99\x45\x97\xc3\x6f\xbc\xf6\x17\x2d\x26\xeb\xc6\x09\xaf\x71\x9d\xc4\x7c\x23\x7b\x8c\xb3\xcc\xed\xb2\x2c\x7e\x8a\xe7\xde\xf1\x6c\xbe\x07\xb8\x5d\xee\x76\x87\xa5\x16\x1d\xe6\x71\x51\xbc\x08\x35\xe0\x9e\x5d\xec\x0d\x3c\x13\x87\xd4\x21\x09\x5f\x08\x89\x45\x37\xdf\x07\x3f\xf9\x14\x85\xd4\x35\x6e\x98\xc9\x98\x70\xd2\xe1\x1c\xe4\xec\x92\xf1\xa1\xeb\x74\x98\x8d\x39\x3c\xca\xa7\x4b\xa6\xa8\xeb\xf9\xe5\x78\x0a\x75\x7e\x14\xeb\x6b\x81\xd2\xd4\xf7\x9d\x24\xf6\x88\x15\xb5\x60\x34\x94\x53\xa2\x50\x09\xa5\xb0\x8e\xbf\x85\xa6\xdb\x7d\x1f\xc1\xa9\xc7\x7c\x27\xcb\x09\xf6\xdf\xf0\x1c\x6b\xdf\x0d\x65\xc2\x05\x11\x96\xc9\x61\x93\x67\x73\xb8\x9e\x4f\xd9\xcc\x5e\x4b\xe7\xf7\xd9\xd1\x6c\xb0\xe8\xc2\x2c\x28\x8a\xe0\xf2\xc1\xfc\x34\xd5\xdd\x94\x9b\x23\x3b\x3b\x19\x27\x27\xd5\xee\xb5\xa8\xeb\x0f\xfe\x7d\x87\xb1\x0f\xe6\x1f\x21\xcd\x23\x1f\xa5\x98\x66\x5c\x29\x32\x1e\x7e\x9c\xf9\xbf\x73\xd0\x52\xed\xdf\x7b\xde\x4a\xf6\x80\x3c\x4d\xfa\x44\x3e\x26\x7f\x98\x8f\x7c\xed\xe4\xf0\xfe\x5d\x6b\x70\x68\x79\x6a\xc4\xc7\x41\xd3\x14\x3a\xe3\x08\x94\x7c\x46\xbe\x1a\x41\x6f\xcd\x66\x7b\xca\x68\x75\xfc\xac\x94\x62\x96\x15\x85\xb7\x0a\x3c\x94\xac\x70\x7f\xba\x53\xae\x23\x85\x71\x90\x7f\xb9\x37\x5e\x7c\x0f\x0f\xbf\xfc\xed\x97\xab\x41\x87\x9b\x59\x6b\xd6\xd3\xad\x78\x2a\x94\x81\xc0\x34\x10\x67\xd9\x94\xc6\xb8\xd7\xff\x19\x00\x7f\xbe\x0a\x6a\x90\x0e\x00\x00"),
+		},
+		"/interrupt.lua": &vfsgen۰CompressedFileInfo{
+			name:             "interrupt.lua",
+			modTime:          time.Date(2026, 10, 17, 7, 10, 0, 0, time.UTC),
+			uncompressedSize: 1004,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\x3f\x6f\xdb\x30\x10\xc5\x77\x7d\x8a\x07\x4f\x32\x20\x09\xe8\x9a\xc2\x43\x11\x74\x48\x91\xad\xdd\x8a\xc2\xa0\xa9\xb3\xc4\x86\xe1\x09\xbc\xa3\x55\x35\x48\x3f\x7b\x41\x5a\xce\xff\x41\x0b\x75\xef\xdd\xbb\xdf\x5d\xdb\xc2\x05\xa5\x18\xd3\xa4\x9d\x4f\xe6\x0a\x9e\x14\xd7\x1a\x7d\x7b\x0d\x51\x9e\x10\x53\x30\xb3\x59\x60\xb9\xa7\xae\x6a\xdb\xaa\x6d\xf1\x05\x3d\x1d\xd2\x80\x91\xf9\x0e\xd6\x84\xc0\x8a\x9e\xa1\xa3\x13\x1c\x16\x38\x15\xf2\xc7\x2b\xdc\x26\xf3\xed\xe6\x47\x16\xf4\x4c\x82\x5c\x65\x8d\xf7\x45\x26\x38\x46\xbe\x87\xe5\xfb\xc9\x79\xea\xa1\xd1\x58\x92\x06\x26\xf4\x59\x60\x30\xb2\xc2\x33\x4f\x70\x02\xfa\x63\xac\xfa\x05\xf3\x68\x14\x03\xa9\x3c\xe9\x3a\x7c\xcf\x7d\x29\x6b\xd6\xb7\x88\xd1\x08\xe8\x44\x71\x39\x1b\x58\x4e\x21\xe7\x9b\x03\x0c\x3c\x5b\xe3\x1b\xcc\xa3\xb3\x63\x16\x89\x9a\x45\xe0\x82\xb8\x9e\xb2\xd1\x39\x48\xc9\x71\x0e\xbb\xdf\x0f\xee\xb7\xd3\x5b\xe6\xe9\x7a\x24\x7b\x97\x45\xf3\x48\x01\x4e\x31\x3a\x15\xfc\xa5\xc8\xa8\x85\x08\xfe\x52\xf3\xb5\x34\x77\xe1\x05\xdb\x81\xb7\x5d\x95\xb5\xab\xdf\xcd\xe5\x0f\xf5\x79\xc2\xdc\x79\x32\xc1\x59\x9c\x8c\x4f\xd4\x21\x92\xe5\x13\xc5\x7a\x5b\xfa\x39\xef\x57\x7c\x6a\x47\x38\x6d\x20\x8c\x24\x14\xcb\x5a\x2e\x2b\x90\xd9\x78\xcf\x33\x4c\xd6\x9c\x77\xf8\x19\x3d\x1d\x29\x0a\x44\xb3\x47\x4c\xa1\x83\xb2\x68\x74\x61\xa8\xb7\x18\xdc\x89\x04\x1b\xf7\x1c\x66\xd3\x55\x1f\x24\xdc\x41\x48\xef\x49\x8d\x9a\x83\xa7\xfa\xe1\xb1\xc1\xc3\x7e\x7f\x31\xc2\x0e\xc7\x14\xac\x3a\x0e\xf5\x16\x91\x34\xc5\xf0\xda\x14\x14\xfa\xc7\x6d\x55\xbd\x85\xf9\x4a\x59\x01\x70\xc7\x0b\xa0\xbd\x9a\x3b\x7a\xca\x80\x7f\x3b\x04\xe7\xcb\x5a\x3e\x2c\xa8\xb7\x99\x61\xc8\x1e\x00\xda\xf6\x4c\xb3\x70\xd2\x7c\x35\x2b\x86\x98\x02\x38\xe4\x52\xe4\xa3\xe6\xa4\xdd\x2a\x29\xf5\xf5\xfb\xd9\x4b\x2c\x0a\x7d\x95\xbf\x97\x0b\x94\xe7\x70\x4a\xde\x4b\xce\x4e\x31\x36\x30\x02\x6b\xd2\x30\x6a\xae\x3e\x2c\x98\xf2\x1d\x35\x70\xeb\xc9\xbf\x65\xd0\x55\x1f\x38\xbe\xe0\x42\x31\x96\x0c\x2b\x57\x5d\x26\x2a\x6f\xd8\xed\xb0\x29\xfb\xd8\x14\x2c\x14\xe3\xcf\x4f\xbf\xf2\xeb\xfb\x21\x4a\xf8\xff\x03\x00\x4e\x92\x78\xf4\xec\x03\x00\x00"),
+		},
+		"/kernel.lua": &vfsgen۰CompressedFileInfo{
+			name:             "kernel.lua",
+			modTime:          time.Date(2026, 10, 17, 6, 27, 29, 0, time.UTC),
+			uncompressedSize: 1791,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x56\x4f\x6f\xe3\xb6\x13\xbd\xeb\x53\x0c\xf4\xbb\xd8\x3f\x48\xca\x6e\x92\x75\xdc\x2c\x74\xe8\xb6\x97\x05\xb6\xd8\x43\x53\xf4\x10\x04\x02\x25\x8d\x2c\x36\x14\xa9\x25\x87\x71\xe4\xc5\xf6\xb3\x17\x43\xc9\xff\xe2\x14\x49\xea\x83\x6d\xcd\xcc\x9b\x79\x6f\x48\x0e\x95\xa6\x20\x35\x2d\x2e\xc1\x8f\x3f\x2d\xaa\x1e\xad\x8b\x22\x65\x2a\xa1\xa0\x69\x24\xe4\x60\xf1\x9b\x97\x16\x67\x71\xd3\xc8\x78\x1e\x45\x45\x51\x4a\x3a\xb4\x97\x92\xd8\x2e\x1b\xf8\x4b\x52\x66\x1c\xe4\x39\xc4\x7f\x4a\x5d\x9b\xb5\x8b\x81\x5a\xd4\x11\x00\x27\xcb\xaa\x1a\x9b\xdb\x5b\x7e\x52\x46\xaf\xc6\x2f\xa9\x09\x0a\x41\x46\x2e\x2e\x67\x95\xd1\x8e\xa0\x6a\x85\x85\xff\xeb\x9e\xec\xfc\x23\xc7\xde\xdd\xf1\x77\xc1\x41\x4a\xe5\x9c\xe7\x97\x6c\x42\x44\xa8\x1c\xbe\x94\x3d\xe0\xde\x90\x3b\xfc\x07\x80\x08\x75\x1d\x45\x69\x0a\xc2\x39\xdf\x21\x2c\x2e\x53\x56\x1e\x52\xea\x3a\xf4\x2c\xe2\x87\x3c\x54\xa7\xa1\x47\xd3\xcc\xde\x7d\xf9\x32\x8f\xd8\x95\x1f\x1a\xff\x60\x6b\x14\x9a\x7c\x68\x8f\x83\xa5\xe0\xf6\xf9\x53\xa7\xdf\x7b\x19\x7a\x71\x7e\x5c\x29\x0e\xb6\x1d\xf8\xc4\xed\xf7\x7e\x86\xbf\x5f\x9c\xc2\xdf\x2f\x76\xf0\x13\xb7\xdf\xfb\x19\xbe\x3c\x45\x2f\x77\xe0\xa7\x4e\xbf\xf3\x96\x03\x21\xe4\xa1\x57\xcb\x28\x6a\x94\x11\xbc\xcf\x8e\xa3\x6b\xe3\x4b\x85\xf1\x7c\x74\x9f\xe8\x08\x56\x66\x91\xa6\x40\x06\x6a\xe9\x7a\x25\x06\x08\x66\x97\x80\x77\x78\x0d\x64\xb4\xef\x4a\xb4\xb3\x39\x87\x54\x46\x3f\xa0\x25\xfe\xbb\xad\x48\xad\x20\x50\x5e\x40\x25\x34\xf4\x56\x6a\xca\x38\xe1\x6f\x52\x7f\xe6\x26\x5f\x43\xfa\xd3\xf9\xf9\xc5\xc5\xd5\xf9\xbb\x8b\xc5\xf2\xc3\xe5\xd5\xd5\x87\xe5\xbb\x25\x07\x88\xc7\x29\xe0\xd4\x7f\x35\x6e\x8e\x5c\x6a\x9a\x3d\x07\x0f\x6b\x3e\x92\xf6\x0e\xa1\xaa\x05\x09\x10\x0e\x5a\xe1\x5a\xb8\xc7\xc1\x65\x59\x06\x64\x1c\x59\xa9\x57\x23\xf3\x4e\xdc\x23\x9f\x98\x0e\x46\xab\x83\x46\x5a\x37\x72\x7d\xe9\xf3\xba\x10\x26\xc4\xe7\xba\xc6\x1e\x75\x8d\x9a\xa6\x4a\x67\x61\xa5\x1c\xf9\xa6\x89\x5e\x9b\xeb\xc5\x10\x2e\x56\x14\x1a\xd7\x9f\x06\xc2\x9f\xad\x15\x03\x48\x07\x95\x45\x41\x58\x43\x63\x4d\x07\x0f\x42\xb9\x04\xd6\xad\xac\x5a\x8e\xe6\xe5\x29\x11\x04\x90\x28\x15\x26\x20\x34\x60\xd7\xd3\xb0\x7d\x36\x36\xf4\x7c\x22\x9d\xc1\xe7\x06\xf8\x48\xba\x9d\x29\x09\x03\x07\xc8\x70\xdc\x37\x6f\x08\xc7\x3a\xd4\xe2\xa8\xdb\x54\x0e\x04\x41\x4b\xd4\x5f\x9f\x9d\x29\x2f\xc2\xd0\xb2\xab\x33\x7c\xa4\xa2\x69\x64\xe1\xb0\x13\x9a\x64\xe5\xb2\x96\x3a\x35\xb5\x2c\x66\x05\x20\x58\x82\x83\x4e\x0c\x20\x94\x33\xcc\x54\x6a\x49\x52\x28\xb9\xc1\x1a\xd6\x92\x82\x08\x10\xf0\xc5\xef\x39\xde\xb4\x2c\xda\xf4\x12\x5d\xa0\xb1\x6e\x8d\xc2\xc9\x1b\xc2\x7b\xe5\x59\x00\xa1\xed\xa4\x16\x24\xf5\x0a\x36\x68\x4d\xca\x4b\xc2\x70\x64\xf4\x00\x8e\x4c\xef\x02\x00\x85\x55\x03\x18\xad\x06\x90\x4d\xc8\x19\x98\xf1\xce\x02\x01\xf7\xda\xac\x75\x02\x8d\x7c\xc4\x1a\x9c\xdc\x60\x16\xb3\x8a\xc6\xeb\x8a\xa4\xd1\x4f\x56\x64\xc6\x2b\x30\xe7\x29\xc8\x7f\x20\x1f\x7f\x8c\x85\xef\x3f\xc6\x51\xca\x37\x81\xdb\x40\x0e\xff\x63\xcf\xde\x66\xd1\xe5\xf0\x9d\x9f\xc3\x04\x65\xb2\x6e\x3a\xba\x1a\xd7\xb3\x98\x47\xed\x6d\x9c\x65\x6e\x93\x65\xf1\x5d\x9c\x84\xc4\xf3\x64\x07\x70\x9b\xdc\x6d\xf6\x8f\x5a\x74\x98\xc7\x45\xf1\x20\x94\xc7\x1d\xbb\x38\x04\x04\x26\x0e\xa9\x43\x12\x61\x23\xcc\x2c\xba\x64\x57\xfc\xe8\x53\x14\x52\xd7\xf8\xc8\x4c\x26\xc1\xb3\x0e\x13\x90\xf3\xe7\x82\x01\xc0\x22\x79\xab\xa1\xc3\x6c\xd2\x70\x2b\xef\x9e\x0b\x45\x5d\x27\xcf\xd7\x53\xa8\xf3\x83\x5a\xff\x56\x28\x4d\xc3\xdc\x99\xc5\x01\xb1\xa2\x16\x8c\x86\x72\x77\x30\x2a\xa1\x14\xd6\xf1\x6b\x68\xba\xcd\xdb\x08\x6e\x67\xcc\x1b\x59\x6e\x61\xff\x85\xe7\xb4\xf7\x9d\x2f\x67\xbc\x21\xa6\x19\xb7\x6f\xf2\x3c\x81\xf7\xc9\x56\xcd\xb3\xb9\xb6\x72\x7e\x04\xef\x94\xd6\xa2\x1b\x6f\xe5\xa2\x18\x53\xde\x98\x4f\xdb\x7d\xb7\xd5\xe6\xc8\x1e\x42\x9e\xec\xf6\xe0\x45\x5d\x7f\x0c\x2f\x32\x8c\xbd\x31\xbf\x8f\x32\x0f\x72\x94\x22\xa4\x90\x0d\x94\x22\x2b\x8a\xf1\x74\xfd\x9d\x83\x96\x6a\xf7\x42\xf3\x92\xd8\x3d\xf2\x58\xf4\x91\x7d\x12\xcf\x9a\xc6\x7a\x7c\xeb\x71\xf9\xf0\x12\xe5\x1d\x5a\xbe\x35\xe2\xc3\xa2\x69\x0a\x9d\x71\x04\x4a\xde\xa3\x1a\x40\x40\x6f\xcd\xe3\x70\xcc\x68\x75\x78\x56\x4a\x31\xcf\x8a\x22\x44\x8d\x3c\x94\xac\x70\xb7\xba\x5b\xad\x13\x05\xb4\xd6\x58\x5e\xfd\xe3\xde\x04\xf3\x35\xdc\x7c\xfd\xf5\xeb\x99\xd7\x61\xc2\x40\x6b\xd6\x7c\x67\xad\x70\x7b\x87\x80\xf1\x04\xa6\x81\x38\xcb\xb6\x32\xa6\x5e\xff\x13\x00\x00\xff\xff\x18\xc7\xa4\x16\x69\x0a\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x54\xc1\x8a\xdb\x4a\x10\xbc\xeb\x2b\x0a\xef\x45\xe6\x49\x82\xbd\x3e\xf0\xe9\xb1\x3c\x92\x5b\x42\x42\x0e\xbb\xc1\xdb\x96\x5a\xf6\xc4\xe3\x19\xd1\xd3\xf2\x26\x84\xfc\x7b\x18\x8d\x6c\x6b\xb5\xf6\x21\xb7\x18\x84\xcc\x4c\x4d\x55\x75\xab\x7a\xca\x12\x7b\x16\xc7\xb6\xb2\x3d\xfd\x0b\xdf\x6b\xd7\x2b\x6a\xea\xb4\x17\x46\xeb\x05\xcf\x5b\x83\x32\x61\x9e\x8b\xac\x2c\x41\xae\x19\x36\xc8\x81\x0f\x1b\x6e\x1a\x6e\xf0\xce\x29\x4b\x27\xac\x2c\x55\x56\x96\x11\xf6\xd9\x35\x2c\x78\xdf\x77\x3f\x94\xa5\xc0\xcb\x8e\x14\xba\x63\xd4\xbe\x61\x74\x62\x9c\x86\x81\x4a\x77\x1c\xe1\x47\xb2\x3d\xc3\xb7\x03\xed\xf7\x4e\x38\x04\xe3\x1d\xb6\x1e\x1b\xaa\xf7\xa0\x80\xc6\xb4\x2d\x0b\x3b\x8d\xf0\x03\x87\x40\x5b\x0e\x15\xd6\xeb\xad\xf9\x66\x74\x9d\x2c\xfe\x37\x3a\x17\x2e\x3b\x3f\x68\x0c\x52\x27\xdf\xc6\x57\x2f\x62\x94\x41\x3a\x3b\xf8\x25\x2e\x17\x20\xfc\xef\xd1\xf6\xae\x56\xe3\xdd\xb9\xdc\x1d\x85\x33\x7c\xe0\xfb\xd0\x7b\xe5\x26\x56\x65\xea\x1d\xc2\xce\xbf\x84\x88\x3d\x61\xc8\x85\x22\x09\xc3\x38\xf5\x20\x04\xee\x48\x48\x19\x9b\xbe\x6d\x53\x93\xce\x68\x16\x39\xd9\x0e\xec\x9a\x30\xb4\x49\xb8\xf3\xa2\x43\x43\xd0\x92\xb1\xdc\xc4\x23\x7c\x24\x0b\xf5\xd3\xa3\x83\xf1\x2a\xcb\xae\xf7\x61\x75\x2e\x26\x5f\x66\x00\xac\xaf\xc9\xc2\xb8\x8f\x1c\x7a\xab\x71\x9b\x6c\xe0\xcb\x8e\x9c\xd6\x7f\xfe\xca\x2e\xab\x7c\x30\x3a\xa5\x0a\x03\x17\x00\xd3\x5e\xb8\x74\xc7\x6e\x5c\x06\x46\xa2\xc7\xbb\xf4\xfe\xe7\xfe\x2b\x56\x08\xe3\x36\x8f\x92\xe9\x77\xe5\x3b\x5c\x04\xd8\x35\xd9\xf8\x8a\xef\xd4\xd3\x89\x93\xaa\xaa\x4e\xd0\x64\xd5\x45\x1d\xb6\x5c\x6b\xbe\xb8\x5b\x14\x78\x03\xe8\x48\x34\xa4\x02\xd3\x72\xcc\xb2\xc1\x0a\xf7\x05\x1c\x1a\x7f\x31\x36\x20\x1f\x4d\x74\xae\x3e\xa8\x18\xb7\xcd\xf3\x91\xdb\x24\xe6\xe5\xcc\x67\xfc\x77\x30\x9a\x2b\x6d\x2c\x57\xb5\x77\x35\x69\x3e\xf0\x14\x58\x3c\xe9\x62\x89\xaa\xc2\xe2\xc9\x2d\x96\xd3\xaa\xce\xa9\xbc\x5e\xd8\xc4\xe0\xbc\xb2\x57\x7e\x93\xf2\x4d\xa7\x6f\xad\x0a\x6b\x2f\x2e\xca\x07\x6d\x7c\xaf\x53\x4f\x63\xb3\x2e\x61\xc7\xea\xda\x08\x64\xc0\xb5\xe5\x1b\x95\x4c\x72\xa7\xd2\xf3\xab\xef\xe2\xf7\x05\x58\x04\x2b\x74\x35\x59\x9b\xbf\x9a\xb3\xeb\x1c\xe7\xec\xa6\x24\x3a\xaf\xf0\xfb\x59\x0e\x59\xc4\x4b\xce\x22\x37\x12\x55\x96\xb3\x00\x7e\xa2\x3d\x8f\x0a\x96\xe9\xc8\x21\x5d\x5d\x37\x6a\x2f\xcb\xd4\x23\x6e\x10\x8c\xab\x39\xaa\xc3\x52\x88\x77\xa8\xb5\x30\x6e\xc6\x9e\x98\xab\x0c\xb8\x2d\x3b\x9f\xd9\x37\xd8\x4b\x13\xa7\x31\x4b\x93\xb6\xcc\xa6\xf3\x77\xce\x79\x2c\x37\x3e\xd9\x95\x4b\x67\xae\xb7\x4e\x45\x3e\x1c\xc9\x3e\x88\x4c\xb7\xff\xbe\x69\x9b\x5f\x84\x7f\x30\x79\xf1\xf9\x3d\x00\x8b\x98\xd3\x96\xff\x06\x00\x00"),
 		},
 		"/math.lua": &vfsgen۰CompressedFileInfo{
 			name:             "math.lua",
-			modTime:          time.Date(2018, 3, 11, 7, 1, 22, 0, time.UTC),
-			uncompressedSize: 952,
+			modTime:          time.Date(2026, 10, 17, 6, 16, 32, 0, time.UTC),
+			uncompressedSize: 1671,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x91\x31\x6f\xd4\x40\x10\x85\xfb\xfd\x15\x4f\x91\x90\x0c\xc9\xa2\xd0\x42\x9c\x02\x2a\x7a\x2a\x1a\x6b\x6d\x8f\xe3\x11\xbe\xd9\x68\x3c\x06\x1f\x05\xbf\x1d\xad\xed\x5c\x36\x67\x0e\x09\x89\x6d\xe7\xed\xf7\xde\x9b\xf1\x1e\x87\x60\x3d\x7a\x1a\x1e\x49\xd1\x4d\xd2\x18\x47\x19\x9d\xf3\x1e\x33\xca\x72\x19\xbf\xed\xa7\x07\x02\xe0\x3d\x8c\x46\x43\x17\x15\xd7\x2c\xdd\x0d\x58\x06\x16\x7a\x56\xfb\x4c\x9e\xab\xfd\x5e\xfd\xab\xc4\x8c\xe7\x97\xab\x25\xc8\x99\xf8\x3e\x27\x07\x69\x31\xe3\x0e\x17\xbc\x3a\x16\xb6\xf5\x63\x54\x58\x4f\xac\x18\x87\xf8\x83\x14\x4d\x9c\xc4\x48\x1f\x83\xda\xf8\xde\xb9\x05\xc0\xa3\x04\x01\xca\x53\xf9\x62\x7e\x0d\x25\x9b\x54\xb6\x94\x1f\x40\xd2\xae\xe2\x95\x7d\x49\xfc\xf7\x94\x2b\x66\xe5\x24\xcb\x7c\xb7\x6f\x70\xeb\x1c\x77\xa8\xaa\x7a\xe2\xc1\x58\xaa\xe5\x2c\x65\x09\xe1\x21\x75\x10\x07\xec\xa6\x0b\xc0\x2d\xd4\xaa\x32\x9d\xa4\x09\x46\x5f\xe2\x67\xb1\x97\x09\xd3\x5f\xee\x52\xc0\x12\xb7\x27\x5a\x7a\xa7\xe8\x1e\xc5\x8c\x57\x78\xb7\x68\x13\x31\x1f\x5e\xa3\xf0\xdb\x74\x33\x63\x31\x7a\x20\xfd\x78\xfc\x4a\x1a\x3f\xf5\xd4\x7c\xfb\xa3\xa3\x44\x3b\x0b\xbd\x6d\x30\xad\x2d\xcb\x41\xaa\x51\x8b\xab\x8d\x8a\x96\xbf\x73\x4b\xa8\x8f\xf8\x49\x1a\xaf\xf2\x4c\xde\x83\x06\x3e\xb0\x04\x4b\x2b\x3e\xa2\xd3\xb0\x98\x86\x01\xe9\xac\xff\xbb\xea\x53\x27\x54\xd5\x21\xcc\x45\xb8\xa9\x9f\xba\x05\xdc\xa3\xce\x1d\x36\x46\xd8\x63\xeb\x3d\x8b\xe5\x25\xeb\xee\xdf\x58\xbf\x03\x00\x00\xff\xff\x16\xea\x26\x3d\xb8\x03\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x53\x4d\x6f\xd3\x40\x10\xbd\xfb\x57\x3c\x45\xaa\x08\x34\xdb\x16\x09\x71\x80\xba\x07\x10\x42\x1c\xa9\x7a\xe2\x62\x4d\xe2\x71\xbc\xc2\x9e\xad\x76\xc7\x4d\xcc\x81\xdf\x8e\x76\xe3\x24\xce\x47\x91\x40\x1c\xf0\xc9\xda\x7d\xfb\xe6\x7d\xec\x1a\x83\x96\xb4\x46\xcd\xcd\x23\x7b\x54\x9d\x2c\xd4\x3a\x09\x59\x66\x0c\xd6\xc8\xf3\xb4\x7d\x55\x77\x4b\x06\x60\x0c\x94\x83\xa2\x72\x1e\x97\x56\xaa\x19\xac\x34\x56\x78\x8f\x36\x23\xf8\x18\x6d\x4e\xd1\x3f\x73\xac\xb1\xff\xc6\x68\x21\x39\x02\xdf\x8d\x99\x49\x4a\xac\x71\x8b\x67\x66\x55\x56\xac\x6e\x0e\x3a\x0f\xad\xd9\x7a\x84\xc6\xad\xd8\x63\xe1\x3a\x51\xf6\x8f\xe4\x35\xbc\xcb\xb2\x44\x60\x83\x90\x00\xf9\xce\xfc\x74\xfd\x12\x9e\xb5\xf3\x32\xa8\x7c\x0f\x96\x72\x03\xde\x70\x3f\x07\xfe\xbd\xca\x0d\xcd\x86\x27\x8e\x1c\x67\xfb\x0a\x37\x59\x66\x2b\x14\xc5\xbc\xb3\x8d\x5a\x29\xe2\x5e\x4c\x54\x6c\x13\x3d\x48\x06\x9c\xec\x26\x82\x2c\xb1\x16\x85\xfa\x4e\x16\xa4\xfc\xe0\xbe\x88\x1e\x2a\xcc\x00\xd8\x2a\x0a\xcc\x71\xb3\x63\x8b\xdf\x4e\xba\xc1\x74\x8d\x0b\xbc\x4e\xd8\xc8\x38\xde\xbc\xc4\xd4\x0c\xbb\xc3\x30\x2b\xca\x4b\xf6\x1f\xfa\x6f\xec\xdd\xc7\x9a\x17\xdf\xcf\x4e\x14\xa7\x47\xa2\x87\x04\x63\x6c\x23\x1d\x45\xa1\xb5\x77\xab\xfb\x4e\xd4\xb6\xfc\xc9\x7b\xe7\xa7\x93\x61\x06\x4a\xfb\x64\x4b\xc6\xbc\xc7\x0f\xf6\x6e\x32\x56\x68\x0c\xb8\xb1\xad\x15\xd2\x18\x78\x8f\xca\x53\x92\x40\x0d\x62\xc9\xff\xda\xb8\x31\xd8\xaa\xba\x4e\x05\x5f\xcc\x30\xe4\x6e\x65\x09\x75\x2b\xf2\x65\x92\x09\x0a\xf8\xec\xe2\x81\xd2\x71\xb8\xc2\x43\xcd\xa8\x2a\x0b\x6a\x3c\x53\xd9\xa7\x55\x04\x97\x2e\xac\x15\x7d\xfb\x26\xd1\x75\xe9\x37\x9e\x5a\x94\xa4\x34\xc3\xbc\x53\x90\x84\x15\xfb\x00\xda\x10\xc7\x38\x82\xf3\x58\x59\xad\xa3\xa5\x88\x6e\x5d\x50\x08\x2f\x49\xed\x13\x0f\x7c\x9e\xb4\x66\x0f\xad\x49\x40\x02\x8e\xa1\xce\xe2\x94\x78\xe0\x6c\x83\x0b\x92\x17\x0a\xe5\x26\xde\x38\x52\x50\x8c\x10\x54\x29\xfb\xe8\x2b\x5c\xed\x8b\xff\xda\xb9\x83\xc2\x67\xe8\xb7\x9d\xf7\xc8\x8f\xc2\xfe\xdb\x76\x6d\x05\xed\x1f\xd3\x5d\xc9\x73\x4c\x52\x24\x93\xf4\xa6\xe3\x6a\x7f\xb0\x3a\x1a\xb7\x6b\xef\x1a\xfd\x69\xa9\x47\x0f\x65\x9a\x60\xc7\xf7\xfa\x9e\xdb\xff\xdf\xde\xc5\x79\x7b\x87\xaf\xad\x75\xe5\x20\x3f\x39\xdc\x5a\x42\x51\xb4\xb4\x9e\xd2\x6c\xbe\xf5\x45\xb8\xc3\xfc\xcc\x1c\x3a\x9d\x31\x3f\xe5\xb2\x72\xc8\x75\xfb\x67\x5c\xbf\x06\x00\xa9\x8d\x97\x33\x87\x06\x00\x00"),
 		},
 		"/prelude.lua": &vfsgen۰CompressedFileInfo{
 			name:             "prelude.lua",
-			modTime:          time.Date(2018, 9, 22, 17, 7, 42, 829365191, time.UTC),
-			uncompressedSize: 867,
+			modTime:          time.Date(2026, 10, 17, 6, 16, 32, 0, time.UTC),
+			uncompressedSize: 921,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x52\xc1\x6a\xdc\x30\x10\xbd\xfb\x2b\x1e\xda\x8b\x05\x6b\xd3\x73\x5b\x37\x87\x52\x7a\x4f\x8f\x21\x18\xd9\x1e\xdb\xc3\x3a\xa3\x20\x8f\x76\x95\xbf\x2f\xb2\x43\xbb\xd9\x40\x29\x14\x7a\xb2\xa5\x37\x7a\xef\xcd\x9b\xa9\x2a\x3c\x07\x5a\xe2\x40\x18\x68\x64\xa1\x15\x3a\xb3\x4c\xf9\xe3\x14\xeb\xec\xe3\x32\x14\x55\x85\x8e\xe0\xce\x8e\x17\xd7\x2d\x84\x8e\x46\x1f\x08\x4e\x5e\x10\x57\x0a\xe8\xfd\x40\xe0\x15\x21\x4a\x5d\x14\x63\x94\x5e\xd9\x0b\xda\x76\xe2\xf6\x3b\xe9\xbd\x93\x89\xbe\xce\xd4\x9f\xca\x74\x04\xdb\x02\x00\x8f\x60\x34\x0d\x84\x17\xe8\x4c\x92\xef\x00\x3c\x07\x16\x2d\x07\xea\xe2\x54\x6b\x70\x3d\x75\xae\x3f\x95\xd6\xbe\xc2\x14\x82\x0f\x30\x97\x99\xc2\x26\xc8\xf9\xfd\xdd\x9d\xc9\x30\xc9\xf0\x4a\x9c\xfe\x9d\x38\xfd\x99\xd8\x07\x30\x3e\xe3\xc3\xfe\xf3\xa5\xc1\x21\x5d\x8b\xb5\xad\xce\xc1\x5f\xee\xa3\x28\x3f\xd1\xb7\xcc\x5d\x1a\x96\x81\x12\x7c\x54\xf8\x11\x21\x47\x82\x07\x53\xd7\xea\x57\x0d\x2c\x53\xa9\x5e\xe2\x53\x47\xa1\x64\x6b\xeb\xda\x3c\xe2\xc2\x3a\x63\x21\x99\x74\xc6\x75\xe1\x21\x59\x7b\xe5\xab\xaa\xf6\xde\xcc\xce\xd9\xe7\x9c\xe1\x25\x9b\x7d\xf3\x2c\x65\x56\x38\x05\xdf\x00\xbc\x01\x9b\xda\x21\x35\x37\x4a\x19\x5a\xbc\x3f\xad\xf0\x27\xf7\x72\x44\x20\x8d\x41\x58\x26\x9c\xdd\x12\xe9\x23\xcc\x11\xe9\x81\x1f\xed\x6e\xa5\x6d\x57\xcd\x43\x36\xc9\xe4\x9b\xbd\x7a\x2b\x28\x48\x86\x4f\xb7\xcb\xf1\xe3\xdd\x72\x1c\x33\xaf\x2d\xae\xda\x7a\x53\x53\x63\x73\x88\x5d\xc7\x22\xdb\xe3\xe6\x7d\x37\x67\xb7\x34\xe6\x17\xd7\xdf\x8f\xee\xbf\x4c\x6e\x1f\x5c\x0e\x05\x4d\xb6\xf8\x3b\xa8\x7c\xd8\x73\xfa\x39\x00\xbc\x55\x6f\x5b\x99\x03\x00\x00"),
+		},
+		"/pretty.lua": &vfsgen۰CompressedFileInfo{
+			name:             "pretty.lua",
+			modTime:          time.Date(2026, 10, 17, 6, 44, 59, 0, time.UTC),
+			uncompressedSize: 11662,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x52\x3d\x6f\x9d\x40\x10\xec\xf9\x15\xa3\x7b\x0d\x48\x0f\x94\xda\x0e\x71\x91\x22\x7d\x52\x46\x11\x3a\x60\x81\x15\x68\xcf\xba\x8f\xe7\xf3\xbf\x8f\x16\x9e\x1c\x7f\x46\x91\x52\xc1\xdd\xee\xce\xcc\xce\x5c\x5d\xe3\xde\xd3\x96\x46\xc2\x48\x13\x0b\x05\xc4\x85\x65\xd6\x8f\x8d\x08\x8b\x4b\xdb\x58\xd4\x35\x7a\x82\xbd\x58\xde\x6c\xbf\x11\x7a\x9a\x9c\x27\x58\x79\x44\x0a\xe4\x31\xb8\x91\xc0\x01\x3e\x49\x53\x14\x53\x92\x21\xb2\x13\x74\xdd\xcc\xdd\x37\x8a\xdf\xad\xcc\xf4\x75\xa1\x61\x2d\xf3\x19\x5c\x15\x00\x78\x02\xa3\x6d\x21\xbc\x21\x2e\x24\x7a\x07\xe0\xde\xb3\xc4\x72\xa4\x3e\xcd\x4d\xf4\x76\xa0\xde\x0e\x6b\x59\x55\xd7\x32\x79\xef\x3c\xcc\xc3\x42\x7e\x27\x64\x9d\xbf\xbb\x33\x5a\x26\x19\xaf\xc0\xf9\xff\x81\xf3\xdf\x81\x9d\x07\xe3\x33\x3e\x1d\x3f\x5f\x5a\x9c\xf2\x73\xb2\x1d\xad\x34\x2c\x23\x65\xb8\x14\xe1\x26\x78\x35\xe1\x06\xdc\x9a\xa6\x89\x2e\x44\xcf\x32\x97\x5c\x35\x8d\xc1\x25\xe8\x3c\x07\x3c\x2f\x9d\xf2\x21\xee\xca\x5e\xd7\xc7\x06\x66\xc7\xc1\xa0\x6e\xc2\x89\x4a\x7a\x31\x96\x77\x44\x1b\xd5\x5d\xbc\xa5\x7a\xe0\xb8\xe0\x94\xdb\x57\x4c\x5a\xda\x9c\x5b\x03\xdc\x6a\x1f\xcf\xf0\x14\x93\x17\x96\x19\x17\xbb\x25\xba\x81\x39\x23\xff\xe4\x5f\xd5\x21\xa5\xeb\x42\xd4\x28\x4d\x36\x7a\x73\x74\xef\x0d\x05\xc9\x78\xfb\xfa\x09\xfc\x78\xf3\x04\xce\x8a\xab\xa3\x4f\x6b\xbd\xe8\x69\xb0\x2b\xc4\xc1\x53\x41\xe5\xbd\x6b\x9c\xdd\x5a\xf3\x84\xf5\xef\x01\x7d\x98\xcf\xed\x7b\x34\xcd\xc7\xe9\x1c\xe1\xe8\xe2\x68\x55\xc6\x1f\x33\xf4\x70\x78\xf1\x3b\x00\x00\xff\xff\x19\xc3\x86\x43\x63\x03\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x3a\x6d\x73\xdb\x36\x93\xdf\xfd\x2b\x76\x98\xf3\x99\x7a\x42\x71\xec\xdc\x33\x9d\x4e\x1a\xa5\xd3\xa6\x69\x26\xd7\xd4\x93\xbb\xb8\xed\x07\xd9\xa7\x81\x49\xd0\x42\x45\x02\x2c\x00\xc9\xd2\x65\x92\xdf\x7e\xb3\x0b\x80\x2f\x22\x69\x27\x73\xfd\xf0\x7c\xb1\x45\x60\x17\xd8\x37\xec\x1b\x30\x9f\x43\xad\xb9\xb5\x87\xb4\xdc\xb2\xe7\x60\xd6\xea\x1e\xfe\xfb\xf5\xfb\x77\xa0\xb9\xd9\x96\xd6\x80\x90\xf0\x46\x81\x39\x48\xcb\xf6\xe9\xc9\x7c\x7e\x32\x9f\xc3\x6a\x75\x27\xfe\x14\x76\xe5\x30\x41\x73\x99\x73\x6d\x80\xc1\x8e\x95\x5b\x0e\x0c\x7f\xbe\x51\x90\xa9\xaa\x56\x46\x58\x8e\x38\xa5\xb0\x5c\xb3\x32\x81\xe5\x8d\x90\xf6\xe3\x45\x02\xcf\x12\xf8\x8f\x4f\xa0\x34\x5c\x7d\xfc\xe1\x39\x5c\x24\xf0\xe3\x73\x88\xf6\xd1\xa7\x04\x34\xb3\x6b\xae\xc1\xae\x99\x44\x54\xbb\xe6\xf0\x6e\xcb\xc0\xb2\xdb\x92\x1b\xb8\x17\x76\x2d\x64\x0a\x6f\x2d\xdc\xb3\x72\x63\x68\xde\x1e\x6a\x0e\x39\x37\x99\x16\xb5\x55\xda\x20\x9e\x2a\xc0\x9a\x83\x71\x9c\x21\xd0\x6a\x65\x0f\x35\x0d\xaf\xb9\xa3\x35\x01\x26\x73\x28\xb4\xaa\x70\x4c\x73\xfc\x8b\xa8\x85\xe0\x65\x6e\x10\x94\x81\xb1\x7a\x9b\x59\xda\x21\x21\xcc\x0d\x3f\x10\x1a\x2f\x79\x45\xc3\x0e\x10\xf1\x2a\x56\xbb\x25\x11\xae\x99\xf7\xeb\x94\x22\xc3\x0d\xb5\x66\x07\x64\xbb\x56\x42\x5a\xae\x83\x50\xdf\x4a\xcb\xef\x50\x8c\x42\x12\xb7\xb9\x92\x67\x16\x36\x52\xdd\xe3\x62\x42\xa3\x44\x1d\x0d\xb8\x3e\x83\x2b\x28\x95\xda\x10\xa3\x7f\x6e\x8d\x85\x52\x6c\x38\x30\xf8\xc7\x55\x02\x46\x41\xa1\xb4\x93\x8b\xaa\xa1\xe4\x3b\x5e\xd2\x17\x6a\x44\x94\x5c\xc3\x9d\xd8\x71\x13\x64\x6b\x2c\xb3\x22\xf3\x9c\x08\xd9\xa8\x97\x49\x73\x85\x63\xdf\x81\xe1\x1c\xae\xf4\x1f\xc2\xae\xdf\x6b\x5e\x73\x99\x23\xaa\x90\x60\x35\x93\xa6\x64\x96\xa7\x77\x2a\x3d\x09\xeb\x95\xa2\x12\xd6\x80\x92\x70\xbf\x66\x16\x84\x21\xb3\x92\xdf\xc1\x73\xc3\x2d\x30\x4b\x40\xb5\x56\x55\x6d\x21\x5b\x33\x79\xd7\x90\x52\xa5\x70\x0e\x15\x67\xd2\x80\x54\x6e\x9d\xf4\xa4\x6f\x6d\xaf\x8a\x3b\x58\xc0\xc7\x13\x00\xc8\x79\x6d\xd7\xb0\x80\x6f\x12\x00\x98\xcf\x1d\x9f\xa4\x0b\xc9\x8d\x15\xf2\x0e\x6e\x79\xa1\x34\x87\x8f\x69\x9a\x7e\x3a\x01\x20\x8d\x18\x58\xc0\xc5\xf9\x79\x82\x18\xf8\xcd\xa5\x35\xe3\x0a\xaa\x58\x7d\x02\x00\xf7\x22\xa7\x6d\xbe\x3d\x4f\x68\x1b\x06\xa5\x92\x77\x5c\x07\x93\x86\x3b\x6e\x0d\x8e\x0a\xc9\xa1\xe6\x3a\xac\x7a\xf2\xe9\xe4\xa4\x54\x19\x2b\xfd\x09\x7b\x2b\x73\x2e\x2d\x2c\x20\x02\x00\x88\xc2\x64\xb1\x95\x99\x15\x4a\x7a\x28\x94\xf8\x25\xab\x78\x6c\x0f\xf5\xec\x04\x00\x1c\x14\x52\x6d\xac\x16\xf2\x2e\xbd\x33\xdb\x5b\x9c\x4d\x57\x2b\x63\x75\x02\xd1\x69\xb1\x3c\xbd\x5f\xdd\x54\x4c\xc8\xd3\x34\x4a\x20\x8a\x08\x51\x73\xbb\xd5\x12\xcc\x09\xea\x6b\x7c\xb3\xff\xda\x2a\xcb\x63\xd3\xd9\xe7\xaf\xa3\x7d\x4c\x02\x67\xcb\xd3\x2c\xba\xbe\xbe\x39\x4b\x1a\xf4\x38\x23\x14\x00\x10\x05\x64\xb0\x58\xc0\x59\x74\x86\x0a\x94\x61\xd7\xb3\xeb\xeb\xe8\x0c\x70\xe7\x3e\x5c\x74\x7d\x1d\xf5\x00\xa3\xeb\x6b\x1c\x1a\x83\x94\x03\x48\x39\x0e\x68\x07\x80\x76\x1c\x50\x0f\x00\x75\x17\x30\x48\xcc\xf1\x5f\x28\x5d\x31\x1b\x47\xd7\xd7\xfb\xd3\xf3\x67\xfb\x28\x09\x13\xb7\x07\xcb\xe3\x6c\x46\x22\xe0\x32\xef\x0a\x1b\xc5\x90\xa6\xf0\x17\xfe\x39\x8b\xce\x1e\x10\xfd\xe5\xb6\xba\xe5\x3a\xde\x11\xb6\x28\xe8\xf4\xc5\xbb\x19\xd1\x99\xe5\xcc\x32\x47\xaa\xa7\x6c\xc2\x08\x94\xfb\x8a\x77\xb3\x04\xa2\xdf\xbe\x7f\xf7\xee\xdf\x5a\xf5\xbb\x65\x3d\xb8\x57\xe5\xfc\xc2\x6d\x20\x7a\x8b\xbb\xd3\x83\xbe\xa1\xe4\xfb\x76\x2c\x08\x29\x8e\x90\x1b\x83\x7f\xa2\x59\xe4\xe7\x87\x42\x3b\x69\x47\x45\x01\x3b\xf8\xbc\x80\x5d\x77\x97\xb0\xdc\x25\xbb\x8c\xdc\x59\x34\x9c\x00\x17\x0b\xa8\x98\x5d\xa7\xeb\xed\x1d\x1f\x43\x78\xfa\x56\x16\x03\x8c\xf9\x83\x28\xf3\x06\xc5\x11\xe4\xc7\x3b\xf2\x72\x9a\xf1\x0e\x8b\xe5\xb9\xe6\x86\xbc\xc0\x2e\x21\xd7\x49\xc1\xc1\x85\x1a\xe7\x89\xe8\xa4\xa7\xe3\xaa\xfc\x21\xcf\x83\x22\xbd\x9e\x46\x74\x4a\xc1\xab\x27\x76\x0c\xbd\xcc\x58\x60\xf2\x80\x91\xc9\xd3\x96\xf6\x34\x5e\x59\x58\xa0\x7f\xa9\xb8\x65\xb4\x82\xdf\x07\x00\x4c\x6f\x34\x01\x29\xca\x66\x0a\x16\x3d\x5e\xc7\x11\x2a\x3b\x0b\x52\x9d\xc6\xeb\x0b\xd0\x93\x58\x31\x9b\xad\xd1\x9e\xa2\xf3\xfd\xe9\xfe\x69\x34\x03\xa5\x83\x9f\x69\x12\x8a\xb7\xe6\x52\x94\x60\xd8\xc1\x38\x7b\x10\x06\x18\x12\x19\xe2\x5e\xe2\x82\x25\xca\x12\x94\x46\x3c\x8c\x05\x09\x48\x25\x29\x60\xde\xaf\x45\xb6\x86\x35\x25\x13\x6d\x60\x4f\xe1\x6a\xcd\x69\x99\x10\x72\xfd\x72\x60\x55\x88\xd4\xb4\x13\xb1\x09\x6b\x55\xe6\x18\x08\x94\x2c\x0f\x20\xac\xe1\x65\x31\xa1\x44\x22\xb7\x3d\x8e\x64\x64\xb4\x8d\x76\xbf\x0b\x56\x1a\xde\x7c\xad\x56\x48\xec\x65\x67\x7e\xb5\xb2\x6b\xad\xee\x2f\x45\xf9\xde\x11\xf4\x5a\x6b\xa5\xbb\xfa\x0e\x46\xa8\xb7\x7c\xc4\x34\x87\xa6\xc2\x64\x0e\x9a\xdd\xdf\x71\x8b\xda\x8a\x56\xab\x3b\x6e\xa3\x59\xa0\x6b\x30\x6b\x99\x3e\x06\xf0\x1b\xc7\x92\xef\x6d\xbc\x6b\x66\x94\x86\xb8\x87\xba\x63\xa5\xc3\xdb\xd1\xb2\x0e\x3c\xf1\xff\x67\x01\x6f\xd6\x3f\x34\xad\x4e\x48\x15\xee\xc8\x9c\x19\xc8\x0f\x92\x55\x3e\x9d\x48\x50\x94\xc2\x92\x16\x95\xe4\x13\xa2\xff\xe9\x20\xaf\x1c\xfb\x5f\x74\x6e\xbc\xc4\xfa\xcc\x1f\xea\x68\xc4\x5e\xa5\x28\x8f\xac\xf2\x47\x66\x44\x46\xf9\x48\x9b\xaf\xaa\x02\xb6\x12\x13\x2d\xa7\x86\x09\x2a\x09\xb3\x77\xcc\xed\x0e\x16\x81\xd6\x40\x39\xd9\x42\xe4\xce\xc9\x18\xd5\xdd\xb8\xbb\x9b\x75\xbc\x9a\xc7\x94\x14\x16\x22\x50\x3a\x8c\x0c\x83\x41\x6f\xa9\x5e\x1c\x39\x5a\x4b\x94\x63\x78\x34\x3e\x84\x0e\xec\x8e\xa2\xc4\x38\x3b\x73\x91\xa0\xe7\xf4\x3a\x51\xe1\x71\x5f\xeb\x30\xff\x53\x09\x09\x15\xdb\x70\xe3\xb3\x45\x97\x45\x91\x11\x35\x25\x83\x53\xcd\x73\x50\x12\x94\xf4\x05\x84\xe4\xde\x9c\x0a\xe1\x0a\x13\x44\xa7\xec\x2c\x21\x5e\x46\x72\xb0\x09\x5d\x22\x09\xf1\x9a\xb3\x3c\x81\x9a\x69\x6b\x12\x10\x94\x9c\x75\x94\x5b\x94\xcc\xc2\x02\x10\x88\x78\xfc\x48\xbc\x93\x35\xa6\x99\x92\x19\xb3\xb1\x47\xc5\x90\xeb\xe4\xf0\x29\x6a\xf1\x43\xd6\x38\xc8\x5e\x53\x9a\xf1\xf6\xf2\x84\xd6\x40\xf9\x9f\x83\xd2\x20\x95\x85\x38\x76\xa8\x2f\xe1\x9c\x4e\xe3\x13\x47\x1a\x3c\x85\x27\x44\xd3\x4b\xb7\xb4\xf3\xb9\x3e\x53\x11\x32\x8f\x71\x32\xa1\xac\x29\xc1\x62\x0a\xdd\xcc\x6c\x36\xa2\x4b\x84\xeb\xa8\xcb\x51\x2b\xa4\xe4\x1a\x16\x5e\x0c\xad\x96\x5d\xce\xda\xc2\xa1\x7c\x0d\xe6\xdf\x1d\xb9\x50\x66\x8d\x41\x73\x95\x40\x0d\x42\x82\xa8\x99\xd0\xc6\x89\x67\x06\xb9\x0a\x11\x0d\x71\x97\x4f\xe8\xdf\xd3\x8b\x1b\x58\xf8\x5d\x71\x33\x5a\x2a\xe9\x9a\xd1\x18\x74\xa0\xcd\x0b\x3a\x58\x5a\x57\x27\x04\xef\xc4\x70\x6c\x77\xaf\x58\x0d\x19\xab\x4d\x53\x9b\xe1\x6a\x99\xda\x4a\x0b\x32\x01\x96\x53\xa0\x60\x50\x32\x63\xc9\x2a\x10\xd3\x2a\x8c\x60\x80\x55\x71\x85\x71\xfa\x9e\x6b\x0e\x25\x2f\x2c\xa8\xed\x94\x71\xbd\x62\x75\x2c\x3b\x96\x54\xb1\xfd\xa8\x1d\x50\x31\xe2\xed\x00\x61\x82\xc2\x25\xbc\xa4\xef\xa1\xea\x2a\xb6\x4f\x20\x4a\xd3\xd4\x1d\xc4\xe6\x88\x49\xc0\xca\x73\xef\x8c\x10\x2a\xa5\xf9\xd8\x89\x94\xc9\x88\x3f\xfc\x85\x1f\x00\x67\x0d\x30\x8c\xc7\x58\xdb\x62\x60\xce\xb9\x2b\x7c\xa9\x88\x3a\xe0\xe8\xcf\x4a\x27\x88\x76\xcb\xb2\x0d\x08\x69\x15\xbc\x51\x13\x02\xf8\x85\x1f\xe2\x4d\x02\x9b\xee\x71\xda\x08\x99\xc3\x02\x36\x36\xc5\x5f\x9e\x6b\x37\x88\xb2\xc1\x5f\x1f\xdc\x76\x0f\xfb\xcc\x4d\xd7\xcf\x11\xfe\xcb\x80\xff\x56\x5a\x92\x1f\x8d\xbe\x08\xa3\xbf\x09\x69\x6b\x3b\x16\x88\xe3\x6e\x2a\xbd\xe9\x27\xd0\x23\xb1\x64\xd3\x4d\xe6\x1d\x49\xbf\xb3\x92\x44\xd9\x7a\x2d\xdc\xd1\x24\x70\xbf\x56\xc6\xf7\x24\x58\xe3\xe0\x2a\x76\x00\x5e\x8a\x9c\x83\x90\x46\xe4\xe4\xd4\x58\xd7\xfd\xb9\x32\x3f\x53\xd2\x32\x21\xb1\x31\x30\x2a\xdd\xd7\xa5\xc8\x29\x85\xeb\x17\x88\x5e\xc0\x58\x17\x6e\x44\x9f\xf0\x63\x29\x63\x8e\xa4\xf4\xf1\x38\x16\xbf\xa0\xb4\x97\x51\x7f\xf2\x87\x50\x12\xf7\x87\x7f\x65\xf5\x03\x15\xce\x6b\xb4\x6f\x4c\x34\x12\x50\x45\x91\x80\x4c\xe8\xd4\x25\xae\x6e\x0f\x2e\x37\x01\xc3\x79\xf7\xb8\x60\x6c\x4e\xc8\x88\x61\x31\x71\xa2\xbc\xd3\x84\x8f\x9f\xda\x31\x27\xd9\xc5\xb1\x90\x70\xc7\x59\x70\x50\x02\x16\x70\x9e\xd0\x0e\xf3\x8b\xd6\x31\xd1\x72\x4b\xe7\x8a\x9d\xab\x69\xd4\x8b\xe4\x2f\x55\x51\x3c\x15\x37\x3d\xea\x9f\x5e\x24\x13\xbe\xd2\xf1\x93\x90\x2b\x27\x9a\x66\xfd\xc2\x88\x18\xeb\x18\xe3\x70\x73\x84\x18\x9a\x1f\x01\x1c\x1d\xde\xdf\xbd\xb8\x0c\xec\x12\x6f\x40\xdd\x46\xd8\x2d\xcf\xf1\xf0\xa2\x49\xc0\x1f\xb8\xe3\x7c\x4e\xe0\x98\x6c\x81\x30\x2e\xa5\x4d\x7a\x51\x37\x58\x63\xc9\xd9\x8e\xda\x5a\x18\x6f\x5d\x77\xc6\xb5\x9e\x8c\x37\xde\xbe\xe5\x0a\x6b\xba\x76\xdb\x52\xb7\x68\x5b\x06\xbb\x04\xd7\x18\xd5\x7e\xd2\x90\xd5\xc9\x00\x3b\xd9\x6a\x30\xea\xd6\xf2\xb0\x59\xa6\x0b\x96\xf5\x44\x39\x96\xb6\xaf\x56\x02\xc1\x30\x53\xef\x40\x8e\x24\x43\xbd\x9a\xd6\x99\x54\x7e\x90\xb0\x18\xcd\x51\xdd\x66\x34\xef\xb6\x1b\x5d\x7b\x98\x37\x8e\x15\xce\xad\xad\xed\x12\x5c\x72\x42\x42\x14\xcf\x83\x59\x3c\x78\xec\xdd\x84\x64\x55\x7b\x1c\x26\x9a\x4a\x14\xc4\x17\x10\x45\x5e\xea\x8d\x71\x74\xd8\xf1\x30\xb8\x5c\x77\x77\xf2\x5d\x38\x98\xc3\x2d\x32\xe8\xec\x0e\x5d\x5c\xa6\x2a\x0e\xf7\x9a\xd5\x35\xcf\x53\xbf\x70\xdc\xf7\xc9\xaf\x5c\xab\xe1\xe2\xd9\xb7\x23\x7e\x88\x9c\xf2\xcc\x35\x51\x47\x8b\xa2\x20\xbe\x61\x05\xf3\x79\xa0\x8c\x1d\x2c\x60\x97\xd2\x7c\x97\xfa\x41\xec\xf9\x51\xa9\x72\xac\x56\x3b\x2a\x86\x3b\x61\xe7\xeb\xc2\xd6\xee\x6b\xc3\x56\x47\x44\x8f\x67\xfe\x93\xb4\xfd\x8c\xc5\xf5\xa3\x27\xe4\xb1\xc2\x75\xd0\x0b\x22\xdb\xa2\xc4\x3f\xc6\xba\x70\xb2\x27\x34\x04\x9f\x2e\x20\x26\x79\x78\xb5\x66\xf2\x71\x1e\x42\x39\xfe\x2f\x43\xf6\x7b\xab\x1f\xa3\xda\xf5\x70\x5b\x1f\x47\xb6\xfe\x79\xb4\xe6\xfd\x7a\x5e\x42\x54\xe4\x95\x77\x0f\xf8\xb3\x25\x06\xbf\x9c\x4f\xfd\x7c\x94\x19\x7c\xe1\xae\x0f\x96\x82\x23\x94\xb8\xf6\x04\x2c\xc6\x9b\x16\x2d\x61\x01\xee\x01\xbf\xfa\xa5\x22\x40\x8f\xc6\xb9\x5c\xba\x25\x6f\x86\x2d\x4f\x06\xd9\x21\x2b\xf9\x70\x83\x7f\xef\x70\xd7\xf8\x4e\x4a\x25\x5c\xcd\x83\xf7\x09\xd3\xe6\xd3\xc5\x46\xbf\xee\xf6\x7f\x20\xfb\x19\xf1\xee\xdd\xac\xd2\x7c\x59\x4f\xaa\x35\xa7\xbe\xdd\x8d\x79\xf5\x96\xda\x46\x8a\x8f\x9c\x89\xa6\x69\xd0\x0d\x32\x15\xdb\xff\xe4\x2f\x61\x86\xf5\x0d\x31\xda\xd6\x37\x0e\x30\x14\x39\xb9\xfb\x5a\xb4\x33\x43\x37\xd7\xd6\x98\x8d\xbc\x27\x1d\xf8\xd0\x78\x45\x31\x6e\x6a\x23\x51\xa2\x13\x28\x1c\xd4\x40\x0a\x64\x47\x3b\xcc\xce\x42\xfb\x6e\x22\x19\x6d\x4b\xe1\xa2\x53\x0a\xa3\x6a\xdc\x95\x62\xa7\x1e\x1e\x4d\xfe\x0a\x54\x61\x50\xc9\x73\x40\x4b\x6a\xc1\x11\xa3\xcd\x16\x96\x08\x5b\x6b\x55\xdf\x24\x84\xd6\x66\x57\x8f\x67\xa7\x8d\xbd\x4d\x70\x89\x85\xe2\x48\xc8\x99\xee\x9b\x4c\x87\x47\x5f\x57\x8c\x56\x14\x83\x1b\x8e\x4e\xb5\x80\x0a\x49\x30\x5d\x47\xe1\x95\x93\x6a\xa5\xdb\xba\x29\xad\x1e\x2d\x97\x7a\xf0\x84\x7e\xaa\xa2\x30\xdc\xba\xdf\x25\x97\x77\xce\x58\x47\x5c\x57\xd0\xf0\xa0\xac\xb1\xca\xb5\xeb\x62\x55\x14\xb3\xce\xa7\x9c\x25\x8d\xcb\x9d\x2e\x77\xfe\x06\xe1\xfe\xca\xea\xa1\x0c\x37\x36\x01\xf2\xb4\x94\x14\x62\x3d\x7f\xe4\xfe\x3d\x18\x3f\x0c\xcc\x76\x93\xc0\x0a\x84\x04\x67\xb5\x3e\x71\xea\x9b\x2c\xa2\x2d\x9f\xe0\x5f\x67\xb0\x9b\x09\xa1\xc9\x6d\xc5\xb5\xc8\xda\x9a\x7f\x24\xe3\xb1\x69\x3f\xe9\xf9\xb9\x54\xcc\x7e\xf3\x4f\xbf\x8e\xeb\xeb\x18\xa5\x6d\x8c\xdb\x75\x6e\x1f\x59\x02\xb7\xb3\x96\x24\x51\x34\xbb\xf5\xd5\xdf\x10\xb3\x87\x45\xab\x9d\x5e\xdd\xcf\x8e\xea\xfe\xd9\x08\xf6\x61\x12\xfb\xf6\x41\x6c\x51\xc0\x3e\xd8\x25\xf2\x7b\x18\x37\xd2\x9e\x29\xec\xe1\x05\x1c\x7a\x73\xad\x60\x8f\x3f\x3c\x0a\x83\x17\x70\xdb\x6a\x61\xd6\xbf\x32\x1c\xaf\xa6\x49\x81\xb3\xa3\x54\x61\xbc\x80\xb6\xb3\x87\xdd\x1d\x06\xa6\x54\x8a\xf2\x17\x7e\xf8\x60\x95\xe6\xf9\x11\x7b\x43\x1f\x87\xb1\xc4\xb9\xb6\xae\x3b\xc3\x35\x7e\x77\x6f\x32\xb8\xf5\xa7\xe6\x6b\xcb\xec\x9e\x84\x42\xc5\x7f\xe1\xca\xcb\x9e\x15\x7b\x96\xdb\xea\x60\x49\x76\x2d\x6e\x6e\x7a\x66\xc5\x7d\x09\x29\x2d\x97\x68\x79\xac\xbc\xf4\x44\x0e\x75\xc8\x7b\x6e\xf3\x58\x57\x53\x3d\x06\xea\x95\xb9\xad\xa9\x63\xf6\xb8\xdf\xff\xdb\xe4\x33\xd2\x8c\x78\xb0\x1f\x31\x5d\xbc\x3e\xd8\x4e\x0f\x21\xfb\x81\x7b\x81\x7e\xee\x10\x5a\x1a\xfd\xa7\x47\xfe\x95\x0a\xdd\x28\xe1\xeb\x15\x49\xdd\x48\xe1\xba\xb9\x6f\x94\x9f\x6e\x9e\xdb\xec\xa8\x57\xd1\x7b\xf3\x72\xaf\x95\xe5\x80\x2f\x4a\x82\x23\x39\xda\x18\x23\x8a\x5b\xa6\x53\x23\x4f\x37\x01\x26\x3b\x00\xa2\xf0\xcb\xfc\x7f\xba\x03\x6e\xf7\xac\xbd\xf1\xa7\x2b\x7c\x2f\x83\x8b\x04\x2e\xfa\xc7\x52\xf3\x02\x16\xfe\x99\xc3\x3f\xa2\xce\xdd\xc0\x11\xda\x3f\x67\xcd\x65\x4f\x74\x7c\x83\x10\xc0\xa2\xff\x79\xf1\xfd\xe9\xfc\x7b\x2c\xa9\x9a\xcc\x7c\x3e\x7f\xe8\xf6\x96\xae\x6e\xe9\x96\x4f\xaa\xee\x65\x6d\x2b\x90\xfe\x25\xeb\x91\x44\x30\xa6\xf3\x62\x78\xa4\x8e\x5e\x20\x38\x99\x0e\x33\xfe\x36\x3a\x8e\xf3\xfc\xcc\xf1\xbc\xbc\x79\x5c\x2e\x15\xab\x97\xd1\x24\x21\x1d\x0a\x06\x04\x34\x8a\xf3\xa4\x20\x43\x4c\xe6\x10\x8f\x34\x31\x3a\xe5\x5e\xef\xe6\x6d\xf6\x60\xbd\xd3\xe3\xff\xcb\xcb\xaf\x49\x73\xf3\x20\xa4\xd9\x2b\x10\x06\xd6\xbc\xcc\xdd\xe3\xbd\xce\x9d\x3a\x9e\x97\xc6\xd8\xd3\x61\x8d\x4b\xc9\xfc\x41\xa6\x6d\x41\x79\x9c\x93\xb7\x2d\x1b\xcf\x41\x27\x24\x4e\xd9\x36\x7c\x76\x76\xdc\x91\x87\x3b\x88\x61\xab\x91\x06\xe9\xa0\x8f\x76\x8e\x41\x39\x81\x8f\x9f\x42\xbe\x3b\xf4\x37\x42\x5a\x6a\xd0\xe4\x40\xbf\x4d\xfb\x32\xd0\xbd\x09\x93\xc0\xf7\xb5\xe6\xc6\x08\x25\x43\x17\x34\xef\x3f\x61\x4b\x40\x49\x0e\x56\xf9\xdb\xc7\x51\xef\xd2\x6c\x13\xa7\x69\xda\xed\x75\x7b\x37\x31\x78\x72\x07\x4a\xfb\x08\x3b\x98\x71\x51\xa6\x5d\x63\xc7\x4a\x8a\xc7\xe1\x8d\x9b\x1b\x95\xb0\x00\xc3\x4b\x9e\xd9\x38\x7a\x12\x25\x10\xf6\x15\x05\x90\xcb\xba\xe8\x34\xd8\x58\x69\x96\x17\x37\x93\x6f\x0f\xdc\x34\x25\xdb\x58\x99\xb8\x97\x02\xd1\x6a\x55\xb2\xff\x3d\xac\x78\x59\x8a\xda\x08\xb3\x12\xd2\x58\x26\xb3\xc1\x13\x97\x31\x21\x74\x78\x0a\x74\xf5\xde\x38\xf9\x2d\xe3\x30\x83\xdf\x2e\x85\x27\x5d\x86\x54\xcc\x34\x89\xfb\x6c\x18\xf2\x65\x2f\xde\xd3\x8a\x02\xa3\x99\x09\x45\xc0\xd2\x34\x35\x00\x3c\x05\x01\x73\xb8\xb8\xe9\x1f\x9e\xb6\x69\xff\xc4\x2b\xea\x25\xc8\x23\xf6\x9c\x53\xf4\x06\xc1\x65\x1e\x3a\xe7\x52\x81\xd5\x2c\xe3\xe1\x9a\x9a\xde\x16\x06\x7f\x88\x9c\xf8\x25\x3b\xfb\x8c\x13\x4f\x72\x8b\x8f\x03\x95\x63\x27\x84\xab\xa5\xb8\x69\x6f\xac\xc6\x83\xea\x07\x6e\xc1\x70\x7a\x95\xc9\xc3\x55\x81\x7b\xa7\x49\xef\x49\x47\x10\x30\x65\x2a\x85\x71\x67\xa2\x72\xcf\xa2\xf0\x01\xe7\x64\xf8\xfc\xc0\x6d\x8c\x06\x92\x80\x0c\xa6\x36\x68\x0a\x2c\x11\xe0\x66\x24\x2e\x72\x6c\x3a\xc6\x51\x78\x87\x61\xb8\xa5\x07\x9c\x67\xfd\x4e\xcf\xd9\x77\xb0\x66\x3b\x1e\x4a\x2a\xf7\x9c\x13\x6d\x95\xae\xc4\x7b\x2f\x40\x26\xb7\x06\xe9\x44\x34\xc5\xc5\x5a\xdd\xc7\x9d\x23\x9a\x8d\xf5\x36\x4e\x26\x1f\x0b\x12\x65\x70\x9a\x5f\x4b\x47\x1c\xfe\x22\xe2\xe0\x34\x8f\x12\xc8\x52\x4f\x7a\xe6\xae\x7f\xf1\x07\x4d\x3b\xe7\xf4\x7f\x03\x00\x77\xcf\x06\x93\x8e\x2d\x00\x00"),
 		},
 		"/reflect_goro.lua": &vfsgen۰CompressedFileInfo{
 			name:             "reflect_goro.lua",
@@ -105,6 +126,13 @@ var preludeFiles = func() http.FileSystem {
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x95\xd1\x6e\xa3\x38\x14\x86\xef\x79\x8a\x5f\xbd\x02\x15\xb2\x18\x56\x5b\x36\x69\x56\x5a\x35\xe5\x01\x66\x2e\xab\x2a\x4a\xc0\x04\xaa\x8c\x9d\x39\x18\x29\xd2\x68\xe6\xd9\x47\x36\x10\x9c\x86\xb4\x64\x6e\x12\xe0\xf8\xf8\xfc\xe7\xfb\x0f\xa6\x68\x44\xa6\x2a\x29\xb0\x5e\xe7\x3c\x93\x39\xff\xd2\x08\xee\xd6\x3e\x2a\xcf\x01\x40\x5c\x35\x24\xf0\x63\xbd\x6e\x54\x91\xcc\xea\x66\x6b\x62\xf7\xcc\xfc\x78\x3e\xd8\x4f\x87\x8b\xdc\x71\x82\x00\x05\xc9\x6f\xd8\xc9\x43\xc9\xe9\xad\xf6\x71\x90\xa4\x78\x0e\x25\xd1\xd4\x1c\xdb\x4a\x41\x1e\xea\x99\xb3\x5e\xeb\xcb\x25\xf1\xef\x4d\x45\xdc\xbd\xdb\x56\xea\xce\xd3\xf9\x2f\x2f\x66\x97\xb7\x1a\xf2\x80\x03\xf1\x8c\xe7\x5c\x64\x7c\x8e\xb2\xda\x95\x9c\xda\x47\xc2\x3c\xc3\x12\xaa\xda\x95\x8a\x13\xb6\x95\xc8\x2b\xb1\x9b\x39\x41\xa0\xb3\x37\x54\x97\x55\xa1\xfe\xda\x9b\xbf\x39\x58\x84\x3d\x2f\x54\xa0\x64\x40\x3a\x03\x80\x5e\xb6\xdd\x88\x7c\x8e\x7f\x01\x9c\x87\x4d\x4c\x12\xe6\x78\xb8\x8c\x39\x8e\xcd\x08\x4b\xf4\xec\xdc\x5a\x91\xee\xb7\xd6\xc8\xf6\x32\xdb\xec\x91\x85\x58\xa2\x56\x34\xcb\xca\x0d\x3d\xc9\x9c\xff\xaf\x5c\xbd\x60\xe1\x38\x40\x55\xe8\xf8\x23\xc2\x63\x12\x42\x95\x5c\x68\xd2\x27\xd4\x59\xa8\xa9\x2e\x1c\xc0\x80\xed\x97\xff\x5a\xea\x5f\x48\x42\x9f\xfc\x34\x92\x1c\x1e\xd3\x34\x5d\xbd\xdb\xa0\x93\xc4\x46\x25\xe1\x1e\xcc\x5b\x74\x65\x98\x29\xc3\xba\x32\xac\xd7\x68\x6e\x4d\xc1\x47\x13\x9e\x56\x76\x68\xf3\xd9\x52\xda\xa9\x21\x2c\x61\x46\x61\xb6\x95\xe4\xb6\x57\xad\x69\xdd\x8d\xb6\xc8\xd5\x2c\xc2\x23\x4b\x3d\x1f\xff\x78\x3e\xec\x08\xd3\x91\x38\xf5\x8c\x76\x53\x8c\xb4\xba\xf0\xf8\x90\x5a\xc5\xae\x28\x6c\x35\xda\x71\xf2\x11\x8d\x30\x8b\xae\x31\x8b\x4e\xcc\x22\xc3\x2c\xea\x98\x45\xe3\xcc\xa2\x9b\x99\xa5\x03\xb3\xeb\xc4\x3e\x67\x17\x6a\x76\x2c\x3a\xc1\x1b\x59\xd7\x93\xd4\x8c\xdf\x41\x8e\xce\x20\x5f\x80\xbe\x89\x74\x55\x20\x3c\xae\x92\xd0\x00\x21\x60\x23\x72\xf4\x5b\xad\xd2\xdb\xf6\xb2\x5c\x8b\x47\x5c\x8b\xaf\xb9\x16\x9f\x5c\x8b\x8d\x6b\x71\xe7\x5a\x3c\xee\x5a\x7c\xbb\x6b\xc9\x90\x32\xc5\xb6\x09\x06\x3e\x78\x3e\x4b\xa6\xf9\xc7\x22\xef\xa3\x85\x91\x65\xf4\xb9\xcf\x71\xef\x73\x7b\x40\xd9\x2e\xa7\xda\x9a\x0e\x0b\x0b\xcd\xdd\x23\xe8\x0f\xcd\xfa\xdb\xa6\x36\x96\xc7\x45\xbe\xd0\xe7\x2c\x17\x63\xe7\x2c\x79\x4e\x2f\x0d\x9d\x57\x84\xff\x06\x61\xe6\x89\xfb\xe1\x94\x79\x96\xa5\xe8\xfa\x5b\xf5\xa2\xae\x1d\x23\x9d\xd2\xaf\x8a\xf4\xa7\x46\x7f\xe7\x9e\xba\xc9\x72\xc9\x1b\x4f\x9e\x96\x3d\x0c\x81\x9e\xb9\xde\x93\xee\x23\xe6\x92\x6f\xbf\x90\x66\x51\x12\x9e\x19\x47\x96\x6f\x97\x2a\x52\xfb\xad\x9a\xa8\xe2\x79\x44\x85\x3d\x56\x83\x0c\x7b\xb4\x2e\x75\x9f\x84\xdd\xac\x7f\xaa\xd2\x74\x44\x29\x58\xf2\x49\xc5\x91\xde\xfc\x56\xc2\x4d\x69\x43\x83\x93\xfb\x6b\x47\x3b\x08\x5e\x5f\x9d\xdf\x01\x00\x00\xff\xff\x3c\xc0\x63\x83\x75\x09\x00\x00"),
 		},
+		"/session.lua": &vfsgen۰CompressedFileInfo{
+			name:             "session.lua",
+			modTime:          time.Date(2026, 10, 17, 7, 40, 0, 0, time.UTC),
+			uncompressedSize: 8469,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x59\xeb\x8f\xdb\x36\x12\xff\xbe\x7f\xc5\x40\xe9\x76\x2d\x54\x16\xb2\x7b\x6d\xaf\x48\xeb\x0b\xda\xa0\x29\x82\xa6\x41\x81\xb4\x77\x1f\xec\x3d\x83\x96\x46\x36\x4f\x34\xa9\x92\xf4\xeb\x82\xf4\x6f\x3f\x0c\x49\x59\xcf\x75\x37\xbd\xcb\x7d\xc8\x6e\x96\x9a\x17\x67\x7e\xf3\xd0\x68\x3a\x05\x83\xc6\x70\x25\x53\xb1\x63\xcf\xc0\xec\xaa\x4a\x69\x0b\x85\xd2\xf0\xcc\xb0\x3d\xa6\x57\xd3\xe9\xd5\x74\x0a\xcb\xe5\x9a\xff\x8b\xdb\xe5\x5a\xbd\xe6\x16\x35\x13\xa0\x51\xe6\xa8\x0d\x30\xf8\x41\xc1\x9e\x89\x1d\xc2\x8a\x65\x25\x11\x73\x69\x15\x9d\x1a\xb5\xd3\x19\x26\x60\x14\xd8\x0d\xb3\xf0\x4c\x28\x96\x43\xc6\x24\x68\x9c\xe6\x98\x09\xa6\x91\xe8\x77\x06\x35\xac\x85\x5a\x31\x61\xe0\xc0\xed\x06\xec\x06\xbd\x4c\x43\xff\x3d\xc1\x86\xe5\xe9\x55\xdb\x8e\xdf\x76\xca\xe2\x0f\x0a\x34\xda\x9d\x96\x06\x0c\xb0\x60\x0a\x97\x16\x75\xa5\xd1\x62\x0e\xc6\x6a\x2e\xd7\x20\xbc\xc9\x74\x17\x78\xa1\xa4\xd5\x4a\x40\xb6\x61\x9a\x65\x16\xb5\x49\xc0\x09\x33\x09\x30\x99\x83\x54\x72\xfa\xed\xdb\x17\xaf\x5e\xc1\xea\x64\xd1\x00\xd3\x08\x68\x32\x56\x61\x9e\x10\xbf\xbb\x0b\x82\x46\xb3\x13\x16\xb8\x21\x33\x79\xde\xdc\x16\x0e\x1b\x66\x71\x8f\x1a\x0c\x6c\x94\xc8\x4d\x7a\xd5\xb7\x79\x06\xc5\x4e\x66\x96\x2b\x39\x31\xf1\x15\x00\x08\x95\x91\x43\x61\x16\x0c\x4e\xd7\x66\xb7\x9a\x98\x04\x6e\xe6\xd7\x59\xb4\x58\x2c\x6e\xef\xbe\x9a\x2e\xee\xbe\xf8\xe2\xfe\x26\x69\x78\x33\xc7\x0b\x00\xbc\x80\x0c\x66\x33\xb8\x89\x6e\xc8\x36\x19\x9c\x02\x37\x8b\x45\x74\x03\x28\xf3\x3e\xdd\x62\x31\x20\x5c\x2c\xc6\x29\xe5\x80\x52\x8e\x13\xda\x01\xa1\x1d\x27\xd4\x03\x42\xdd\x26\x0c\xc7\xc1\x11\x85\xd2\x5b\x66\x27\x37\x8b\xc5\xf1\xfa\xe9\xdd\xf1\x26\xa9\x1f\x50\x70\x26\x59\xec\x5c\x80\x32\x8f\xaf\x1a\x56\x72\x43\x9a\x82\xa6\x1f\x37\xd1\xcd\x15\xc9\xa6\xc8\xd9\x53\x85\x20\xd9\x16\x1d\x56\x0c\xa2\x84\x42\xab\x2d\x70\x69\x78\x8e\x50\xb1\xac\x64\x6b\x84\x2d\xe3\xb2\x89\xd9\x5a\xfd\x72\xaa\xf0\xad\xd5\xed\xa8\xd9\x53\x75\x21\x6e\xf6\x54\xa5\xcb\xa5\xb1\x3a\x81\xe8\xba\x98\x5f\x1f\x96\xf7\x24\xf3\x3a\x8d\x12\x88\xa2\xb6\xa5\xda\xdb\xe6\xc5\xd4\x1a\xb9\xb4\xaf\xb9\x6d\xab\x3b\xb6\x94\x19\x98\x81\x55\x5e\x5d\xe7\x41\xde\x58\xb1\x65\x36\xdb\x10\x7c\xa2\x7f\x4e\xae\xa7\xcf\xaf\xf3\xcf\xe2\x5f\x9f\xbf\x7e\xfd\x89\xd7\xcd\x0b\xc8\xe1\xf7\x19\x48\x2e\x5c\x20\xba\x7e\xcf\x83\x43\xaf\x1e\x0a\x45\x74\x9d\x3e\x2d\xa2\x04\xac\x92\xbb\xed\x0a\xf5\xe4\x18\xc7\x63\xd7\x28\x84\x62\xe3\x17\x39\xc2\xac\xcd\x1d\x6c\x3a\x92\x4d\x47\x50\x9a\x9e\xcf\x60\xcb\xec\x26\xdd\xec\xd6\x78\x3e\x99\x36\x47\x2d\xb3\xa7\x53\x90\xaa\x4e\x70\x57\xb6\xde\xb0\x37\xc4\xf3\x4a\x16\x69\xf7\x6a\x92\x8b\x47\x5c\xee\xf6\xaf\xeb\x28\x81\x63\x7c\x86\xcd\x96\x55\x50\xe2\xc9\x57\x02\x63\x95\xc6\x1c\x76\x54\xfa\xe8\xf4\xa5\xd2\x93\x32\x4e\xe0\xb0\xe1\xd9\x86\xa8\xc9\x02\xbb\x41\x57\x85\xd6\x44\xc3\x65\x6e\x80\x9b\x26\x68\x65\x9c\xf6\x5c\xb5\x65\xd5\x8f\x78\xea\xf9\xaa\x34\x09\x29\x20\xf4\xb5\xa2\x5c\xc2\xac\x3e\x4d\x49\x74\x70\x5e\x09\xb3\x19\x2c\x97\x74\xf2\xd6\x69\x19\x89\x6c\xaf\x0a\x4d\x4a\x13\xb7\xdc\xe1\x84\xfc\xad\x16\xf2\x4a\x5a\x57\x0c\x4b\xf8\xa6\x3e\xfa\x95\x4b\x5b\x59\x3d\x22\xb8\x83\xba\xb2\x0f\xbb\xe7\xaf\x9f\x7f\x12\x0d\x34\x9d\xcd\xfd\x4e\xa9\x31\x18\x96\x66\x18\x2a\x8a\xdf\x58\xbe\x98\xef\x98\xe1\xd9\x8f\x5c\xe6\x1d\xff\xb5\x13\xad\xaf\x50\xe9\x11\x97\x29\x1d\x6c\x98\x5c\xf6\xc4\x0b\xb5\xad\x04\x1e\x6f\xef\xbe\x6a\x40\x52\x1b\x93\x9f\x24\xc5\x06\x0a\x17\x76\x02\x82\xab\x3a\xaa\x00\x16\x7a\xe4\x06\x45\xee\x7b\x24\x30\xe9\x9b\x55\xc1\x32\x4c\xc1\xdd\xa2\x6e\x7a\x1d\xac\xad\xd4\xb1\x69\x3e\x01\x60\x5b\x38\xa0\xeb\xa4\x4a\x8a\x13\xac\x15\xac\x4e\x74\xcc\x35\xbc\xde\x31\xa7\xf4\x19\x31\x78\x15\x5f\x7e\x0e\x59\xce\x2c\x03\x8d\x2c\x37\xae\x4d\x03\x33\xf4\x24\x01\x06\x3e\x13\xe9\xc0\xe5\xec\x97\x9f\xa7\x57\xfd\xeb\xb4\xfc\xba\x6f\xa1\xd1\x9e\x60\xe6\x74\x85\x53\x5e\xb8\xa3\x19\x44\x96\xad\x04\x46\x23\x81\xdd\xa7\xcb\xa5\x3d\x55\x74\x88\xc2\x60\xc3\xe1\x51\x14\x8d\x02\x97\x54\x2c\x97\xa9\x27\x19\xb2\xae\x94\x12\xc8\xe4\x45\x5e\xa2\x19\x72\xfa\xab\x5f\x64\x0c\x3e\x19\xf2\x3a\x8f\x76\x58\x9b\x14\x5d\x2e\x57\x14\xcd\xbf\x53\x30\xef\x08\x33\xc1\x41\x03\xf8\x13\xbc\x5a\x12\x46\xf4\x73\x69\xc3\xc3\xa0\xbe\xc5\xfd\xab\x8f\xed\x65\x01\x3b\x47\x54\xcb\x90\xf9\x85\xc4\xaa\xc3\xbe\x93\x07\xcd\xaa\x4e\xd4\x13\xa8\x9b\x1e\x2f\xea\x90\xb7\x23\x4d\x39\x32\x92\x91\xae\x19\x92\xa9\xb1\xa3\xa0\xe8\xef\x99\x78\xb8\xfd\x04\x82\xa1\x8d\xfb\x41\xaa\x09\x6e\xdf\x22\x4a\x3f\x64\x91\x28\xa8\x94\x4b\x27\x03\x2b\xa4\x7c\xf6\x03\x6a\x7b\x6c\x63\x16\x18\x64\xa7\x4c\x20\xac\xf9\x1e\x4d\xbb\x75\x68\x66\x37\x48\xa9\xc5\x24\xd1\xa3\xcc\x05\x1a\x03\x1a\xb3\x9d\x76\xf3\x71\xaf\xec\xd4\xea\x67\xf0\xee\x7d\xdf\xae\xf3\x54\xda\x4c\x85\x94\xb6\xfb\x04\x94\x77\x1e\xfd\x70\x66\x29\xed\x1c\xc1\x0b\xd8\xc3\x86\x99\x5e\x2f\xdb\xc2\x84\xb9\x18\x10\x5d\xb6\x61\xd2\xdd\x81\xf8\x38\x91\x5a\xe2\xa5\x0c\x0e\x17\x07\xab\x80\xc1\xaa\xa9\x23\x09\xa4\x69\x1a\xa7\xf0\x8f\x0d\xfa\x4b\x09\x9e\x23\xf1\x5a\x4d\x0f\x19\x64\x6a\x5b\x29\xc3\x2d\x9e\xb5\x0a\x64\xe4\x18\x55\x14\xc0\xad\xa9\xc7\xa6\x04\x98\xbb\x0c\x13\x42\x1d\x4c\x3d\x31\x31\x09\x28\x33\xa1\x0c\x79\x7b\x20\x2a\xbd\x6a\x7b\x64\x80\xa5\xc4\x5b\xd3\xeb\x6d\x35\x5c\x1e\xdd\x28\xce\x6d\x75\xff\x3f\xea\x66\xdd\x31\x6c\x44\xec\xd9\xa2\x97\x54\x19\xfe\x72\xd7\x6b\x26\x2f\x7d\xbd\xb8\x20\xb9\x9e\x8c\x2e\xc9\x7e\x74\x17\x6f\x64\x5c\x8d\x15\x17\xdf\x5c\xda\x72\x1c\xd4\x66\x83\xfc\x6b\x34\x44\x92\x8b\xa8\x5b\x2e\x9a\x21\xf3\x24\x61\x76\xb6\x21\x74\x87\x4e\x6d\x73\x14\x17\xa4\x87\x21\x6c\x44\xb6\x69\x49\x16\xdc\x4e\xba\xc5\x88\x50\x93\x9f\x64\xec\x7e\x26\x50\x30\x61\xb0\xa5\xd6\x7c\xb0\x52\x6f\xab\x03\xdb\x70\x38\x18\x3e\x19\xcc\x08\x67\x92\x01\xcc\xce\x4f\xbe\xe9\x61\x22\xee\x59\x37\x9d\x42\x89\x58\xd1\x29\x31\xb1\x2d\xcf\x42\xb6\x49\x65\xfd\x29\x16\x6c\x27\x2c\x28\x89\xe9\x48\x85\xef\xbd\xa2\x90\x4d\x31\xa4\x29\x44\x93\x88\x7e\x19\xf7\xff\x78\x18\xcc\x20\xc0\xb4\xa1\x33\x9d\xfa\x4a\xe4\x4b\xa9\x92\x4d\x49\x50\x45\x53\x62\x12\x5f\x86\xbc\x1b\x68\xdc\xdd\xc9\xcc\xbf\x37\x13\xd7\xbf\x51\xd7\xab\x00\xc7\xb4\x65\x55\x1a\x60\x39\x69\xe1\xf2\x67\xab\x1d\xcb\xe4\x0c\x44\x2a\x8e\x30\xf3\xe9\xbf\x5c\x4a\x2e\xe2\xb8\x33\x8e\x9d\x59\x5f\x90\xf6\x71\xde\xe5\x92\x4c\x7b\xf3\x30\xef\x4b\x2a\xa4\x0f\xf1\xda\x8d\x56\x87\x37\x5c\xfc\xec\xef\xf9\xbd\xd6\x4a\x3f\x24\xe8\x27\x56\x3d\x20\xc7\xc3\x32\x1e\xc9\xdb\x73\x56\x8d\xa7\xea\xcf\xdd\x5a\x34\x9d\x36\x2e\xf7\x55\xbd\x29\xaf\xde\xbf\xdc\xc0\x41\x73\x6b\x51\xc2\xa7\xbf\xbc\x4b\xd3\xf4\x7d\xda\xc9\x26\xb4\xa1\x9a\xa2\xc0\x6d\xf7\x49\x09\x33\x40\x7b\x2e\xb3\xde\x16\x2c\xe1\xf7\xda\x98\xb7\x56\xef\x32\x8f\xe5\xf6\xf1\xb7\x5a\xb3\x13\xb0\x33\x57\x87\x45\xf0\x0c\x07\x1c\xe4\xa8\x0f\x49\xc8\x5e\x6f\x9d\xef\xef\x1f\xcf\x3e\x70\x99\x04\xe6\x0c\x0e\x88\x0e\x7f\x58\x83\xa2\xe8\xba\xca\x32\xbd\x76\xee\xda\x8f\xbb\xc3\xdf\xbb\x6b\x48\x6b\x08\xfa\xbd\x19\x82\x08\x06\xe9\x72\xe9\xa4\x8d\x95\xa3\xb1\x1b\x74\x2e\x01\xd0\xb2\xc6\x4b\x9a\xc4\x83\xab\x8e\xb8\x69\xe6\x5a\xfa\xc5\x7a\xea\x05\x27\x40\xff\x3a\xe5\x73\x54\x5c\x63\xe0\x9f\xaa\xae\x35\xea\x3f\xf5\x85\xa8\x0d\x7c\x6f\x5d\xa5\xb1\xe0\x47\x98\x41\x14\x85\x6c\x90\xca\x86\x01\xa5\xa5\xe5\x4c\x36\x2c\x76\xf5\x3c\x1a\x94\x06\x70\x0b\xdc\x7e\xef\x84\xb4\x06\x0e\xb4\xf1\x1f\x65\x40\xb0\x17\xbb\x2d\x98\xb2\x40\xe9\xce\xa9\x07\xc3\xb9\x2c\x00\x74\x9e\xfa\x34\xe8\xb1\xfc\xc4\xaa\x87\x13\xff\xcc\x31\xd0\xd1\xed\xd9\x03\x16\x37\x4e\x77\x6a\xe6\x87\xb7\xf3\x8a\x69\x6b\xfc\x04\xfb\x47\xb5\x83\xe6\x57\x0e\x33\x78\x9a\xc0\x93\x3d\x4c\xe1\x16\x72\xd5\xe8\x1a\x47\xdc\x7e\xce\xef\x3d\xde\xce\x61\xa1\x58\xc4\x9d\x34\x32\x7f\x3e\x55\x9c\xf9\xf3\x27\xee\xd7\x67\xb7\x84\x5a\xf3\x10\x10\x03\x8e\xa8\x1f\xbe\x73\x90\x74\x19\x9b\x66\x4a\x66\xcc\x4e\x9c\x84\x04\x68\x3f\xe7\xfb\xe7\xfb\x0b\xa5\xba\x57\xd4\x68\x9e\x4a\x25\x17\x3f\xe2\xe9\xad\x7f\x61\xff\xc0\xb9\xc7\xed\x96\x5a\x31\x20\x4f\xd3\x16\x65\x09\x5c\x42\xc5\xb8\x36\x93\xf0\x56\x14\x77\x7c\x4e\x7c\xf3\x27\xf4\xd3\xdf\xbd\x1c\x5e\xde\x5f\xd2\x28\x6d\x27\x44\x17\xff\x37\xc1\x5f\x26\x50\x1a\xe0\x12\xb8\xb7\xc9\x09\x1c\x01\x41\x29\x60\x36\xdc\x6c\xb9\xbd\x90\x1b\xee\xf1\xd4\x8d\x7f\x29\xfe\x3c\x00\x82\xc5\xa1\x58\xee\x99\x98\x97\xe6\xbe\x23\x1d\x7d\xdc\xb8\xb4\x28\xa9\x16\x30\xf1\x86\x0b\xf7\x5a\x3e\x54\x87\x9d\xb2\x37\xae\xab\x0f\x71\xfc\xff\xe2\xbb\x14\x0e\x9e\xcf\xa0\x29\xab\x1f\x19\xed\xa1\x0a\x0e\xb6\x1c\x03\xf4\x04\x8c\x14\x22\x6f\x81\x84\x22\x5e\x70\x14\xf9\x28\x54\x0a\xf7\x5a\x28\xf2\x66\x25\x74\xb9\x9a\x78\xd2\x4a\xab\xea\x3e\x81\xa2\xe3\xf6\xe2\xa3\xba\xdd\x2b\xa6\x4f\x07\x1f\xdf\xfd\xd3\xa9\x1b\xb1\x25\x0a\xe3\xbf\xf5\x98\xc4\x0d\x7f\x02\x8f\x09\xec\xa4\x61\x05\xa6\x61\x48\x4d\xc7\x76\x38\xad\x4d\x84\x61\x5b\x74\x43\xaf\x5f\x76\xf8\xb5\x74\x4e\x72\xdc\x77\x8f\x5b\xbf\x8e\xd8\xb8\x4f\x70\x05\xd7\xc6\xd2\xdf\x85\x34\xf3\x5b\xf2\xaf\x34\xf3\xbb\x7b\xb7\x41\xf0\x9b\x93\x30\x47\x91\xd0\xab\x30\xf9\x53\x46\xb9\xbd\xa1\x34\xf3\xa7\xf7\x49\x6b\x9b\x21\x95\x44\xe0\xa6\xbf\x34\x39\x5b\xd4\xea\xce\x85\xf4\x85\xa9\xee\x30\xb7\x09\x3c\x29\xa4\xe9\xf6\x18\x5e\x80\x66\x07\xfc\x6d\xc7\xc4\xa4\xd6\x46\xbf\xf9\x7d\x3c\x5e\x6f\xcf\x7b\x01\x1e\x3f\x6a\xf5\x35\xf6\x89\x73\xc2\x9a\xfd\xec\xbb\xf7\x89\xdb\xe5\x87\x6d\x77\x1c\x7e\x27\x90\x31\x21\x28\xbc\xde\xa7\x3f\x28\xf7\x91\xd1\x51\xfa\x4f\x4d\xe4\x33\xbf\x92\x69\x36\xc1\x87\x0d\x4a\x60\xc0\x4d\x6f\x5d\x43\xac\x86\x4b\xf7\xc2\xae\x0c\x02\xd3\x08\x52\x59\xf0\x0b\x60\x20\x07\x59\xe3\xe5\xb4\x45\x18\x97\xa3\xc4\xac\x8a\xce\x67\xac\xaf\x81\xbb\xb8\x45\x11\x28\xda\x6e\x1d\xb8\x71\x1f\x74\xe1\x5b\xc7\x56\x61\xc6\x99\x80\x8c\x19\x0c\x97\x8b\x28\x2a\x11\x58\x56\x22\x51\xcc\xef\x5b\xf7\x0f\xf2\x1d\x24\xdd\xf4\xe1\x51\x35\x08\xad\x2a\x80\x5b\xa7\xe5\x97\xce\x77\x52\x26\x8c\x02\x81\x85\x05\xde\xbc\xbb\x0a\x66\x6c\xf0\x76\xd2\xfe\x7c\x42\x8b\x33\x37\x0a\x2a\x28\xd0\x66\x9b\xaf\xeb\x5c\xd6\x08\x5b\x64\xb2\xbd\x29\x6b\x7f\xac\x0b\xb2\xda\xf8\x62\xfe\x6e\x0e\x06\x23\x6a\x9b\xa2\x3f\xba\xd9\xf3\xd8\x63\x23\xd5\xa4\x9e\xaf\xa2\xde\x12\x27\xac\x0d\x6a\x57\x36\x0c\xe3\xca\xfb\xfe\x9b\xb0\x78\x7c\xe3\xd3\x66\x53\xba\xab\xb7\x5e\xc7\x57\x6d\x23\xc2\x92\xd5\x6d\xbe\xc2\xdf\x51\xc7\x20\x7b\xaa\x9c\x01\x7e\x51\x3c\x27\xa2\xfb\x7a\xcf\xdd\x23\xe9\x2e\x7c\x58\x6f\x71\xe5\xa8\x1e\xe5\x20\x6f\xa8\x2a\x13\x30\x30\x83\x8a\x92\x67\xd2\xf2\x7b\x02\xbd\xad\x0f\xf3\x7b\x67\xf7\xb3\xfd\xda\x12\xde\x17\x54\x09\x4a\x83\x79\xa4\xf2\xf1\x00\x98\xf6\xd7\x40\x57\x0c\xfe\x33\x00\xc3\x01\x32\x82\x15\x21\x00\x00"),
+		},
 		"/string.lua": &vfsgen۰CompressedFileInfo{
 			name:             "string.lua",
 			modTime:          time.Date(2018, 3, 11, 7, 1, 22, 0, time.UTC),
@@ -112,12 +140,19 @@ var preludeFiles = func() http.FileSystem {
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6f\xdb\x30\x0c\x85\xef\xfa\x15\x0f\xcd\x25\x46\x1c\x23\xe9\x8e\x86\x06\x74\x3b\xed\xba\xf5\x56\x04\x82\x6b\xd1\xb5\x5c\x8f\x1a\x24\x79\x45\xff\x7d\x41\xcb\x8e\x1b\x34\x07\x41\xa4\x18\xbe\xef\x91\x56\xc6\xc4\x14\x1c\xbf\x3c\xfa\xdf\x13\x53\x84\x46\x37\x71\x9b\x9c\xe7\x7d\x4c\xa1\x50\xc0\xe8\xdb\x66\x44\x13\x42\xf3\x0e\x8d\x5f\x9c\xbe\xdd\x3f\x48\xb0\xdf\x49\x41\x7d\xad\x08\x13\x53\x89\x01\x1a\xa7\x2d\xe9\x24\xbc\x46\x0c\x0d\xf9\x97\x02\xde\x7a\x37\x12\x52\x98\x08\xd6\x2b\xc8\xcf\x75\x70\xf8\xae\xc1\x48\x3d\x71\xce\x01\x78\x0e\xd4\xbc\xe6\x88\xd8\xe6\x4b\x3e\x45\x11\x1a\xc6\x58\x6a\xbd\x25\x31\x20\xd0\x25\xdc\x8c\x05\x64\xea\xa7\xe1\x02\x3d\x17\x3f\x9d\x2f\xf5\xe7\x06\x42\xe7\x70\xc8\x6f\xf7\x97\x9c\x14\x07\x03\x0e\x38\xab\x55\xf0\x78\x84\x63\x0c\xb1\x44\x83\x38\x3d\xe7\x51\xb8\x88\xd1\xbd\x92\xa4\x46\xd7\x92\xbc\xfd\x77\xf4\x06\xcf\x92\xea\x9b\x40\x16\xf3\x9c\x7e\x4c\x5d\x47\xa1\x52\x40\xa0\x34\x05\xce\x50\xd5\xda\x68\x7f\x2a\x31\x14\xb5\x22\xb6\xb5\x52\xc6\x08\x4b\x7c\xf4\x7f\xe6\xad\xdc\xac\x43\x64\x64\x21\xae\xcb\x92\x95\x31\x23\xf1\x4b\xea\xa1\x35\x4e\xdb\xd0\x16\x99\xbb\xbb\xfa\xea\x20\x8f\x3f\xa6\x00\xbd\xe4\x3b\x1f\xf2\x72\xca\xdd\xdc\xec\x78\x5e\xf7\x90\xab\xe4\xac\x2a\x18\x43\xbc\xcd\x76\x51\xcd\x53\x5d\x23\xdf\x75\x91\x12\x0e\x70\x97\x62\x53\x5c\x20\x62\x0a\xab\x35\x65\x4c\xeb\xff\xbd\x7f\x35\x66\x63\x2a\x11\x43\x5b\xdc\x7c\x27\xc6\xfc\x75\xbc\xdf\xc5\xd0\x96\xb0\x31\x5d\xcd\x16\xb7\xf4\xbc\x81\xe7\xaa\x0c\x97\xef\x9f\xd0\xc4\x52\x68\xab\xb6\x6f\xc2\x4f\x6f\xe9\x21\xed\xdd\x57\x5a\x5e\x58\x3f\x02\x00\x00\xff\xff\xc2\xdd\x75\x5b\x16\x03\x00\x00"),
 		},
+		"/timer.lua": &vfsgen۰CompressedFileInfo{
+			name:             "timer.lua",
+			modTime:          time.Date(2026, 10, 17, 4, 17, 25, 0, time.UTC),
+			uncompressedSize: 2104,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\x4b\x8b\xe4\x36\x10\xbe\xf7\xaf\xf8\xd8\x93\x9b\xd8\x26\xd9\xe3\x24\x3e\x84\x49\xb2\x97\x30\x81\xcc\xde\x1b\xb5\x54\x6e\x0b\xab\x25\x23\x95\xdb\x0c\xcd\xe4\xb7\x07\xc9\xed\x47\x3f\x66\xc3\x1e\x32\x30\x60\x4a\xaa\xfa\x1e\x55\xa5\x2e\x0a\xb0\x3e\x92\x2f\x4d\x2f\x9e\xf0\x67\x2f\x0a\x2b\x58\x9f\x08\x27\xf2\x41\x3b\x1b\xe0\x6a\x70\x43\xd8\x1b\x27\x5b\x6d\x0f\x9b\xa2\x40\x27\x3c\xa7\x83\x4e\xc8\x56\x1c\x28\x95\x28\x37\x45\x11\x0f\xbf\x36\x84\xd0\x08\xe5\x06\x52\xe3\xc1\xaf\x35\x93\xcf\xc7\xef\x57\x43\xd4\xe5\x20\x96\x25\x06\xd7\x1b\x15\x53\x52\xed\x84\xf2\xd7\x2b\xb8\xf1\x24\x54\x0e\x61\x15\x06\xcd\x0d\x34\x83\x4e\xe4\xdf\x70\x70\xde\xf5\xac\x6d\x82\xc2\x6e\xa7\x6d\x60\x61\xcc\xd7\xc8\x3f\x20\x0c\xa2\x0b\xb1\xc8\x31\x87\xb3\xf1\x03\xfa\xd8\x39\xcf\x94\x30\x22\x3a\x58\xec\x0d\xe5\xa8\x9d\x5f\xf4\xed\x7b\x6d\x78\xca\xe0\xb1\x96\xb6\x31\x45\x36\xc2\x46\x5f\x72\x0c\x8d\x96\x4d\x94\xdd\xc2\x59\xf3\x96\xae\x4a\x61\x8c\xb6\x07\xc8\x85\xd5\xe6\x96\x53\x85\xba\xb7\x92\xb5\xb3\x59\xd7\x1e\xb6\x1b\x00\xc6\x49\x61\x60\xdd\x80\x0a\x5d\x7b\x28\x5f\xdc\xb0\x84\xc9\xd0\x11\x15\x76\x3b\x7e\xeb\x68\xb7\x2b\x13\xe9\x68\xc4\x55\xa4\x8c\xd5\x37\x1b\x00\x45\x81\x40\x56\xcd\xd4\x93\x61\xae\xe7\xb9\x5b\x4f\x30\xba\x25\x7c\x71\x39\xc4\x25\x21\x49\x4c\xda\x2c\x19\x34\xce\xa8\x00\x67\x09\x27\x61\xfa\x11\x4c\x79\x37\x3a\x09\x4f\x81\xcb\x85\x5e\xc4\x8a\xd8\x6b\x5d\x32\xa9\x02\x22\x45\x11\xda\x32\x90\x21\xc9\xd9\xf9\x2c\x51\x41\xe6\x70\x1d\xaa\xe9\xec\xf5\xf7\x97\xdf\x72\xc4\x80\x75\x43\xb6\x7d\xcf\x71\x7e\x7f\x4f\xf9\x64\xd5\x66\xe5\x0e\x0d\xc9\xc0\x35\x8e\xca\x51\xe7\xe8\xc8\x6b\xa7\x26\xc8\xf1\x36\xa3\xc2\xf9\xfd\x3a\x14\x5d\x3c\x0f\x0d\xd9\x04\x2e\xf6\x61\x97\x10\xf1\x03\xd4\x54\x04\xd5\xe5\x23\x47\x1d\x81\xa6\x0a\x5c\xbe\xb2\xeb\xd6\xd0\x13\x1c\x00\x4f\xdc\x7b\x3b\x6b\x65\xd7\xed\x92\x9f\x19\x1f\xa7\x5b\x51\xca\xf8\x55\x14\xf8\xe2\x20\x9d\x22\x1c\xc5\x5b\x1a\x18\x70\xa3\x03\x44\x00\x97\x7f\x53\x20\xce\xd4\x16\xce\x2f\xf7\xf9\x69\x0a\xff\x9c\xda\x70\xe9\x82\x24\x7d\x22\x0f\x5d\x63\x20\x1c\x1c\xc7\x86\x95\x33\xdf\x94\xb2\x26\x2c\x72\xec\x57\xa4\x47\x4f\xd4\x67\x54\xe3\x10\x8c\x7f\xba\x86\x40\x55\x81\x23\x86\x5d\xe2\xc0\x78\x75\xbf\x84\x16\x49\x73\x35\x21\xd3\x2b\x51\x7d\xd3\x0a\x00\x7c\x2c\x1f\xb7\xe1\xf3\x15\x93\x4b\x4b\xfe\xa9\x60\xb5\xb9\x27\xc4\xc7\x72\x6e\x9a\xfa\xfc\x01\xb1\x99\x89\xf0\xfc\x88\xca\xa5\x77\x23\xf3\xbb\x66\x7d\x33\xfb\x92\xca\xeb\x51\x8d\xcb\x9b\x5e\xb3\xab\x29\xbd\xdd\x86\x78\xe1\x12\x5d\x27\xbe\x3c\x9a\xf0\xeb\xb1\x96\x8b\xb9\xcf\xe3\xb2\x3e\x59\x1a\xb2\x9f\xf2\xf4\x4a\xdc\xaf\xc0\xb4\x34\x69\x53\xe6\xd1\x9d\x77\x36\x93\xdb\xc8\x60\x3b\x4f\xcd\x33\x2a\xc8\xff\xd0\x97\x5e\xee\x87\x1c\x2f\x19\x6b\x2d\x99\xda\x96\xcf\x0f\x2b\xfc\xd1\x5b\x79\xbb\xcb\x37\x85\x3e\x60\x3f\xd9\xd8\x89\xc1\x66\x75\x7c\x2f\x16\x15\x77\x7e\xca\xf6\x03\xb2\xba\x86\xc2\x2f\x15\x7e\xbc\x99\x2c\xf2\xde\xf9\xec\x93\x75\xb6\xe8\x5c\xd0\x69\xa2\xb5\x65\xf2\x27\x61\xd2\x8f\xc4\x5c\xf6\xd3\xfd\x76\xff\x9f\x5d\xca\xf1\x9d\x8d\x8a\x2c\xbf\x4b\xfa\x64\xbb\x36\x77\xc2\x6e\x5b\x2b\xdb\x9b\xde\xc6\xff\x7f\x07\x00\x2b\x91\xf0\x0c\x38\x08\x00\x00"),
+		},
 		"/tsys.lua": &vfsgen۰CompressedFileInfo{
 			name:             "tsys.lua",
-			modTime:          time.Date(2018, 12, 14, 6, 16, 15, 536888986, time.UTC),
-			uncompressedSize: 101379,
+			modTime:          time.Date(2026, 10, 17, 6, 44, 59, 0, time.UTC),
+			uncompressedSize: 106158,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xfd\x7b\x93\x23\xb7\x91\x28\x8a\xff\x6d\x7e\x0a\xb8\x66\x7f\xa7\x49\xa9\x58\xd3\xdd\x1a\x8f\xa5\x6e\x51\x0a\x5b\xaf\x95\x57\xaf\xe3\x19\xef\x89\x38\xed\xfe\xf1\x80\x2c\x90\x84\x58\xac\xa2\x0b\x20\x39\xd4\xc4\xec\x67\xbf\x81\x4c\xbc\x0b\x45\xb2\x47\xf2\x9e\xdd\x1b\x97\x11\x33\x4d\x56\x01\x09\x20\x91\x48\x24\x12\xf9\x18\x8f\x07\xe3\x31\x91\xe2\x28\x8a\x6a\x47\xc9\xb6\x6d\xf6\xbc\x64\x82\xc8\x15\x23\xf2\xb8\x65\x44\x1c\x85\x64\x1b\xb2\x68\x5a\xb2\xe4\x3f\x73\x59\x0c\xb0\xca\xb7\x92\x08\x49\x5b\xc9\x4a\x52\xf1\x05\x23\x54\x10\x4a\xb6\x4d\x2b\x49\xb3\x80\xda\xdf\x34\xdb\x15\x6b\xff\xf2\x0a\xc0\xa8\x1a\x1a\x92\x6c\xc8\x77\x3b\xfa\x97\x6f\x5f\xe7\x84\xd6\x25\x11\x92\x57\x15\x11\xab\xe6\x20\x88\x68\x36\x50\xf2\x67\xba\xa7\x62\xde\xf2\xad\x24\x7b\x26\x24\x5f\x32\x51\x0c\xd4\x8b\xff\xc5\xc8\xa1\xd9\x55\xa5\x82\xc9\xe7\xb4\xaa\x8e\x84\x0a\xb1\xdb\x30\xd5\xa2\x60\xa4\x6c\x16\xbc\x62\x84\x6f\x54\x47\x84\xaa\x41\x5b\x46\x68\xd5\x32\x5a\x1e\x49\xd9\xd4\x8c\xcc\x8e\x64\xdb\xb2\x6a\x57\x32\x52\x35\xb4\xe4\xf5\x52\x8d\x88\x7c\xdd\xb4\xa4\x64\x7b\x72\x68\xda\x75\x4e\x0e\xec\xaa\xaa\xe0\xbd\x02\xbc\x21\x7c\x41\xea\x46\x1a\x40\x80\x02\x55\x69\x3a\xdd\xf0\x9a\x2f\x04\x69\xea\xea\x48\x56\x54\x90\x25\x93\xf3\x43\x09\x03\x9b\xaf\x4a\xde\x02\xec\xbf\xec\x84\x24\xac\x6e\x76\xcb\x95\x1a\xfd\xac\x69\xa4\x90\x2d\xdd\x02\x1c\x0b\x63\x42\xde\xbe\x1b\x4c\xa7\x8b\x05\x27\x13\xd2\xb2\x7f\xec\x78\xcb\x48\xb6\x58\xf0\x6c\x50\x35\x73\x5a\x91\xe9\xb4\x11\x35\xdd\x30\x32\x21\x50\xac\x68\x04\x99\x4c\x48\xf6\xbf\x78\x5d\x36\x07\x91\x41\xab\xd9\xc1\xfc\x6a\x5a\x92\xed\x6a\xfe\x26\xd3\x5d\x95\xcd\x76\xda\x2c\xa6\x25\x5b\xb0\x96\xfc\xac\xba\xb4\xa1\xed\x5a\x90\x66\xd7\x92\x6d\x23\xb8\xe4\x4d\x4d\x9a\x1a\xc8\x61\xc5\x88\xc2\xae\x9a\xe1\xf9\x3a\x27\xa2\x21\x07\xf5\xa4\x26\x92\x55\x95\xc2\x06\x25\x57\x2d\x9b\x37\x7b\xd6\x5e\xa9\x0a\x07\x2a\x48\xc9\x5b\x36\x97\xaa\xd9\xba\x91\x85\xa2\x8f\x96\xc9\x5d\x5b\x2b\xba\x58\xec\xea\xb9\x82\x8f\xc0\xa9\x24\x87\x15\xab\x09\xaf\xf7\xcd\x9a\x95\x39\xb4\x25\xc8\x62\x38\x2a\x06\x51\x3f\x27\xb6\xea\x70\x31\x1a\x10\x42\x0c\x2a\x4a\x46\xdb\x69\xb5\xa3\x3f\x73\x39\x2d\x9b\x69\xdd\xc8\x29\xaf\x2b\x5e\x2b\xec\xdc\xaa\x82\xd8\xb8\xab\x0e\xb5\x09\x51\xad\xdc\xeb\xaf\xa7\xa1\x9c\x78\xfb\xe1\x0d\x80\x60\x75\x39\x50\xff\x06\xd3\xe9\x6c\xc7\x2b\xf5\x76\x0a\x6b\x82\xd7\xcb\xa9\x38\x6e\x66\x4d\x35\xad\xb8\x90\x93\xb7\xef\xee\x93\x65\xd4\xca\x70\x25\x06\xa6\xaf\x64\x3a\x15\xb2\x69\xd9\x9f\x55\x05\x5e\x0b\xec\xbb\x5a\x81\xeb\x7c\x4a\x78\x4d\xb6\x94\xb7\x62\x38\xfd\x66\x44\xca\xc6\x8e\xe5\x64\x17\x1e\xd6\x8f\x13\xd9\xee\x98\xed\x77\x12\x20\x76\x68\x7a\x06\xac\xed\x75\x07\x28\x20\xc3\x1f\x45\xa3\xf8\xc3\x74\xcd\x8e\x62\x28\xbd\xe9\x6b\x91\xd8\x53\x7d\x90\x5e\xe3\x92\xce\x2a\x56\xf0\x5a\xb0\x56\x0e\xdb\x9c\xac\x47\x5e\xef\xf1\xa5\x6a\x61\xd8\x8e\xbc\x09\x6f\xb1\x17\xe3\x31\x51\x5d\x24\x8a\x8a\x97\x55\x33\xa3\x15\xd9\xd3\x56\xf8\xbd\x5b\x56\x1a\xb3\xd8\x29\xb5\xec\xae\x4d\x9f\xa6\xf9\x5a\xf5\x89\x1b\xc4\xf8\x23\x99\x7e\x33\xf2\x3a\x89\x95\xf7\x8a\x60\xbe\x79\x58\x3f\xe2\x43\xfd\x8e\x4f\xf8\x87\x37\xfa\xfb\xb6\xe5\xb5\x1c\x66\x0f\x59\x51\xc8\x46\xc8\x96\xd7\xcb\x21\x1f\x15\x45\xf6\x48\xfc\x47\x6b\xf5\x88\xdc\x05\xcf\xf6\xa3\x51\x80\x61\x33\xb6\x9d\x60\x6d\x67\x54\xbf\xe1\xa0\x76\x62\xde\xb4\x6a\x29\x7c\xf2\x07\x32\x1e\x93\x6c\x9a\x99\x71\x2d\xc8\xb3\x35\xf9\x8c\xdc\x6a\x0e\xae\x7a\x59\xcc\x8e\x92\x0d\xd7\xf9\x4d\x7e\x33\x9a\x4c\x74\xd5\xee\xeb\xdb\xfc\x76\xa4\xb8\x96\x2e\x20\x57\xac\xd6\x40\x09\x51\x8d\x1c\x18\x69\x36\x5c\x92\xe9\x54\xb1\xe9\x05\x7f\xc3\x4a\xb2\x61\x72\xd5\x94\xe2\xf9\x9e\x56\x3b\x26\x74\x71\x56\x09\xe6\x6a\xf2\xc5\xb9\x15\xa0\x1a\xad\x79\xa5\xf8\x93\xab\xa6\x98\xfa\xf9\x95\x13\xf5\xd2\xcd\x6f\x92\x02\x82\x77\x3e\x05\xfc\x56\x74\xa0\xc7\x8f\xcb\xc0\x7d\xeb\x90\x87\x5a\xa7\x11\x69\xc8\xf7\xa3\x0d\xc3\x17\x3c\x0a\xe9\x41\xb8\xe5\x0d\x5d\x74\xf7\xe1\xda\x67\x27\x11\xa6\x3d\xcc\xea\x2e\x04\xf8\x8d\x90\xfb\x9b\x60\x36\x81\x4c\xcd\x3e\xd2\x38\x5d\xfe\x96\x48\x3d\x35\xde\x7f\x12\x2f\x01\x19\x49\xad\x36\x25\x59\x88\x2d\x9d\x33\x32\xe3\xb5\x12\x8b\xd4\xae\xaf\x76\x63\xac\x48\xb8\x20\x3b\xa1\x78\x2e\x59\xb4\xcd\x86\x7c\xd3\x0c\xbc\x97\x13\x62\xbe\x0e\xac\xb0\x52\xfe\xc3\xdf\xbd\x85\x6c\x3d\x2c\xc9\xa3\xaa\x72\xdc\x32\xfb\x9c\x2f\xe0\xe1\x84\x64\x08\x27\xf3\x49\x41\xb3\xf6\xab\xec\xaa\x28\x84\x6c\x8b\xe2\x2a\xbb\x1a\x68\x1e\x80\x15\xff\x63\x42\x32\xd8\x11\x52\xf5\xec\xe0\x4d\x63\x7a\x92\x07\xc8\x72\xe8\xbe\xe1\x25\xe1\xf5\x82\xd7\x5c\x2a\xa9\xb0\xd9\x8a\xc2\xf5\x75\x23\xc9\x44\xc9\x75\x1b\x26\x29\x34\x61\xc1\x88\xe8\x61\xae\x28\xde\x1b\xa5\xf0\xf0\x72\xa2\xd2\x46\xfa\xfb\x97\xc0\x79\x31\x58\xd4\x42\xf3\x84\x0c\xdf\xea\x41\x69\x01\xef\x77\x13\xf2\xd6\xd1\x3e\xca\x9d\xbf\x9b\x90\x6c\x8a\x5f\xb3\xdc\xbd\x04\x49\x14\xde\xc1\x37\xff\xd5\x86\xbe\xd9\x52\xb9\xfa\xdd\x84\xdc\xbe\xbc\x36\xcf\xdf\x99\x2f\x4a\x7c\xec\x6b\xe7\x44\x33\x27\x5a\x79\x71\xfd\xc9\x4b\xdb\x4c\xc0\x17\xf1\xd1\xe8\xc1\x48\xb9\x8f\x03\x14\x86\x8b\x79\xc9\x16\x43\x55\xf4\xe1\x61\xf0\xbb\xdf\xcd\x57\xb4\x25\x84\x7c\xf0\xf8\x48\x8a\xc2\xe2\xa7\xd0\x72\x77\x51\x90\x87\x07\x32\x24\x50\xea\x83\xd9\x6e\x91\x13\xc1\x7f\x61\x53\x09\x7f\xc8\xe8\x7e\xf0\xbb\xdf\xf1\x5a\xfe\xee\x77\x51\x75\xe8\x31\x71\xd5\x9b\x5a\x48\x0d\x44\x75\x1c\x2b\x3e\x3e\x0e\x46\x03\x2b\xaf\x9b\x26\x1d\x8d\x13\x9f\x0f\xcc\x76\x8b\x85\x15\xd4\x6b\x76\x18\x66\x0a\xdc\xc3\xe7\x8f\x59\xee\x9a\xd5\x78\x81\x6a\x58\xf2\x8b\x87\x68\x48\x8f\x43\x05\xa9\xa7\x8e\x26\x19\xac\xaa\xe9\x4c\x15\x1f\x19\xb1\x54\x77\x15\x87\xe7\xf7\x34\x0d\xc2\x6b\x1d\xaa\x3c\x62\x39\xb5\x2c\xaf\x2d\x3b\xfc\xdb\x72\x95\x13\xae\xa4\x7b\x60\x19\x70\xc2\x79\xd5\xe0\x11\x68\x27\xd4\x96\xce\xd5\x81\x08\x0e\x11\xd9\x74\x0a\x87\xc6\xa9\x3e\x72\x65\x70\xe6\x60\x2d\x51\xc7\xb4\x1c\x4e\x0e\x2b\x3e\x5f\x29\xe6\x42\xe1\x99\x3a\x3c\x56\x5c\x4a\x7b\x88\xa3\xf5\x9c\xe5\x08\x5c\xae\x58\x0b\xc7\x43\xd9\x90\x3d\x6b\xf9\xe2\x88\x67\x17\x35\x41\x5c\x90\x79\xd3\xaa\x03\x48\xe1\x73\x67\x3d\x7e\xec\xea\x9f\xa9\x60\xe5\xd7\xbc\x62\x5f\xbd\xe1\x42\x8a\xa1\x6a\xcf\x9b\xb1\x66\x9d\x13\xd6\xb6\x39\x99\x37\xa5\x12\x7a\x1a\x53\x0f\x0a\xe6\xc4\x16\xd7\x87\xc1\x66\xed\xb3\x1a\xbe\xd0\xf5\x26\xe4\xe6\xa3\xae\x58\x53\xb2\x9a\xab\x93\xce\x6c\x27\x15\xee\x18\xf4\xc0\x95\x30\x8c\xaa\xdd\xb1\xee\x3e\xe4\xde\xeb\x2e\x76\xe4\x6c\x3d\xcc\x92\xb7\x7a\x68\x76\x7a\x95\xd8\xf6\x3c\x83\x53\x2d\x4c\xca\xac\x91\x2b\xf2\xb7\x9a\xbf\x01\xd1\x4c\x9f\x1c\x03\x3a\xd0\xb0\x16\x0e\x4f\x0a\x58\x51\x64\xcf\xb3\x91\xa5\x81\xd7\x2b\x46\xb6\x0d\xaf\xe1\xb0\x6f\x4f\xb0\x5c\x78\x27\xc5\x05\xaf\x4b\xd8\x5c\x94\x08\xc8\xd4\xd6\xaf\x69\xe0\x27\x98\xaf\x85\x42\x03\x17\x80\x49\xc1\x40\xab\xa0\x8e\x8c\x07\x5e\x55\x84\x56\x07\x7a\x14\x64\xc6\xa0\xe2\xec\x88\x8a\x07\x44\x9e\xa2\x2e\x00\x5b\x55\xa0\x31\x90\xb4\x2e\x69\xa5\x0e\xf6\x25\xdb\xb3\xaa\xd9\x6e\x58\x2d\xa1\x51\xc9\x84\xd4\xa7\xfb\x01\x48\x2b\x7e\xfb\x5a\x3a\x31\xf3\x34\x1e\xeb\x2d\x35\xea\xa5\x50\xa5\x8a\xa2\xc8\x7c\x3a\x69\xf9\x92\xd7\x93\x2c\x73\x8f\x70\x75\x35\xc0\x13\x58\xbd\x1f\x66\xdf\x7c\xfb\x97\x6f\x5f\x4f\x7f\xfa\xeb\x57\xdf\xfd\xed\xcb\xaf\xa6\x5f\x7e\xfb\xd7\xcc\x50\x8e\x2a\xfa\x1f\x61\xe3\x84\x68\x98\x44\xb1\xe8\xa0\x03\x6a\xfc\xb8\xdd\x76\x00\xda\x03\x5a\x30\x2c\x80\x5f\x14\x6a\xd2\x07\xa1\x90\xac\x7b\xca\x16\x74\x57\xc9\x9f\x82\x3a\xd9\x73\xd1\xce\x9f\x2f\xb9\x5c\xed\x66\xc5\xbc\xd9\x3c\x5f\x56\xc7\x39\x6b\x79\xcd\x9e\x2f\xf9\xf3\xed\x7a\xf9\x7c\xde\x6c\xb6\xbc\x62\xed\x73\xb3\x8e\x03\xa0\xcb\x66\x8b\x80\x7c\x0c\xfc\xf8\xd3\x9f\x5e\xff\x6b\x36\x72\xcb\x43\x97\xea\x0e\xfe\xa2\xf1\x23\x38\x57\x23\x1e\xb7\x86\x5e\x14\xa9\x21\x3a\x84\x74\x0e\x0e\x8a\x9d\xb4\x47\xf2\x2f\xff\xfa\xe3\xf7\x5f\x3d\x5f\x36\xb1\xec\xb9\x6a\x36\x2c\x1c\x98\x2a\x98\x8d\x82\x93\x07\x14\x4a\x8e\xeb\x92\xa1\x99\xa6\xb3\xa0\x5a\x3c\x3e\x68\x03\xc6\xb1\x6c\xb2\x4b\x86\xd9\x19\xa9\x61\x44\x50\x4d\xf1\xd0\xf9\xa1\x7c\x5a\x4f\xd5\x7e\x0f\x25\x4f\xf6\x34\xda\x20\x87\x23\xe0\x1d\x67\x0e\x2e\xd8\xb9\xf9\x8a\xcd\xd7\x20\x39\x2b\xc6\xee\x6d\x15\x85\xc7\x77\xcf\x70\xf5\xa0\x3b\x45\xd1\xd9\x81\x46\xfe\x14\xb1\xb6\x6d\xda\x61\x06\x7f\x94\xa4\x6e\xf4\xa0\x77\x64\x0e\x6a\x46\xd5\x9e\x62\x64\x64\xe3\xb4\x86\xa8\xe7\x6a\xda\x63\x41\x5e\xb7\x9c\x95\x21\x02\x26\x57\x59\x51\xc4\x7d\xb8\xba\x57\x32\x38\xe2\x77\x14\x0c\x59\xb3\x9d\x9d\x50\x52\x74\x8c\xc9\x24\x28\xc3\x85\xb9\x66\xbc\x31\x2b\x43\x15\x68\x8c\x86\xab\x8d\xfa\x53\xed\xe8\xd5\x48\x21\x1a\x0e\x27\xd3\x0d\x7d\x93\x23\x10\xf5\x47\xb6\xbb\x7a\x4e\x25\x7b\xdd\x7c\x5b\x4b\x68\x43\x31\xe9\x5a\x7e\x7c\x61\x0b\xbc\x96\x2f\x5f\x84\x4d\xf0\x5a\xb2\x25\x6b\xf1\xd4\x44\x0e\x5c\xae\xc8\x37\x8d\x3a\x69\x00\x5f\xd6\x4d\x28\xde\x52\xb1\x37\x17\xb6\xa2\x4b\x63\x3b\x1e\x26\xc4\xaa\x39\x7c\xf9\xf5\xab\x1f\xdb\x92\xb5\x31\x28\xcb\xdb\x83\x42\xc8\xdc\x41\xb9\xa9\x15\xc1\xa4\x5c\xc0\xec\xe3\xf2\xee\xe9\x80\x2e\x13\x34\x4e\x67\x62\x5a\x37\x87\x0b\x87\x40\x67\xa2\x6e\x0e\x1d\x20\x92\x8a\xf5\xa5\x48\x58\xd1\xda\xaf\xef\x0e\xa9\x0b\x50\xbf\x1e\x72\x52\x51\x09\x6b\x67\xb9\x6b\x19\x69\x76\xb0\xe0\xc5\xbc\xd9\x32\x52\x36\x87\xba\x18\x4c\xa7\xe5\x42\x7c\x83\x95\xd4\x9a\xfd\x81\x29\xcc\xbc\x92\x54\xb2\xe1\x08\x20\x82\xaa\xf7\xbb\x1d\x6d\x51\x63\x8b\xdb\x75\xbb\xab\x6b\x85\xa9\x5d\xad\x50\x88\xfb\xb2\x2a\x3c\x3b\x2a\x36\xa1\xf6\x5b\x94\xff\x4c\x77\x2a\xba\x54\x6d\xe1\x12\x54\xab\x4b\x1d\x89\x94\x94\x03\x2d\xb4\xb4\x16\x15\x45\xc5\xf3\xc2\xd7\xfa\xcf\x50\xf3\x49\xae\xb6\x6d\x23\x1b\x45\x3e\x57\x64\xfc\x99\xa2\xa3\xc2\x3e\x51\x10\xce\x7f\xae\x40\x96\x6f\x77\x6a\xc5\x5a\x18\xd3\xa9\xf7\x14\xd4\xb7\x5c\x3a\xa5\xfb\x30\x9b\x71\x99\x21\x12\x26\xfd\x1f\x7d\x0f\x32\x63\x8a\x69\xd2\xb9\xdc\xc1\xb9\xd6\xdd\x98\x08\xb9\x5b\x2c\x74\xa1\x53\x60\x06\xe6\xac\x4f\x26\x6f\xdf\xdd\x13\x37\x97\x2d\xdb\x36\x70\x99\x02\x3a\x87\xe9\x54\x3f\xd6\xda\xe4\x4d\x53\xee\x2a\x66\x7e\x6d\xe9\x7c\x4d\x97\xcc\xde\x22\x20\xc4\x22\x78\xe1\x7e\x0c\xa6\x53\x5e\x7e\xd1\xec\x6a\x09\x2a\xf6\x6b\x80\xb0\x5e\x42\xe5\x7b\x54\x20\xb1\x7a\x29\x57\xaa\x75\xda\xb6\xf4\xa8\x84\xe2\x5d\x0d\xd3\xfb\x70\xfd\xa8\x38\xf1\xb6\x65\x82\xd5\x7a\xf2\x15\x79\xb5\x3b\x10\xb7\xc8\x74\x5a\xb1\x5a\x95\x50\xa7\x5c\xd4\xdc\x11\xba\xa7\xbc\x0a\x04\xf3\x8a\xd5\xbf\x0c\x01\xb4\x11\x8c\xe0\x47\x4c\xff\x76\xf1\x96\x6c\xb6\x5b\x16\xb2\xa5\x73\x36\xa3\xf3\xf5\xd0\xe9\x6b\x90\x83\xcf\x69\xad\x78\x35\x5c\x5a\x20\x74\x64\x37\x0a\x18\x40\xce\x7c\xbe\x8b\x7b\xbb\xda\xea\x9e\xc1\xcb\x53\x87\xfd\xa0\x93\x1b\x69\xf6\x79\x25\x61\x6e\x64\x81\xa3\xed\xee\xfd\x9e\x40\xa9\x0a\x1c\x28\x4a\xba\x35\xaf\xac\xec\xa0\x25\xee\x3a\xb5\x1f\x78\xb5\x4c\x0d\x83\x22\x85\xff\x13\xed\xd9\x32\xbc\xdb\x62\x3d\xa9\x51\x85\x14\x9e\x2a\x6a\xcb\x44\xf4\x03\x5a\x13\x60\xe6\x84\xe2\x3d\x20\xe8\x5d\x73\xbc\x01\x23\x65\xb3\x9b\x55\x2c\x9e\x4b\xee\xe1\xe9\xbf\x12\x76\x55\xa9\xf4\x96\xfb\x9f\x8a\x62\xdd\x8d\xf8\xdc\x86\x8a\xc1\xff\xcd\xda\xe6\x0b\x25\xf9\x28\x6c\x78\x1d\x89\xba\x61\x48\x1d\x2b\xe1\x49\x69\xc3\x05\x4e\x11\x74\xa7\x2e\xd9\x1b\xbc\x7d\x55\xe7\x69\xa4\x7a\xff\x92\x6a\xd3\xf8\xea\x8a\xe1\x9b\x9c\x1c\x47\xa6\x87\x6f\xc8\xff\x8f\x1c\xef\x55\x69\x64\x27\xad\x60\xdf\xd6\x32\x28\x3e\x72\x87\xc4\x40\x56\x18\xca\xa6\xde\x6d\x66\xac\x1d\xbe\x19\x61\x7b\x1a\xc0\xd7\x55\x43\x65\xf7\x0a\xcf\x6a\xe5\x74\xad\xc5\xe8\x7e\x00\xed\xe2\x3d\xe5\xa2\x6d\x76\x75\x69\x2f\x0f\x6b\x46\x5b\x26\x24\x59\x28\x60\x1f\xdd\x0e\x6c\x81\xa0\x6b\x1e\x5c\x5d\x50\x3d\x34\x50\x1f\x1e\x50\xc3\xc2\x37\x3b\xb5\xdb\x7d\xaf\x76\x51\xf8\x4e\x9a\x96\x38\x30\x33\x8f\x7c\x29\x8a\xb2\x33\x2e\x8b\x19\xad\xcb\x21\x7e\x6d\xc5\x8a\x2f\xe4\x90\xe6\xe4\xe6\xe5\x28\x27\xd7\x6f\x16\x8b\xc5\x02\xef\x13\x75\xb5\x2a\xac\x46\x53\x85\x66\xa7\x60\xcf\x7a\x61\xcf\x22\xd8\xb3\xb0\x90\x1e\xfe\x70\x48\x2b\xf2\x01\x99\x55\x23\xf2\xa1\x2e\x4d\x35\xe8\xb0\x21\xfc\x51\xe9\x11\xad\xa0\x0e\xf9\x90\x60\xed\xd5\xc8\x74\x03\xfe\x99\x1b\xbe\xfb\xc1\x78\xfc\x88\x9a\x39\x85\xe5\x7f\x63\xc7\xee\xf4\xf2\x05\x59\x28\xba\x5d\xf8\x8b\xc7\xdb\x70\x26\xde\x77\x7d\x91\xea\x7a\x9f\xfd\x40\x7f\x98\x4e\xe1\x80\x63\x35\xa7\x5e\xf9\xd1\x7d\x77\x6d\xd9\x72\x8e\x8e\x54\xf7\xa8\x94\xac\x7e\xf9\xa2\x4b\x26\xe3\xb1\x21\xf9\x62\x3a\x5d\xf1\xa5\x1a\xf9\x8b\xdb\x4f\x5e\x7c\xf2\xf2\x8f\xb7\x9f\xbc\x24\x1f\xc2\xf3\xaa\x39\xf8\x48\x7d\xa3\x01\x0f\xa6\xd3\x6f\x51\x4f\x7c\xc4\xb9\x40\x39\x65\x0a\xd2\xf5\x6a\xb7\xd4\x72\xcd\x8a\x91\xe9\x74\xad\xce\x0d\x48\xe2\x82\x6c\x76\x42\x92\x19\x23\x6b\xb6\x95\xea\xa0\x21\x8e\xf5\x1c\x77\xa8\x56\x09\x24\xcb\xa6\x70\xfc\x97\x95\x4a\x9c\x9a\x4e\x67\x54\xf0\xf9\xbf\x2b\xde\x7b\xab\x60\x0d\xf7\x23\xd2\xd4\x64\x57\xb7\x6c\xde\x2c\x6b\xfe\x0b\x2b\x89\x7a\xae\xe4\x2b\xf5\xf7\x6f\xf5\xba\x6e\x0e\x8a\xed\x8e\x6f\x00\x07\xea\xe1\x9f\x9b\x46\x51\xcd\xcd\xbd\xfe\x8d\x6b\xfa\xd6\xfb\xf9\x31\x99\x90\x8f\xbc\xdf\x37\x2f\xc9\x84\xbc\xf0\x1e\x7c\x74\x4b\x26\xe4\x0f\xde\x03\x40\xea\x4b\xf3\xe0\x6f\x1c\x40\xfe\xd1\xff\xad\x60\x7e\xec\x3f\x00\xa0\x9f\xf8\x4f\x00\xea\xcd\xb5\xff\x08\xe0\xde\xdc\xf8\x8f\xb6\x52\xc9\x27\x37\xb6\xbf\x5f\xe3\xda\x56\xcf\x3e\x0a\x9e\x61\x5d\xdb\xed\x2f\xf0\x94\x80\x4f\xff\x10\x3d\xbd\xb9\x55\xfd\xbb\xb1\x23\xf8\x13\x4a\x1e\xe4\xc6\x8e\xe1\x8b\x15\x55\x78\xbc\xb1\x63\xf8\x7a\x57\xcf\xd5\x83\x4f\x3c\x34\xb0\x76\x41\xe7\x60\x6e\x60\x47\xf1\x3d\xdd\xaa\xdf\x76\x08\x3f\x41\xf7\x6f\x6d\xf7\x5f\x55\x1c\x6b\xd8\xce\xbf\x32\xf7\x27\xb7\x2f\xbc\x47\xbb\x39\xcc\x92\xed\xf8\xdf\x6a\x41\x17\xec\xa7\x86\x6b\x81\xed\xf6\xa5\x9d\xe0\x5b\x01\x6d\x80\x8a\xfe\xe1\xe6\x71\x92\xb9\x79\x47\x0d\xfc\xc3\xad\x7d\xf8\x6d\x2d\xf5\xb3\x8f\xfc\x67\x1f\xeb\x87\x2f\xfc\x87\x37\x2f\xf5\xd3\x3f\xf8\x4f\x3f\xba\xd5\x4f\x5f\xfa\x4f\x5f\xbe\xd0\x4f\xff\x68\x9f\xaa\xc9\xd3\x0f\x3f\x0e\x1e\x9a\xc6\x3e\x09\x9e\xda\xd6\x6e\xae\x83\xe7\xb6\xbd\x9b\x9b\xe0\xb9\x6d\xf1\xe6\x36\x78\xbe\x95\xad\x79\xe1\x86\xa8\x89\xc6\xbc\x78\x11\xbe\x70\xa0\xdc\x48\x2d\xf9\x98\x57\x2f\xe3\x57\x37\xb7\x66\x20\x37\x6e\xd0\x40\x48\xe6\xb1\x1b\xb6\xa2\x26\xf3\xd4\x0d\x5b\x91\x94\x99\xa1\x6b\x1f\x9b\x48\x57\xe6\x95\x1b\xf7\xf7\x74\x6b\x1e\xba\x41\xff\x64\x07\x7c\xeb\x06\x0c\x64\x66\x1e\xbb\xe1\x22\xad\x99\xe7\x7f\xf0\x9f\xef\xe6\x66\xb6\x6e\xdd\x50\x03\xaa\xcb\xf2\xc1\x3b\xbc\x2d\xfc\x99\xd1\x3b\x22\x28\xf0\x40\x54\xd8\x6c\xb4\x15\xd6\x16\xc4\x9a\xd9\x11\x8e\xf4\x14\x6e\x03\xdd\x51\x02\x98\xdd\x33\xea\x8b\x3f\x54\x08\xd6\xca\x6f\x05\xe0\x6d\x48\x23\xe1\xd1\xde\xa2\xae\xf3\xbd\xb3\x0f\xa1\xde\x75\x69\x2c\x6c\xf1\x05\x79\x46\x41\x64\x4a\xe8\x78\x40\x7a\xad\xcd\x71\x26\x6a\x9c\x2c\x28\xaf\x58\x99\x85\x77\xa3\x7e\x57\xcb\xb2\x65\x42\x34\x8b\xc0\x8e\xa5\x2b\xcf\xca\xee\x1d\x9f\x8c\xaf\x05\x15\x2c\xff\x66\x30\x5d\x27\xbc\x15\x54\x75\xac\x98\x2e\xc0\xca\x4a\xac\x9a\x03\x94\xce\x95\x54\xae\xce\x47\x78\x56\x22\x2b\x56\x6d\x19\x5a\x9e\x09\xc6\x6a\xbc\xdf\x14\xe1\x05\xa7\x62\x3a\x4d\x4d\x04\xab\x16\xe3\x96\xcd\x77\xad\xe0\x7b\x34\xf8\xc3\x0b\x9d\xd7\xed\x11\xce\xf2\x8d\x86\x29\x9a\x0d\x03\x7b\x93\x92\x4a\x8a\xda\xc0\xef\x76\xb4\x35\xb6\x5f\xf3\x96\x8a\x15\xe8\x05\x04\x28\x54\xca\xa6\xbe\x92\xba\xaa\xa9\x55\x84\xf6\x4d\x80\x17\xba\x61\x39\xc8\xad\xb5\xcc\xc9\x3f\x76\x9c\xa9\x81\xa3\xb5\xc7\xb4\x64\x62\x9e\xc3\x08\x46\x81\xe0\x0e\x43\xbd\x23\xb2\xd9\xe2\xe5\x83\x90\x56\x7a\x97\x89\xf3\xa3\xbb\x8a\xcd\x3e\xad\x79\xf5\x99\x67\xc3\xa2\x48\x02\x1a\x8d\xb4\xb6\xd8\x90\x88\x4c\x02\xfc\x2b\xda\xc4\x79\x42\x77\x4b\xf5\x27\x27\x32\x3a\x93\xe0\x04\x33\x45\xd6\xf0\xa7\x69\xb5\xfd\x13\x5f\xc0\x83\x07\x99\x3a\x71\x18\x99\x28\xf3\xda\x33\xa5\xc1\xf0\x4a\xab\x62\xcd\xe8\x8f\x5b\x45\x80\x3d\x77\xe1\x5e\x81\x89\x2a\xb0\x6a\x19\x2d\xb3\xce\x4d\x55\x6a\x34\x70\x3b\xa7\x2b\x78\x9a\x6f\xd3\x3d\xfd\xa6\x83\xad\xf1\xd8\x7e\x21\x7f\x6e\xe4\x8a\x54\x7c\xb9\x92\x96\x88\xd4\x19\xcf\xfe\x58\x51\x4d\x7e\xc3\x11\xc9\xcc\xd3\xac\x70\x00\x6c\x49\x2e\x48\xc5\xd7\x8c\x50\xb2\xa1\x55\xd5\xcc\x59\x49\x1e\x1e\x67\x47\xc9\xd0\x9c\x74\x4e\x6b\x04\x66\x17\x93\xf0\xa0\xbc\x5e\x31\xb2\x62\x74\x7f\x0c\xe0\x7d\xf3\xc5\x18\x65\xae\xef\x76\xd4\x2b\x1c\x56\x0b\x3b\x0f\xf6\x79\x0a\x2d\x5f\xe0\x3d\x18\x6b\x73\xa2\x75\x10\x51\xeb\xb9\x03\x03\x97\x94\x8a\x33\xb2\x12\x18\x1b\xfb\xc7\x8e\x56\x8a\x8d\xce\xe0\x72\xd3\x40\x42\xe0\xa5\x57\x51\x0d\x4c\x1f\x36\xa1\xaf\x45\x8c\xe1\x68\x72\x2d\x02\xfb\xa6\xf7\xc8\xb6\xb9\x45\x41\xd6\x25\xf4\x84\xfd\x82\x1c\x9d\x5f\x38\x51\x37\x12\x96\x1c\xfe\x02\x43\x73\x0e\x30\xe6\xf0\xed\x9a\x2a\xc1\x48\xa2\xb4\x38\x65\xfb\xd4\x4b\xba\x66\x19\x52\xb4\x17\xcc\x46\x1a\x42\x7a\x41\xab\x17\xfd\x8b\x1a\x97\x01\x98\x1d\x16\x85\x62\xfb\x44\xef\x0a\x00\x15\xeb\xf9\x8f\x73\xe4\xbc\x60\x3c\xe3\xf3\x5e\xc5\xb6\x80\xf1\x82\xd9\xeb\x49\x4b\x93\xf7\xdb\x4e\x4c\x5f\xd4\xb9\x59\x1b\xd9\x39\x82\x44\xd3\xdf\x19\x5b\xa8\x37\x38\x7e\x5e\x2f\x7f\x6f\xf8\x08\xc2\x5c\x9b\xfd\x57\x6f\xc7\x68\x75\x0c\x7f\x9a\x96\xf8\x57\x9f\xea\xd9\x5c\xbf\xd3\x7c\x08\x8a\x29\x26\x14\xcc\xbc\x5f\x70\x0e\x57\x55\x77\xc4\x67\x6c\xda\x7c\x0a\xf6\x02\x32\x31\x5f\x9a\xd6\xef\xc7\x16\xac\x11\xb5\x41\x61\xcb\xb6\xc3\x8c\x64\x39\x79\xf1\x01\x16\x1e\x15\x05\x00\x1f\x04\x04\xac\xea\xa8\xd6\x4e\xa8\x60\x27\x24\x2b\x0a\x85\xc6\xa2\xc8\xfe\x5e\x67\x27\x48\x80\xce\xb4\x2a\x1d\x75\x30\x6a\x36\x0d\x49\x19\x69\x85\xfb\xd2\x8a\x6f\xcd\xba\x9e\xac\xad\x01\x97\x36\xf4\xa2\x15\xec\x4b\xe1\x6d\xaa\x3c\xee\x8d\x71\xd4\x7e\xd4\x43\xdc\x7e\x03\xaa\x13\x39\xe1\x84\x8b\x3b\xb8\x31\x0a\x2d\xc2\xae\xfc\x2b\x58\x00\x7d\x82\x3f\x98\x0e\x99\xf7\xe3\x71\xd9\x8c\xeb\x46\x62\xeb\xe3\x0d\x3d\x8e\x61\xa7\x1f\xcb\x15\x1b\xef\x37\xde\xa5\xde\xc0\x2d\x5e\xaf\x9d\xf4\x26\x63\x5a\x91\xea\xe5\x78\xbc\xab\x01\x3a\x2b\x7b\xc1\xf9\x12\x41\x1f\x47\x0b\xca\x70\x01\x97\x0c\xfe\x66\xa5\x1b\x05\xc9\x63\x9f\x67\x59\x8e\x34\xf3\xe1\x47\x39\x8a\x1d\x3d\x52\x47\xe7\x16\x55\xcf\x5c\x77\xa9\xee\x47\x5d\x3e\xb8\xd7\x7b\x2d\x6e\xc6\x60\x94\x00\x8f\x37\x32\x78\xd1\xe1\x8f\xc1\x82\xdf\xbb\x05\x1f\x8f\xc6\xb3\xea\x3b\x59\x7f\xbf\x91\x11\x87\xbd\x18\x9a\x63\xb0\x80\xed\x3e\x34\xde\xe4\x0a\xe1\x29\x2c\x3a\x86\x91\xd8\x61\x9c\x1d\x20\x0f\xc8\x14\xb0\x33\x4f\x91\x28\xd6\x70\xaa\xa1\xc5\x82\x17\x0a\x40\xb3\x18\xf2\xd8\x88\x53\x61\x42\xf1\x8b\xa2\xd8\xb6\xac\x28\xb2\x8e\x71\x24\xc9\xc8\x9a\x1d\xc9\x50\x3d\x3f\x16\x45\x36\x4a\xae\x20\x85\x1f\x7c\xa1\x46\xaf\x9e\x28\x26\x11\x1c\x3b\xe0\xe2\xee\xda\xef\xaa\x66\x3d\x45\x91\x7d\xca\x36\x5b\x79\xc4\x8d\xe3\x33\x02\xaa\x2d\x90\xe6\x35\x80\x41\xac\xfb\x8e\x80\x88\x00\x81\x9a\xaf\xad\x9a\x83\x99\x85\x8d\xcc\x49\xb6\x91\x45\xb3\x28\x32\x64\x80\x46\xa2\xfe\xf0\x26\x87\x65\x90\x14\xa9\x03\xa8\xd8\x8e\xc2\xc7\xdf\xeb\xac\x28\x14\xf8\x70\x7c\xc9\x0d\xdf\xdf\x97\xdd\x1d\xbe\xd9\x74\xdc\xf5\x8f\x38\x71\xbc\xf1\xd4\xf1\x42\xba\xed\x28\x9c\x06\xe1\x31\x32\xb3\x63\xeb\xfe\x3b\x1b\xd6\xed\xb6\x3a\x92\xc5\xae\x56\xdc\x99\xd1\xf9\x8a\xb0\x8a\x81\x2d\x90\x76\x47\xc2\xfb\x25\xda\xb6\xb9\x56\xd1\xd5\x64\xde\x80\x12\xbb\xa6\x92\xa1\x9b\x8f\x6c\x96\x4c\xae\x58\x8b\x87\x55\xb1\x05\x75\x0d\xaf\xf1\x9a\x4f\x1e\x94\xe4\x0e\xa0\x9b\x9a\x81\x83\x0b\x6d\xe1\x1a\x59\x4f\xb0\x02\x79\x60\xde\x3d\x8a\x6a\x16\xdf\xe9\x2d\x0b\x2a\x28\x09\x15\x8c\x9f\x09\x95\xaa\xf0\xc3\xf5\xa3\x1a\xcc\xc3\xcd\x63\x68\xba\x46\xb7\x7f\xaa\xcb\xbf\x34\xbc\xc6\x73\xbb\x18\x62\x7f\x72\x18\x83\x1a\xa9\x7f\x5e\x66\x07\x05\xda\x78\x55\x8c\xc7\x64\x45\xeb\xb2\x52\x52\xf2\x2f\xac\x6d\x08\x6d\x97\xbb\x0d\x1c\xb2\xdc\xd5\x9d\x27\x78\xcc\x76\x9b\xad\xd9\xf1\xcd\x8c\xa4\x6f\xdc\xb0\xfc\x2f\x7b\xd0\x73\xe3\x1d\x85\x26\x11\x78\xd6\x25\x61\x0d\xda\x6c\x7c\xd8\xd1\x87\x9b\x47\xd4\xcb\x0e\x55\x2d\x9f\x80\xbc\x1d\x54\x5b\x4d\xd3\xb6\xf5\x8f\xfc\x58\x9f\x7f\xa8\xe0\x1a\x20\x7b\x1f\x82\xc1\x3d\xc8\x69\x38\xc3\x43\xac\x95\xeb\x19\x1d\xc5\xf7\x5d\x68\x96\xad\x58\x81\xc0\xa3\x2d\x0a\x4a\x9b\xc1\x14\x4c\xb5\x7d\x15\xf2\x66\xe4\x1f\xb6\x36\x7d\x87\x2d\x0d\x18\x67\x23\x10\x70\x22\xd7\x17\xb7\x93\x6f\x3a\x76\xe0\xf2\xb8\x36\xdc\x71\x1d\x70\x47\xe0\x36\x99\xe9\x53\xcc\x21\xd7\x3e\x83\x5c\x77\x59\xe2\xaf\xf3\xaa\x01\xdd\xfa\x4e\xac\xbe\x68\x6a\xd1\x54\xcc\xc7\xcd\xc8\xdc\x1e\xc9\x55\xdb\x1c\xfe\xba\xab\x25\xdf\xb0\xaf\xc0\xb4\xc6\x2b\x55\x14\xc5\x48\xeb\x64\xf0\x2b\xdc\x17\x41\x95\x1f\x78\xa5\xb5\x4d\x9d\x5a\x23\x42\x12\x70\x87\x19\xaf\xf7\xb4\xe2\x25\xd9\xb0\x4d\xd3\x1e\x8d\xe4\x0d\x4e\x68\xbc\xb2\x07\xaa\x92\xb5\x6c\xc1\x5a\x56\xcf\x59\x36\xb2\x97\x5c\x70\x59\xec\x5f\x5f\xd4\x39\x69\xe7\xfb\x56\xad\xb0\xa5\x18\xb9\x0b\xa5\x7a\xe8\x3d\xb6\xd5\x37\x74\xcd\xb4\x5e\xd8\x03\x11\xdc\x44\xc5\xbe\x67\xea\x28\xf9\xe3\x97\x3f\xde\xa1\x9f\xa4\x5c\x71\xf1\xfb\x80\x97\x66\x3f\x33\x1a\x97\xc8\xc9\x01\xcc\x2f\x04\x99\x4e\xd9\x1b\xc9\xda\x9a\x56\xfc\x17\x46\xca\x86\xd7\xcb\xcf\x3f\xff\x3c\x8b\x6e\xc2\x7f\xf8\xf1\x35\xf9\xf2\xc7\x1f\xbe\xf2\x1b\xc9\x5c\x07\xec\x15\x9e\x07\x6b\xb8\xa8\x87\xd8\xd4\x70\x3a\x15\x6a\x89\xbc\x56\x44\x37\x9d\xfe\x2c\x7e\x9c\xfd\xcc\xe6\xf2\x27\xd9\x8e\x46\x43\x63\x81\x50\x80\xca\xcc\x19\x62\x14\x50\xa5\x50\xe8\x1c\x1a\x46\x23\x72\xf2\xf6\xdd\x68\x34\xca\xad\x27\x43\x01\x9c\xd0\xea\x37\xbd\x2b\x25\x8d\xcf\x5d\xbd\x13\x2c\xb8\xd6\xdb\x6b\x8a\xd2\x6e\x92\x74\x6b\x34\xf5\xb6\xc4\x3f\x97\x15\xfe\xb3\x99\x5d\xff\x05\xf0\x6f\xc6\x06\xb1\xa0\xbd\x16\xc3\x0d\xf9\xdf\x69\x40\xf7\x2d\x9b\xef\x51\x17\x37\xf2\x8e\x80\x5a\xd2\x53\x2f\x0b\xaf\xa2\xc0\x3b\xd3\xb7\xef\xf4\xf5\x58\xfc\x76\x02\x15\xc1\x8c\xe5\xf9\x07\xa4\x56\xc7\x5d\x35\x98\x6d\xcb\x37\x5c\xf2\x3d\x13\xe4\x83\xe7\xae\x91\x85\x2e\xff\x00\x16\xff\xf7\x03\xef\xfa\x30\xad\x0b\x5b\xdc\x77\x38\xaa\xb6\x31\xc1\xae\x7a\x80\x16\x21\xff\xd0\x70\x94\x9c\x41\xe7\xeb\x2f\xd9\x56\xae\x7e\x5c\x2c\x04\x93\x5a\x90\x0a\x1f\x8e\xed\xc5\x24\x1a\x88\x06\x8c\xb9\x45\x3b\x9b\x2d\x50\x7c\x67\x91\x27\x3e\x86\x89\xaa\x0e\x62\x77\x1f\xdd\x4a\xb1\xee\xa8\xac\x2e\x47\xef\x5c\xab\x0b\x5e\xd3\xaa\x3a\xf6\xf6\x7b\xd2\x7d\xf4\xa1\xdf\xeb\xba\x21\x73\x2a\xe7\x2b\x50\xc8\x32\x0e\x72\x4d\xcb\xc6\xc0\x44\xd5\x1c\xda\x4e\x89\x5d\x65\x6d\xd1\x03\x53\x78\x32\x21\xbb\x7a\xab\x36\xff\x96\x89\x48\xfd\x13\x5a\xbf\x1b\xd1\x0f\x80\x7b\x87\x07\x60\x49\x4c\x13\x75\xa4\xae\x89\x3a\x40\x86\x4a\xe8\x82\xe3\xf5\x8a\x91\x66\xfd\x1c\x6d\x2f\x5b\x21\xd1\xfe\x24\x32\xec\xc0\x8d\xaa\x65\x9b\x66\xcf\x54\xf7\x72\x72\x33\xb2\x1c\x45\x9f\x69\x90\x18\x14\x19\xf8\x77\xb9\x8b\xfb\x68\x39\x7c\xf5\x66\x1b\xec\x35\xf2\xb8\xed\x2e\x07\x4b\x65\x81\x0d\x5a\x48\xb7\x58\xa6\x50\xbc\x75\xdb\x31\x42\x24\x24\x7e\x9f\xa0\xce\x4b\x27\x1a\x6e\x78\x7d\xd4\x3b\x12\x0d\xa9\x54\x13\x69\x2f\x69\x5e\x42\xbd\x46\xde\x29\x0e\x2d\xdd\x6e\x59\xd9\x3d\xaf\x76\x3f\x96\xba\x95\x74\x0b\x48\x1b\xfa\x8f\x2c\xcd\x27\x3f\xc1\x99\xb3\xfb\xd1\xf3\x88\xd8\x4c\xac\xa3\x68\x29\x05\x54\x2d\x57\x2d\x3b\x24\xe9\x3a\xb5\xe8\x9e\xbe\xee\x12\x4b\x4f\xaf\x0a\x42\xeb\x23\x61\x6f\xe6\x0c\x6e\xc9\x02\x5d\x41\x72\x3d\xd9\xe5\x03\x5d\xee\x3b\x91\x9f\x59\x0d\xde\x82\x08\xf7\x85\x80\x14\xdd\x82\xe0\x6a\x6b\xfe\xde\xae\x0a\xa1\x2d\x02\x3b\x2f\x7c\xe2\x85\x95\xe2\x16\x8a\xa6\xed\x2e\xac\x87\x6c\x9a\x81\x8b\x15\x09\x16\x4d\xcf\x5a\x89\x97\x88\x92\x15\xe3\x09\x55\xf2\x98\xea\x61\x51\x14\xef\x4e\xcd\xd8\x19\x0e\x7f\x7a\x05\x91\x73\x4b\xe8\xc2\x35\x64\x6f\xf2\xda\xa5\x78\xb8\x79\x44\xce\x31\xd4\x74\x08\x92\xe5\x09\x02\xbe\x8c\x32\x93\xe3\xec\xa3\xcd\x27\xaf\x8e\xa7\x13\x6a\xc2\xfd\xa0\x6e\xea\xf1\x96\xd6\x7c\x6e\xb9\xb1\x3a\x6f\x01\x86\x07\x09\x64\x9d\xa0\x6b\xa7\xb1\xb7\x3f\x5c\x5c\x87\x33\xb4\x47\x26\x40\x5f\xdd\x65\xe1\xaf\x06\x1d\xe3\xa3\x6a\x0e\x39\x01\x33\x21\xda\x32\x72\x3d\x9e\x51\x25\xa5\x82\xc8\x4b\x1e\xd4\x4b\xf5\x6e\x54\x90\x0d\x7d\x03\x7a\xc7\x15\x1a\x26\x6f\xe8\x1b\xbe\xd9\x6d\xc8\x9c\x6e\xe9\x9c\xcb\xa3\x51\x43\xa0\xac\x3c\x98\x4e\xc5\x6e\x26\xb4\x39\x88\x73\x92\xc5\x03\xbe\x6d\x32\x57\x60\xcc\x89\x13\xfa\xd0\x5d\x28\xa1\xa2\xa6\x6a\x0e\xe4\x53\x72\x8d\x42\xda\x10\xaa\x78\x26\x95\xf0\xfb\x53\x55\x68\xa4\x4b\xa8\x5e\xc7\x05\x7c\x1b\x4c\xfa\x86\x7c\x0a\x4f\x47\xa7\x40\x7e\xa6\x87\xa5\xce\x54\x38\xdc\x1e\xf8\xea\x67\xaa\x70\x60\x29\x96\x38\xe6\x21\xa6\x66\xcd\xae\x2e\x05\x58\xb3\x37\x0b\xd2\xd2\x7a\xa9\x8e\x73\x83\xd0\xa1\xd7\x69\xfe\x6d\x33\xce\xd6\xbb\x90\x4a\x50\x36\x2f\xd0\xf8\x14\x00\x88\x62\x3a\x6d\xcc\x22\x32\xef\xf5\x83\x0f\x89\xb1\x06\x13\x68\x91\xba\x04\xef\x0c\x53\x4a\x3f\x18\x07\xa5\xec\xb4\x4f\x3a\xa3\xf5\x4a\x9a\x59\x4d\x68\xfe\xfc\x96\xa0\x8c\xab\xe5\x66\xdb\xc3\x6e\x54\xd7\x6b\x5f\x15\xea\x54\x36\x9a\x0f\xc7\xfa\xe7\xcd\xf6\xf8\x2a\x26\xc8\x52\xc8\x9c\x88\x76\x1e\x99\x66\x80\xcf\xc8\x50\xb4\x73\xdb\xcb\x9c\x94\x42\xda\x5f\x88\x53\x84\x89\xc6\x1d\xf8\x56\x1b\x5f\x60\x45\xfd\x03\xdf\x20\xaa\xcd\x2b\xf3\xab\x36\xaf\xfd\x29\x64\x15\xdb\x04\x16\x8f\x68\x5c\xeb\x2f\x5b\xaf\xe5\xd4\x68\x00\xea\x8f\xae\xc5\x1f\x5d\x73\x00\x7c\x10\x2a\x26\x1d\x2c\xc5\xa6\x58\xa9\x2d\xcb\xc9\x84\x64\x50\x27\x73\xe0\x26\x59\x00\x3b\x73\xd0\xd5\x1b\xfb\xa3\xbf\x85\x15\x15\x0a\xc0\x5d\xa6\x8b\x80\x8a\xb7\x14\x67\x6a\x88\x76\x1e\xd6\x30\x53\xa6\xfe\xd5\xa0\x0f\xd2\xb6\xb6\xb5\xf5\x60\x45\x8d\x35\xae\xd2\x12\xe3\x35\x88\x76\x4e\x60\x99\xba\x31\xe8\xc7\xba\xdb\xa1\x39\x74\xa0\xd2\x05\xdc\x86\x5e\xf2\xed\x7c\x14\x0a\xed\x96\x00\xbd\x75\xaa\xb6\x1b\x85\xf4\x62\xcd\x2d\x59\x0b\xf0\xa4\xf1\x8d\xf3\x9a\x36\x78\xa8\x4d\xe4\x22\x2e\xa8\x1d\x2c\xfb\xc7\xf2\x99\x1b\x4a\xb4\x87\xc1\x79\x9b\x4c\x48\x3d\xbe\xc9\xaf\xf3\xf1\x8d\x3b\x69\x9b\xad\x8c\x6d\x0a\x85\x73\x35\xce\x07\x07\xf1\x43\xc2\x1f\x61\x5e\x1f\x1c\x64\xf5\x2c\xda\xca\xfd\x0d\xf8\x12\xac\xd9\xe2\xb1\xa0\x77\xdf\x39\x47\x99\x9e\x5f\xe7\x75\xd4\xed\xf7\xee\xb3\x7f\x48\xbb\xbc\xb7\xfd\x33\xfd\x5e\x93\x72\x72\x46\xba\x03\x22\x93\xc4\x88\xfe\x19\x03\xea\x47\xf9\x53\x3a\x65\xaf\x4b\x2e\xec\x4e\xb0\xfa\xa9\x04\xc9\xc7\xf8\xe6\x10\x35\xc7\x30\xa1\x84\x8b\x34\x1b\x38\x53\x55\xcd\x4e\x5c\x15\x78\x8e\xa7\x7d\x9b\x83\x8f\xb5\x2f\xab\x28\x3e\x2a\x8f\x5b\x4f\x21\x5e\x4c\xa7\x68\x29\x30\x21\x59\x4d\x25\xdf\xb3\xe9\x37\xcd\x14\x19\x37\x6a\x01\xf1\x04\xd9\x26\x83\x74\x1c\xb7\xd0\x5f\x30\x94\x59\xe8\xe1\xa0\x87\x9e\x2e\x01\xea\x3b\xed\x8b\x02\x56\x22\xaa\xf7\x60\x4c\xd1\xce\x8b\x41\xac\x16\x32\x1d\x56\x70\xa1\x8f\xaa\x83\xb0\x1c\xe0\x0d\x6e\x6b\xfe\x36\x02\x8f\xdd\x7e\xa8\x75\xc7\x3f\x2e\x90\xd9\x7c\xd1\xd4\x7b\xd6\x0a\xde\xd4\x3e\x12\x9a\xd9\xcf\x3e\x12\xd4\x6f\xf0\xd8\x6c\xde\x70\x26\xb4\xb0\x16\x70\xcd\xa8\x80\xd1\xa3\xc5\x6f\x1e\xd4\x2f\x7f\xd3\x53\x73\xd2\x2a\x82\x6a\x66\x3f\x77\x55\x60\xaa\xd6\x11\x5f\x7a\x30\x70\x46\x54\x3d\x23\x6c\xe8\x72\x3d\x36\x72\xdb\xb6\xd9\xb2\x56\xc6\x1d\x0b\xca\xa0\x6d\x61\xa0\xf2\xe6\xac\x2a\x7f\x6a\x9b\xad\x27\x98\x3b\x48\x0f\xf6\xf5\x23\x09\x02\x89\x60\x2c\x91\x40\x07\x62\x82\x1c\xcc\x7e\xf6\x6a\x81\xba\x3d\x0f\xea\x89\xa0\x1e\x2a\x87\xa2\x5a\xa8\x56\xdc\xb1\xfb\x90\xfb\xbe\x4b\xf3\xcf\x69\xbe\xf0\x34\xad\x0a\x6d\xc0\x3b\x01\x9c\x18\x05\x9c\x07\xc7\x3f\x5c\x20\x9a\xb7\x09\x9e\x39\xb0\x28\x78\x73\x8c\xd5\x45\x43\x87\x98\x11\xa8\x48\x7f\x66\x94\x6c\xe8\x71\xc6\x3e\x07\xef\xeb\x3b\x5b\x0d\xf5\xee\xc5\xbc\x65\x54\xb2\x21\x68\xd9\x2d\x94\x9c\xf8\x60\xfc\xf6\x8a\xe9\x14\x95\xd5\xf0\x2b\x4d\x59\x8e\x2a\xe2\x72\x06\x84\xa3\xce\xa0\x66\x42\x7c\xd4\xd5\x9d\xe0\x35\x98\x4e\xd5\x02\x0f\xbd\x73\xcc\xd1\x3d\x7d\x68\xd7\x72\x80\x96\x3c\xf5\xe9\x78\xe0\x47\xa4\x80\x75\x58\xd3\xea\x4f\x00\xd9\x1c\x92\x54\xc9\x9c\xdc\xe6\xe4\x99\xfa\x36\xbe\xf1\x9c\x3f\xb0\x0b\x1d\x61\x56\x57\x94\x0d\x02\x1a\x69\x3b\x1f\x62\xfd\x29\x60\x6b\x6a\x99\x68\xaa\x3d\x1a\x4d\xb1\xaa\xe2\x5b\xc1\x45\x31\xf0\x8d\xdb\x4c\xfd\x1e\x13\x0e\x55\x4e\x17\xf1\x19\xe2\x74\x5a\xd1\x5f\x8e\x53\x03\x72\xca\x6b\x01\xc1\x4d\x7a\x6d\xf4\xb0\x23\xbc\x5e\x12\x55\xd1\xf5\xc5\xb7\x6a\x31\x0d\x81\x88\xa7\xf1\x33\xd2\xb7\xf0\x76\x14\x50\xdd\x5e\x80\x15\x21\xc5\xc6\x66\xac\x06\x4c\xb8\x1b\x98\xa7\x39\xb1\x05\xb2\x70\x67\x01\xe4\x46\xbb\x0f\xe2\x1b\x5f\x65\x56\x1e\x55\xcc\x1d\xa7\xc6\xf3\x6d\x33\x9d\xf2\xfd\xf1\x95\xb4\x8d\x1e\xa5\xde\x84\xde\xe9\xca\xc6\xa1\x06\x5c\x4b\xd1\xb4\x2f\x3c\x1b\x39\xd4\x74\x75\x4b\x17\xb4\x62\xab\x27\x1a\x72\x0b\xdd\x6b\xae\x4b\x1a\x09\xf3\x47\x7d\xc7\x74\x94\xcc\x58\xd1\xa8\x22\xaf\x9b\x3f\xab\x27\xae\x7a\xe4\x14\xd5\xb7\x04\x00\x4e\x4e\xae\x73\xf2\x0c\xbe\xa6\xbc\xa3\xfa\xea\x7a\x24\xaa\x0f\x62\xde\x13\x73\xfe\xf2\x1e\xd9\x83\x9d\x55\x15\x06\x60\x13\xeb\x4c\x83\x35\xb0\x34\x80\x80\x68\x14\xfb\xb3\xf6\x75\x21\x40\x33\xcf\x5c\x90\x2e\x51\x3d\x01\x0a\xca\x39\x31\x14\xe7\x76\x7a\x19\x14\xad\x09\x50\x60\xdc\x88\xd0\xa2\x0b\xc7\xa5\x8e\x77\xde\x08\x8d\x36\x46\x9f\xe0\x23\xdb\x20\x73\xf8\x56\x43\x49\x9c\x89\x6a\x76\x30\x47\xd7\x40\x53\x71\x1f\x14\xf9\x31\xad\xad\x08\x0b\x7d\xd7\xa3\xac\xf8\x50\x77\xed\x7e\x70\x39\x0e\x1c\x38\x85\x06\xcf\x3c\xc7\xbe\x18\x85\xd7\xb0\x5f\xf4\xeb\x40\xbc\x5e\xaa\xad\xb6\x47\x59\xa3\x5e\xdd\x9b\x23\x84\x6b\xfe\xb3\x00\xb8\x6f\x62\xea\x61\xe5\xfa\x3e\x58\x72\x72\xb3\xfd\x82\x6e\x1d\x87\xee\x28\x65\x3e\x25\x37\xd7\xb7\x2f\x62\x63\x33\xa8\x95\xd2\xe1\x7c\x80\x41\x66\x3b\x5a\x4e\x5b\x23\xf6\x91\x4d\x40\xf8\x03\x79\x4e\x5e\x74\x6f\xc6\x42\xcc\x41\x94\x0e\x87\xe2\x5c\xb7\x30\xb2\xf7\x3f\x1e\xb5\xbc\x7d\x17\x8c\xf9\xd0\x21\x8e\xce\x99\x31\x22\x0b\x5f\xd2\x31\x70\x1f\xf0\x1c\xe3\x13\xe2\x03\x27\x1f\x92\xc3\x63\xef\x59\xf4\x19\x72\x00\x6f\x20\xd1\xf9\x2d\x84\x0d\xc2\x96\x12\xee\x87\x3d\xe2\x94\x59\x1e\x5d\x3a\xcd\x3b\x74\x9a\x07\x54\x90\x79\x3f\xcd\x82\x0d\xc7\x0c\x7a\x99\xe0\x09\xca\x05\xbe\xf2\xca\x74\xd7\xf2\x34\xd7\xc4\x87\x51\xe5\x98\xdf\x69\x8d\xd2\xfd\xe5\xdd\xa7\x0b\xc9\x5a\x38\xda\x80\x8f\xce\x9e\xb5\xd8\x6a\xb8\xb7\x9a\x2e\x8d\x42\xbe\x61\x64\x9e\x5e\xd5\xa7\xad\x07\x3d\x32\x35\x7c\xe5\xa7\x1d\x5b\x5c\xc2\xaa\x23\x2d\x31\xc6\x25\x3c\xa5\xa3\x37\xf7\xfe\x19\xcb\x14\x0e\x95\x75\x62\x37\xb3\x71\x24\xfd\x80\x91\x9e\x26\xdc\x9c\x32\x03\xfd\xb6\x53\x67\x7b\xbf\x3f\x23\xcf\x84\x6c\xcf\x6b\x94\xb1\xc1\x0b\x15\xcb\x86\x63\xa3\x19\x9f\xd8\xcd\x6c\xf7\x3e\xbc\xd1\x1d\x04\x79\x1e\x7a\xc0\x05\xe1\xf5\xbc\xda\x09\xbe\x67\x70\x03\x58\x37\xe4\xc3\x1b\x52\x33\x56\xb2\xb2\xb0\x1b\x28\x1a\xda\x34\x1d\x2d\xa5\xdb\xe1\xf4\x41\x76\x6b\x97\x76\x64\x9c\xef\xf1\xb1\xca\xb0\xc6\xeb\x94\x12\x25\xe4\x02\x79\x57\xc1\x1d\xeb\xb2\xc3\xe5\x3a\xdf\x3e\xac\x1d\x13\xd0\x2c\xc0\x8b\xc3\xea\x5b\xb6\x87\x62\xe5\x7c\xeb\x13\xce\xda\x3f\x6d\x6f\xfd\x63\x03\xd2\x01\x1c\xd9\xfe\x4c\x05\x9f\x7f\xff\xda\x9c\x16\x8d\x04\xad\x04\x68\xff\x3d\xba\x2b\x06\x21\x48\x1d\x06\x59\xb5\xc8\x89\x77\x63\xe8\x29\x4e\x6d\x79\xad\xcb\x85\xfb\xa7\x08\x74\x60\x6c\xb7\x65\x00\x0f\x8f\x56\xa7\xfc\x5a\x82\x20\xa5\xb6\x86\x8d\x55\x1a\x70\x35\x8e\x1e\x20\xfe\xd5\x88\xab\x92\x0e\x25\xe6\x8d\xc1\xef\x6c\xd1\x19\x52\xae\xad\x57\x1d\x3c\xc1\x64\x11\x85\x2c\xf3\xde\x4e\x26\xd8\x97\xce\xad\x1e\x5c\xd7\x49\x42\x09\x38\xa2\x1b\xcd\xcb\x6c\x27\x21\xd0\xbf\x76\x7a\x32\x2a\x7d\x94\xf9\x9a\x96\x20\xbf\x29\x02\x48\xc6\x53\xec\x53\xb9\xe2\xc2\x35\xab\x7e\xdd\xbb\xf0\xab\xe0\xc9\xf0\x59\x32\x52\x98\x6f\xba\xe6\xcc\x82\xdd\xb4\xa4\x10\x1c\x2a\xd2\xc2\x29\x8c\x68\xad\x07\xd3\x89\xc0\xb0\x29\xff\xb7\x4f\x7b\x46\x10\x4a\x06\x17\xf7\x1e\xf4\x1d\xef\xe0\xee\x71\x27\x18\xac\x61\xc0\xae\x00\x32\x01\x7c\x0b\xb3\x58\x80\x99\x7e\x8b\xce\x28\xbe\x05\x8b\x15\x42\x3d\xaa\xd1\x71\x43\x90\x48\x7e\x6f\x76\x13\x0c\x16\xb2\xa1\x6b\xf0\x66\x43\x76\x78\x68\x5a\x30\x4c\xa5\xbe\xfb\xad\xd6\x8e\x8c\x48\xd9\xc0\x30\x3d\x9b\xb9\x06\x42\x9d\xb6\xf4\xb0\x64\xe0\xd6\x99\x19\xce\xe2\x87\x4d\x14\xd5\xfc\x3b\x70\x80\x0c\xca\x21\x6f\xf0\xcb\x59\x13\x68\x21\xa9\x64\x15\x13\x62\xca\x25\x6b\xd1\xd4\xcf\x9a\xb3\xfa\x2c\x07\xec\xef\x3f\x9b\x40\x37\x3e\x34\xed\xa4\xd6\x66\x87\x4a\xf4\x9c\xac\x73\xd3\x29\x68\x04\xe1\x58\xb3\x58\x0d\x42\xa3\xeb\xaf\x2e\x44\x8e\x64\x2d\x95\x4d\x6b\x7b\x9c\x63\xd2\x0c\x74\xc9\x35\xe1\xad\x71\xa5\xf8\xea\x8f\x36\xc6\x81\x17\x9f\xc8\x8f\x31\x13\x8b\xd9\xb4\x6d\x47\x81\x09\x7c\x80\x1f\x6d\x16\x3e\xbe\x31\xc1\x5e\x80\x3e\x60\x73\x39\xc1\x4c\xf5\xfb\x2c\xd7\x32\x0f\x37\xa4\xd4\xa5\x2f\xcd\x6f\xb1\x40\x7f\x09\xdd\x0c\x3b\x60\x34\x1a\x9f\x28\x73\x85\xe8\xae\x4b\x53\xd8\x95\xc2\xab\x6c\xf8\x99\xa7\x9d\xf6\x54\x12\xa3\x8e\xb0\x6b\x6f\xb9\x2c\x99\x38\x0a\x41\xb1\xa1\x69\xc9\x41\x91\xca\xb3\xf8\x02\x08\x75\x01\xd9\xa1\x55\x0b\x5d\x36\xfa\xd8\x08\x4f\xef\x08\x9d\xcf\xc1\xa8\x78\x27\xc7\xcd\x62\x8c\xc2\x42\xd7\xa3\x45\xff\x91\xb8\xa8\x1f\x0e\xa0\x84\xb4\x0b\xda\xa2\x26\x89\x97\xf3\x48\x09\x31\xb2\x9e\x5c\x75\x02\x7d\x3b\x4f\x2f\x2d\x26\x40\x30\xb2\x84\x01\xb9\x7e\xd1\xbb\x91\xe9\x2e\x60\xb1\x03\x15\x5a\xec\xc9\xd1\xe4\x58\xd3\xee\xe7\x9f\x7f\x4e\x0e\xab\xe3\xe7\xd9\x28\xae\xd8\x1b\xaa\xcb\x69\x5e\x0e\x2b\xd6\x32\xb4\x96\x80\xb0\xe2\xac\xfc\x3c\xc1\x58\xed\x22\x59\xfb\xae\x58\x66\x53\x5e\x9f\x70\xa1\xd2\x23\x00\xff\x4a\x49\xe7\xeb\x3b\x1f\x59\xdd\xfe\x75\x3b\x88\x0e\x00\x54\x80\x7b\x10\x84\xa8\xdd\x6d\xb7\xe8\x25\xc0\x6b\x12\x2d\x9e\xa8\x77\x0e\xbd\x49\x07\xa6\x75\x92\x50\xfd\x2b\x2a\x24\xef\x70\x6f\x00\x1e\xa7\x29\x78\x9d\xa4\xe0\x8b\x91\xdf\x32\xaa\xc5\x9e\xb3\x44\x7e\x9f\x08\x27\xcf\xa1\x8c\xe0\x25\x23\x0f\xd7\xea\x4c\x15\xc4\xea\x79\x26\x47\xe0\x47\x34\x4a\x38\x15\x87\x01\xb4\x4c\x83\x5a\xf6\xc6\x48\x12\xcd\xba\xe8\xac\x73\xa7\xa1\xca\xec\xf7\x38\xcc\x97\x7d\xa1\xe3\xe5\xc3\x92\xc3\xf5\x56\xb1\xba\xbb\x35\xfa\xfa\x31\x08\x45\xe7\xa3\x3b\x5c\xaf\xbf\xa5\x80\x19\x53\xcc\x7f\x69\x01\xd3\xf1\x9e\xff\x57\x0b\x98\xee\x17\xb2\x4d\xa4\x97\x67\xae\xff\x3d\xc3\x4a\x07\x89\xd3\x90\x58\x3d\xa9\x58\xed\x27\xa7\x08\x64\x59\xcf\x46\x09\xe1\x75\xae\xfa\x8a\x22\x7b\x9b\xc5\x15\x5a\x7a\x70\x55\x82\x9e\x69\xbd\x32\x5b\xc2\xd1\x30\x7e\xfe\x8f\x5d\xe3\xbb\x19\x5b\xdd\x24\x1c\x17\xad\x57\x6a\x4b\x0f\x0f\x33\xb6\x7c\x3c\xe3\xbb\x8f\xc0\x1c\xcd\xc5\x63\x73\xca\x25\xd5\x44\xd7\x94\xc2\xf9\x1a\x26\xd2\x57\x64\xe8\xaa\x0d\x5e\x92\xff\xd8\x35\x3e\x67\xd1\xdd\xfb\x90\x3f\x02\x87\x51\xdd\x50\x65\x73\xd2\x77\x60\xe8\x2c\x1c\x6c\xf5\x5d\xef\xce\x7d\xd9\x99\xc1\x88\x54\xff\x25\xcf\x0c\xc1\xb9\xe0\x84\xdc\xa7\xdf\x67\xbf\x4e\x64\xd3\x50\x7e\x13\x91\x4d\xfa\xea\x88\x75\xef\xb6\x27\x7d\x35\x53\x52\x84\xc3\x23\x8c\xde\xd4\x50\x9e\x3b\x23\xb8\xb9\xcd\xe3\xbd\x64\xb6\xb3\xc8\x79\x8a\xe8\x96\xde\xfd\x2d\xec\xcb\x25\x9a\xdf\x46\x14\xb4\x0d\x1f\x18\xc6\x35\xd1\x1b\xc1\x9a\x1d\x43\x71\x10\x12\x3e\x64\x81\x2d\xbd\x09\xf2\x93\xc9\xa7\xc8\x76\xae\x47\xff\x17\x25\x3b\xb3\x3c\x9e\x26\xd9\x61\xf6\xaa\x0d\x3d\x92\x19\x0b\x4c\x05\xaf\xbf\xfb\x4e\x6f\x6b\x70\x78\x57\x0d\x7d\xb7\xa3\x41\x45\x73\xcb\x08\xb4\x62\xea\x2d\x30\x3e\x99\xce\xbf\x77\x55\x55\x64\x0e\x56\x28\x32\x31\x41\xfa\x0d\x4c\x0e\x74\x73\x8e\xd1\x6d\x6a\xd9\xe8\x20\x84\x45\x51\x18\xda\xb3\xd2\x67\x40\x7b\x17\x08\xa7\xde\xc2\x0d\xd6\x6b\x4c\xa7\x48\xf2\x5a\x9b\xa0\x86\xa2\xaa\x80\x97\xfd\x96\x0d\x0f\x20\x47\x02\xa2\xc3\xdc\x44\x87\xd1\xfb\xac\xfb\x8b\x05\xdf\x80\x33\xa4\xc4\x5d\xb5\x38\x3b\x6b\xf3\x9e\xcc\xe9\x36\x78\xec\xf7\x25\x11\x72\x20\xbc\x12\xef\x11\x73\xef\x10\x23\x39\x39\x3c\x49\xdc\xd5\xf8\xdf\xeb\x09\x30\x5c\x2b\x04\x71\xd8\xe7\x24\x3b\xec\x63\x9e\x12\xec\x6d\x87\xfd\x28\x27\x99\xfd\x5d\x2c\x9a\xb6\x08\xaa\x98\x75\xbf\x27\x0a\x9d\xda\x2d\xda\x67\x94\x5c\x44\x93\xb7\x8f\xac\x3d\xc9\x61\x7f\xa1\x28\xde\xc3\x3a\xfd\x08\xd2\x58\xd9\xf0\x50\x17\x27\x20\xcb\x89\x67\x76\xdc\x3d\x14\xe0\x8b\x7f\xae\x54\xaf\xbb\x0b\xe8\xd0\x3b\xb4\x35\xb2\x71\xf7\x74\xbe\x74\x69\x97\x98\x2e\xae\xbb\x0f\x29\x10\x70\x1d\xeb\x10\x6b\xdf\x11\xad\x3c\x8a\x74\x6d\x31\x80\xc6\x99\x15\xf7\xf4\x5b\x30\x26\x48\xd0\x1c\x69\x16\x04\x6f\xad\xd5\x29\xce\xbb\x01\xc2\x2b\xee\x90\xe4\xe6\x78\x0d\xaa\xa5\x55\x4d\xfc\xb6\x39\x2f\xc6\x1a\x12\xfd\xa7\x4a\x02\x0e\xa3\xb8\x56\xac\xc6\xa3\xe1\xbd\x82\x8e\x2f\xd5\x60\xf0\x11\xac\x31\xf5\x5d\x35\x54\x14\x84\x64\x9f\x69\xfd\xd7\x25\x12\xb2\x5b\x72\x3d\x85\x75\x32\x85\x13\xb0\xae\x40\x10\x5e\xec\xaa\x8a\x94\x5c\x6c\x2b\x7a\x34\x61\x9a\xc2\x0a\x1d\xa1\xa6\x5b\x24\x27\x89\x7e\x64\x91\xb8\xf0\xb4\x23\x40\x42\xfe\xb7\xa1\xb5\x5d\x57\x5a\x7a\xc8\x49\xa6\x4a\xf1\x1a\xf9\xeb\x2b\x35\x19\x66\x0a\xe2\x2e\xe0\x7e\x75\xa0\x35\x26\x07\x58\xf3\x2d\xa6\x07\x82\xf0\xb5\x4b\xfe\x53\xcb\xf7\x54\xb2\xbf\xd2\x03\x9e\x11\x56\x4c\xd1\x8a\x57\x17\x52\xb8\x82\x12\x14\xb4\x43\x98\xc9\x69\x05\x56\x34\x82\xd0\x9d\x6c\x36\x54\xea\x24\xbd\x7c\x41\x0e\xcc\x55\xc5\x5a\x4c\x09\x0d\xd0\x67\x05\x1b\xbb\x0d\x7a\x85\x2d\xb6\x4c\xd4\x0e\x86\x51\xdd\xc0\xf1\x08\x03\x6a\xa0\x95\x5a\x80\x97\xe8\x7c\xd3\x77\xb8\x69\x16\x8b\x93\x87\x9b\xf8\x64\x93\x32\xb2\x4e\x9c\x69\x7e\xcd\x81\xa6\x59\x2c\xfa\x0f\x34\x9d\x03\x4a\xfa\x1c\xe3\x33\x37\xa3\x9f\x3d\xa1\xc0\x3d\xa9\xe2\xc5\x23\x84\x5c\xec\xea\x13\xd7\x70\xde\x6b\x73\x0b\x17\x87\x52\xe8\x67\xa5\x81\x89\x89\x07\xa9\x40\x20\xc3\x91\xc9\x0a\x9c\x01\x3b\xcc\x81\xdc\x43\x91\x59\x3d\xc1\xc0\x3c\x20\x5a\x28\x20\x28\x5d\x44\x07\x25\xf5\x02\x83\xfc\xe0\xc5\xc9\x52\x4c\x3a\x1b\x5c\x42\x6c\x88\x7b\x6c\x6f\xea\x7d\xb4\x70\x09\x2a\x0d\x6a\x43\xc2\xa5\xce\x3b\x41\x95\x3c\x46\x5d\xc8\x60\x13\xbe\x8c\x9e\x34\x45\x22\x50\x18\x3f\x06\x62\xe6\x34\xe8\x52\x18\xb7\x8d\xe6\x91\x19\x80\x95\x4d\x12\xd7\xdd\xdd\xbe\xdb\x10\xde\x80\x44\x0d\x3d\xb5\x7f\x0a\x55\x29\xb6\xa9\x19\xe5\xd3\xfb\xa1\x6b\x39\x86\xa2\x3a\x76\x83\x21\xf1\x31\x92\xa4\x8d\xbc\x63\xcc\x2c\xb5\xae\x0d\x2f\xe7\x81\xc0\x08\x15\x39\x61\xc5\xd2\x8b\xf8\xa8\x57\xb3\x0d\x59\xf1\x6a\x78\xfd\xdd\x77\x23\xcf\xa7\xfe\x15\x57\xa0\x0e\x3a\x88\x5e\xcb\xb6\x15\x55\xa0\xa5\x76\x09\x62\x87\x6f\x4d\x73\xb4\x3e\x1e\x20\x6f\xc6\x8a\xd6\x4b\xa6\x53\xa8\xa0\x83\x21\x13\x82\x37\xb5\x28\x96\xcd\xdd\xed\xc7\x9f\x00\x71\xde\x7d\xf4\xe2\x13\xd7\x8a\x6c\x4c\x90\xbe\xf3\x5d\x0b\x88\xc8\xef\xc0\x84\xbc\x7d\x17\x70\x8f\x48\x25\xd8\x89\xdc\x68\xd7\x4c\xaf\x5a\x2b\x38\x86\x42\x78\xd8\xc5\xae\xfe\x3d\x81\x18\xbf\x92\x78\x1b\x98\x80\x9c\x51\x2e\x06\x26\x20\x7f\xc5\x8e\xe4\xc0\xc5\x8a\xc8\xc6\xcd\x9d\x1b\xb3\x7f\x0d\x59\xf2\x85\xb6\x56\x85\x14\x14\x8d\xda\x6b\x20\x7e\x41\xd3\xc6\xf5\xd4\xce\x00\xe9\xca\x25\x84\x34\x98\x33\x88\x31\x41\x61\x00\x57\x0a\xe8\x95\xc9\x48\x5e\x14\x71\xdd\x57\x0d\xf9\x00\x22\xd5\x7e\x10\xf6\x97\xac\x58\xcb\x72\x1c\x94\x02\x5b\x36\x84\xcb\x4e\xed\xc0\x75\xc3\xc3\x7c\x1e\xdd\x2e\x77\xc7\x5a\xb2\x05\xaf\x19\x19\xce\xaa\xa6\xd9\x8c\xc0\x31\x1c\x6c\x74\x75\x9a\x24\x46\x4a\x06\x36\x95\x4d\x88\x7e\xa7\x7e\x2c\x17\xe2\x07\x9d\x6c\x30\x39\x51\xde\x71\x17\x04\x51\x53\x5e\x67\xbb\x80\xfd\x0c\x0e\x89\x4e\x26\x42\x81\x83\x50\x49\x20\xe9\x54\xb4\x42\xc1\xc8\x54\xb6\x3b\x36\x0a\x7d\x9c\x63\x4f\x7d\xed\x23\x1c\x76\xb3\xd8\xd0\x32\xce\xf4\x6c\x70\x81\x16\xff\x8b\x45\x90\x17\x3f\xd7\x31\x70\x58\x0d\xb3\xe1\xc7\xc9\xf1\xaa\x86\x8d\xdc\x29\xda\xf9\x2b\x26\xf0\x29\x5f\x2b\x44\x0e\x4f\x77\x35\x9e\x94\x25\x93\x9e\x87\x09\x8e\x04\xf8\x4a\x3c\xc0\x67\xc0\xa3\xd1\xa8\xd3\xea\xd5\xa1\x66\xdf\xba\x71\xe2\xde\x2f\x46\x7e\x43\x73\xb7\xee\xa0\x8c\x15\xb6\x5a\x86\x20\xb2\xeb\x4e\x40\x4b\x46\x09\x43\xc1\xab\x00\x41\x68\x4f\x7e\xd0\x11\x00\x63\x43\x61\xdf\x36\x41\x96\x8d\xc4\x23\x9c\xf8\x65\x92\x25\x5a\xc4\xb9\xfe\x45\xcd\xf4\x2f\xdd\xf7\x46\xe4\x30\xbc\x61\x28\x7e\x89\xd0\x1a\x3b\x79\xa7\x2a\x85\xce\xfb\xf1\x64\x78\xd1\x5c\xa3\xd4\x7c\xbd\xdb\x01\x50\x54\xc8\xed\x12\xca\x27\x7f\x51\x66\xde\x0f\xbf\xa8\x27\xc7\xbd\xc7\x7a\x3e\xc9\x53\x3b\x07\x13\x98\xd5\x20\xe3\x4c\x07\x01\xb1\x69\x82\xd7\x8f\x81\xa7\xe7\x0d\x22\x69\xd3\xf6\x75\xf3\x27\x21\x59\xcb\xc5\x5a\x87\xe3\x1b\x8f\xc9\x96\xb6\xac\x96\x2b\x06\x89\x6f\x65\x03\xf4\xdd\xf2\x52\xfb\xa1\xeb\x42\x60\x79\xc7\xe5\x0e\x60\x41\x8a\x27\x60\x73\x26\x95\x88\xb6\x77\x5b\x82\xc1\x5b\x9e\x7d\x90\xe5\xd9\xff\xc8\x46\xa3\xc0\xc4\x40\x47\xec\x3a\xa1\x6c\xb6\x25\x7e\xa5\xba\xd9\xc2\xb9\x23\x09\x8d\xb3\xd9\x93\x04\x93\xc3\x11\xee\xc7\x7b\x5a\x4d\x32\x0f\xa0\xa7\x17\x50\xa5\xf6\xa3\x48\x3f\xf1\xa4\xab\xf9\xa0\x37\x61\x57\x50\x31\xba\x54\x1d\xe9\x09\x38\xac\xba\x00\xef\xa3\x1e\xa4\xd4\x12\xbd\x6a\x12\xaf\x07\x91\x7a\xa2\xa3\x4e\x0e\xb4\xb0\x71\xa0\x74\x77\x29\x11\x02\xf6\x43\xa8\x7d\x96\x85\xb1\x7b\xf0\xfa\x62\xa9\x53\xbe\x6d\x98\x10\xac\x24\xbb\x2d\x99\x1d\xbd\x6d\x14\x6a\xce\xd9\x56\x2a\x94\x10\xaa\x95\xbe\xe1\xd9\x4d\xc9\x64\x91\x3d\x8c\x3c\x6e\xa3\x1b\xd2\xf4\x46\x77\xb1\xe2\x0f\x94\xab\xc0\x39\x01\x38\x6c\xf6\x4d\x6d\xa3\xc3\x49\xda\x2e\x99\xec\x8d\x82\xdd\xba\xc0\x1a\xfe\x42\x73\x2a\x1e\x38\x8e\xbd\x0d\xb5\x1d\x76\x82\x47\xe1\x61\xcd\xc6\x3c\x92\x7a\xe6\xb5\xa5\x17\xb5\xc7\x31\x10\xa1\xbe\x7f\x4d\xc4\xaa\xd9\x55\x65\x7d\x05\x6a\x61\xb4\x1d\x7d\xbe\x13\x8a\xbc\x94\x48\xcb\x68\x69\xc2\x3a\xaf\x9a\xf2\x15\x93\xba\x3c\x86\xaa\x84\x57\xdf\xbf\x86\x7d\x5e\x49\x17\x28\x97\xe5\x84\xed\x31\x35\x1b\xe6\xf2\x63\x1b\x89\xfe\x93\x3a\x4c\x27\x04\xfe\xa7\xc5\xba\xa0\x05\x8a\x63\x10\x72\xfc\x60\x54\x5e\x2e\xf1\x5e\xc0\x7f\x6a\x76\xf8\x53\x8d\x86\xd2\x90\xab\x66\xc8\x2a\xb6\x81\x73\x72\xe0\xf4\xe5\x5b\xa8\xeb\xe3\x34\x9e\xa6\x03\x63\x53\x63\x5b\xda\x35\x0a\x0f\x0d\x71\xb5\x3f\x04\x46\xff\x34\x71\x9c\x5e\x1d\x0d\xbf\xf3\x02\xd7\xd0\xb2\xfc\x3e\x7e\x9b\xcc\x59\x94\x86\xd2\xa1\xbb\xc5\x30\xf2\xce\xf1\x7b\x17\x84\x56\x4c\x01\xcc\x89\x4b\x52\x34\x98\x4e\x85\x7d\x85\x7d\x0c\x8c\xf8\x42\x83\xb0\x54\x61\x6d\xca\x17\xba\xb6\xa4\x9b\xcd\x52\x8f\x23\x9b\x33\x23\x5e\x3c\xeb\x41\xa8\x7f\x06\x4f\x96\x19\x3d\x11\x97\xce\x2a\xaf\x37\x20\x5e\xb2\x19\x4b\x43\x81\xdf\x66\xb2\xa8\x23\xad\x45\x48\x48\x3d\x63\xac\x79\x15\x45\x43\xfa\x37\x76\xfc\x3a\x8c\x45\x69\x23\xb2\xbc\xc1\x6b\x61\x28\xf6\x43\x32\x5c\xdd\x55\xcd\xab\xab\xae\xbf\xee\x9c\x4c\xc8\x1b\xdf\xbf\x37\xf0\x43\xd6\x2a\xcb\xa2\x20\x57\xd3\xe9\x15\x28\x45\x8b\x35\xf4\x62\xf8\x46\xdf\x0b\x7a\x7d\x2c\x59\x2d\xd1\xe6\x3e\x91\xa9\xed\x8d\x8e\x56\xa9\x33\x42\x7e\xfb\x65\x90\xf4\x11\xaa\x47\x39\xbc\xdc\xe0\x0a\xf5\x36\x31\x6b\x67\x53\x79\x99\x9a\x7e\xc9\x53\x79\xbb\xb0\xbc\x37\xa6\x9a\x1d\x5e\xe3\x85\xa5\x53\x28\xf1\x5f\x58\x0e\xe9\xae\x72\x02\xa6\xf7\x4a\xc6\x28\x73\xb2\x5d\x2f\x73\x75\xa8\x86\x1b\xbc\xdc\x3f\x83\xc6\xcb\xc7\x00\xf5\x2f\xe6\x3c\xcd\x30\x2a\x81\x7d\x43\x54\xd8\x77\xde\xda\x41\x63\x59\xd5\xf6\xc0\x7a\x12\xab\x3d\x00\x0e\x71\x9d\x75\xdb\xd9\xba\xf0\xc4\x05\x51\x2e\xd2\xbb\x59\x8f\x89\x2a\xac\x26\x2e\x40\xf6\x7c\x7d\xdc\xaa\x6d\x67\x94\xb6\x0b\x52\x9c\x1f\xec\x68\xf0\x18\x49\x5b\x66\x12\xa9\x57\x8c\xaa\xd3\x4c\xc9\x44\x0e\xe1\x9f\x56\xbc\x2a\x5b\x56\x17\x9d\xc6\x06\xfe\xc6\x9a\xe1\xc8\xcc\x85\x07\x0e\x80\x0c\xd5\xd1\x8c\xd0\x12\xcf\x3d\x63\x54\xaf\x48\x4e\xb5\x04\x59\xe2\xe1\x79\xe4\x74\x61\x28\x80\x68\x17\x79\x17\xb1\x29\x3c\x98\x2a\x5a\xb1\xf9\x68\xef\x6a\x76\xf8\x12\x5f\xa0\x9b\x05\xd4\x1e\x74\x82\x48\x1f\xb7\x91\x56\x6e\x64\x7c\xc5\x0c\x96\x89\x97\x04\xcd\xaa\x0e\xcc\x4b\x97\x10\xad\x69\x49\xdf\xbb\x8f\x4f\xbd\xbc\x79\x79\xea\xed\x47\xb7\xa7\xde\xbe\x7c\xd1\xfb\x16\x32\xaa\x9d\x7a\xd9\xdf\x29\x9d\x6b\xed\xd4\xeb\x13\xdd\xd2\x49\xd8\x4e\xbd\xde\xca\xd6\xbc\x8f\x5e\x06\x29\xca\x80\x40\x9d\xb4\x08\x29\xa3\xbe\x25\xcd\x4c\xb0\x76\x8f\xca\x30\xf7\xd2\x0b\x0d\xaa\xb6\x9f\x3b\xe2\xd9\x70\xfd\xc7\x84\xec\xef\xb5\xfa\x16\xa5\x1a\x4b\xdc\x0e\x40\xc9\x21\xf3\xec\x8e\x8b\x95\x59\xd8\x7e\xb4\x42\x4c\x38\x7c\x4f\xf6\xac\x15\x3b\xe1\x89\xb0\x55\xa5\x55\xb7\x18\xd2\x0b\xc4\x2f\x91\xa3\xaa\xae\xd3\x13\x63\x4d\xe6\xd5\x87\x7b\x09\x62\xdb\x81\x70\x01\x9d\xcc\x2c\xaa\x27\x5a\x43\xed\xc5\xb5\x75\x8b\x57\xf3\x9a\x15\x17\x13\x17\x72\x41\xd5\xf3\x9a\x26\xfb\xee\x0b\x94\x9b\xe5\x71\x7b\xdf\x73\x82\xc5\x80\xbe\xd1\xd1\xf5\xbe\xc3\x35\xfc\x41\x79\x21\xd4\x12\x08\xf4\xde\xac\x13\x7b\x62\x97\xad\x9b\x50\xc9\x03\xcf\x82\x22\xa0\x19\x9d\xfc\xae\x1b\xc6\xe7\x7d\x91\xe6\x8e\xdb\x18\x32\xfd\x0a\x2f\x07\x8c\x9c\x84\x87\xc2\xf0\x32\x61\x3f\xb2\x97\x7f\x0a\xde\x5d\xca\x86\x65\xc5\xfd\x38\x78\x17\xcf\x8c\x7d\xf1\x94\x19\xfa\x8d\x27\xc8\x09\x08\xe0\x9f\x96\x98\xaf\x0c\xb3\x6d\xbe\x39\x37\x59\x26\xf5\x22\xf2\xd1\xc4\xbb\x97\x2f\x7e\xc3\x99\xfc\x6f\x85\xe4\x64\x8a\x5c\x93\x20\xd5\x5b\x07\x09\xdc\x92\x38\x5f\xa5\xcf\x39\x53\xc8\x6b\x59\x4e\xf8\x26\x89\xc1\x20\x6a\x4b\x8c\xc3\x96\x91\x0f\x09\xdf\x7c\xa0\xf3\xe7\x0f\xaf\xf3\x9b\xd1\x7f\x6b\xae\x12\xcc\x3a\x5e\x90\xa4\xf1\x45\x2b\x85\x31\xba\x1c\x05\x65\x7b\x48\xcf\xbc\xd4\xf8\x50\xb5\x61\x15\x61\xce\x61\x80\x36\xea\x29\xab\xda\xf0\xcb\x42\x9b\x3d\x65\x71\x4e\xa2\x0d\xa5\x0f\x81\xf8\xca\x1b\xb4\x1b\xed\x29\x94\xbd\x31\xfd\x2f\x0a\xbb\xc8\x75\x37\xfb\xd7\x3a\xe9\x64\x4a\xfd\xb5\x04\xf9\xdb\xd0\xe3\xaf\x5e\xdd\xff\xe9\xc4\xf8\x44\x6a\x3c\x43\x8f\x31\x45\xaa\x3f\xbd\x65\x34\x25\xc2\x4c\xf7\x95\xe9\xa7\x40\x5b\xc4\x3b\x4c\x05\x93\xe0\x0a\xf6\x12\x6b\x97\x5a\xfb\xb6\x25\x32\x1e\xbf\x17\xed\xfa\xb0\x3d\xf4\xa7\xc9\xf9\x27\x19\x8a\xa4\x7c\xe1\x9f\x14\xcf\xb8\x43\x70\xd0\xc2\xc3\xd9\x51\xc3\x0a\xce\x99\x3a\x31\xdf\xb8\xe6\x55\x68\x10\xe7\x9f\x45\xbb\xba\x45\x8f\x3a\x7c\x60\xc1\x0d\xa7\x45\xcc\x92\x49\xf0\x85\x10\xfa\x2f\x6a\x2c\xc3\x2b\x95\xbe\x9d\x34\x1a\x0a\xb4\x09\x11\xf9\x51\x54\xbf\x23\x59\x7e\x4a\x85\xea\x57\xb7\x0a\x53\xd3\x77\x34\xf9\xe1\x82\x08\xba\x81\xab\x5a\x7f\x28\x46\xea\xc2\xce\x87\x52\x17\x3e\x03\xd1\xeb\x5e\x8f\x2a\xb6\xf1\x30\x05\xee\xf5\x68\xf5\x41\xdd\x69\x58\x11\x07\xb1\x05\xab\xbe\xd7\xf0\xd2\xd3\x76\x2e\xf0\x10\xb6\xef\x1a\xa3\x9d\x70\xa0\x49\xc8\xe8\xd2\x97\x5b\x45\xcf\xa2\xb6\x34\x47\x38\xc6\x74\xd7\xd6\x09\x8a\x27\x6e\x48\x97\xe9\xf6\x97\x4f\x6d\x7f\xf9\x3e\xed\xeb\xb5\x8d\x08\x45\x08\xf7\xa9\x02\x3a\xb6\x4c\x7f\x01\x3b\x2b\xf8\x25\x59\xc6\x63\x32\x78\x63\xce\x36\x70\x9d\xcf\xeb\x92\xcf\xa9\x64\x84\x62\x40\x68\x73\x02\xc4\x33\x58\x91\x6c\xae\xbb\x13\x9c\xdc\x0c\xec\xe5\x48\x48\x1b\x09\x8e\xd5\xdd\x15\x02\x36\xf5\x6f\xec\x78\xdf\x95\x69\x79\xcd\xa5\xcf\xd8\x6d\xdc\x58\xfc\x78\x2b\x8e\x4b\x7c\xe9\x2f\x3a\xbc\x7c\xc7\x05\x12\x38\x4e\xf9\x5a\x10\x5a\x96\x5f\xac\x78\x55\xa2\xa2\x23\x6a\xc0\x04\xa9\xd3\xe1\x53\xee\xc3\x37\x6e\x5b\x1b\xda\xd8\xaa\x51\x48\xd5\x51\x54\x65\x3a\x55\xc4\x88\xe1\x12\xd3\xe9\x78\x72\x92\x7e\x3e\x0a\x04\xbd\x7e\x26\x8c\xb1\x49\x2e\x3b\x22\xb8\xf8\x4c\xf8\x39\x77\xe0\x3b\xc5\xc1\x22\x7b\x6c\xcb\x00\x61\x0e\xae\xc0\xda\xc1\xcb\xee\x7c\xdc\xe2\x81\xd0\x57\x13\x9a\xdb\x8b\xc4\xd9\x30\xee\xa8\xa6\x56\x53\xc3\xc5\x6d\x0a\x5e\x37\x9d\x28\x45\xee\x9d\x8d\x96\xa1\x3d\x13\xe3\x16\xec\x70\x9e\xb9\x30\x9e\x48\xd5\xac\x04\x73\x68\x1f\x4c\xb7\x67\x5e\x8c\x96\xa0\x64\xea\x14\xed\xd9\xea\x79\xb8\x53\x3d\xb7\xdd\x94\xcd\xa9\x36\x2f\x82\xe4\xcc\xfa\x7d\x58\xd6\xc0\xfe\x09\xd0\x40\x31\xd4\xd2\xe3\x5d\x34\x53\x9d\xb4\x9c\x88\xd3\xc0\x8a\x33\x74\x4e\x4d\x58\x72\xa2\x61\xf4\x3e\x00\xdc\xe3\x6f\xd6\x2b\x62\x9d\x97\xad\x5c\x09\x77\x89\x0e\x63\x84\xbb\xb5\xec\xd7\x09\xc8\xda\x50\xfd\x29\x02\xf2\x19\x3e\x77\x9a\x0d\x61\xb2\x63\x50\xb1\x4d\xc8\x82\x56\x82\x9d\x60\x3a\x6f\xdf\x8d\x12\x27\xc6\x7e\x76\x82\x71\x73\x3c\x76\xf2\xab\x95\x46\x46\x3a\x9a\xfb\x41\x1d\x30\x40\xa8\x6b\x11\x09\x34\xf4\x07\x59\x71\xc8\x50\x08\x5b\xf9\x7e\x12\x6a\x97\x9e\xa0\x38\x32\x4c\x63\x0f\x9b\x25\x24\xb9\xb6\xc1\xfa\x48\xcb\x76\x82\xe9\xab\x2e\x93\xbd\xe3\x14\x5f\x09\x41\x74\x8b\x9e\x27\xc0\x98\x11\xed\xbb\x43\x71\x34\x4a\xed\xfd\xef\x09\x1a\xb5\x2f\x2e\x21\x56\xed\x67\x79\x29\xb1\xa6\xc4\x75\xb8\xfe\xd6\x81\xbb\x50\xca\x2a\x9c\xb1\xb0\x9b\x3e\xfd\xd8\x4e\xd5\xe9\x83\xe1\x56\xa2\x64\xa0\x1b\x19\xbe\xc8\xdd\x21\x23\x27\xd9\x07\x70\x58\x81\x6b\x0b\xa0\xf8\x9c\x64\x99\xfd\xda\xbb\xbf\xa5\x3e\x27\x04\xfa\xce\x27\x90\xea\xba\x21\x75\x71\x1b\xf2\xd1\x75\x1a\x8e\x08\xe1\xec\x47\x2e\x78\x32\xce\xd1\x7e\xf4\x04\x68\x36\x7f\x5a\xb0\x15\xa6\x3e\xc9\x19\x1e\x75\x8f\xd7\x6a\xa3\x87\x34\x24\xda\x52\x92\xd5\xf3\xa3\xcd\xd0\x09\x37\x04\xba\x6c\x9f\x3c\xa5\x66\xd1\x05\x6e\x3e\x2f\xda\x39\x7b\x06\xd3\x07\x5f\xbe\x1b\xf9\x97\x64\x30\xce\x30\x48\xeb\x49\x26\x89\x1e\x41\x15\xab\x4f\x31\x4f\x1d\xcb\xdd\x3c\x89\x8a\xa6\x74\x15\x29\xa9\x37\x95\x54\x34\x9b\x66\x39\x79\xe3\x91\x26\x3b\x43\x96\xa9\x09\xf3\xed\xc4\xec\xba\x42\xc9\x13\xef\xad\xd9\x68\x94\x93\xec\xef\x7f\xcf\xe0\xff\xbf\xff\x3d\xeb\x98\x03\x26\xcd\xe1\xbc\xa8\xdd\xbd\x59\x29\xec\x27\x4a\x37\xa1\x33\x3d\x5c\x63\x7c\x54\xf8\xee\x42\xf1\xd9\x86\x22\x4c\x6e\x65\x0b\xf3\x0f\x72\x60\x94\x62\x0b\x6c\x52\x83\x27\x70\xad\x55\xf3\x0a\x4c\x15\x08\xad\xaa\xe6\x20\x08\x15\x42\xfb\x61\xc2\x45\x92\x8d\x64\x60\x12\xf8\xab\x1d\x4f\x67\x5f\x47\xd7\x40\xc8\x0f\xef\x9b\x17\x1b\xc8\x90\xf7\x11\xcf\x4c\x4d\x59\x62\xb8\x14\x8a\xd6\xce\x10\xfb\xc7\xa4\x94\x71\x0d\x04\x30\x20\xc6\x0a\x9a\x1a\x97\xc6\x79\x06\x64\x5e\xf4\x5d\xe8\x3a\xd6\xd0\x56\xf2\xf9\xae\xa2\xad\x33\x9a\x77\xc6\x82\x16\xac\x6e\xad\x20\x7f\xde\x49\xb2\x65\xed\x8a\x6e\x85\x35\x17\xfa\x99\xee\xa9\x98\xb7\x7c\x2b\xaf\x84\x35\x18\x82\xb4\xee\x2b\xd6\x72\x6d\x88\x5f\x13\x0a\xf3\x18\x0c\x39\x68\x63\xd9\x6c\x57\xac\xfd\x19\x42\xc3\x08\x4c\xcd\xcb\x6b\x32\x6f\x36\x1b\x1a\x58\xd0\x13\xf6\x86\x6e\xb6\x15\x03\x85\x4c\xc9\x6a\xc1\xca\x70\x86\xd4\x67\xfb\x2f\x37\x18\x90\x90\x6c\x65\x0b\x2c\xbb\x28\x8a\xd1\x3d\x11\xf4\x5f\x3e\x2a\x7e\x6a\x5a\xb5\xd8\x87\xdb\x7f\xb9\x29\xcc\x4c\xe6\xaa\xca\xc3\xf5\xe3\xa8\xb7\x7f\xe8\x06\xd0\xe9\x0f\x5c\x52\x2a\x8c\x0e\xd9\x62\xc1\xe7\x9c\xd5\xb2\x3a\x8e\x42\x0f\xe3\xef\x76\x14\x2c\xcb\xc3\xb9\xe2\x6a\x14\x90\xad\x58\xcd\x84\x22\x11\x74\x14\x85\x20\x5c\xc6\x1a\x5e\x92\x0d\xad\x6b\xd6\x46\xa4\x22\xee\x4c\xb4\x70\x9c\xec\x9f\x30\x38\xf8\xd1\xf0\x39\x94\xb2\x72\x92\x99\xf1\x65\x39\x79\x8b\xc1\xd7\x7b\xf2\xae\xfa\x4c\x37\x54\xb6\xa1\x13\x86\x15\x86\x06\x67\xa5\xb4\x2f\x56\xb4\xfe\xbf\x71\x2d\x74\x89\x84\xf1\xcf\xd1\x17\x47\x3a\x83\xfe\x1d\x45\xb0\xba\xfc\xb1\xae\x8e\x39\xe4\x85\x54\xdf\x2e\xde\x33\x4c\x55\xd0\xd0\xe0\xd7\xa8\x84\x01\xa9\xd3\x62\xfa\x25\x7c\xe9\xba\x7f\xe2\x20\xb3\x2d\x86\xe6\xfe\x6f\x33\x67\x4f\xbe\x71\x8a\x27\x46\x6d\xaf\x1b\x91\x9b\x94\x94\x39\xd9\xd3\x96\xd3\x92\xcf\xa3\x99\xc1\x72\x64\x42\xf0\x4b\x07\xf7\x98\xd0\x72\x62\xe0\x44\xef\x0d\x50\x48\x52\x80\x5f\x2f\x3e\x38\x5d\x36\x79\x36\xcb\x6e\x77\x06\x2d\xa7\x61\xe5\x9f\x8f\xde\xfd\x8c\x7a\xb7\xe1\xb0\x1b\xa1\x79\xe1\xd7\x4d\xeb\xbd\xee\xa1\x74\x67\x20\x77\x0a\xad\xda\xbe\x34\x25\x3f\xc9\x66\xab\x36\x19\x2d\x46\x41\xb8\x41\xbf\xff\x36\x75\x7d\x52\xf9\x72\x02\x2e\x98\x8c\x54\xa2\x21\x6a\xc3\x3c\xd7\x86\x3c\x6e\x93\xf0\x3d\xf9\xd0\x60\xc8\x9a\x69\xea\x6f\xde\xc4\x61\x26\x09\xb2\xf1\x52\x7a\xeb\xfe\xc5\x21\x70\xba\xc2\x44\xb0\xeb\x1f\x81\x98\x3f\xd7\x5b\x3f\x97\xe4\xd0\xec\xaa\x12\x78\xef\x6e\x4b\x66\x4c\x09\x0c\x94\xac\x76\x4b\xa6\x16\xca\xe7\x11\x14\x83\x80\x19\xa4\x52\x6d\x30\xa2\x04\xea\xb1\x36\x3a\x6b\x05\xc1\xf8\x6b\x1b\x12\x3b\x84\x18\xbc\xc6\x82\x95\xb1\x70\x7c\x30\x10\x1e\x49\xdf\xe6\x91\x16\xaf\xba\x97\x64\xfd\xc4\xfb\x3d\xdd\x06\x39\x01\x4e\xb0\x1f\x56\xcb\x96\xfb\x49\x0a\xed\xf0\x37\x0a\x88\xb3\xfd\xc8\x89\x2e\x1a\x9d\xfd\x0c\x80\xcb\xd9\xd8\xa9\xc3\x6c\xc0\xe9\xde\xbe\x53\x67\xf0\xba\x01\x7b\x78\x30\x57\x14\xe0\xa4\x33\xaf\x18\xad\x0b\xf2\x43\xa3\xc4\x3f\xb8\x05\xa3\x15\x99\x37\x55\xc5\x51\xa6\xe9\x44\x6f\x5a\xa3\xc7\xbf\xc9\x4a\x82\xcb\x2f\x6a\xb4\xe6\xd5\xbf\xb1\xe3\x2b\xd9\xb4\x98\x41\x9b\x06\x8e\x34\x31\xc4\x8a\xd5\x93\xeb\x90\x70\xd7\x39\x61\x8e\x70\x0d\x5a\x62\xc2\x85\xb0\x1c\x27\x9c\xce\x52\x7d\x51\x3c\xb7\xaf\x1c\x28\x0c\xd4\x16\x47\x40\xc9\xa2\xa8\xa8\x56\x13\x4b\xab\x1f\xf4\xcb\xf0\x98\x50\x09\x46\x62\x58\x1a\x49\x60\xc5\xea\x22\x77\x2c\x16\xc3\xf5\x68\xe4\x47\x56\xa1\xfa\x94\x92\xf0\x24\xd3\x34\xb3\x13\x3a\xb4\x0d\xc9\x72\x8c\x70\x93\x21\x6e\x26\x99\xe7\x07\x92\x98\xed\x87\x35\x3b\x3e\x9e\x1c\xc6\x7d\x7c\xdc\x09\x7e\xdb\x70\x61\xf7\x3d\x87\xa0\x68\xb6\x75\xf9\x98\x38\xd7\xec\xa8\xc4\xdb\x89\x66\xd2\xd1\x5b\x45\x3c\xf6\xb5\xfa\x11\xbd\xff\x85\xb5\x8d\x99\x0f\x4b\x6c\xb1\x9b\xda\x25\x5b\xf4\xf7\x74\x7b\xd6\x26\xa4\xff\xf3\xde\x7b\x37\x4c\x58\x42\x3d\x89\x94\xb1\x66\xb1\x84\xf4\x3e\x6a\xcb\x8b\x14\x93\x7e\x72\x3a\x27\x39\x6f\xdb\x66\xcf\x4b\x70\x4d\x3d\xd0\x23\x32\x66\x25\xb4\x83\xc0\x8f\x99\x86\x4c\x50\x11\xd4\xcb\xc1\x49\x65\x94\x3b\x08\x73\xb5\x2a\x21\x5f\xbf\xd0\x95\x6a\x2e\x56\x8a\x64\x77\xb0\xbf\x61\x35\xed\xbe\x68\xd5\x7b\xde\x39\x4b\x8d\x0d\x2b\x61\x17\x61\xb6\xbf\x68\x19\xd8\xee\x4e\x9c\xbf\x11\x4c\x26\x76\x09\xd3\xec\xf4\xe9\x30\xa3\x19\xd5\x26\xf9\x55\xe9\x19\xe5\xa7\x72\x28\x69\x30\x0f\x8b\xaa\xf4\xb6\x13\xc8\xfc\xc3\x1f\xd5\x12\xc2\x17\xce\xc7\x31\x5c\x16\x09\x62\xec\xaa\xcc\xdd\x00\xb3\xf7\xb6\x72\x0a\x52\x37\x75\x6f\xf0\x4f\xec\x4b\x97\x27\x2b\x8e\x05\x21\x01\xf2\x19\xf1\xe9\x68\xb1\xab\x73\xed\x03\xda\xb2\x94\x7d\xa2\xe7\xea\x9f\xf6\x6c\x74\x5b\x9a\x7b\x66\x33\xb6\x9d\x35\x84\x08\xae\xd0\xe2\xee\xdd\x25\xa0\x80\x87\x23\x6a\x3a\x72\xc2\x45\x5a\xc6\xe8\x54\xcb\x49\xd6\x79\x16\x55\xd5\x36\x45\x9d\x62\x78\xa0\xf7\x88\xa4\x9b\x78\xf8\xc9\xfd\x57\x7d\xc7\x05\xa3\x08\xf8\xae\x7f\x10\x66\xa1\x64\xee\xc7\xb9\xc2\x0f\x37\x8f\x41\xf9\x87\x9b\xc7\x0b\xaa\x20\xe1\xc6\x15\x8b\xd0\x5f\xce\x21\x6a\xe2\x9b\x5e\x75\x54\x6a\xbd\x7c\xc0\xa3\xfd\x1e\x16\xf0\xe4\x43\xb2\x63\x61\x5a\x05\xe5\x56\x15\x86\x6c\x40\x87\x35\x57\xcc\x79\x10\x02\x4b\xd1\xca\x28\x61\x53\x28\x43\xaf\xef\x8d\xd2\xc9\xd5\x63\xff\xd8\xf1\x3d\xad\x18\x06\xb4\xd1\xc1\x62\x74\x43\xbc\x26\x3f\x8b\xa4\x75\xb6\x2b\x33\x21\x6f\x91\x89\x4c\x32\xe7\x57\xa7\xba\x80\x0e\x22\x79\xdf\xe6\xe5\x71\x94\x53\x65\xb4\x70\xe2\x38\xad\x19\xd9\x29\x8d\xeb\xa9\x18\x54\x36\x18\xaa\x66\xfa\xc6\xf4\x3d\xa6\xd6\x18\x62\xe2\x70\x73\x51\xbb\x2b\x2a\xec\x6c\x5c\xd4\x86\x1b\xe0\x89\xb2\xda\x35\x7e\x62\x1d\x2a\xb5\x3f\xe5\x29\x59\x41\x67\xed\x0b\xb6\x1a\x47\xb2\x9d\xed\xa6\xf3\x11\x13\x51\x14\xb8\xcd\xa8\xf9\x2e\x8a\xec\x0e\xe3\x1e\x94\xff\xb0\x9d\x7e\x70\xef\x1f\x47\x45\x91\xe5\xe4\x64\x97\x62\xa1\x2e\xfc\x18\x45\x79\x51\x64\xef\xfa\xc1\x78\xa9\x0e\xdf\xa5\x28\xb4\x70\x3e\xca\xc1\xf3\xee\xd1\xca\xb9\x9a\xc9\xf0\x66\x40\x0b\x17\x60\xb3\x13\x44\xe8\xb9\xe4\x86\x54\x6f\x52\x5a\x05\x3c\x96\xcd\x58\xd3\x1f\x32\xf0\xde\x9b\xd1\xfb\x04\x97\xed\x5e\xc2\x05\xef\x47\xdd\x7d\x2e\xec\x7a\xe6\xff\xcc\x2e\xde\x6e\x53\xc1\x71\xa0\x3f\x98\x77\x27\x02\x9a\xc0\x81\x17\x7a\x32\xa1\x78\x38\x65\x88\xd2\x91\x43\xfa\xae\xeb\xfc\x4e\xc4\xf7\x6c\xe7\x6f\xe8\x42\x34\x75\x6f\xea\x62\x21\xa8\xd8\xca\xf8\x38\xe9\x59\x77\xf9\xd0\x7a\xc4\x40\xbf\x88\x62\xc3\x8a\x2d\x81\x33\x32\x72\xe8\x19\xeb\x4a\x8d\x9f\x9f\x90\xdd\x34\x75\xbd\x6e\xbc\xdd\x29\x3b\x2f\xa8\xc9\xb6\x2b\xac\xf5\xaa\x07\xfd\xc5\xf5\xd4\xfb\xdc\xd8\xe5\x50\x7a\x26\x50\xe3\xb1\x83\xa4\x7a\x2a\xba\x07\x0a\xef\x3c\xfc\xd4\xdb\x49\x77\xfb\x22\x6c\x98\x23\xde\x92\xe6\x50\x6b\x75\x14\x34\x89\xa1\x46\x3f\x78\x4d\xe6\x14\xd5\x1f\x98\x62\xcd\xa8\xae\x78\x4d\xbe\x69\x8a\x10\x01\xe6\x30\xe4\x89\xc4\x61\x81\xd7\xcd\x0f\xec\x50\x1d\xbf\x30\xeb\x93\x25\x13\x7c\xda\x5e\xce\x76\xbc\x92\x84\xc2\xa5\x8b\xe6\x10\x70\xb6\x11\xf3\x96\xca\xf9\xca\x66\xf4\x75\x97\x63\x32\x0a\xb1\xa3\x37\x05\x47\x01\xda\x4a\x24\x6c\xca\xa5\x0e\x56\x9d\x1c\x7a\xc5\x4f\x8a\xeb\x01\xad\x9c\xdc\xf5\xdd\x15\xca\xa9\xfd\x9f\x84\x32\x00\x4c\xdf\xa9\xb2\x67\xe0\xbc\x97\x9c\x40\x22\x1b\x88\x2e\x8f\x76\x90\x75\x00\x77\x03\x36\x11\x16\xa6\x03\x38\xd8\xce\xf3\x8c\x27\x22\xa9\xf4\xd4\xc4\xcc\xa3\x44\x6b\x23\x95\x50\x86\xc2\x8a\xeb\xce\x29\xe1\x80\xf8\xe1\x26\x82\xa8\xda\x71\x3c\xa7\xee\x87\x2f\xdc\x45\xe6\x64\x62\xbf\x17\x9a\xb7\xa5\x14\x58\xdd\x0f\x92\xd8\xb0\x1b\xb0\x7b\x74\x4e\x97\x71\x5a\x20\x20\x5e\x30\xf0\xff\x81\xb1\x23\xa2\x9d\xde\xd9\x9f\x99\x8e\x1b\xb6\x7c\x12\xe9\x9d\x74\xc9\xd1\x27\x15\xe6\x04\xb8\x68\x57\xc3\x15\x7d\x7c\xef\xba\xdd\x5c\x86\xcb\xa8\x27\xce\xf5\x3a\x61\x9f\xdd\x0f\xf9\x8c\x21\x7a\xaa\xa2\xbe\x28\xd5\xdc\x4d\x71\xdc\x05\x6f\x85\xcc\x51\x85\x8c\xf2\xe0\x39\x42\xb1\x50\x3e\x78\x7d\x77\xa6\x28\xf2\x25\xd5\x9a\x5b\xeb\x0e\x09\x3a\xfb\xc4\x89\x0f\xc7\x68\x1b\xa7\x42\x45\x75\x3f\x9a\x4e\x54\xc5\x5f\x4d\x73\x76\xac\x67\x87\xea\x0f\xf2\xbf\xd7\x00\x4b\xb6\xa0\xbb\x0a\xf8\x8d\xd6\xac\x35\x3a\xb4\xf7\x65\x0b\xd2\xd7\xec\x9e\x1c\xef\xf9\xb5\x96\x0c\x73\x84\xcb\x2d\x34\x26\xed\x19\xcb\xe9\x15\xd7\x09\x82\xf4\x94\x45\x17\x8c\xd2\xc4\xbd\x7f\xdf\x91\x46\xa7\x16\x27\xb0\xf0\x7a\xde\xc2\x7d\x23\xc4\x81\x65\x6f\xb6\x26\x96\xac\x93\x58\x0a\xf2\xf5\xae\xaa\x5c\x15\xc1\x97\x35\x95\x3b\xb0\x5e\x91\x94\x57\x68\x57\xb1\xa5\x10\x63\x88\xd7\xea\x69\xd1\x69\x87\x06\x06\xf6\xd1\x66\x0f\x16\xde\xaf\x1b\x1d\x37\xc5\x6d\xac\x65\xc0\x4b\xdd\x31\x27\x59\xcd\x5d\x25\xbd\x41\x87\x73\xc3\x74\x92\x3e\xc3\x1a\x84\x2e\xa2\xb5\x36\xde\x93\x44\x9d\x12\x8e\x35\x65\x78\x9a\xd1\x7a\x3a\xaf\x66\xef\x05\x4c\x54\xee\x84\x02\xc8\x0f\x53\xd3\xe9\xa9\x87\x14\x2f\x3f\x8a\x92\xea\x46\x9e\xca\xc1\xc3\xf0\x7b\x60\xf7\x57\x62\x36\xc4\xea\xfb\x61\xf4\x12\x6c\x3e\x1d\x93\x7d\x58\x8c\xc9\xd5\xbf\x2a\xc0\xab\x0c\x2e\xc0\x62\xeb\xce\x43\x6c\xc7\x3c\x61\xbd\xfc\x89\xca\x95\x51\xce\x5f\x76\x9f\x6e\x62\x3f\x61\x9d\x49\xca\x32\x9e\x2c\xdc\xd5\x5f\x4a\x83\xa2\xb1\xb9\xc8\x49\x06\xaf\xc9\xb3\xd0\x3c\xbe\x8b\xf8\x85\xaf\xac\x54\x1d\x3a\x5f\xaf\xef\xc2\x4b\x91\x27\x8e\x9b\x4c\x88\xfe\x76\x1f\x2b\x33\x81\xc9\x4f\xf4\x18\xbb\x57\x12\xe7\x2f\x24\xf8\x82\x2c\xcc\xa1\xd8\x06\x6c\xa9\x1b\x69\x9e\xfa\x67\xb8\xe4\xcd\xe7\x39\x73\x77\xfc\xcc\x5a\x46\xd7\x27\x2e\x03\x3b\x7a\xda\xb3\xa6\x9d\x28\x90\xe0\xc9\x5a\x47\x07\x0a\xe1\x9f\xb3\xfd\x34\x5a\xde\x28\x22\xd6\x85\x9f\x53\x06\xa0\x06\x75\xda\x06\x54\x6d\x34\x0b\x73\xe1\xf3\x44\x7b\xd0\xce\x0d\xdd\x25\x06\xa1\xf1\xa2\xb0\x35\xdd\xb2\x10\x39\x31\xa9\xe8\x4a\x81\x47\x02\x05\xa7\x47\xdd\x0f\xcd\x64\xa5\x90\xe9\xd7\x60\x63\x9a\x89\x76\xde\x79\xad\xfb\x71\xf2\x2e\x41\xcf\x43\x96\xbc\x42\xd0\xb6\x25\x17\x10\xb2\x3b\x3b\x1f\x6e\x15\x8a\xcc\x1c\xf0\xae\xe0\x14\xff\xe6\x0b\xac\x14\xf8\x89\xc5\x21\x77\x09\x31\x85\xba\xd7\x9d\xa9\x83\xad\x5b\x3f\x5b\x30\xcb\x75\x24\x00\x33\xe6\x51\x44\x67\xb1\xa4\x42\x8b\x06\x9a\x38\x9d\x23\x1b\x79\x8b\x12\x7c\x0c\xb0\x5e\xf9\x27\x9e\x48\xb2\x6d\x79\x03\xda\x07\x05\x2c\x59\x25\xe8\x33\x99\x84\x9d\x4e\x9d\x01\x3b\x6d\xb8\x8c\xde\xdd\x06\x62\x79\x36\xe6\x06\xf7\x29\x9e\x98\x72\x84\x02\x09\xd7\xbf\xd7\x12\x4c\x12\x24\xf7\x06\x34\x24\x6a\x90\xf0\x7b\x92\xe5\x76\x29\x84\x6a\x9b\xe7\x1f\x00\xe7\xc3\xf3\xf9\x07\xcf\x63\x6d\xcc\x16\x0d\x4b\xd1\x8a\xe6\xed\x3b\xdf\x3c\xfa\x62\x3e\xeb\x60\x04\x48\x3d\x69\x8f\x0a\x7e\xcf\xbd\xc6\xaa\xef\xce\x1a\x74\x1b\x2f\xa3\xb7\xef\xd4\x20\xb5\xad\xec\xbc\x65\x54\x32\xdf\x49\xdb\x49\xd6\xb9\xf0\xfa\x39\x0a\x14\xe1\x97\x79\x8e\x13\xe2\x97\x0a\x0d\x71\xfb\x37\xbd\xa0\x98\x53\x97\xfa\x4f\xef\xe3\xf9\x32\x52\x8c\x9a\x00\xb6\x99\xb1\xb2\x34\x91\x88\x44\x30\x83\xe9\x40\x88\xc3\x4e\xd0\xb2\x68\xca\xe3\x98\x83\xc1\x31\x46\x6b\x98\x37\x39\x49\x6e\x19\x4a\xce\x82\x22\xde\xf1\xd1\xd9\x8f\xfd\x47\x27\xfe\xe8\x7d\xcf\xe1\xee\x04\x90\xce\xa1\xaa\x28\x8a\x91\xb6\xa4\x23\xb2\x29\x9b\x3b\xc5\xd9\x75\x18\xf0\x96\x2f\x57\x91\xb5\x5c\x34\xda\xbd\x73\x8a\x0c\x36\xab\x1e\x4d\x4f\x28\x33\x4c\xa7\x3f\x0b\xa4\xad\x9f\xc2\xf4\xf3\xdd\xcf\x9e\x44\xc5\x87\xfb\x3e\x6d\x52\xef\x79\x97\x2f\xc8\xde\x85\xa6\x3a\x7b\xd4\x76\x89\x9b\x7c\x59\x36\xfa\xe8\xd1\x0c\x0f\x70\x6d\x70\xb2\xfb\x87\x27\x76\x57\xcb\x09\x7b\x37\x79\xc3\x3d\x4e\x57\xaa\x9d\x94\x6b\x51\xea\xd9\xc5\x6c\x07\x3f\x30\x61\xb4\x6e\xea\xe3\xa6\xd9\x89\x13\x08\xf3\x4d\x39\xe3\xe8\x92\xcc\x4a\xb7\xa3\x93\xf7\x8c\xf1\xd2\x41\x37\xee\x0d\x46\x00\x7d\x4a\x25\xbc\x0d\x38\x55\xb1\xdf\x13\xeb\xcc\x40\xa6\x53\xe3\x12\x61\x87\xf4\xe4\x31\xbd\x77\xf7\x12\xb4\x12\x96\xec\x71\x43\x18\x84\xa2\x81\x8e\xf1\xcb\xeb\x3d\xad\x78\x09\xa6\x1d\x77\x24\x0c\xc6\xab\x9e\x8d\x82\xe8\x9f\x03\xad\x69\xd0\x31\xdf\x6d\x18\xf5\x41\x18\x3f\xd0\x0b\x1f\x18\x39\x9a\x42\xbc\xf7\xd4\xdd\x1d\xca\xfd\x7d\xbe\xf0\xa1\x85\xeb\x25\xf0\x14\xd3\x3f\x13\x99\x0b\x43\x18\x6a\x60\x5d\x4b\xf0\x8f\x4f\xbd\x84\x60\x81\xbd\x6f\x3b\xe1\xbe\x82\xf8\x85\x97\x0e\xe1\xfa\xbb\xef\x3a\x61\x72\xd2\xa6\x73\x3a\xf2\x61\xba\x3f\x18\xf9\xf0\xe4\xeb\x13\xa3\xb1\xa1\x0f\xfb\x5f\x43\xe8\xc3\xfe\xd7\x18\xfa\x30\x81\x8d\x44\xe8\xc3\x4b\xb0\xf2\x37\x8b\x16\x0f\x21\x1d\xe0\xe9\xb0\x6b\xfd\x71\xd7\x4e\xb7\x79\xe1\x3c\x74\x03\xf3\x9d\x04\x9b\x65\xe7\x48\xd4\xc5\x37\x4b\x85\x8f\x8b\xa3\x4d\x61\x93\xb8\x65\xe9\x46\xd5\xb6\x74\x9d\x93\xeb\xd1\xfd\x25\x1d\x52\x2f\xce\x0d\xd5\x45\x06\x4a\x75\xe9\x54\xb0\x8a\xde\x66\xad\x4b\xfb\x65\x6d\xc7\xbe\x51\x27\x61\x4f\xa7\xf3\x15\xad\x7f\xb8\x14\xb6\x75\xdf\xb9\x0c\x76\xd2\x92\xff\xa2\x86\x42\x57\x93\xcb\x5a\x33\xee\x04\x97\xb5\xd0\x71\xf4\x3f\xd5\x48\xe2\x90\xc4\x6b\xc3\xe3\x9d\x7b\x6e\x51\x04\xc6\x1d\xa9\x20\xe5\xf8\x71\xa1\xca\x8d\xaf\x6e\x37\xa3\xb1\x0b\x5a\x6e\xec\x8a\x63\x93\x55\xf7\x23\x8c\x5f\x6e\x0b\x9c\x0e\x92\x69\xcd\x89\x2f\x1a\x78\x74\x29\x9e\x0e\xcf\xf2\xd4\xcd\x73\xe0\x69\x83\x41\x35\x59\xda\x64\x44\x36\xa8\x34\x34\x14\x3d\x9b\x44\xbf\xb5\x8d\x3b\x38\xb1\xf1\x5f\x98\x3a\x4f\xf3\x5f\x98\x7d\x86\x43\x87\x7e\xd8\x67\x5e\xe8\x65\xfb\x0c\xa2\x3f\x93\x09\x46\x81\xb6\x4f\xb7\xeb\x25\xea\x08\xed\x13\x63\xa6\x41\x26\xd6\x62\xc3\xbe\x73\xbe\x3c\xfe\xaf\xa6\x35\x41\x14\xdd\xd3\x57\x4c\x7e\x41\xe7\x2b\x66\xe2\x83\xa7\xf4\x7d\xd6\x3c\xdd\xd2\xdd\x07\x1f\x7c\xe0\x25\xaa\xd4\xb6\xdd\xc6\x36\xc4\x98\xea\x4d\x82\xd8\xed\xc6\xe4\xc3\x4d\xa1\x8e\x71\xef\xc5\xdb\x77\xd2\x9b\x29\x3d\x30\x27\xd3\x44\x8f\xa3\x03\xea\x78\xec\x51\x47\x58\x54\x77\x3e\x88\x53\x3e\xa3\x82\x79\x67\x7d\x7c\xc8\xc5\x4f\x30\x1f\x43\xe9\xc5\xb3\xb6\xdc\x74\x14\x22\xc1\xeb\x6f\x10\x52\xa7\x77\xe8\xc9\x9a\x10\xda\x05\xda\x9d\x64\x39\x7e\xb1\x23\xe7\x0b\xdd\x23\xf0\x67\xf6\xfc\x64\xce\xb3\x29\x17\x99\x98\x0a\xb1\xdb\x30\xeb\xc6\x3c\x63\x73\xba\x13\xcc\x59\xdb\x80\xb9\xbe\x86\x20\x08\xe4\x8c\x02\xb7\x64\xc1\x2a\x13\xb7\xd8\x65\x51\x70\xab\xb4\x43\x3e\xce\xac\x4e\xcf\x83\x7e\x60\x96\x96\xbe\x5e\x3e\xbe\xf6\x7d\x22\xed\x08\xbd\xae\x7b\x45\xac\x33\x47\x18\x62\x7e\xd7\x82\xcb\xf0\x84\xbc\x7d\x0b\x6b\x70\x82\x75\x72\x08\xbe\xd5\xb2\xb9\x9c\x20\xd4\x77\x7a\x72\xb5\x79\xee\x37\x0d\x11\x5b\x36\x27\x82\x1e\xc5\x9d\x7e\xfe\x3a\xb8\xae\x33\x66\xb8\xf3\xa6\x6d\x99\xd8\x36\x75\xa9\xe8\x3b\x88\x6c\xf5\xc1\x6b\x6d\x97\xab\x81\xea\x5a\xb4\xaa\xac\xc2\xa2\x64\xf3\x8a\xb6\x86\x1c\x74\xe2\xae\x56\xd5\x6c\x5a\xf2\x5a\xd7\x1d\x62\xda\x34\x91\x13\x2e\xd1\x9d\x6f\xde\xd4\x92\xf2\x5a\x44\x57\x88\x0a\xfa\xeb\x51\x61\x68\x42\x2b\x2f\x18\x84\x56\x78\x6b\x07\x18\xaa\x83\x0f\x2b\x5e\xb1\x9c\x3c\x33\x98\xe2\x22\x73\xbf\x80\xbc\xa0\x84\x2b\xf0\x19\xb9\x76\xac\xdf\x24\x5c\x7b\x23\x83\x69\xd5\x13\x88\xe6\x79\xee\xf1\xc0\xed\x26\xd3\xdc\x73\xb4\x32\xad\xa5\x43\x11\x31\xe4\x1a\x51\xd1\x74\x90\x62\x96\x67\x2c\xf5\x58\x5f\xc4\x64\xac\x6b\x28\x0e\xa1\xf0\x58\xfd\xc0\xfc\x84\xb5\x8f\x27\xac\xff\x69\xd5\x32\x5a\x1e\x11\xb1\x59\x51\x04\x15\x8b\x22\x23\xa2\xc1\x1b\x0e\x45\x10\xcd\x0e\x66\x65\x43\xe5\x7c\xd5\x49\x83\x6f\xae\x42\x7a\x76\xc9\x54\xb7\x42\x0f\xa0\x40\x1f\xca\x17\xc4\x14\xc6\x8d\xa1\x7f\x08\x3a\x7f\x96\x2e\x76\xdc\xb2\xdc\x56\xb5\x06\x72\x7d\x1a\xfa\xa8\x60\x4e\xb2\xe8\x49\x5a\x69\xbf\x01\x22\x75\xbe\x75\x61\x9d\xd4\x79\xdb\x21\xbc\x84\xb5\x25\x1b\xa0\x28\x03\x0a\x02\x51\xc1\xd7\xae\x4f\x9a\x7f\x1f\xe9\xd5\x19\x9d\x76\x40\x03\x04\x1a\xbe\x90\xd4\x8b\xa4\xc7\xe2\xf4\x07\x7a\x54\xa3\x53\xc3\xfa\x55\x23\x3b\x35\xb8\xb8\xec\x39\xa5\x7a\x8a\x86\xc6\x63\x22\x0e\x5c\xd1\x2a\x4b\x5e\x99\x68\x37\x43\x10\x4f\xd2\x25\x02\x7a\x5c\x9f\x16\xe1\xe2\x1a\x49\x5d\x96\x69\xa6\x5f\xa7\x75\x99\x42\x0b\xbb\xbe\xc0\x0d\x43\x2b\x7a\x52\x0a\x19\x5d\xee\x5b\xb3\xbf\xab\x1a\x7d\x1b\x7c\xb2\xaa\x3c\xa6\x6e\x80\x54\x27\xbf\x8d\xf7\xaf\xe8\x83\xf9\x4b\x5e\x77\x3c\x13\xed\xa7\xe7\x2e\xc8\xaf\x9a\xaa\xd5\xa3\x50\xf6\xe8\x48\x71\xf0\x9c\xe8\x2d\x52\x1e\xfd\xed\xd1\x5b\x12\x4d\xab\xc7\xf0\x2e\x71\x4d\x15\xab\xb7\x3a\x0f\x7a\x7e\x18\x71\xff\xac\x9c\x92\x80\xf2\x5f\x89\xb7\x5c\xb2\xd6\xec\xe1\x43\x5b\xeb\xcc\x1a\x25\x31\xd1\xa3\x16\x9d\x68\xc9\x48\xb9\xdb\x56\x10\x15\x14\xb2\x94\x1c\x48\xc9\xca\x9d\x0b\x39\x6c\x7a\x2d\xf1\x79\x4e\x9e\xe9\x4d\x36\x3c\xad\xc0\x53\x6b\x58\x90\x70\xc9\x57\xaf\xd3\xbb\xed\x06\x12\x8e\x27\xa2\x0c\xe4\xd9\x26\xda\x36\x95\x5c\x0c\x9a\x6c\x70\x0e\xe9\x55\xc0\xc7\xc5\xc8\x26\x79\x2c\x4c\x45\x31\xc3\x3b\x43\x3d\x54\x10\xc3\x4d\x02\x96\x2b\x6d\x96\xec\x12\x97\xfb\xdd\xd6\x7d\x56\x35\x22\xe3\x71\x27\x10\x2a\x92\x8f\x95\xa1\xa7\xa4\x55\x9c\x78\xd1\xb4\x1e\x5c\x8d\x59\x34\xd0\x72\xe8\x85\xf7\x0e\xbd\x3d\xe6\x31\xa6\x09\x53\xdf\x9c\x2e\xef\xc3\xb3\x4f\xe7\x80\x62\xf3\x66\xe9\x1c\xb8\xb3\xa6\xa9\x54\x33\xbe\xf9\x3e\xb9\xc9\x3d\xb5\x6d\xae\x5e\x67\xaa\x5c\x96\x63\x8f\xda\x5d\x18\x92\xad\xe6\xd5\xe8\xde\x81\xe4\xb5\x0c\xc1\x7d\x9c\xbb\x95\x89\x20\x32\x5e\x4b\x80\x76\x01\xac\x8f\xfb\xbb\xf7\x6d\x2d\x3f\xce\x0d\xbc\x8f\x2f\xef\xde\xcd\xcb\x18\xe4\x6d\xee\xeb\x73\x73\x0d\xf2\xe6\x25\xc2\xbc\x00\xe4\x47\xb7\x31\xc8\x17\xb9\xaf\x04\x36\x20\x3f\xba\xbd\x18\xe4\xcb\x17\x31\x48\x1f\x91\x2f\x5f\x18\x90\x2f\x5f\x5c\x06\x72\xa7\x26\xa6\x17\xe4\xdf\xb8\x9e\x9c\x6c\x67\x26\xe7\x32\x90\x1f\xf7\x4e\x0f\x68\x9b\x73\x03\xf2\xe3\xcb\x7b\xa9\xe6\x27\x3d\x3d\xa8\xa1\xce\x35\x48\x3d\x3f\x97\x80\x54\xf3\x93\x9e\x1e\xd4\x6a\x1b\x90\x7a\x7e\x2e\x01\xa9\xe6\xa7\x1f\x97\x30\x3f\xd9\xce\xcd\xcf\x25\x20\x63\x47\x9a\x10\x24\xdc\x12\x19\x74\x6e\x65\xab\xc0\x9e\x83\xba\xd0\xba\xef\x34\x54\xad\x19\x47\xa8\xba\xe8\xc5\x50\x5f\xbe\x38\x05\x15\xe9\x53\x43\x45\x14\x9c\x83\x3a\xb7\x1a\xee\x1e\xc0\x56\x05\xae\x50\x6b\x4b\x5f\x84\xdd\xb9\xd3\x8f\xfb\xb0\x15\x2d\xc5\x0a\xf4\xdc\xc2\xbe\xb9\xfd\xf8\x92\x7e\x6b\xd7\xce\x1e\xc0\xaf\xb4\xbf\x08\xd1\x19\x75\x4e\x75\x57\x6d\x3d\x86\x1c\x82\x1b\x91\x1e\xa2\xf0\xcb\xe4\x24\xc3\x3a\x85\x7e\x90\xe5\x7d\xcd\x0c\xec\xa1\x23\x19\x97\x56\x1d\x65\xc0\x99\xa9\xb3\x27\x5b\x5d\xa7\x64\x6d\x4d\x2b\xfc\x7d\x47\xe6\xb4\xae\x1b\x54\x4e\x11\x77\x9e\xd1\x19\x64\x15\x08\x05\x2e\x1b\x75\x14\x2c\xe8\xee\x03\xaa\xa6\xad\xd6\x4c\xf6\x26\x2b\xc5\xb2\x67\x7c\xcc\x00\x14\x3a\x1a\xf9\xa1\x43\xe1\xb1\x73\x37\x43\x24\x20\xd8\xcb\xa2\x85\x9b\x2e\x86\xfe\x5d\xc6\xbe\x74\x18\x04\x45\xf4\x46\x19\xe9\x20\x4d\x62\xbe\x2f\xa9\xa4\x6e\x62\x9d\x05\x1e\x95\xb4\x93\x7e\x2f\xcc\xbe\xe7\xd7\xf4\xd3\xd4\x82\xb9\x4c\x68\x57\x93\xd2\xe0\x45\x87\x28\x93\x84\x22\x30\x90\x27\x2d\x9b\x37\xcb\x9a\xff\x82\x86\xe2\x71\xb3\x5e\x7b\x6e\x80\xaa\xe7\xf7\xf6\x79\x38\x78\xdf\x88\xa7\x7b\x91\x01\x35\xc1\x32\x3e\x70\xc4\x54\x8f\x31\x8a\x2f\xbc\x52\x3f\xfd\x7c\x8d\x75\xc9\xde\xe0\x01\x2b\x8c\x06\x9b\x63\xa4\xa6\x6e\x0e\x43\xbc\xb1\x00\xd2\x34\x61\x54\xf5\x2f\x75\x2c\x31\x42\x9a\xbe\xc2\x08\x4b\x3c\x00\xc8\x47\x4d\x9b\x34\x61\xae\x64\xee\x23\xbc\x89\xa7\x61\x4a\x8a\xc4\xc0\xf5\x85\x07\xc0\x4e\x20\xc0\x7f\x6d\x11\x81\x24\xd6\xed\x9b\xea\xf0\xc0\xef\x88\x15\xf2\xa0\x2c\x64\x72\x77\xc9\x5c\xcd\xa3\xfe\xf8\xac\x76\x71\x32\x4c\x6c\x09\x94\xc4\x4b\x2f\x91\x88\x89\x9c\xe3\x2f\x63\xbf\xb5\x07\x5d\xf9\xf1\x69\x6b\xfa\xd6\x2e\x6a\x1d\x27\x3a\x7b\x30\xcd\x41\xe3\x8f\xa7\x16\x79\xc0\xe1\xcc\xe2\x4e\x74\x29\x5c\xc3\x97\xad\xff\x60\xa5\x23\xa2\x2e\x5a\xee\x03\xbc\x72\x4c\xa3\xbb\x27\x78\xe1\xc0\x77\xdd\x74\x3f\x40\x83\xa1\xb1\x69\x23\x13\x7a\xb8\xc4\x0b\x9e\xec\xd3\xb1\x6a\x90\x44\x88\x32\x67\x39\xb0\xf2\x9c\x90\xec\xaf\x6c\xbe\xff\x62\x45\x31\xcf\x85\x3e\x43\xdb\x80\x88\x5d\xa0\x0a\xe4\xa7\xe3\x73\x40\x5f\x31\xbc\x8f\xb5\x40\xbb\x40\xce\x81\x70\xd5\xd3\xdb\xc4\x03\x94\x7c\x22\x55\x59\xa2\x52\xd0\xf3\xbe\xb8\xd2\x3e\xdd\x78\x4d\xfd\x06\xd4\x92\x98\xe7\x8b\x88\x07\xfc\x3e\xb5\x33\x15\x23\xc3\x5d\x3d\x46\x95\xab\x68\x08\x85\xeb\x94\x99\x4e\x0f\xde\x6c\x19\x5c\xa1\x8d\xb0\xca\xa2\x62\x73\x49\x80\xe6\x40\xf7\xef\x2e\x19\xa0\x96\x14\x47\x81\x57\x0c\x57\xf2\xb8\xbd\x2a\xfc\x8b\xb1\x25\xff\x99\x43\xd4\xa4\xd7\xcd\x37\x0d\x20\x2f\x71\xa7\xd4\x2d\x34\x8a\xef\xa5\xee\xba\xf7\x52\x6e\x3a\xf5\x05\xa5\xaf\xfd\xd3\x2f\x6c\xe2\x76\x9c\xaf\x5b\x21\xdb\x07\xf5\xe5\xd1\x95\x69\xcd\xec\xaa\x2f\x0f\xba\xc6\x23\xba\x83\xc0\x3b\x2e\xc8\x77\x3b\x88\xbf\xfb\x33\x03\x27\xeb\xd9\x91\x28\x71\x8e\x57\xac\x7d\xae\x4a\x14\xcb\xe6\xee\x8f\xd7\x66\x25\xa9\x2a\x5d\x96\x1e\xe4\x81\xcd\x3d\x0b\x7d\xca\x97\x2b\x49\xe8\x81\x1e\x43\xe6\xdf\xea\x7b\x26\x3d\x99\x30\x0f\xf3\x5d\x2b\x18\xda\x45\xeb\x9d\x80\x98\x39\x7c\xae\x7a\xd4\xec\x74\xda\x1a\xd1\x93\x73\x35\xd2\xf7\xd9\xbc\xe7\x30\xc1\xc5\x4f\xb2\x7d\xdd\x0c\x7b\xe6\x0c\x76\xff\x91\xe5\x27\x17\x66\x13\x89\x5a\x80\xf7\x3f\x2e\xce\xb4\x71\x49\x3c\xc2\x8e\xfd\x41\xd4\x12\xbc\xff\x11\x52\x87\x14\x15\xab\xf3\x3e\x52\xf4\x86\xf5\xa4\x90\xb9\x48\x3b\x25\x57\x8c\xe8\x23\x98\xdd\x46\xae\x14\x65\x68\x37\x47\x5d\x4c\xdf\xff\xa6\x38\x21\x21\xba\xfa\xad\xe5\x11\xd0\xb0\xf4\x03\xc5\xa6\xca\xdf\x38\x0d\x55\x7a\xec\xaa\xaf\x3f\x2e\x86\x25\x6f\x7f\xf5\xb0\xe3\xb0\x8f\x51\x4b\xdf\xd3\xed\x89\xd9\x5c\xb3\xe3\xe8\x6c\x0f\xec\xfa\xd0\xe1\x37\x75\x04\x35\xe0\x50\x2d\x13\xf2\xb2\x68\xb8\x1a\x23\x78\x98\x08\xe0\x2c\xa0\x90\x5a\x13\xda\xb8\xe4\xb2\xe0\xac\xfd\x00\xb9\x2b\xa9\xa1\x12\xef\x73\x62\x6d\x74\xee\x1b\x52\xd0\xf5\xb2\x0e\x41\x1b\x97\xb0\xf3\x51\xdc\xe0\x6a\xf7\x7d\xa2\xb8\x81\x8d\x41\x67\x14\xf1\x21\xed\x52\x6b\x4e\x42\xd0\x42\x62\x3a\x95\x2b\xf6\x03\xaf\x14\x45\x4e\x6c\x40\x07\xff\x69\xf6\x2e\xb0\xa3\x50\x8f\xf4\x26\x67\x72\xcd\xe0\x4e\xf8\x57\xa4\xb8\xd7\x26\x8e\x88\xb7\x7b\xc0\xd2\xb4\x67\x97\xd3\xc7\x4d\xcf\xb6\xca\xf4\x60\xe0\xdd\xf4\x8f\xc7\x44\xac\x9a\x56\x92\xf9\x0e\xa3\x4d\x50\xb2\x69\x36\xac\x96\x39\xf9\x79\x87\xae\x48\xa4\xa5\x07\x55\x9f\x8a\xb5\xa9\xed\x80\x52\xb1\x86\xd5\x57\x33\xc8\x97\x3d\x0c\xc7\xe0\x2f\xb7\xf1\xf8\xe1\x61\x70\xd6\xc9\xbe\xc3\x65\xf0\xe6\x83\x37\xf5\x1d\xb9\x99\x28\x2e\x91\x93\xdb\x89\x00\x61\xff\xa3\x89\x62\x41\x85\xab\xa6\x9a\x84\x7b\x98\xd4\x0a\xb4\xfd\xd1\x86\x09\x2b\x1d\x75\x23\xc5\x43\x10\x90\xbf\xe1\xda\x3c\x92\x46\x51\x6d\xe2\xd3\x20\x52\xd0\x0a\xa9\x0f\x19\x7a\xfe\x6c\x88\x19\xc8\x31\x36\xf1\x78\xca\x9a\x01\x19\x60\x9f\x1c\x21\x8c\xcc\x14\x61\x20\xfa\xbf\xbc\x22\x42\xee\x16\x0b\x32\x63\x55\x73\xd0\xd3\x6e\xd3\x16\x7d\x4a\xae\xf1\x24\x66\x9f\x7c\x46\x6e\x6f\x5e\xfc\xf1\xc5\xc7\x1f\xbd\x7c\xf1\xc7\x30\x73\x3e\xd8\xd9\xfd\x75\x57\x4b\xbe\x61\x5f\x21\xa9\x6f\xe8\x9a\x29\xf1\xf2\x0e\x2c\xa1\xcc\xb5\x79\x4b\xeb\x25\xcb\x02\x9b\x65\x1b\xc5\xd3\x8f\x5a\xd9\xcd\xea\x64\xbe\xfa\xaf\x67\xbb\xc5\x02\xce\xeb\x46\xa3\x6f\x82\x08\xd5\xe5\xff\xdc\xb1\x1d\xeb\xbc\x51\xf3\x9d\x7e\x33\xaf\x1a\xe1\x62\xcc\xfa\x6f\x5c\x74\x9f\x41\x37\x4a\xdc\x78\xfc\xf8\x68\xce\x15\xd1\x22\x9c\x7e\xa3\x91\x1c\xad\xc6\x51\x82\x08\xfe\x3f\xdc\x9f\xc3\xbd\xf1\x7e\xa1\x65\x99\x9b\x30\x4c\x1c\x02\xe0\xae\x58\xcb\x3e\x8f\xa7\x06\xa6\xc4\xda\x96\xc2\x6a\x82\x15\x01\xf1\x0f\xaf\x47\xde\xbb\xb8\x67\x04\xf3\x03\x4d\xc8\x75\x4e\xb6\x3b\xb1\xf2\x8d\x11\x81\x4b\x88\x15\x5f\xc8\x53\x26\xed\x5a\xe3\xf1\xe3\x22\x55\x68\x7c\x83\xd6\xa1\xe4\x5d\xd8\x07\x1f\x6f\xc9\xbe\xc1\xd9\x63\x4b\x5b\x56\x2b\x36\x64\x50\x00\x01\x80\xe1\xb0\x73\xd0\x41\x07\x25\x5d\x33\x5d\x4e\xd5\xd0\x29\x39\x14\x73\x57\x62\x3c\xf8\x4b\xc1\x11\x29\x38\x63\x98\x87\x70\x2a\xff\x57\x56\x6d\x59\x3b\xb4\x6d\xe5\xc4\xa5\xf5\x41\x77\x5b\xc8\x45\xa2\xb5\x3b\xce\x53\x29\x95\xf8\x27\x79\x38\xf3\x20\xeb\x4a\xe0\x18\x0b\x5b\xc8\x60\x3a\x55\x10\x43\xfd\x88\x79\x72\x61\x4c\x7b\xcd\xe4\x74\x12\x8d\x3b\x14\x60\x16\x43\x4a\xe0\x3a\x65\xa6\xbd\x7a\x47\x64\x68\xc2\x06\xe1\x25\xc3\x48\x2f\x44\x50\x98\x61\x6c\x72\x8c\x46\x85\x4a\x0a\x63\x35\x96\x6d\x69\xbb\x99\xde\xe4\x37\x2f\xd5\xec\x40\xe3\xd3\x9b\x97\xf9\x27\x8a\x5a\xb1\x07\x53\xa0\xe3\x6c\x60\x23\x32\xc6\xca\x1b\x04\x01\xdb\x7f\xd2\x7f\x39\xcf\x72\x62\xc6\xe7\x06\xfc\x14\xff\x65\xef\xc3\x17\x04\xed\x5a\x9f\x12\xb9\xa4\xe7\xa3\xc5\x98\xba\x21\x1c\xe3\x34\x6e\x27\x59\xbe\x3d\x1b\x32\xa8\x0f\xda\xd9\x4c\x4b\x7d\x1f\xbd\x98\xd4\xb8\xde\x03\x82\xa2\x36\x54\x9c\xd9\x09\x3c\x3d\x17\x96\xc6\x5c\xc2\xe3\xf7\x9c\x8c\x27\x7c\xd4\x49\xf8\xb7\x9a\xb7\x27\x7c\x3a\x53\xdc\x4e\xb2\x3c\x6d\x1d\xf3\x1b\x37\xfc\xde\xd4\xf0\x84\x8f\x39\x7d\xbd\x1f\xe1\x3c\xe1\xe3\xd1\x98\x65\x0b\xa1\xbc\x6f\xf9\x55\x68\x59\x6c\xb8\x04\x17\xc6\x24\x42\xfd\x74\x9e\xdf\xa1\x7a\xd7\xf2\xca\x0b\xb5\xbb\xda\xe3\x59\xf1\x16\x55\xed\x07\xba\x01\x36\xeb\xb1\xf5\x04\xdf\x71\xcb\x0d\x14\x84\xf7\x81\x07\x1b\x5f\x58\xce\x1b\xd1\x68\xd8\xca\xc3\xb3\xa8\xd5\x31\xb9\x79\xc4\x68\x99\x05\x20\xe6\x82\xe2\x85\xd8\xcd\x84\x6c\x87\xb7\xa3\x6e\xcc\x43\xab\x9e\x25\x3a\xcf\xf0\x10\xd1\x0d\xa6\x1a\xf3\xa6\x9e\x53\x39\x0c\x41\xe6\x24\xcb\x49\x86\xb3\x34\xca\x22\x93\x56\xbe\x20\xcf\x6c\xc2\x93\x09\xb9\x89\xc6\x66\x4d\xf7\xa1\x36\x9e\xe4\x74\x71\x0c\x5e\x6c\xac\xfa\xdd\x61\xd6\x82\xfb\xec\x1c\xb4\xe1\x09\x6e\x44\x7a\xd8\x91\xa3\x6c\x6f\x8e\xa2\xa1\xa5\x82\x06\xa6\xb5\xb2\x5f\xef\xea\xf9\x45\x5a\xd9\x14\x05\x1a\xf5\xac\xd3\xdb\xd5\x8d\x64\x61\x5a\x3b\xee\xc2\x1e\xd7\xec\xe0\xb2\x52\x7a\x51\x5f\xd3\x52\x09\xc8\x3a\x48\xa3\xa3\xf3\x05\x35\xa6\x46\x83\x58\x0b\xdc\x2f\x3d\x5c\xa8\x06\x1e\xc7\x5a\x0c\x90\x43\x83\x43\x80\x2d\xf0\x4a\xb6\xba\x53\xfe\x81\x11\x72\x76\x64\x7a\xb5\x82\xfb\xf0\x7a\x09\x49\xb2\x83\xe4\xd8\x90\x93\x45\xbf\x54\xb3\x59\x64\xdd\x8e\x09\xf5\xc6\xd8\x63\xe9\xb0\x9e\xbc\x5e\xaa\xd5\x32\xdc\xf8\xd6\xc6\x39\x79\x09\x6e\xe4\x62\x37\x43\xd6\xce\x36\xcd\x5e\x89\x85\x19\x4e\x7b\x66\x74\x0e\xb6\xeb\xa1\x1c\x16\x3c\x4e\x66\xc2\x19\x04\x97\x25\x4e\xe2\xe9\x0d\xd3\x62\x8d\x8e\x1d\xac\xf7\x88\xd3\xe2\x63\x28\xcf\x62\x74\x78\x8f\xd0\xc7\xe7\x7e\xe0\x05\x63\x09\x19\x6a\x38\xf2\x27\x71\x55\xcd\x7b\x1c\x5d\xbc\x75\x21\x91\xd5\xfa\x37\x86\xd8\xff\x31\x21\xd7\x49\x06\xe0\x57\x25\xfd\x3c\xe0\x9e\xf8\x78\x4b\xd1\x19\x2e\x7c\xf2\x2e\xeb\x2c\xfc\x78\xc5\xfb\xd6\x4b\x26\x6d\xd0\x45\xcb\xbe\x07\x4f\x3d\x6b\xdf\x5f\xf7\x16\x84\xdd\x72\x2e\xa6\x81\xfe\xab\x1e\x3d\xb7\xe9\x6a\xa8\x93\xd2\xc7\xa5\x96\x95\xbb\xba\xa4\xb5\x24\xb3\xe7\x73\x53\x8f\x18\x17\x00\x0a\x81\x26\xe4\x8a\x09\x96\x8e\x23\x38\x1e\x9f\x60\x37\x86\xc2\x42\xfe\xf4\x1e\x00\x2c\xdf\xb2\xf3\x37\xea\x70\x6e\x64\x64\x66\xe5\x5d\xc2\xb6\xac\x1d\x0b\xdb\x6c\xe5\xd1\xe9\x6b\x3b\x74\x8f\x49\x8b\x9d\x43\xa3\x65\x00\x98\xa1\xff\x1c\x01\x65\x50\xac\xdf\xd4\x45\xc3\xc1\x01\xbc\x7d\x8b\x5a\xd6\x09\xc9\xbe\xd2\xd5\xb4\x96\xd3\x7f\xb0\x5d\x2f\x27\x00\x48\xfb\xfc\xb8\x7d\x67\xf8\xf6\x9d\xb1\x73\x76\x76\x3f\xef\x74\x8b\x23\xf2\xee\x1d\xda\xd6\x6c\xe8\x36\xe4\x65\xfa\x41\x32\xd5\x4a\x4e\x36\x56\x4d\xca\x17\x64\xcd\x8e\x69\x41\x5c\x8b\x6c\xfa\xbd\xce\xaf\x80\x16\x1a\x1a\x7c\x01\x39\x5d\xbd\xa8\xf2\xa7\x74\x95\xf1\x05\xab\xe6\x9d\x1a\xbc\xbb\xe9\xd7\x77\xff\x89\xdb\x7e\x33\xca\xb3\x7c\xcb\xad\xcf\x4d\xb3\x07\xab\x12\xc1\x4b\xe6\xe6\x35\x27\xbb\x9a\x2f\x38\x2b\x0b\x22\x1a\x88\x51\x88\x75\x1b\xcf\xd7\x0f\x22\xc5\x0c\x2b\x2a\x24\x6e\xc8\x70\xd5\xe9\x33\x44\x8c\x87\xde\xcd\x6a\x65\x8b\x39\x14\x6e\xe8\x16\x8b\x9b\x90\x79\xfb\x28\x90\xfa\x7e\x14\xd6\x8b\x62\xba\x27\x5e\xf5\xa1\x24\x28\xdb\x9f\xfd\x2a\x51\xea\x57\x26\xc0\x1a\x8f\x49\xa2\x9f\x98\x06\x4b\xcd\xb1\xce\x80\xd5\x69\x38\x99\x04\xcb\x2f\x95\x80\x0a\xa9\xb0\x42\xb4\x9c\x4e\x87\xe5\x97\x4c\x65\xc4\x8a\xde\x9f\x48\x8a\x75\x7f\xa2\xec\xf9\xc4\x58\x9d\xda\x2c\x1e\x6d\x80\x3c\x5c\x20\x90\x15\x2b\xd5\xee\xc5\x39\xb0\x7a\xba\x7c\x71\x1a\xac\xb8\xd3\x75\x19\x3f\xb2\xc9\xb0\x82\x17\x71\xc1\xee\xec\xeb\x7a\xdd\x17\x26\x2d\xd6\x9a\x1d\xbb\x2f\x6d\x56\x2c\xf0\x3b\xe9\xbc\xf6\x93\x62\xf9\x09\xb1\x7a\xbb\x72\x2e\x2d\x56\x50\x38\x88\x4d\x6f\x5f\x74\x73\xe5\xf5\x0a\x23\xdf\xd3\x6d\x4e\x14\x4f\x40\x53\x23\xb5\x38\x5c\x06\x8d\xa7\x1b\x1c\x75\x58\x40\x24\xa5\xf4\x8b\x15\x6b\x76\x1c\x9d\x2d\x14\x5f\x4c\xf9\xdb\xb3\xcb\xde\x75\xa9\x79\x89\xc0\xb5\x44\x05\x51\x3c\x11\x63\x96\xa9\x15\x5b\x51\xcc\x5b\x0c\x1a\x6c\x01\x0b\xf6\xc0\x20\x16\xbe\x67\x1c\xa8\x2b\xdb\x68\x67\x10\x16\x7f\x43\xb7\xa2\x18\x24\x09\x17\x2f\x1f\x14\x1a\x69\x2b\xd5\x3c\x28\x5c\x4d\xb9\x64\xed\x14\x3c\x35\xa6\x35\xaf\x26\x50\xc2\x3e\x3f\x70\xb9\x52\x8f\xa7\x6b\x76\x54\xaf\x06\x3e\x1d\x28\x78\xa8\x26\x76\x79\x0f\xdc\xdb\x2c\x1f\x0c\xfa\xa3\x07\x87\xa1\x83\x2d\x1b\x53\x73\xa5\x16\x81\xdd\xb1\xbc\x1d\x23\x8a\x13\x8c\x2b\x9a\x1d\x33\x00\x95\x41\x6d\x2a\x09\x0c\x0e\xbc\x49\xb4\x39\x9d\x93\xc9\x7b\xb2\xfe\xa9\x0d\x33\x64\x6d\x09\xcf\x4b\xd2\x32\x98\x14\xbc\xdc\xac\x1b\x32\x5f\xd1\x7a\x09\x93\x55\x31\x3f\x09\x74\x22\x6d\x13\x1c\x7a\x83\x5c\x76\x8a\x68\x5c\xce\x74\xf2\xa1\xb5\x51\x30\xef\x4e\xa6\x1f\x0c\xe3\xad\xf9\x9c\xd6\x8b\x7e\x8c\xe4\xe6\x1d\x09\x9c\x10\xc0\xeb\x3d\x6d\xef\xc8\xda\x4b\xe6\x3d\x08\x26\x62\x0d\xf1\x04\xb4\x74\xea\x67\x40\x5f\xbb\x1b\x76\xbf\xa4\xbd\xd4\x1e\x79\x6a\xa2\x74\x58\x39\x40\xb6\x66\xb4\xa2\xdf\xe9\xe8\xa9\x48\x8b\x50\xe2\x35\x90\xc0\xc9\x20\x35\x53\x69\xbc\x14\x64\x0f\xbf\x78\xe5\xa7\xb4\xfc\xcf\x1f\xc4\x99\xb4\x92\x9d\xc9\x36\x1d\xd0\xcb\x47\xaf\x0d\x56\x97\xc0\x54\xcc\x42\xf2\x56\x89\x17\x1d\x7b\xd0\x13\x5f\xdf\x0b\xae\x3f\x1e\x93\x6f\x6b\x21\x19\x05\x78\xba\xb0\x97\xe5\x6f\x27\x94\x74\xa9\xd6\xe9\xf0\x6a\xc9\xe4\x95\x8e\x69\x07\xde\x5f\x87\x06\xb9\x55\x69\xad\x65\xbd\xfe\x9a\xd8\x8a\x10\xec\x64\x8c\xfc\x50\x17\xdb\x6d\x9b\x1a\xa6\x65\xdb\x32\xc1\x6a\xe9\xd2\x60\xd9\xde\x36\x75\x75\x24\x6c\xcf\x5a\x5d\x47\x90\xa6\xd6\x99\x1a\x1e\x6e\x1e\xbd\x0a\x90\xcd\x81\xd5\x73\x76\x47\x1e\x6e\x1e\xc9\x4a\xca\xed\xdd\xf3\xe7\xd5\x8e\x8e\x77\x82\xb5\xa2\x68\xda\xe5\xf3\x8a\x0b\x29\xe0\x59\xf5\xfc\xf6\xfa\xfa\x8f\xe3\xeb\x3f\x3e\xdf\x88\xe5\xf5\xf5\xcd\xc7\xb7\xc5\x4a\x6e\xaa\x70\x13\xf0\x0c\x2c\xfa\xb8\xd4\xe8\xb7\x60\x45\x66\x1b\xb1\xcb\xfe\x34\xeb\x41\x63\x19\xbe\x20\x4e\x08\x38\x50\x51\x5f\x49\x08\x38\x30\x64\xc5\xb2\x30\x47\x98\xb5\xe2\xdb\xa3\x3c\x49\xc7\xaa\x30\x97\x44\x34\x1b\xb6\x6a\x0e\x71\xd6\x0a\xdb\x27\xdb\x48\x8a\xa8\x53\x11\xa4\x83\xb5\x76\x31\x13\x8a\xa0\x9c\x64\x45\x01\x4c\x7d\xc3\xec\x96\x96\xaf\xd6\xae\xd0\x4c\x3f\xb5\x71\x86\x28\x71\x37\xc0\x9d\x91\x99\x08\x81\x18\xa4\x3f\x5c\x51\x36\x03\x99\xbf\xa8\x46\x5d\xf2\xb1\xe5\x14\xe9\xc0\x51\xc9\xf3\x33\x38\xb1\x59\x7a\x8a\x46\x10\xa6\x8a\x42\x1a\xb1\xd1\xf8\x59\x2a\x79\x4a\x3d\x36\x02\xa3\xf5\xbf\x7c\x9b\x05\x40\x5a\x87\xa5\x24\x1a\xff\xb1\x6b\xac\x3e\x13\xd1\xa7\xba\xf3\x19\xb9\x86\xf0\x25\x31\x7c\x85\x57\xe3\x7a\x13\xa1\x52\x43\xba\xca\xae\x3a\xa8\xd4\xb3\x7a\xae\xa9\x60\x84\x27\x5a\x5a\xf7\xb7\xa4\xff\xe8\x30\xe2\x7b\x77\x64\x6a\x43\x63\x31\x85\x5a\xd0\xbe\x2a\x58\x41\x0c\xf0\xa2\xc0\x47\x24\xd3\x76\x5e\xfb\xb0\xc4\x7e\x44\x8a\x62\xaf\x8b\xe4\xa4\xab\xa9\xf3\x75\xbb\xd9\xbb\xac\x4b\x3c\x38\xdd\x29\xba\x41\x83\x89\xb2\x61\x82\x2c\x5d\x7c\x9a\x19\xd8\x42\x92\x67\x04\x4c\x93\xa1\xce\xef\xbd\x3a\xde\xbe\x10\x51\x97\x5d\xcb\x9a\xb0\xa0\x1b\xd8\x07\x40\x4a\xaa\x17\x81\x98\x66\xca\x21\xdc\xdf\x67\x71\x4f\x15\xa3\x11\x84\x02\x65\x1f\x9a\x76\xad\xd0\x4d\xf5\x79\xd5\xc3\xbd\x42\x3d\xa6\xc8\xf1\x24\x97\x6f\xa5\x1a\x4c\xd3\x7a\x57\x08\x00\x0d\x52\x32\x42\x0c\x23\x48\x99\x5e\xeb\x7c\xe1\xea\x87\xd9\x09\xf0\xf2\xe1\x8d\xf4\x5e\x51\x21\x9a\x39\xa7\x92\x95\xb8\x47\xb8\x76\x9a\xd6\x28\x40\xd4\x96\xc9\xa5\x46\x61\xc8\xa0\x6c\x1f\x84\xa4\x92\x55\x4c\x08\x90\x99\x83\x8d\x32\x1d\xf1\x58\x0d\x3d\xac\x64\x35\x21\x60\xbf\xbd\x26\x41\x02\xba\x75\x22\x36\xbd\x12\x79\xd7\x51\x6e\x37\xb3\xaf\xf4\xc8\xef\xa9\x00\xbf\xe1\x6e\xe3\xbf\x0c\x84\x11\x07\xf9\xd4\xd9\xa1\x77\xb3\x52\x78\x1f\x6a\x7e\x82\xc7\xb5\x1e\xa1\x27\xec\xdd\xb7\x26\xcb\x3f\x39\x36\x3b\xcc\x10\xa6\x4e\x58\x28\x15\x08\x56\xa1\x65\x1f\xa9\x9a\x25\x9f\x87\xa7\x26\xf6\x46\xf6\x00\x3d\x28\x2a\x50\xfb\x2a\x28\x6e\x9a\x5d\x8d\x76\x8b\x90\x8a\x98\x82\xb9\x29\x85\x9c\x5f\x1c\xd5\x0d\xea\xc4\x35\x63\xfa\xa4\x55\x04\x90\xe8\x88\xac\x9a\x83\x02\x04\x38\x09\xde\xcd\xec\x3b\x7d\x6c\x80\x06\xd2\x7b\x0b\x0e\x47\xd1\xe3\x9a\xb1\x2d\x59\x36\xbc\x5e\x06\xc0\xe6\x16\x18\xda\xbc\x86\xdd\x28\xed\x5b\x6d\x21\x0f\x62\x83\xde\x41\xd6\xec\x28\xc8\x8c\xce\xd5\x22\xc3\xbc\x55\x1c\xa4\x11\xd0\x37\x16\xe4\x4f\x73\xb9\xa3\x55\x75\x8c\x88\xc2\x46\x90\x5a\xd1\xba\xd4\xcc\x64\xd1\x36\x35\x0a\x90\x4b\x56\xab\xe5\xc0\x4a\x32\x6f\x4a\x06\xca\xb0\x03\xd3\xd1\xa4\x14\x6a\x3b\xc0\x1a\xb5\xcc\xdb\x23\xd1\xc9\xfc\x15\x82\x57\xac\x65\x45\x40\xbb\x84\xd1\xb6\x3a\x12\xc9\xda\x0d\xaf\x61\xb1\xa9\x0e\x50\x25\x0e\xce\x2a\xb6\x01\x11\xe6\xc0\xd0\x44\xc7\x4c\x0e\xaf\x89\xa2\x8b\x0d\xf5\x13\x96\x62\xb0\x26\xf4\xd8\xf8\x6e\x87\x8c\x45\xb1\x11\x6d\x2f\xa5\xe1\xb3\x82\xbc\x52\xfd\xbe\xaa\x2a\xb2\xe1\x42\x60\xb7\xd6\xec\x18\x22\xf7\x55\x43\xbe\x55\xd8\xa8\xd7\x3e\xe1\x1c\x5a\x2e\x19\x34\x8d\xb1\x95\x90\x4b\xa5\xe9\x6d\x01\x19\x18\x0e\xda\x1e\x56\x31\x68\xaa\x1d\x1b\x2c\x57\xc1\x29\x4f\x02\x70\xf1\x99\xfe\xcd\x3f\x44\xb8\xc7\xff\xee\x67\x03\xd2\xe5\x72\xf3\x46\x47\x49\x70\x2b\xcf\x63\x4b\x01\x2f\xe2\xe0\xbc\x0a\x74\x02\xb1\x9a\xe2\x5a\x3a\x01\x72\x87\x09\xd9\x06\x33\xfd\x2d\x11\x78\x42\xf7\x45\x97\xf9\x77\x5a\xa5\xb3\x3b\x7b\x9c\xc2\x1f\xc2\x09\xd1\xf1\xaf\xe6\xf4\xa0\x31\xe9\xed\x0a\xb9\xdb\x10\x72\x5c\x9d\x36\xb8\x17\x19\x3a\xb1\x4d\x91\x0a\xab\x2a\x81\xfb\x82\x59\xc8\xa3\x22\xda\x93\x03\x46\x9d\x13\x99\x1b\xc9\xcf\xdb\x1a\xc1\xf1\x36\x3c\x2c\x79\x49\xfe\x3c\xe1\x0e\xce\x44\x23\x75\xd4\x6c\xd6\x96\xdf\x17\x45\x81\x31\x4b\xbc\x3a\x38\xc7\x6a\xf7\x06\xfd\x86\x96\xac\xc9\x84\x38\xe1\xdb\x82\x85\x13\x13\x44\xfe\x52\xdf\x3a\xad\xae\xd1\xc0\x04\x8f\x20\x6e\xec\x07\x96\x38\xa7\xb9\xa6\x46\x90\x6b\x2e\x3c\xe2\xb9\xca\xa2\xc1\x25\x63\xb5\x52\x78\x33\xbb\xab\x24\xdf\x56\x9a\xa9\x79\x49\x8d\x65\xa3\x63\x68\x7d\xd3\x5c\x09\x92\xd1\x9c\x34\x6b\x72\x37\x21\x9b\xa3\x12\x55\xd7\x8f\x19\x6c\x80\x51\x42\x24\xbe\x80\xf1\x80\x50\xb7\x64\x32\x0b\x2e\x33\xfc\x01\x7a\x72\x4f\xe2\xb8\x75\xf2\xc4\x75\xd9\xa1\x2b\x75\xee\xca\xe3\x50\x5e\x3d\x01\x7e\xd4\x29\x87\x1d\xfd\x93\x6b\x20\x93\x40\x0c\x4c\xdc\x03\xcc\x46\x04\xbf\x3a\xe9\xde\xbc\xa8\xb0\xb0\x9e\x12\xb9\x59\x2e\x0c\x11\x15\x1e\xba\xec\x9b\xf4\x31\x2a\xae\x7f\xfa\x20\xf5\xe4\xb3\x94\x3f\x8d\x7b\x88\xcb\xd8\xbf\x55\x46\xae\x23\xee\x18\x96\x87\x39\xba\x9d\x15\xce\xa9\xc0\xf2\xdd\x79\x49\xf7\x2a\x2a\x64\xd7\xc7\x24\x73\x4b\x25\xaa\x89\x27\x09\x4f\x98\xd5\x88\xea\x84\x32\x82\x1d\x52\xb7\xa3\x53\x60\x70\x08\x0e\x99\x93\x8c\x90\xbd\xfa\xba\x8f\x81\xc7\xe1\xa0\xce\xd1\x45\x0f\x1d\xb8\x93\x6a\x1e\xa9\x17\x07\x01\x0a\xed\x0a\x2c\x59\xc5\x24\x4b\x2c\x42\x98\xb0\x15\x15\x2b\x2d\x82\x63\x41\x77\xf0\x48\xb5\xde\x59\x90\xef\xb1\x22\x3b\x5a\x52\xbc\x2b\x4b\x95\xfa\x77\xba\xd3\x71\x4f\xbb\xaf\xbd\x83\x34\x19\xdf\x74\xd6\x53\x9a\x28\x42\x85\x9a\x1e\x31\xf2\x58\x80\x34\x0a\x5b\x49\x4c\xd8\xa0\xa7\x95\x53\xab\xb0\x8b\xc4\x0b\xd5\x90\xa7\x08\x3d\xd5\x9d\x44\x63\x1a\x8a\x61\x61\x28\xaf\x1e\x7d\x15\xb2\x87\x53\x5f\x75\x19\xbd\x0a\xd0\xed\x6b\x3f\x13\x7c\xfd\x2c\x96\x43\xf1\xc0\x44\x82\x7a\x87\x26\x00\xa0\xcf\x0a\x9c\xbe\xf1\x06\x14\x2e\x7a\xf0\xda\xdb\xe8\x29\x7c\x13\x00\x1d\xfc\x52\x1e\xfd\x0b\xe5\xe1\x25\x55\xc8\x44\x55\xb3\x17\xad\xf7\xd6\x75\x49\x47\xe0\x22\xd9\x26\xd0\xb5\x65\x7e\x98\xdc\x8d\x71\x2c\x01\x7d\x26\x88\x88\xc0\xf4\x6e\xd1\x7b\x0c\xae\x98\xf9\x02\x25\x77\x85\x0d\x14\x22\xf1\xdc\x0a\x6c\xa4\x69\x9d\x63\x15\x86\x26\x59\xd7\xea\xc8\x84\x82\x33\xca\xe7\xf6\xf2\x08\xf2\x14\x7b\xd6\x6b\x51\x7b\xc3\x7d\xe0\xbd\x74\xc4\x2b\x34\x36\xdc\x1b\x23\x09\xf5\x68\x42\xb2\x79\x49\x25\xcd\xba\xc6\x51\x73\x8d\xbd\xc5\x82\x83\x4d\x6b\xb3\x18\xee\x3d\xed\xe7\x1c\xab\xf3\x38\x00\xbf\xef\x1b\xa6\xad\x4c\x42\x66\xe4\x2a\x7e\x7c\xa6\xde\xc7\x7d\x15\x6f\x5e\x9e\xa9\x79\xf3\xb2\xaf\xea\x47\xb7\x67\xaa\x7e\x74\xdb\x57\xf5\xec\x38\x5f\xbe\x48\x56\x85\x88\x51\x27\x6a\xfe\x8d\xf7\xa0\x68\x77\x0e\x47\x10\x1a\xaa\xb7\xea\x69\x2c\x61\x0c\xa8\xde\xca\xa7\xf1\x84\xd1\x9e\x7a\x2b\x9f\xc6\x14\xc6\x75\x4a\x56\x36\x71\x96\x4e\xd4\xd6\xc1\x96\xfa\xab\x9f\x6e\xdc\x24\x2a\x88\x38\x56\x28\xf4\xa5\xd6\x60\x90\xf0\x48\x9b\xdc\x27\xd6\xf8\xae\x36\x07\x6e\x58\x57\xaa\x67\x77\x68\x16\x6e\x36\x82\xb9\x3c\x8e\x82\xb4\x60\xd6\x09\x75\xe0\x3b\x44\xc3\xda\x9c\x35\x4d\xc5\x68\x9d\x25\xdd\x39\x4d\x68\xb9\xfb\x6e\xbd\x7a\xb7\x99\xb1\xb6\xbf\x9a\xc6\x42\xb7\x62\x42\xef\x1a\x54\x44\x93\xc6\x41\x18\xb2\xaf\x17\x5d\x97\x21\xca\x62\x48\xdb\xcc\x13\xe7\x49\x2b\x2a\xde\x35\x5d\xb5\xb7\xf7\xde\x91\xcc\x15\xf4\x63\x28\x68\x3f\xb9\xcc\xbb\xf1\xef\x77\x93\x3d\x93\xf8\x19\x0d\xe9\x7a\x9b\x32\xf1\x99\x7e\x7f\x2a\xf0\x46\x01\x75\xdf\x37\x9a\x0b\x04\x12\xc8\x49\xf6\xf0\x54\x7b\x0a\xd7\xf4\xaf\x8e\xc3\x71\x69\x7c\x26\xb5\x3b\xbe\xd2\x2d\x7a\xfe\x66\xdb\x5c\x3b\xeb\x45\xce\x95\x7e\xac\x75\x5b\x33\x8a\x86\xc1\x8c\x9f\x9f\x71\xb2\x18\xe2\x6f\x6f\x31\xe1\x03\xbc\x96\x55\x5f\x9a\x96\x40\x88\x8a\x84\x63\xa4\x7a\x87\xa5\x0c\x15\xdb\xca\xb2\xc1\xe5\x63\xe0\x07\x1d\xb4\x7b\x3f\xf4\xf1\x4e\xa7\xd4\x33\x55\xcc\xf0\x08\x17\xd1\xaa\xef\xf4\x75\x10\x39\x90\x76\x29\xc1\xeb\xb5\xeb\xa9\xc7\xa8\xbc\xf7\xb6\xcb\x01\x56\xf5\xfc\xe0\x75\x8b\xea\x95\x75\x52\xd5\xbf\x3f\x23\x9f\x5c\x5f\xff\xf1\xe6\x93\x4f\x6e\xff\xf0\xe2\x8f\x2f\xae\x3f\xf9\x04\x39\xaf\x92\x13\x6f\xff\xff\x7f\xf8\xc8\x92\x49\x8f\xc3\xaa\x40\x14\x28\x09\xaf\xdf\x61\xf5\xb4\x93\xec\xa7\xa6\x2b\xb1\xef\x6c\xb2\x63\x17\x76\x68\x4e\xb7\x41\x87\xee\x02\xbb\x44\x8b\xa2\xa0\x9b\x61\xe2\x0d\x58\x7e\x7f\xaa\x8f\x60\xed\x0c\x5c\xcb\x86\x6f\x48\xba\x05\x7b\x8b\x0b\xbd\x2c\x11\x36\x3c\x2e\xe0\xe2\xc7\xa7\x4b\x3f\xba\xa8\xe6\x08\x5a\x6e\x0c\xbc\x11\x20\xd6\xc1\xad\xb0\xae\x08\x0b\xdf\xf8\x9d\x2e\x7d\x67\x04\x88\xa5\x4c\x13\xce\x08\x58\xce\x4f\x8a\xaa\x8b\xa6\x93\x9e\x76\x8b\xeb\xa2\x99\x2e\x9a\x05\xf1\xe7\x4c\xa6\x29\xdf\x72\x1f\xef\xce\x16\xbe\x13\x03\x7a\xf5\x2c\x3b\x09\x24\xb4\x71\x57\x30\xbc\x14\xc8\x3c\x00\xa9\x0d\x6b\xbd\x87\x74\x79\x6f\x37\x0c\x08\x25\x11\xda\x0d\xbb\x67\xe7\x52\x18\x5f\xec\x03\x61\x52\xd5\x46\x63\x08\x05\x6e\xe4\xe3\x5e\x8f\x9e\xea\x9b\xe0\x84\xed\x67\x26\xb1\x70\xaf\x23\x82\x8e\xa1\xe1\x39\x30\x84\x22\x4d\x54\xec\x9c\xb3\x82\x1b\x60\x44\x85\xd6\x51\xe1\x62\x17\xa5\xeb\x3c\x08\x06\xd2\xeb\xad\x60\x67\xc6\x53\xa0\x26\x3e\x09\x3f\xfc\x53\x05\x69\xbb\x84\x82\x45\x51\x9c\x2d\x7b\x6a\xca\xfa\xeb\x62\x60\xf4\x9a\x8b\x15\x0e\x10\xd8\xc5\x17\x2d\xa3\x5e\x36\x48\x83\x4e\xd5\x9b\x13\x63\x8b\x42\x15\x90\x30\xf3\x5c\xba\x63\x1d\xcb\x48\xeb\xa4\x71\x69\x3e\xe5\x7e\x31\x60\x11\xfa\x68\x9c\x70\x6a\x88\x57\xd3\xfd\x49\xf9\x60\x30\x9d\xb2\x7f\xec\x68\xa0\x6d\xa7\x39\x99\x41\x6e\xa3\xd1\xc9\xc5\x61\xec\x8d\xd4\xab\x59\x24\x87\xba\x4a\xbd\x29\x30\x83\xea\x89\x84\x27\xe2\x10\xc5\xd4\x72\xa0\xd5\xab\x6e\x26\x28\xdf\xfb\x10\xc2\x1a\x3e\x9b\xa5\x8f\x1f\x81\x4a\xcf\x2d\x1a\x50\x33\x4e\xae\xf3\x67\x34\x4a\xe7\xc4\x17\x04\xb4\x32\x1a\x55\x43\xfa\xc0\x1f\x73\x32\x83\xff\x6d\x28\xa1\xde\xdb\xda\x7e\x05\x62\xc7\x5e\xc1\xd7\x80\x7b\x07\x02\x7f\xb8\xdd\x30\x3e\x7e\xef\x83\x54\x01\x7d\x69\x02\xba\xc3\xf1\xd2\x2f\xcf\xfc\x1f\x86\xe8\x7e\xd3\xb1\x25\x06\x95\x8e\x7e\xe4\x52\x83\x99\xd7\xdf\x8a\xaf\xb0\xc7\x39\x99\x8d\xee\x23\x19\x2c\x4d\x4f\x2e\xc4\x66\x08\x23\xa6\x77\x20\xf5\x30\x17\x4c\xb7\xd2\xef\x09\x75\x99\x40\x40\x0b\x45\xf3\x8c\x66\x17\xd6\x9d\x61\x5d\x6b\x83\x22\xe4\x70\x96\x67\xb3\xcc\xac\x32\xea\x65\x99\x9f\xf5\x3b\xcc\x34\x35\xd8\xd2\x43\xc0\x2f\xb4\xa5\xcc\x3c\x5d\x90\x05\x42\xeb\x32\x09\xc5\x01\x02\x08\xb4\x65\x01\x88\x70\xc2\x92\xfb\x97\xd7\x0f\x6c\x3f\x27\xe6\x7b\x23\x13\x80\x7c\x3d\x72\xa8\x5a\xd4\x90\x6a\xc6\xe5\x0a\xae\xdc\x20\xba\x11\xd8\x89\x62\x1e\x2d\x9d\x8c\xc0\x39\x53\x79\xa8\x77\x0f\x73\x75\x16\x0c\x4b\xf8\x28\xf5\x5c\xb1\x40\xb4\x9d\x45\x0f\xcf\xa2\x38\x06\xde\x4b\x6c\xc9\x21\xd5\x7e\x9f\xcc\xee\x46\x8d\x0a\x90\x06\xd2\xce\xcc\x3c\x9e\x8d\x02\x4e\x0a\xed\x64\x70\x1f\x90\xa1\xa1\xd6\x71\x06\x12\xa6\x7e\xd4\x65\xab\x16\xe9\x56\xa9\x40\xfd\x0a\x06\xc6\xe4\x37\x80\x71\xb2\x1f\x7d\xbb\x03\x2d\xa2\x94\xd9\xb3\xe0\x41\x77\x4e\xbc\xb7\xa8\x23\x07\xe6\x55\xc4\x33\xe2\x38\x92\x3b\xf4\x84\x6d\x5d\xb2\x27\x15\x0d\xbc\x85\xbe\xeb\xef\x11\x4c\xe8\x42\x00\xd8\x4f\xfd\x76\xee\x84\xe4\xa8\x7b\x57\xfb\xd5\x94\x60\x9c\x11\x25\xd7\x45\xb0\x41\xe0\x18\x05\xbb\xa4\x4b\x79\x6f\xc3\xd3\xe0\x4e\xde\xa8\x01\xa0\xc3\x15\x30\x01\xfd\x1d\x0e\xef\x11\x2a\x48\xa0\x33\xb7\x1b\x82\x31\x55\x98\x99\x2f\x41\xb5\x91\x27\x3b\x50\x21\x58\xdb\x11\xe8\xf7\xfa\x42\x17\xbd\xc8\x15\xec\xd7\xbb\x6d\xc5\x46\x41\xc2\x38\xdf\x85\x32\x91\x36\xce\xbe\xf6\x16\x48\xb3\xf6\xaf\xa5\xf4\x35\x01\x17\x82\xd7\x4b\x9b\x8a\x3c\xcb\x8c\x48\x8f\xb7\xc0\xbd\x6b\xdd\x01\xf3\xb7\x25\x98\x57\xbf\x73\x9d\xd3\x00\xa6\xfb\x49\xe8\xf8\xd5\x57\xdf\x26\xb9\x2e\x15\x5d\x87\xb7\x07\x1d\x9b\x31\x7c\xec\x62\x73\x06\xdb\xb4\x8f\x15\x05\xae\x6f\x1b\xde\xbb\x0b\xf4\xe4\x5e\x1c\xb0\x6f\x18\x37\xde\x89\xdb\x3c\xe5\x9e\x26\xca\x70\x68\x57\xc7\x5e\x51\xef\x40\xd7\xf6\xca\x58\xdd\x7a\x30\x4c\xcc\x86\x81\x1d\x17\x9c\x0a\xe6\x74\xbe\x52\x65\xcb\x86\x81\x8d\xf4\x92\x49\xb2\xdb\x96\x60\xed\x44\x85\x71\xc3\x76\x95\xd4\x66\x04\x4e\xc4\x60\x06\x55\x72\x01\xab\x82\x4b\xa2\x16\x4f\x45\x16\xfc\x8d\x7a\xb5\x6d\x84\xe0\xb3\x0a\x82\x63\xa3\xa5\xd9\x4e\xee\x5a\xe6\xcc\x38\x60\x84\x20\x0f\x1b\x8b\x37\x56\xfe\xf9\xf8\x10\x8d\xc0\x66\x70\x87\xe2\xee\x5a\x8d\x2f\xe0\x49\x6a\xe3\x44\xc0\xa1\x99\x82\x87\x9d\xef\x6d\x6e\xc2\x49\x90\x93\xd1\x43\xd4\xa8\x53\xd3\xca\x09\xdf\xa7\x52\x4f\x06\xaa\x6e\x77\xaf\xef\xb7\xc5\x45\xc2\x4c\x28\x2c\x33\x4a\x40\xe9\xb4\x9b\x84\x13\x97\xf2\xad\x96\xa6\x53\x14\x34\xff\x37\x6b\x9b\x2f\x56\x6c\xbe\x4e\x94\x3e\x51\xb8\xb7\x8b\x3a\x8d\x8c\xf4\x13\x94\x77\x20\x93\xb2\xf1\x17\x42\xb0\x2a\xb4\x3d\x29\x84\xa3\x9d\xa4\x0c\x3b\x74\x0b\x7b\xbf\x85\xa8\x3b\xa7\xb2\x24\xcd\x55\xff\x15\x5d\xef\x37\x84\x2e\x29\xaf\x85\x24\x72\x93\x93\xc3\x8a\xb5\x8c\xc8\xcd\x24\xeb\x9c\xee\x4c\xe0\xe0\xae\x6f\xbe\xcb\xee\x53\x97\x64\x7f\xa2\xee\xbe\x5b\x37\xfe\xad\x98\x9e\x8d\xdd\xa0\x96\xb5\xfd\x81\x79\x30\xf7\x1b\x4c\x87\x0a\x6f\xd4\x37\xfb\xd8\x71\x02\xf3\xbd\x27\x64\x92\x67\xe8\x26\xe7\x2b\x8d\x64\x83\x04\x85\x0f\xc5\xa6\x36\x45\x77\x14\x80\x76\x9c\x91\xae\xa1\x0f\x7e\x20\xfb\x5f\x2a\x95\xd6\xe0\xd4\x6f\xc3\xb4\x11\x7a\xaa\xdb\x51\x9f\x3f\xa8\x1b\xf9\x81\x2e\x0e\x36\x42\x0e\x65\xa8\x28\x36\xbf\x83\xab\x21\xfb\x89\xf6\x0d\xff\x03\x0b\xd7\xdf\x8f\xbe\x6e\xda\x0e\xdb\x21\xde\xbc\x74\x20\x24\x50\xd0\x6f\x85\x74\x9e\xc5\x91\x09\x69\xd6\xdd\x93\x2e\x5f\x00\xc6\x9a\x75\x84\xae\x78\x2f\xbd\x68\x3c\xf1\xc6\x61\x02\x46\xdb\x6b\x04\x27\x23\x18\x1d\x3d\xcc\x83\x5a\x40\x55\xd3\x6c\x73\xc4\x68\xa6\xfe\xfa\x52\x6f\xb7\x87\x10\x28\xde\x4a\x13\x5d\x5f\x9f\x9e\x6d\x1b\x3f\xbe\x79\x53\x64\xd9\x92\x4a\xc6\xec\x42\xd4\xe6\xa9\x03\x8c\x97\xd5\x73\x19\xf9\x53\xa0\xd8\xf1\x1f\x7d\x62\x87\x42\xb3\x48\x6e\x9f\x9d\x16\xd4\xc2\xdf\xd2\x9a\xcf\x87\xea\xef\x7c\x4d\x97\x4c\x3c\x64\x2d\x4a\x92\xd9\x63\xa1\x50\xfa\x27\x40\x2e\x6f\x6a\x90\x2c\x21\xb9\x73\x96\xe5\xaa\x89\x9c\x78\xa1\x6b\x82\x59\x1c\xb9\xeb\x28\x14\x47\x95\xe0\x31\xa6\x06\xd2\xd8\x24\x41\x81\xa8\x1f\x6a\x1a\xd4\xaa\xd8\x88\x25\x24\x16\x1b\x7f\xd6\xcd\x36\x66\xe1\x9b\xec\xab\x50\xc1\x6f\xd2\x2d\xa5\xf0\x18\x70\x42\xda\xda\x1b\x27\x4c\x8d\xa7\x3d\xad\x22\x19\xfc\x9c\x86\x29\x84\x90\x14\xe3\x7b\xe8\x29\x2d\x57\x85\x4a\x34\xb4\x15\x31\xfa\x05\x21\xe9\x7c\xfd\x25\xdb\xca\xd5\x8f\x8b\x05\x26\xa1\xbb\xbe\x1f\x4c\xa7\x4b\x26\x5f\xd9\x57\x9d\xe4\xdc\x3a\x52\x6e\x8b\x61\x41\x70\x36\x46\xf7\xda\xd3\x51\x77\x91\xb5\x6d\x01\xd0\xfb\x55\x70\x26\x01\x75\xd8\xc1\x44\xa7\x3e\x24\xcf\x2c\xb8\x42\x6c\x2b\x2e\x87\xd9\xdf\xeb\x6c\xe4\xb9\x50\x6b\xe9\x8a\xf9\x1e\xba\xa8\x5c\x82\x7d\xb2\x40\x37\x6b\xda\x4a\x41\xa8\x24\xf4\xe1\xfa\x11\x96\x1e\xf8\x08\x5b\x57\x42\xef\x66\xe1\x17\xbd\xbf\xfa\x07\xdd\x5a\x61\x67\x10\xf8\x58\x3d\xa3\xe6\xb0\xa6\x20\x76\x7d\x5d\x55\x15\x34\x27\x8b\x2e\x3c\xe2\x64\xe7\xaa\xa0\xef\x08\xaa\x88\x8c\x7c\x3a\x21\x98\x09\xd3\x62\x2c\xa7\x0f\xf5\xa3\xcf\xb3\xe0\x58\x05\xc6\x42\xac\xe2\x9b\x6b\x34\x97\x5f\x81\xa2\x3d\x27\xb2\x01\xd9\xb5\xe5\x60\x39\x25\x1f\xae\x1f\x73\xf0\xb3\x84\xe0\x38\x7c\x21\xc1\x4b\xf2\x28\x81\xa7\xed\xb6\x46\x2e\xc5\xb6\x94\xac\xdb\xb6\x14\xe2\x0c\xa0\x8d\x2f\xaf\x2a\xed\x57\x4d\x25\x79\xb8\x79\xcc\x71\x25\xd4\x63\x08\x2d\xf3\x5c\x7d\x03\x53\xd2\xf1\x58\xd5\xf9\x16\xcd\xd2\x2a\x86\x26\xfc\x7b\xca\x2b\xb4\xc4\x3e\x30\x97\xa2\x9a\x4a\x54\x55\x8f\xc7\xd8\xf1\x6f\x1a\xbc\xce\x82\xdc\xe5\xd0\xbc\xc8\xb1\x98\x9b\xbb\x6b\x28\x6d\x1d\xc0\x4c\x7b\x3f\xca\x15\x6b\x0f\x5c\x04\x2d\x50\x9d\xe0\xc5\x99\x7f\x53\x49\xb4\x8e\x43\x4d\x58\xd3\xc2\x40\xc0\xb1\x1e\xee\xe0\x1b\x72\x55\xf3\xea\x8a\xac\x9a\x0a\x1d\xe9\x21\x37\x33\x2f\xcb\x8a\x41\x43\x1e\x85\x00\xbe\xb5\xf3\x13\xae\x6a\x36\x94\x23\x45\x03\x57\xa0\x56\xb8\x4a\x50\xbc\xf4\x4f\xc2\xaa\x52\xef\xe2\x08\x8f\xcc\xa8\xce\x01\x43\x38\x83\xc8\xcf\xbd\xb3\xa4\x5a\xb6\xcb\x20\x5a\x83\xe9\xd5\x46\x1a\xa2\x04\x2f\x28\x7a\x58\x32\x39\xdc\x48\x50\x3d\x55\xac\xce\x46\xc9\xa4\x0b\x76\x0f\x54\x2d\x82\xc4\x11\x38\x70\x99\x59\x7a\x6e\xc2\xc1\xb6\xcd\x46\x4d\x84\xbf\xc1\x28\x7a\x7e\x26\x83\x47\xad\x7f\xdd\x62\xb4\xd6\x75\xa4\xb4\x0e\xd2\x2c\x2a\x12\x7e\xe0\x8f\xa3\xce\x16\x63\x02\xdf\x45\x9c\x79\x3c\x06\x33\xf4\x03\x23\x15\x83\x28\xae\x64\x57\xa3\x87\x7e\xe9\xa1\xeb\x17\x25\x22\x3c\x5c\x9b\x54\x42\xbf\xf4\x33\x28\x19\xc1\x8f\xc7\x11\x77\xf6\x17\x8f\x59\x70\x93\x73\x00\xbd\x43\x14\x33\x8e\x33\x6e\xef\xa1\x23\xdc\x77\x3f\x4d\x1f\xe0\xfc\xfc\xd2\xe1\xa9\x38\xee\xc1\xbe\x8b\x2b\x3e\xe1\x1f\x26\x38\x50\xdb\xb9\x4c\xdd\xd5\x6a\xb7\x7e\x6f\x92\xbe\x94\xa2\x5d\x55\x6c\x70\xe8\x96\x92\x8e\x07\x8c\xd8\x99\x4e\x2b\xfa\xcb\xf1\xab\xaa\xe2\x5b\xc1\x85\x1f\x67\x02\xdc\x2c\xfc\x4d\x49\xb0\x6a\x11\x29\xd4\xd4\x23\xe7\xba\x0a\x3e\x1a\x61\xcc\x7c\x05\x7c\xca\x34\x74\x3d\x66\x6f\x7e\x35\x34\x3f\xa8\x45\x50\x63\xaa\x4e\x0e\xb4\x9e\xb3\x2c\xb7\x45\xb5\x39\x3c\x3c\x00\xfa\x08\x22\xa8\x40\x5a\x89\x70\x48\xa3\x93\xf3\x01\xab\x50\x5f\x6d\x87\x96\x98\xfb\xae\x25\xe6\xbe\xcf\xf8\x4a\xaf\xe5\xbf\x67\x59\x51\xec\x8b\x42\x7d\x19\x69\xe7\xfc\x52\x27\xaf\x99\xd1\xf9\x5a\xf2\xf9\x1a\x54\x1a\x46\x69\xba\xef\x51\xb2\x2a\x2a\xf5\xce\x6a\xfd\x78\x89\x68\x58\xcb\x0c\x6f\xb6\x14\x0e\x53\xfb\x61\xe7\xe8\x3c\xf7\xa2\x14\x41\xb1\xce\x69\x36\xc4\xc9\xbc\xcf\x7f\x30\x15\x07\x21\xd0\x73\xef\x47\x1d\x64\x43\xac\x7f\x04\xff\x3f\x77\x8d\x64\xa5\xbd\x44\x76\xd9\xc8\xec\x15\x70\x9c\x84\xc7\xaf\x67\x7d\x38\x69\x27\xa1\x2f\x1d\xe9\x08\x25\x93\x2c\x27\xcf\xe8\xe8\xb4\xe8\x10\x0e\x56\x15\xf2\x05\x51\x44\xd9\xde\xd3\x04\x50\x0f\x5d\x1d\xe2\x89\xa4\x05\x08\xe0\x05\xfb\xbe\xf1\xdc\x03\x8f\x41\xa2\x16\x16\x44\xb6\x7c\xbe\xa7\xad\x20\x25\x5b\xf0\x1a\x33\x1d\x2d\x68\x0b\xe2\x62\xd3\xb2\x3f\xef\x78\x25\x79\x2d\x86\xa3\xc1\xfb\x89\x90\x86\x57\xff\x4c\xf7\x54\xcc\x5b\xbe\x95\xcf\x37\xcd\x2f\xbc\xaa\x28\x59\xf2\x3d\xb8\x05\xa3\xe4\x08\x46\x74\x64\x57\x97\xac\x25\x28\xfd\xe5\xba\xea\x61\xc5\xc1\x8d\x48\xa7\x34\x38\x50\x41\x30\xdc\x93\xc2\xa7\xd9\x22\x8b\x81\x09\x52\x51\xf1\x1a\xc4\x7c\x70\x13\xee\x18\xe8\x29\x01\x50\xca\xa3\x5a\x12\x15\x13\x42\x37\xf8\xdd\x8e\xfe\xe5\xdb\xd7\xc5\x20\x36\x51\x8c\xc7\x6b\x65\x69\x38\xf3\x04\x43\x06\x01\x57\xbf\x30\xc1\x59\xe0\xd9\x00\x99\xd8\x97\x6c\xc1\x5a\xed\xb5\x60\xb3\x0f\xea\x67\x39\xf9\x59\x7c\xd5\xb6\xb8\xbb\xfe\xa4\x20\x8c\xfc\xd3\x87\x7d\x0a\x1b\xbb\xa9\xe4\x6f\xf6\xe6\x59\x81\xae\xce\x9f\x4d\xc8\xb3\xe9\x74\xbe\x6b\xbf\x69\xda\x66\x27\x79\xcd\x0a\x28\xf1\x0a\x31\x1d\xe7\x57\xc1\xd6\x63\x3b\x2c\x78\x98\xa0\x55\xe3\x8a\x78\xf8\x0a\xce\x04\x46\xb0\xc7\x8c\x15\xed\x31\x14\x03\xd0\xb0\x66\x0b\xfe\x66\x1d\x39\x38\xf1\xe9\xed\x75\xb1\xdd\x89\x95\x45\xd8\xa9\x70\xd5\xee\x40\x0a\x23\x68\xda\x9f\x64\x3b\xc4\x11\xfa\x61\x85\x47\xef\x82\x9e\x36\xeb\x5c\x1f\x72\xf4\x4e\xd5\x32\xe1\xc4\xa0\x39\x68\x65\x86\xac\x6d\x3d\xed\x79\x52\x3f\x61\xf1\xc2\xda\xb6\xab\xd5\x38\x31\xbc\x66\x3b\xf4\x6c\x37\x7c\x8a\xf1\xc8\x04\xa1\xdb\x72\x48\xa7\xd1\xbc\x45\x6d\x50\x51\x31\xb6\xed\x6e\xd1\xc1\x45\x4d\x97\xd2\x27\xdd\x47\xe3\x1b\xa8\xd3\xec\x24\x6b\x7f\xea\xd0\x7f\x67\x4d\x44\x85\xcd\x9a\xf0\x97\xc8\xbd\xb7\xd1\xc1\xff\x51\xc9\x60\x20\x0e\xbc\x87\x2c\x75\x36\x88\x2a\x26\xb9\x6b\xa2\xbb\x01\xcf\xf2\x71\x1f\x2c\xe1\x08\x7a\x74\xbd\xa5\xe9\xfd\x7d\x88\xfd\xee\x4e\x36\xdb\xbb\xbb\xce\xf3\x9e\xe2\x29\xc9\x32\xf1\xe1\x0b\xc7\x1f\x2e\x0d\x2c\xef\x2a\xf4\xd3\xe7\xc3\x09\x7e\x32\x26\x37\x8f\xa7\x83\xad\xbf\x4f\xaf\x6c\x72\x85\xd3\x25\xc8\xeb\x15\x23\x30\x63\xa4\x65\x74\xbe\x42\xcf\x31\x22\x9b\xed\x05\x75\x75\x84\x6a\xd4\x35\x90\x2f\x2a\x46\x5b\xc2\xd1\xd3\x09\x6e\x44\x2f\x00\xa1\x8a\xab\x4d\xec\xbb\x1d\x45\x6e\xfa\xeb\x07\xd5\xbb\xbb\x9c\xa9\x87\xcc\x3c\x22\xd8\xd3\xc1\x96\x63\x45\xf5\xe5\x2f\xb5\xd7\x0f\x3a\x41\xdb\xed\x27\xe0\x62\x89\x0f\x98\xf7\x56\xfd\x1e\x9a\xf1\xe7\x42\x7e\xd9\xdb\xd8\x79\xde\xd0\xfb\xf1\x56\xc5\x25\xc8\x5f\x36\xb2\x51\x54\x07\x9a\xb1\x79\x53\x4b\x5e\xa7\x6f\x10\xdc\xe7\x24\x7e\x43\x3e\xfd\x1e\x00\xdc\xf9\x46\x61\xfc\xe1\xfa\x71\x08\x7f\x6f\x1f\x73\x7c\x70\xf3\x78\x66\xa2\x5a\x54\x23\x14\xd3\xe9\xac\x5a\x5f\x8c\x39\x47\x0a\x6a\xbf\x7e\xab\xab\xe7\xe4\xed\xbb\x9c\xb4\xef\xce\xcf\x98\x93\x75\x2e\x99\x24\x2d\xbf\xf8\xee\x0b\x3d\x05\x7f\x0b\x5c\x9f\x44\x57\x0f\xa9\x29\x1c\x26\x16\xf4\x85\xd8\xf4\xc7\x07\x21\x33\xc0\xad\x44\x49\xc0\x2d\x9b\x37\x7b\xa6\xe8\xf3\x14\x43\xe9\x1f\xb6\x3b\x2a\x8d\xf4\x31\x67\xc1\x6b\x5a\x55\x47\x0c\x75\xa8\xe4\x9d\x81\xd3\x81\x9f\x5f\x47\x20\x78\xc4\xc3\x4c\x12\xcd\x89\x6d\x5d\x91\x4c\xcc\xbf\x52\x42\x54\x97\x3f\xa6\x84\x92\x9e\x1d\x3d\x12\x49\x7c\xe9\xe9\x22\x31\xe8\xc3\x9b\x7b\x9b\x81\xa8\x2e\x49\x12\x6d\xa0\x1d\xc7\x33\x77\xcb\x60\x2b\xf1\x74\x52\xbd\xa2\x66\x52\xa6\xc4\x8c\xb4\xde\x6e\x84\x34\x61\x44\x51\xdf\x80\x10\xb7\xc1\xd8\xc8\x65\xa4\x6d\xf9\x4e\x62\x7d\xef\x70\x1d\x09\x9f\x70\x1b\x65\x3d\xee\xbd\x8c\xe0\x9a\x02\xbb\xa7\xbd\x24\x29\x38\xbb\xc1\x61\x92\x4e\x3a\xf2\x18\x19\x93\xdb\x51\xd2\xb3\xcc\x5c\x59\x85\x33\xd7\xbf\x67\x06\x27\x3a\x5f\xf8\x34\x23\x01\xec\x06\x3e\x63\x6d\x3b\xf2\x11\x8d\x29\xbd\x54\x51\x75\x98\xfe\x6a\x4f\x2b\x94\xf1\xb3\x6c\x80\xc1\xce\xff\x15\x9c\xd3\xda\xaf\x9b\xf6\xab\x7d\x68\xb8\x69\xe6\x29\xae\xca\xda\xd6\xe9\x28\x30\xa0\xfa\xef\x49\x0a\x96\x60\x4c\x20\xb9\x64\x40\x36\x9e\x25\x67\x7c\xb8\x9d\xdb\xe9\x6d\x77\xb5\x3a\x04\x0f\x47\x58\xc5\xd7\x3d\xa9\x86\x8d\x6a\x40\xc9\x4e\x1b\xca\x6b\xc2\x54\x4b\xdb\xb6\x99\xb3\x72\xd7\xba\xb0\x14\xa0\xf0\x20\x7f\xfd\xea\xa7\xef\x40\xcb\x2f\x31\xdc\xa2\xce\xec\x2b\x70\x7f\xd8\xd5\x82\x5c\xcd\x9b\x92\x5d\x61\x1c\x41\xc2\xde\x70\x09\x77\x30\x03\xad\x31\xf9\x9e\xf2\x3a\x46\x8b\xaa\x10\xba\x4a\x59\xab\xd4\xa0\x4e\x96\x44\x1e\xde\x65\x0e\xbc\xdc\x8c\xbb\x1a\x17\x55\x4e\x9a\x75\x0a\x2c\x8c\xb2\x6a\x9a\xed\x9d\x27\x49\x17\x45\x61\x53\xeb\xeb\x41\x21\x28\xd0\x95\x1d\x25\x53\xbd\x54\xef\x1d\x7c\x38\x11\xd0\xd2\x38\x07\xa9\x51\x84\xe9\x7d\x5c\x48\x1d\x57\x4e\x75\x40\x15\x85\x3b\x47\xf5\x05\x6e\x29\x6d\x70\x23\xd6\xb6\x93\x2c\x57\x9d\x47\x83\x45\x68\x4d\xcd\x36\x7c\xb1\x7e\x88\xc9\xc3\xb8\xe3\x09\x0a\x2d\xaa\x49\xa2\xaf\x46\x7d\x27\x26\xef\xdc\xea\x19\x1a\x68\x8c\x90\x15\x56\x9a\x10\xe8\x84\x6f\xb3\xef\xe9\x9f\xe3\xea\xed\x0e\xef\x4b\x34\xde\x4a\x8b\x2f\x8b\x54\xbf\x74\xd1\xf7\xf9\x55\x45\x5d\x80\x22\xc7\x52\xdf\xc4\xc7\x2f\xc4\xe2\x70\x04\x7a\xe1\xd4\x0a\xf3\x02\xe4\x38\xb8\xff\x29\xfd\x77\x53\x80\xbd\x86\x99\x08\x68\x1d\xbc\x17\x83\x27\x81\xc1\x87\x9b\x0d\xda\x37\x1b\xa4\x01\x52\x6a\x20\x60\xb2\x26\x35\x72\x81\x1e\x43\x53\x94\x9a\xe6\xd3\x04\x75\x76\x40\x5d\xd2\x8a\xc3\xf0\x76\x6a\x63\xe5\xba\xd1\xf2\x4e\xc9\xa4\x4e\x2b\xfe\x7c\xae\x7a\xaa\xe4\x1f\x30\xd0\xf1\x60\xab\xe1\xc1\x50\x1b\x3f\x0a\x10\x33\x2a\xaa\x41\x2c\xf5\x84\xab\xd6\x63\x0f\x47\xce\xaa\x52\x2d\x5a\xc0\x55\x34\x66\xcd\x2d\x60\x31\x4f\x88\xe3\xb7\x50\xc9\x62\xa5\x17\xac\xbb\x6e\xc5\x1b\x48\x76\x00\xbe\x00\xb8\xb5\x1c\xd1\x38\xa7\x29\x9e\x3c\xad\xd9\x1b\x39\xc5\xa0\x75\x13\x72\x63\x1e\x27\x39\xe9\x49\x25\x44\x9f\x5a\xc1\xd3\x6e\x03\x60\xdf\x8b\x16\xbb\x16\x7a\xa3\xab\xc6\xd2\x36\x47\x16\xa0\x96\x98\xc4\xd0\x5a\xca\x8c\xc7\x44\xf0\x7a\x0e\x57\xb4\xd0\x4a\xb3\x20\x54\x49\xb0\x8c\xef\xd9\x73\x0c\xeb\x47\x36\xf4\x08\xf7\x13\x55\x03\x86\x6b\x39\x39\xb0\x48\x92\xc2\xfb\x68\x75\xc8\x07\xcc\xcd\xb8\xb4\x8c\x95\xea\xc4\xc3\x6c\x5b\xe9\x4a\x4d\x4d\xb8\x14\x10\x41\xd0\x4d\x93\xd6\x75\xc1\x0e\xa3\xd6\xd3\x17\x4d\xdb\x04\xf3\x38\x6f\x19\x95\xcc\xc7\x5b\xb4\x1f\x21\x06\xac\x6f\x53\x70\x25\x37\x9d\xd2\xaa\x9a\x2a\x60\x79\xdc\x88\xde\xc5\xd4\xbb\xdb\xba\x91\x4c\x3c\x44\x05\x1e\x27\x6f\xa7\xd3\xaa\x99\x4f\x9e\x85\x50\x30\x1d\xf4\xbc\x19\x2b\xbc\x8d\xfd\xa9\xe8\x10\x08\xca\xf1\x29\xba\xe9\x3c\xfb\xf0\xc6\x48\xb0\x36\xc6\xdd\x8a\x11\x31\x5f\xb1\x72\x57\x81\x83\x32\x24\xce\x82\x6b\x7b\x2e\xc8\xd2\xe0\xc7\x68\xe4\x4d\x28\x30\x0e\xf6\xb2\x02\xce\xbb\x6d\x53\x61\x3c\xb9\x83\x8e\xc5\x67\x64\x64\x20\x2b\x85\xe8\xa3\xf6\x30\xf3\x6a\xcf\x15\x45\x2c\x16\x6c\x2e\x07\x2e\xb0\x10\x85\x18\x85\x86\x3e\x48\xb9\x83\x3d\x74\xbe\xc2\x50\xf8\xf0\xec\xd3\x31\x69\xb6\xc2\xcc\xa7\xa4\x62\x3d\x85\xfc\x3e\xc3\x34\xde\x21\xd1\x32\x8e\x68\x6a\x47\x39\x1c\x99\x48\x6e\x15\xa3\xf5\x6e\xfb\x25\xa3\xa5\xaa\x33\x1c\x59\xb2\x35\xd2\x59\xad\x23\xa2\x31\xb0\x34\xc7\x45\xcc\xeb\xa5\xb5\x16\x1a\xbd\xeb\x91\x01\xf4\xaa\x82\xd5\x78\xa7\x70\x0a\x46\x64\x9e\xd8\x3f\xb2\xb2\x98\x50\xb8\x00\xf9\x0a\x52\x95\xcd\x94\x80\xa5\xaf\x00\x21\x19\x80\xb6\x4f\x98\xef\xda\x16\xcc\x5d\x34\x89\x5e\x09\x22\xe6\xcd\x16\x2c\x16\x9c\x47\xa0\x89\x31\xa9\x0e\xd4\xcd\x4e\x6d\x7c\x8a\xe7\x62\xf4\x2f\xff\x22\x8c\xc9\x29\xb4\x81\x41\xfc\x87\x8a\xda\x72\x52\x2a\xf9\xf9\x69\xb7\xdb\x35\x26\x74\x41\x81\x74\xc9\x24\x3c\x1d\x02\xa0\x9c\xf0\x68\xaf\xa9\x43\x33\x17\x9d\x55\xd7\x2b\x03\x87\x05\xba\xe9\x09\xc2\xbc\xef\x1c\x08\x55\x07\xb9\xb1\xa7\x09\xaf\x9d\xe1\x0c\xa0\x19\xaa\x1a\x2d\x8c\x73\x5a\xc6\xd7\x54\xaa\x35\x4d\x2b\x27\x71\x62\x26\x6b\xd9\x54\xb4\x5e\x5e\x09\x54\x77\x3c\x37\x47\x21\xc1\x36\xb4\x96\x7c\x2e\x74\x42\x2c\x55\x14\x82\x98\x1f\x56\x0c\xec\x50\x90\x49\x41\x61\x25\x99\xa8\x5d\x8e\x0b\xf2\x01\xe6\x2c\xaf\x8e\x1f\xe0\x44\x43\xaa\x1e\x4c\xa7\xb7\x80\x23\xbe\x9d\x32\x08\x2d\x29\x0b\xf2\xca\xe4\x87\xd8\xec\x84\x04\x03\x7d\x08\x09\x5a\x32\xb6\x55\x9c\x0f\x08\x85\x56\x15\xde\xac\x1d\x20\x5c\x10\x6d\x99\x5d\x9a\x5e\x1f\xb8\x09\xdb\xab\x25\x76\x2e\xbe\x84\xce\xc0\xf9\x2f\xb8\x77\x57\xb0\x7a\x44\xf6\xa0\x52\xae\x2f\xf4\x20\xbf\x8f\xab\xa5\xcf\xbe\x2d\x5f\xbe\xd2\xaf\xe1\x9d\xb7\x47\xcc\xd8\x92\xd7\x7f\xd2\xd6\x97\x10\x72\x41\xfd\x01\x27\x96\xed\x96\xd1\xd6\x4b\xdc\xee\x15\x85\xb4\x6a\x0e\x43\x57\x7a\x68\x57\x59\x50\x3a\x27\xcc\xb9\xaa\x2f\x78\x5d\xe2\x78\x72\x0f\x52\x38\xb6\x19\x5b\xa2\xc5\x27\x54\x46\xc1\x9c\x81\xec\x64\x04\x72\x55\x22\x65\x50\x43\x64\xcb\x37\xa4\x59\x2c\xcc\x75\xab\x5a\xd8\xc8\x8a\xed\xbc\x90\x19\x93\x07\xa6\x0e\x4b\x7b\xbf\x26\x84\x31\xae\xe0\x1a\x16\x68\x03\xf8\x30\x0c\xc7\x18\xdc\x08\x8b\x3b\x9b\x01\x50\x0f\x84\x59\x75\x80\x46\xe7\x6e\xb9\x2a\x6c\x5c\x57\xd1\x90\x45\x4b\x97\xbc\x62\x85\x0e\x10\x3f\x63\x52\xa1\xef\x40\x8f\xbe\x75\x11\x6d\xd7\xac\x4d\x63\x2a\x44\xf3\xe2\x4a\x4b\xdf\x7c\x61\x6b\x9d\xb2\x2e\x0a\xe9\xaa\xe4\x68\xb5\xa9\xe0\xeb\xea\x1e\x5f\x45\x6b\xd6\xb4\xdf\x14\x6c\x5a\x54\x10\xbe\xd9\xb0\x92\x53\xc9\xc8\xff\x81\x55\xe2\x48\xfa\xff\xe4\x44\x36\x0d\x46\x97\x75\x0e\x17\x36\xb4\x4c\xd8\x11\x67\x40\x0a\x5d\x99\x4e\x65\xb3\x9d\x36\x8b\x29\xc2\xe4\x35\x22\x1c\xa5\x20\xbc\x26\x88\xad\x44\xe1\xec\xa8\x26\x17\x63\x6a\xa8\x05\xe1\x63\x2a\xb3\x53\x0d\x94\x06\xc3\xa3\x76\xd0\x03\x2f\x44\x43\xbb\x84\xfb\xf4\xee\xcc\xde\xe4\xba\x74\x48\xa1\xc0\x6c\xf3\x78\xf5\xdd\x19\x48\x26\xbf\x2c\xfc\x52\xbd\xfe\xf4\x53\xbd\x8f\xe9\x47\x7f\xaf\xff\x5e\xfb\x29\x67\xa7\x39\xa9\xc5\x6e\xd6\x89\xe8\xa0\x0a\x87\xb3\x9f\xe5\x24\xcb\x2e\xeb\x8c\x86\xe8\x0b\x2e\xea\xd1\xc8\xea\xbb\xd4\xfb\x4f\xc9\x6d\xca\xd8\x28\x69\xd2\x8a\x86\xce\x40\xe6\xff\x4f\x00\x00\x00\xff\xff\x45\x3b\x7c\x47\x03\x8c\x01\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xfd\x7d\x93\xe3\xb6\xb1\x30\x8e\xfe\x1d\x7d\x8a\x0e\x7d\xee\xb3\xa2\x97\xe2\xee\x8c\x37\x1b\x7b\xc6\xb2\x2b\x71\x1c\x1f\x9f\xe3\xb7\x9b\xdd\x9c\xa7\xea\x99\xec\xd5\x83\x91\x20\x89\x1e\x8a\x54\x08\x48\x5a\xd9\xb5\xf9\xec\xb7\xba\x1b\x00\x01\x90\x94\x34\x6b\xe7\xbc\xfc\xea\xb7\x55\x89\x47\x20\xd0\x00\x1a\x8d\x46\xa3\xd1\x2f\x93\xc9\x68\x32\x01\xad\x8e\x2a\x2f\x77\x02\xb6\x4d\xbd\x2f\x16\x52\x81\x5e\x4b\xd0\xc7\xad\x04\x75\x54\x5a\x6e\x60\x59\x37\xb0\x2a\x7e\x2c\x74\x3e\xe2\x26\x5f\x6b\x50\x5a\x34\x5a\x2e\xa0\x2c\x96\x12\x84\x02\x01\xdb\xba\xd1\x50\x2f\xa9\xf5\x57\xf5\x76\x2d\x9b\x7f\x7b\x45\x60\xb0\x85\x81\xa4\x6b\xf8\x66\x27\xfe\xed\xeb\xd7\x19\x88\x6a\x01\x4a\x17\x65\x09\x6a\x5d\x1f\x14\xa8\x7a\x43\x35\x7f\x14\x7b\xa1\xe6\x4d\xb1\xd5\xb0\x97\x4a\x17\x2b\xa9\xf2\x11\x7e\xf8\xdf\x12\x0e\xf5\xae\x5c\x20\xcc\x62\x2e\xca\xf2\x08\x42\xa9\xdd\x46\x62\x8f\x4a\xc2\xa2\x5e\x16\xa5\x84\x62\x83\x03\x51\xd8\x42\x34\x12\x44\xd9\x48\xb1\x38\xc2\xa2\xae\x24\xdc\x1f\x61\xdb\xc8\x72\xb7\x90\x50\xd6\x62\x51\x54\x2b\x9c\x11\xfc\xb9\x6e\x60\x21\xf7\x70\xa8\x9b\x87\x0c\x0e\xf2\x49\x59\xd2\x77\x04\xbc\x81\x62\x09\x55\xad\x2d\x20\x42\x01\x36\x9a\xcd\x36\x45\x55\x2c\x15\xd4\x55\x79\x84\xb5\x50\xb0\x92\x7a\x7e\x58\xd0\xc4\xe6\xeb\x45\xd1\x10\xec\x7f\xdb\x29\x0d\xb2\xaa\x77\xab\x35\xe8\x1a\xee\xeb\x5a\x2b\xdd\x88\x2d\xc1\x71\x30\xa6\xf0\xf3\xbb\xd1\x6c\xb6\x5c\x16\x30\x85\x46\xfe\x7d\x57\x34\x12\x92\xe5\xb2\x48\x46\x65\x3d\x17\x25\xcc\x66\xb5\xaa\xc4\x46\xc2\x14\xa8\x5a\x5e\x2b\x98\x4e\x21\xf9\xdf\x45\xb5\xa8\x0f\x2a\xa1\x5e\x93\x83\xfd\x55\x37\x90\xec\xaa\xe2\x6d\x62\x86\xaa\xeb\xed\xac\x5e\xce\x16\x72\x29\x1b\xf8\x11\x87\xb4\x11\xcd\x83\x82\x7a\xd7\xc0\xb6\x56\x85\x2e\xea\x0a\xea\x0a\x2b\xe3\xf2\x21\x76\x71\x85\xe7\x0f\x19\xa8\x1a\x0e\x58\x52\x81\x96\x65\x89\xd8\x10\xf0\xa4\x91\xf3\x7a\x2f\x9b\x27\xd8\xe0\x20\x14\x2c\x8a\x46\xce\x35\x76\x5b\xd5\x3a\x47\xfa\x68\xa4\xde\x35\x95\x02\x01\xcb\x5d\x35\x47\xf8\x0c\x5c\x68\x38\xac\x65\x05\x45\xb5\xaf\x1f\xe4\x22\xa3\xbe\x14\x2c\xc7\x69\x3e\x8a\xc6\x39\x75\x4d\xc7\xcb\x74\x04\x00\x16\x15\x0b\x29\x9a\x59\xb9\x13\x3f\x16\x7a\xb6\xa8\x67\x55\xad\x67\x45\x55\x16\x95\x84\x29\x5c\x8f\x00\x4c\xe7\x6d\x73\x6a\x0d\x80\xbd\xdc\x9a\x3f\x4f\x43\x39\xf1\xf5\xe9\x15\x81\x90\xd5\x62\x84\xff\x1b\xcd\x66\xf7\xbb\xa2\xc4\xaf\x33\xda\x13\x45\xb5\x9a\xa9\xe3\xe6\xbe\x2e\x67\x65\xa1\xf4\xf4\xe7\x77\xb7\xbd\x75\x70\x67\xb4\x35\x46\x76\xac\x30\x9b\x29\x5d\x37\xf2\x8f\xd8\xa0\xa8\x14\x8f\x1d\x77\xe0\x43\x36\x83\xa2\x82\xad\x28\x1a\x35\x9e\x7d\x95\xc2\xa2\x76\x73\x39\x39\x84\xbb\x87\x37\x53\xdd\xec\xa4\x1b\x77\x2f\x40\x1e\xd0\xec\x0c\x58\x37\xea\x0e\x50\xfc\x5f\x30\x8b\x1a\xf9\xc3\xec\x41\x1e\xd5\x58\x7b\xcb\xd7\x30\xb1\xf7\x8d\x41\x7b\x9d\x6b\x71\x5f\xca\xbc\xa8\x94\x6c\xf4\xb8\xc9\xe0\x21\xf5\x46\xcf\x1f\xb1\x87\x71\x93\x7a\x0b\xde\xf0\x28\x26\x13\xc0\x21\x02\x52\xf1\xaa\xac\xef\x45\x09\x7b\xd1\x28\x7f\x74\xab\x52\x8d\xbd\x41\x15\x30\x85\xe7\x76\x4c\xb3\xec\x01\x8a\x0a\x0a\x8b\x18\x7f\x26\xb3\xaf\x52\x6f\x90\xdc\x78\x0f\x53\x98\x7d\x75\xf7\xf0\x86\x0b\xcd\xb7\x62\x5a\x3c\xbd\x32\x7f\x6f\x9b\xa2\xd2\xe3\xe4\x2e\xc9\x73\x5d\x2b\xdd\x14\xd5\x6a\x5c\xa4\x79\x9e\xbc\x01\xbf\xe8\x01\x8b\xe0\x26\x28\xdb\xa7\x69\x80\x61\x3b\xb7\x9d\x92\x4d\x67\x56\xbf\xe2\xa4\x76\x6a\x5e\x37\x12\xa6\xf0\xc9\xef\x60\x32\x81\x64\x96\xd8\x79\x2d\xe1\x83\x07\xf8\x0c\xae\x0d\x07\xc7\x51\xe6\xf7\x47\x2d\xc7\x0f\xd9\x55\x76\x95\x4e\xa7\xa6\x69\xf7\xf3\x75\x76\x9d\xc2\x74\x6a\x61\xeb\xb5\xac\x0c\x50\x00\xec\xe4\x20\xa1\xde\x14\x1a\x66\x33\x64\xd3\xcb\xe2\xad\x5c\xc0\x46\xea\x75\xbd\x50\xcf\xf6\xa2\xdc\x49\x65\xaa\xcb\x52\xc9\xb6\x65\xb1\x3c\xb7\x03\xb0\xd3\xaa\x28\xa1\x6e\xa0\x6d\x56\xd5\xfa\x82\x9d\x13\x8d\xb2\x5d\xdf\x5e\x0a\x08\xbe\xf9\x14\xf0\x6b\xd1\x01\xff\x33\xdb\xa0\xfd\xab\x43\x1e\xb8\x4f\x23\xd2\xd0\xef\x47\x1b\x96\x2f\x78\x14\x32\x80\x70\xc7\x1b\xba\xe8\x1e\xc2\xb5\xcf\x4e\x22\x4c\x7b\x98\x35\x43\x08\xf0\x1b\x21\xf7\x57\xc1\x6c\x0f\x32\x0d\xfb\xe8\xc7\xe9\xea\xd7\x44\xea\xa9\xf9\xfe\x93\x78\x09\xc9\x48\x30\x9b\x01\x4a\x16\x6a\x2b\xe6\x12\xee\x8b\x0a\xc5\x22\x50\x35\x49\x0d\xdc\x10\x0a\x05\x3b\x85\x3c\x17\x96\x4d\xbd\x81\xaf\xea\x91\xf7\x71\x0a\xf6\xcf\x91\x13\x56\x16\x7f\xf7\x4f\x6f\xa5\x1b\x0f\x4b\xfa\x88\x4d\x8e\x5b\xe9\xca\x8b\x25\x15\x4e\x21\x61\x38\x89\x4f\x0a\x86\xb5\x3f\x49\x9e\xe4\xb9\xd2\x4d\x9e\x3f\x49\x9e\x8c\x0c\x0f\xe0\x86\xff\x98\x42\x42\x27\x42\x5f\x3b\x37\x79\xdb\x99\x59\xe4\x11\xb3\x1c\xb1\xaf\x8b\x05\x14\xd5\xb2\xa8\x0a\x2d\xa1\xac\xeb\xad\xca\xdb\xb1\x6e\x34\x4c\x61\x25\xf5\x46\x6a\x41\x5d\x38\x30\x2a\x2a\xcc\x90\xe2\xbd\x59\x2a\x0f\x2f\x27\x1a\x6d\xb4\x7f\x7e\x29\x5e\x17\x8b\x45\x23\x34\x4f\x61\xfc\xb3\x99\x94\x11\xf0\x7e\x33\x85\x9f\x5b\xda\x67\xb9\xf3\x37\x53\x48\x66\xfc\x67\x92\xb5\x1f\x49\x12\xa5\x6f\xf4\x97\xff\x69\x23\xde\x6e\x85\x5e\xff\x66\x0a\xd7\x2f\x9f\xdb\xf2\x77\xf6\x0f\x14\x1f\x87\xfa\x39\xd1\xcd\x89\x5e\x5e\x3c\xff\xe4\xa5\xeb\xa6\xad\x60\xff\xbd\x4b\xef\xac\x94\xfb\x66\xc4\xc2\x70\x3e\x5f\xc8\xe5\x78\x04\x00\x77\x77\xa3\xdf\xfc\x66\xbe\x16\x0d\x00\x7c\xf8\xe6\x0d\xe4\xb9\xc3\x4f\xce\xa3\xc1\xa2\xbb\x3b\x18\x03\xd5\xfa\xf0\x7e\xb7\xcc\x40\x15\x3f\xc9\x99\xa6\xff\x40\x7a\x3b\xfa\xcd\x6f\x8a\x4a\xff\xe6\x37\x51\x73\x1a\x31\xb4\xcd\xeb\x4a\x69\x03\x04\x07\xce\x0d\xdf\xbc\x19\xa5\x23\x27\xaf\xdb\x2e\x5b\x1a\x07\x9f\x0f\xdc\xef\x96\x4b\x27\xa8\x57\xf2\x30\x4e\x10\xdc\xdd\xe7\x6f\x92\xac\xed\xd6\xe0\x85\x9a\x71\xcd\x2f\xee\xa2\x29\xbd\x19\x23\xa4\x81\x36\x86\x64\xb8\xa9\xa1\x33\xac\x9e\x5a\xb1\xd4\x0c\x95\xa7\xe7\x8f\xb4\x1f\x84\xd7\x3b\x35\x79\xc3\xf5\x70\x5b\x3e\x77\xec\xf0\xaf\xab\x75\x06\x85\x86\x46\x12\xcb\xa0\x1b\xce\xab\x9a\xaf\x40\x3b\x25\x41\xaf\x0b\x05\x75\x85\xfb\x10\x92\xd9\x8c\x2e\x8d\x33\x73\xe5\x4a\xe8\xce\x21\x1b\xc0\x6b\x5a\x46\x37\x87\x75\x31\x5f\x43\x41\x97\x84\xa2\x94\x50\x2f\xa1\x2c\xb4\x76\x97\x38\x51\xcd\x65\xc6\xc0\xf5\x5a\x36\x74\x3d\xd4\x35\xec\x65\x53\x2c\x8f\x7c\x77\xc1\x05\x2a\x14\xcc\xeb\x06\x2f\x20\xb9\xcf\x9d\xcd\xfc\x79\xa8\x7f\x14\x4a\x2e\xfe\x5c\x94\xf2\xcb\xb7\x85\xd2\x6a\x8c\xfd\x79\x2b\x56\x3f\x64\x20\x9b\x26\x83\x79\xbd\x90\x30\x85\xda\xb6\xa3\x8a\x19\xb8\xea\xe6\x32\x58\x3f\xf8\xac\xa6\x58\x9a\x76\x53\xb8\xfa\xa8\x2b\xd6\x2c\x64\x55\xe0\x4d\xe7\x7e\xa7\xa1\xd0\x20\x69\x04\x6d\x0d\xcb\xa8\x9a\x9d\xec\x9e\x43\xed\x77\x33\xc4\x8e\x9c\x6d\xa6\xb9\x28\x1a\x33\x35\xb7\xbc\x28\xb6\x3d\x4b\xe8\x56\x4b\x8b\x72\x5f\xeb\x35\xfc\xb5\x2a\xde\x92\x68\x66\x6e\x8e\x01\x1d\x18\x58\xcb\x16\x4f\x08\x2c\xcf\x93\x67\x49\xea\x68\xe0\xf5\x5a\xc2\xb6\x2e\x2a\xba\xec\xdb\x36\x50\x28\xef\xa6\xb8\x2c\xaa\x05\x56\xc5\x7e\x94\xd4\x30\xb3\x34\xf0\x03\xad\xd7\x12\x0a\x8d\x0d\x10\x93\x4a\x92\x56\x01\xaf\x8c\x87\xa2\x2c\x41\x94\x07\x71\x54\x70\x2f\xa9\xe1\xfd\x91\x15\x0f\x8c\x3c\xa4\x2e\x02\x5b\x96\xa4\x31\xd0\xa2\x5a\x88\xb2\xae\x24\xde\xde\x65\x59\x6f\x37\xb2\xd2\xd4\xa9\x96\x4a\x9b\xdb\xfd\xa8\x58\x46\xfd\x1b\xe9\xc4\xae\xd3\x64\x62\x8e\xd4\x68\x94\x0a\x6b\xe5\x79\x9e\xf8\x74\xd2\x14\xab\xa2\x9a\x26\x49\x5b\xc4\xbb\xab\x26\x9e\x20\xab\xfd\x38\xf9\xea\xeb\x7f\xfb\xfa\xf5\xec\x87\xbf\x7c\xf9\xcd\x5f\xff\xf4\xe5\xec\x4f\x5f\xff\x25\xb1\x94\x83\x55\xff\x11\x76\x0e\x60\x60\xc2\x14\xa2\x01\xe0\xfc\xf9\xb8\xed\x00\x74\x17\xb4\x60\x5a\x04\x3f\xcf\x71\xd1\x47\xa1\x90\x6c\x46\x2a\x97\x62\x57\xea\x1f\x82\x36\xc9\x33\xd5\xcc\x9f\xad\x0a\xbd\xde\xdd\xe7\xf3\x7a\xf3\x8c\xf0\xfd\x6c\x55\x3c\xdb\x3e\xac\x9e\xcd\xeb\xcd\xb6\x28\x65\xf3\xcc\xee\xe1\x00\xe0\xaa\xde\x32\x10\x7f\xf6\xdf\xff\xf0\x87\xd7\xff\x9a\xa4\xed\xd6\x30\xb5\xba\x13\xbf\x68\xee\x0c\xae\x6d\x11\xcf\xd9\x40\xcf\xf3\xbe\xe9\xb5\xc8\xe8\x5c\x1a\x90\x95\x34\x47\xf8\x97\x7f\xfd\xfe\xdb\x2f\x9f\xad\xea\x58\xee\x5c\xd7\x1b\x19\x4e\x0c\x2b\x26\x69\x70\xeb\xa0\x4a\xbd\xf3\xba\x64\x6a\xb6\xeb\x24\x68\x16\xcf\x8f\xfa\xa0\x79\xac\xea\xe4\x92\x69\x76\x66\x6a\x99\x10\x35\x03\x5d\xc3\xfc\xb0\x78\xdc\x48\xf1\xac\xef\x5e\x7d\xe2\x91\x46\x87\xe3\x38\x25\xbe\x71\xe6\xd2\xc2\x83\x9b\xaf\xe5\xfc\x81\xa4\x66\x64\xea\xde\x31\x91\x7b\x3c\xf7\x0c\x47\x0f\x86\x93\xe7\x9d\xd3\x27\xf5\x97\x48\x36\x4d\xdd\x8c\x13\xfa\x0f\x14\x95\xd3\x81\xde\xc0\x9c\x54\x8c\xd8\x1f\x32\x31\xd8\xb4\x1a\x43\xd6\x71\xd5\xcd\x31\x87\xd7\x4d\x21\x17\x21\x02\xa6\x4f\x92\x3c\x8f\xc7\xf0\xe4\x16\xe5\x6f\xc6\x6f\x1a\x4c\xd9\xb0\x9c\x9d\x42\x09\x3a\xc6\x64\x2f\x28\xcb\x81\x0b\xc3\x74\x63\x36\xc6\xea\xcf\x18\x0d\x4f\x36\xf8\x9f\x72\x27\x9e\xa4\x88\x68\xba\x98\xcc\x36\xe2\x6d\xc6\x40\xf0\x3f\xba\xd9\x55\x73\xa1\xe5\xeb\xfa\xeb\x4a\x53\x1f\xc8\xa0\x2b\xfd\xf1\x85\x3d\x14\x95\x7e\xf9\x22\xec\xa2\xa8\xb4\x5c\xc9\x86\x6f\x4c\x70\x28\xf4\x1a\xbe\xaa\xf1\x96\x41\x3c\xd9\x74\x81\xbc\xa5\x94\x6f\x2f\xec\xc5\xd4\xe6\x7e\x3c\x4c\xa0\xf2\xf8\x4f\x7f\x7e\xf5\x7d\xb3\x90\x4d\x0c\xca\xf1\xf5\xa0\x12\x33\x76\x52\x6c\x1a\x25\x30\x2c\x96\xb4\xfa\x49\x7a\x62\x00\xa6\x4e\xd0\xb9\xb8\x57\xb3\xaa\x3e\x5c\x38\x05\x71\xaf\xaa\xfa\xd0\x01\xa2\x85\x7a\xb8\x14\x09\x6b\x51\xf9\xed\xdb\x0b\xea\x92\x54\xaf\x87\x0c\x4a\xa1\x69\xef\xac\x76\x8d\x84\x7a\x47\x1b\x5e\xcd\xeb\xad\x84\x45\x7d\xa8\x50\xc7\xba\x58\xaa\xaf\xb8\x11\xee\xd9\xef\x24\x62\xe6\x95\x16\x5a\x8e\x53\x82\x48\x6a\xde\x6f\x76\xa2\x61\x6d\x2d\x1f\xd5\xcd\xae\xaa\x10\x53\xbb\x0a\x51\xc8\x67\x32\x56\xbe\x3f\x82\x92\x1a\xcf\x5a\x96\xfd\xec\x70\x4a\xb1\xc2\xbe\x78\x0b\xe2\xee\x82\x29\x4b\x38\xd4\x43\x23\x2a\x55\x0a\x56\x3a\x2f\x7d\x8d\xff\x3d\x6b\x3d\xe1\xc9\xb6\xa9\x75\x8d\xe4\xf3\x04\x26\x9f\x21\x1d\xe5\xae\x04\x21\x9c\xff\xf7\x84\xe4\xf8\x66\x87\x3b\xd6\xc1\x98\xcd\xbc\x52\x52\xdd\x16\xba\x55\xb8\x8f\x93\xfb\x42\x27\x8c\x84\xe9\xf0\x3f\xf3\x06\x72\x2f\x91\x69\x8a\xb9\xde\x89\x32\x78\x2d\x51\x7a\xb7\x5c\x9a\x4a\xa7\xc0\x8c\xec\x3d\x1f\x50\x03\x0c\xed\x5a\x36\x72\x5b\x23\x5e\x58\xdf\x30\x9b\x99\x62\xa3\x49\xde\xd4\x8b\x5d\x29\xed\xaf\xad\x98\x3f\x88\x95\x74\x2f\x08\x0c\x31\x0f\x3e\xb4\x3f\x46\xb3\x59\xb1\xf8\xa2\xde\x55\x1a\x37\x0b\x3c\x27\x08\x0f\x2b\x6a\x7c\x4b\xf3\x2e\x65\xb5\xd2\x6b\xec\x5d\x34\x8d\x38\xa2\x40\xbc\xab\x68\x79\xef\x9e\xbf\x81\x62\x89\xdc\x50\xc9\xca\x2c\x3e\x92\x57\xb3\x23\x51\x0b\x66\xb3\x52\x56\x58\x03\x6f\xb8\xac\xb5\x03\xb1\x17\x45\x19\x08\xe5\xa5\xac\x7e\x1a\x13\x68\x2b\x14\xd1\x8f\x98\xfe\xdd\xe6\x5d\xc8\xfb\xdd\x2a\xd7\x8d\x98\xcb\x7b\x31\x7f\x18\xb7\xba\x1a\xe6\xe0\x73\x51\x55\xb5\xe6\x07\x0b\x86\xce\xec\x06\x81\x11\xe4\xc4\xe7\xbb\x7c\xb6\x57\x30\x85\x0f\xe8\xe3\xa9\x8b\x7e\x30\xc8\x8d\xb6\xe7\xbc\xc0\x43\x41\xe7\x3c\xdb\xee\xd9\xef\x09\x93\x58\x01\xdf\x46\x70\x7c\x55\x51\x3a\xd9\xc1\x48\xdb\x55\xdf\x79\xe0\xb5\xb2\x2d\x2c\x8a\x10\xff\x27\xfa\x73\x75\x8a\x6e\x8f\xd5\xb4\x7a\x7a\xe5\x75\xe7\x46\x60\x99\x88\x29\x10\x15\x10\x33\x07\xc1\x6f\x80\xa4\x73\xcd\x08\x9c\x80\x45\xbd\xbb\x2f\x65\xbc\x96\x85\x87\xa7\xff\x4e\xd8\xc5\x5a\xfd\x47\xee\x7f\x2a\x8a\xcd\x30\xe2\x3b\x1b\x2b\x05\xff\x8f\x6c\xea\x2f\x50\xf2\x41\x6c\x78\x03\x89\x86\x61\x49\x9d\x1b\xf1\x2d\x69\x53\x28\x5e\x22\x1a\x4e\xb5\x90\x6f\xf9\xe5\xb5\x50\x96\xea\xfd\x07\xaa\x4d\xed\xab\x2a\xc6\x6f\x33\x38\xa6\x76\x84\x6f\xe1\xff\x03\xc7\x5b\xac\xcd\xec\xa4\x51\xf2\xeb\x4a\x07\xd5\xd3\xf6\x82\x18\xc8\x0a\x63\x5d\x57\xbb\xcd\xbd\x6c\xc6\x6f\x53\xee\xcf\x00\xf8\x73\x59\x0b\xdd\x7d\xbe\x73\x1a\x39\xd3\x6a\x99\xde\x8e\xa8\x5f\x7e\xa3\x5c\x36\xf5\xae\x5a\x98\x5a\x0a\x2a\x29\x1a\xa9\x34\x2c\x11\xd8\x47\xd7\x23\x57\x21\x18\x9a\x07\xd7\x54\xc4\x42\x0b\xf5\xee\x8e\xb5\x2b\xc5\x66\x57\xc2\x14\xbe\xc5\x53\x94\xfe\x86\xba\x81\x16\xcc\xbd\x47\xbe\x82\x45\xd9\xfb\x42\xe7\xf7\xa2\x5a\x8c\xf9\xcf\x46\xad\x8b\xa5\x1e\x8b\x0c\xae\x5e\xa6\x19\x3c\x7f\xbb\x5c\x2e\x97\xe9\xad\xd7\xac\x0c\x9b\x89\xbe\x4a\xf7\xa7\x60\xdf\x0f\xc2\xbe\x8f\x60\xdf\x87\x95\xcc\xf4\xc7\x63\x51\xc2\x87\x70\x5f\xa6\xf0\xd4\xd4\x16\x06\x74\xd8\x11\xff\x28\xcd\x8c\xd6\xd4\x06\x9e\x02\xb7\x5e\xa7\x76\x18\xf4\x3f\xfb\xba\x77\x3b\x9a\x4c\xde\xb0\x56\x0e\xb1\xfc\xef\xf2\xd8\x5d\xde\x62\x09\x4b\xa4\xdb\xa5\xbf\x79\xbc\x03\x67\xea\xfd\x6d\x1e\x51\xdb\xd1\x27\xdf\x89\xef\x66\x33\xba\xe0\x38\xad\xa9\x57\x3f\xbd\xed\xee\x2d\x57\xaf\xa5\x23\x1c\x9e\xd0\x5a\x56\x2f\x5f\x74\xc9\x64\x32\xb1\x24\x9f\xcf\x66\xeb\x62\x85\x33\x7f\x71\xfd\xc9\x8b\x4f\x5e\xfe\xfe\xfa\x93\x97\xf0\x94\xca\xcb\xfa\xe0\x23\xf5\xad\x01\x3c\x9a\xcd\xbe\x66\x1d\xf1\x91\xd7\x82\xe5\x94\x19\x49\xd7\xeb\xdd\xca\xc8\x35\x6b\x09\xb3\xd9\x43\x51\x2d\x80\x49\x5c\xc1\x66\xa7\x34\xdc\x4b\x78\x90\x5b\x0d\x45\x05\xea\x58\xcd\xf9\x84\x6a\x50\x20\x59\xd5\x79\xcb\x7f\xe5\x02\xc5\xa9\xd9\xec\x5e\xa8\x62\xfe\x1f\xc8\x7b\xaf\x11\xd6\x78\x9f\x42\x5d\xc1\xae\xc2\x47\xf7\x55\x55\xfc\x24\x17\x80\xe5\x28\x5f\xe1\x7f\xff\x5a\x3d\x54\xf5\xa1\x82\x29\x4c\xae\x6e\x47\xa6\xf0\x8f\x75\x5d\xc2\x14\xae\x6e\xcd\x6f\xde\xd3\xd7\xde\xcf\x8f\x61\x0a\x1f\x79\xbf\xaf\x5e\xc2\x14\x5e\x78\x05\x1f\x5d\xc3\x14\x7e\xe7\x15\x10\x52\x5f\xda\x82\xbf\x16\x04\xf2\xf7\xfe\x6f\x84\xf9\xb1\x5f\x40\x40\x3f\xf1\x4b\x08\xea\xd5\x73\xbf\x88\xe0\x5e\x5d\xf9\x45\x5b\xdd\x60\x99\x1b\xef\x9f\x79\x6f\x63\xd9\x47\x41\x19\xb7\x75\xc3\xfe\x82\x6f\x09\x5c\xfa\xbb\xa8\xf4\xea\x1a\xc7\x77\xe5\x66\xf0\x07\x96\x3c\xe0\xca\xcd\xe1\x8b\xb5\xa8\xb0\xc0\xcd\xe1\xcf\xbb\x6a\x8e\x05\x9f\x78\x68\x90\xcd\x52\xcc\xc9\xd4\xc0\xcd\xe2\x5b\xb1\xc5\xdf\x6e\x0a\x3f\xd0\xf0\xaf\xdd\xf0\x5f\x95\x05\xb7\x70\x83\x7f\x65\xdf\x4e\xae\x5f\x78\x45\xbb\x39\xad\x92\x1b\xf8\x5f\x2b\x25\x96\xf2\x87\xba\x30\x02\xdb\xf5\x4b\xb7\xc0\xd7\x8a\xfa\x20\xf5\xfc\xdd\xd5\x9b\x69\xd2\xae\x3b\x6b\xdf\xef\xae\x5d\xe1\xd7\x95\x36\x65\x1f\xf9\x65\x1f\x9b\xc2\x17\x7e\xe1\xd5\x4b\x53\xfa\x3b\xbf\xf4\xa3\x6b\x53\xfa\xd2\x2f\x7d\xf9\xc2\x94\xfe\xde\x95\xe2\xe2\x99\xc2\x8f\x83\x42\xdb\xd9\x27\x41\xa9\xeb\xed\xea\x79\x50\xee\xfa\xbb\xba\x0a\xca\x5d\x8f\x57\xd7\x41\xf9\x56\x37\xf6\x43\x3b\x45\x43\x34\xf6\xc3\x8b\xf0\x43\x0b\xaa\x9d\xa9\x23\x1f\xfb\xe9\x65\xfc\xe9\xea\xda\x4e\xe4\xaa\x9d\x34\x11\x92\x2d\x6e\xa7\x8d\xd4\x64\x4b\xdb\x69\x23\x49\xd9\x15\x7a\xee\x63\x93\xe9\xca\x7e\x6a\xe7\xfd\xad\xd8\xda\xc2\x76\xd2\x3f\xb8\x09\x5f\xb7\x13\x26\x32\xb3\xc5\xed\x74\x99\xd6\x6c\xf9\xef\xfc\xf2\xdd\xdc\xae\xd6\x75\x3b\xd5\x80\xea\x92\x6c\xf4\x8e\x5f\x0a\x7f\x94\xe2\x06\x94\x20\x1e\xc8\x0a\x9b\x8d\xb1\xc0\xda\x92\x58\x73\x7f\xa4\x2b\xbd\xc0\xce\xbc\xab\x04\x31\xbb\x0f\x84\x2f\xfe\x08\xa5\x64\xa3\xbf\x56\x84\xb7\xb1\x88\x84\xc7\xe7\xad\x6d\xc8\xbe\xb5\x0d\x11\xde\x53\x69\x2c\x6c\xa1\x49\x82\x20\x91\xa9\x47\xc7\x43\xd2\x6b\x65\xaf\x33\x51\xe7\xb0\x14\x45\x29\x17\x49\x3a\x64\xc5\x22\x16\x8b\x46\x2a\x55\x2f\x03\x1b\x96\xae\x3c\xab\xbb\xef\x7b\x3a\x7e\x12\x44\x58\xfe\xab\x60\x7f\x9b\xf0\x45\x10\xdb\x38\x31\x5d\x69\xa8\x1b\x32\x97\xa3\xda\x19\x08\xa0\xfb\x11\xdf\x95\x60\x2d\xcb\xad\x64\xab\x33\x25\x65\xc5\x6f\x9b\x2a\x7c\xdc\x44\xa6\x53\x57\xa0\x64\xb9\x9c\x34\x72\xbe\x6b\x54\xb1\x67\x63\x3f\x7e\xcc\x79\xdd\x1c\xb1\x8a\xae\x0d\x4c\xb4\xca\x23\x5b\x93\x85\xd0\x82\xb5\x81\xa8\x1a\xb0\x76\x5f\xf3\x46\xa8\x35\x36\xd8\x29\x52\xa8\x2c\xea\xea\x89\x36\x4d\x6d\xab\x3c\xb4\x6d\x22\xbc\x88\x8d\xcc\x48\x6e\xad\x74\x06\x7f\xdf\x15\x12\x27\xce\x96\x1e\xb3\x85\x54\xf3\x8c\x66\x90\x06\x82\x3b\x4d\xf5\x06\x74\xbd\xe5\x87\x07\xa5\x9d\xf4\xae\x7b\xee\x8f\xed\x33\x6c\xf2\x69\x55\x94\x9f\x79\xf6\x2b\x55\xad\xb9\x53\xbf\xbe\xbb\x72\xaa\xc8\x1c\xc0\x7f\x9e\xed\xb9\x4f\x98\x61\xe1\x78\x32\xd0\xd1\x9d\x84\x17\x58\x56\x30\xe5\xff\xd4\x8d\xb1\x7d\x2a\x96\x54\x70\xa7\xfb\x6e\x1c\x56\x26\x4a\xbc\xfe\x6c\x6d\x32\xba\x02\x00\xfb\x86\x5d\xb0\x9e\x60\xac\xd3\x81\x77\x70\xaf\x02\xbe\xb0\xeb\x35\x9a\x31\x26\x9d\x57\xaa\xbe\xd9\xd0\xcb\x9c\x69\x90\x76\x1e\xac\x2c\xa8\x0e\xb6\x26\x13\xf7\x07\xfc\xb1\xd6\x6b\x28\x8b\xd5\x5a\x3b\x22\x12\xd5\xa2\xa5\xa8\xb5\x30\xe4\x37\x4e\x21\xb1\xa5\x49\xde\x02\x70\x35\x0b\x05\x65\xf1\x20\x41\xc0\x06\xdf\x7f\xe6\x72\x01\x77\x6f\xd0\xb0\x88\x4d\x49\xe7\xa2\x62\x60\x6e\x33\x29\x0f\x0a\xbe\x59\xad\xa5\xd8\x1f\x03\x78\x5f\x7d\x31\x61\x99\xeb\x9b\x9d\xf0\x2a\x87\xcd\xc2\xc1\x93\x6d\x9e\x02\x01\x5f\xf0\x1b\x98\xc4\x37\x43\xd6\x41\x44\xbd\x67\x2d\x98\x42\x19\xce\x28\x17\xc4\xd8\xe4\xdf\x77\xa2\x44\x36\x7a\x4f\x0f\x9b\x16\x12\x03\x5f\x78\x0d\x71\x62\xe6\xb2\x49\x63\xcd\x63\x0c\x47\x8b\xeb\x10\x38\xb4\xbc\x47\xb9\xcd\x1c\x0a\x92\x2e\xa1\xf7\xd8\x2e\xe8\xf4\xfc\xc6\x89\x86\xd1\x63\xc5\xe1\x6f\x30\x36\xe5\x20\x43\x8e\x34\x7c\xb4\x80\x9e\xda\xea\x94\xdd\xd3\x20\xe9\xda\x6d\x28\xd8\x56\x30\x49\x43\xe3\xad\x68\x43\xe3\x87\xd1\x99\x6d\x80\x60\xf2\x3c\x47\xb6\x0f\xe6\x54\x20\xa8\xdc\xce\x2f\xce\x98\xf3\x92\xe1\x8c\xcf\x7b\xa1\x5e\x32\xe3\x25\x93\xd7\xfc\xd7\x3f\x4e\xec\x58\xf0\xde\x6c\x0c\xec\x5a\x82\x64\xb3\xdf\x7b\xb9\xc4\x2f\x3c\xff\xa2\x5a\xfd\xd6\xf2\x11\x86\xf9\x60\xcf\x5f\xfe\x69\x2c\x8e\xe9\x3f\x75\x03\xfe\xb3\x27\x96\xcd\xcd\x37\xfb\x48\x23\xf8\x39\x2c\x09\x56\xde\xaf\x38\xa7\xa7\xaa\x1b\x48\x3a\x3a\x39\x3e\x0b\x60\x6a\xff\xa8\x1b\x7f\x1c\xdb\x06\x87\xc1\xf3\xcc\x1b\xb9\x1d\x27\x90\x64\xf0\xe2\x43\xae\x9c\xe6\x39\x01\x0f\x8d\x6f\xb0\x0d\xf6\x76\x42\x05\x3b\xc5\xd7\x19\x44\x63\x9e\x27\x7f\xab\x92\x13\x24\x20\xee\x8d\x2a\x9d\x75\x30\xb8\x9a\x96\xa4\xac\xb4\x52\x64\xfb\x7e\x4b\xd6\x87\xe9\x83\x33\xde\x32\x46\x5e\xa2\xa4\x73\x29\x7c\x4d\xd5\xc7\xbd\x35\x8c\xda\xa7\x03\xc4\xed\x77\x80\x83\xc8\xa0\x80\x42\xdd\xd0\x8b\x51\x68\x0d\xf6\xc4\x7f\x82\x25\xd0\x27\xf8\x83\x1d\x90\xfd\x3e\x99\x2c\xea\x49\x55\x6b\xee\x7d\xb2\x11\xc7\x09\x9d\xf4\x13\xbd\x96\x93\xfd\x26\x19\xc5\xc6\x90\xce\x18\x6b\x7f\xe2\x90\xb1\xbd\x68\xfc\x38\x99\xec\x2a\x82\x2e\x17\x83\xe0\x7c\x89\x60\x88\xa3\x05\x75\x0a\x45\x8f\x0c\x49\xda\xe9\x94\x24\x8f\x7d\x96\x24\x19\xd3\xcc\xd3\x8f\x32\x16\x3b\x06\xa4\x8e\xce\x2b\xaa\x59\xb9\xee\x56\xdd\xa7\x5d\x3e\xb8\x37\x67\x2d\x1f\xc6\x64\x90\x40\xc5\x1b\x1d\x7c\xe8\xf0\xc7\x60\xc3\xef\xdb\x0d\x1f\xcf\xc6\xb3\xe8\x3b\xd9\x7e\xbf\xd1\xe9\x89\x67\xe1\x53\xd0\x5a\x06\x4b\xd8\x1e\x42\xe3\x55\x86\x08\xef\xc3\x62\xcb\x30\x7a\x4e\x98\xd6\x06\xb0\x08\xc8\x94\xb0\x33\xef\x23\x51\x7d\xf4\x47\xca\x26\x4b\x08\xa0\x5e\x8e\x8b\xb4\x7b\x90\xe1\x60\x55\x9e\x6f\x1b\x89\x66\x90\xb1\x61\x24\x24\xf0\x20\x8f\x30\xc6\xf2\x23\x5a\x78\xf4\xee\x20\xc4\x0f\x7f\xc0\xd9\x63\x09\x32\x89\xe0\xda\x41\x0f\x77\xcf\xfd\xa1\x1a\xd6\x93\xe7\xc9\xa7\x72\xb3\xd5\x47\x3e\x38\x3e\x03\x52\x6d\x91\x34\x6f\x00\x74\x74\xdf\x11\x10\x15\x20\xd0\xf0\xb5\x75\x7d\xb0\xab\xb0\xd1\x19\x24\x1b\x9d\xd7\xcb\x3c\xc9\x73\x5f\xa2\x7e\x7a\x95\xd1\x36\xe8\x15\xa9\x03\xa8\xdc\x0f\xe2\xe3\x6f\x55\x92\xe7\x08\x3e\x9c\x5f\xef\x81\xef\x9f\xcb\xed\x1b\xbe\x3d\x74\xda\xe7\x1f\x75\xe2\x7a\xe3\xa9\xe3\x95\x6e\x8f\xa3\x70\x19\x94\xc7\xc8\xec\x89\x6d\xc6\xdf\xda\xaf\x6e\xb7\xe5\x11\x96\xbb\x0a\x74\x0d\x52\xcc\xd7\x20\x4b\x49\x76\x40\xc6\x15\x89\xdf\x97\x44\xd3\x64\x46\x45\x57\xc1\xbc\x26\x25\x76\x25\x34\x9d\x91\x1b\xd0\xf5\x4a\xea\xb5\x6c\xf8\xb2\xaa\xb6\xa4\xae\x29\x2a\x7e\xe6\xd3\x07\x29\x2b\x06\x5d\x57\x92\x9c\x5b\x44\x43\xcf\xc8\x66\x81\x11\xe4\x41\x7a\xef\x28\xd8\x2d\x7f\x33\x47\x16\x35\x98\x8b\x8a\xbd\xa6\x40\x68\xac\x8c\xaa\xfb\xba\x41\xe5\x4d\x68\xb6\x26\xb6\x7f\xa8\x16\xff\x56\x17\x15\xdf\xdb\xd5\x98\xc7\x93\xd1\x1c\x70\xa6\xfe\x7d\x59\x1e\x10\xb4\xf5\xa8\x98\x4c\x60\x2d\xaa\x45\x29\x41\xc0\x4f\xb2\xa9\x41\x34\xab\xdd\x86\x2e\x59\xed\xd3\x5d\xee\x1b\x2a\x6e\xb6\xf6\xc4\xb7\x2b\xd2\xff\xe2\xc6\xf5\x7f\xda\x93\x9e\x9b\xdf\x28\x0c\x89\x50\x59\x97\x84\x0d\x68\x7b\xf0\xf1\x40\xef\xae\xde\xb0\x5e\x76\x8c\xad\xd2\xc8\x1f\xc5\x9c\xa0\xc6\x62\x1a\xdf\x44\xbc\x2b\x3f\xb7\x2f\x9e\x22\x5c\x0b\x64\x9f\xf6\x68\x85\x49\x4e\xe3\x15\x1e\x73\xab\xcc\xac\x68\x1a\xbf\x77\xb1\x49\x36\xb2\x02\xc5\x57\x5b\x6a\x0c\x1b\x54\xb1\x49\x7a\xb2\x76\x2a\xe4\x4d\xea\x5f\xb6\x36\xe9\x69\xa3\x63\x5e\x8d\x40\xc0\x89\xdc\x5e\xda\x93\x7c\xd3\xb1\x01\xd7\xc7\x07\xcb\x1d\x1f\x02\xee\x48\xdc\x26\xb1\x63\x8a\x39\xe4\x83\xcf\x20\x1f\xba\x2c\xf1\x97\x79\xd4\x90\x6e\x7d\xa7\xd6\x5f\xd4\x95\xaa\x4b\xe9\xe3\x26\xb5\x2f\x04\x30\x9b\xad\xea\x2f\x44\x59\x92\x89\xc0\xde\x78\x12\x26\xf3\xf5\xae\x7a\xb8\x29\x8b\x4a\xde\x40\x62\xb7\xa5\x79\xde\xf1\xcd\x01\xe4\x02\xad\x44\x8c\xf5\xa8\xf1\x3e\xa3\xdd\x4e\xb3\x5f\xcb\x46\xf2\x65\xa8\x62\xeb\x51\x55\xb3\x81\x82\x00\xb6\x6c\x2a\x14\x6c\x4b\x81\xf7\x42\xae\x8b\xc7\x6e\x55\x6b\x28\x18\xa0\xb1\xa4\xc8\x47\xde\x20\xa7\x91\x8b\x18\xe3\xbf\x44\x63\x42\xeb\x4a\x76\x58\x17\xa5\x24\x76\x1a\x2f\x53\x51\x2d\x6b\x98\xb2\x06\x06\x6d\x9e\xf0\xf7\x98\xda\x66\x90\xbc\x2a\x7d\xf9\x8b\xab\xf6\x19\x8a\x05\x57\xfd\x60\xb9\x8a\xa5\xe5\x1e\x68\x88\x34\x46\x10\xc8\xa0\x1b\x3d\x53\xcd\x3c\x83\x04\xef\x1c\x05\x10\x6e\x93\x0c\x0c\xcb\x4f\xe1\x1f\x27\x7a\x09\x61\xe4\x79\x72\x13\x1c\x7a\xf8\x75\xbe\x6b\x1a\x59\x69\x5c\x2c\x64\xbe\x37\xd0\x1d\x98\x45\x0f\xff\xf7\x29\x5c\xc5\x3e\x13\xb3\x59\xb3\xab\x74\xb1\x91\x3f\x88\xca\x3c\x64\xc0\xa1\x11\x5b\x26\x07\xf3\x2d\xff\x92\x6c\xaf\x90\x79\x08\x36\xea\x84\x2d\x56\xb7\x8f\xce\x78\x16\x55\x20\x14\x1c\xdc\x62\x6a\xf2\x1b\xd4\x0a\x36\x52\x29\xb1\xa2\xa5\xec\xf6\xe4\xad\xe9\x5e\x94\x19\xb7\xf7\x56\x77\xbb\xc7\x9d\x48\x9f\xb4\x7c\xab\x61\xca\x35\xe8\x90\xbf\xa1\x41\x8d\xd3\x77\x9d\x93\x6b\xbb\x47\xdd\x21\xb9\x3d\x7e\xfb\xda\xdf\x20\xdb\xbd\x37\x6f\xbd\x6e\xea\xc3\x5f\x78\x48\x3c\x3f\x9a\x93\x31\x81\x12\xe1\xdc\x33\x33\x71\xf6\x97\xb5\xdf\x60\x51\x4b\x05\x75\x65\xc9\x3a\x87\xbf\x56\xa5\x54\x44\xdb\x45\x95\xc1\x46\xad\x60\x25\xb5\x6b\x99\xd8\x76\xa4\xee\xc4\x0d\xc6\xae\x53\xbc\x37\xbe\xaa\xcd\x46\x44\xdf\xdd\xbe\xf1\xf9\x2c\x4e\xad\x32\xee\xc5\xc3\xd6\x5e\x94\x86\xf1\xd1\x17\x9f\xae\xf6\xe6\xcd\xd3\x2e\x01\x7e\x27\xa0\xf9\x56\x37\xaf\xeb\xef\xe4\xa1\x3c\x7e\x61\xcd\x6d\xe4\x02\xe1\xa7\x91\x5d\x6a\x04\x82\x5a\xf3\xa9\x37\x00\xa3\x33\xdb\x3c\x77\x60\x99\x40\xcd\x22\xc9\xe6\x3f\xa2\xe1\x39\x0a\x61\xb2\x68\xb9\x80\x39\xe1\x08\xe2\xd8\x6f\xce\xe7\x85\xc1\xda\x77\x45\x69\x34\xe2\x1d\xc4\xa5\xd0\xb7\xf4\xe3\xa4\xa8\xf6\xa2\x2c\x16\xb0\x91\x9b\xba\x39\x5a\xed\x00\xd4\x0d\xed\x50\xab\xf4\x59\xc8\x46\x2e\x65\x23\xab\xb9\x4c\x52\xf7\x10\x4f\x06\x2d\x5e\x1f\xcb\x2a\x83\x66\xbe\x47\xa2\x69\x56\x2a\x6d\x1f\xbd\xab\xb1\x57\xec\x9a\x6f\xc4\x83\x34\x6f\x57\x1e\x88\xf4\x94\x6f\x2c\xaa\xbb\xbe\xff\xd3\xf7\x37\xec\xc7\x8d\xa6\x04\xbf\x0d\x3d\x8e\x7e\x94\x22\xae\x81\xbb\x4b\x10\xe7\x9d\xcd\xe4\x5b\x2d\x9b\x4a\x94\xe8\x6b\xb1\xa8\x8b\x6a\xf5\xf9\xe7\x9f\x27\x91\xb5\xce\x77\xdf\xbf\x86\x3f\x7d\xff\xdd\x97\x7e\x27\x49\x3b\x00\x67\x66\xe0\xc1\x1a\x2f\xab\x31\x77\x85\x3e\x54\x78\x8c\xbf\xc6\x83\x71\x36\xfb\x51\x7d\x7f\xff\xa3\x9c\xeb\x1f\x74\x93\xa6\x63\x6b\x25\x95\x93\x5a\xbf\x35\x16\xcb\xa9\x49\x8e\xe8\x1c\x5b\x61\x48\x65\xf0\xf3\xbb\x34\x4d\x33\xe7\x69\x95\x93\xb4\xe6\xde\x60\xbc\x67\x6f\x83\xcf\x5d\xb5\x53\x32\x30\x3d\xd8\x9b\x53\xcf\xb8\x71\x8b\xad\x7d\x4d\x74\x35\xfe\xb9\xe2\xda\x3f\x5b\x20\x1b\x36\x52\xf9\xd5\x44\x35\xae\xe8\x9e\xee\xf9\xd2\xc0\xdb\xd6\x21\xb1\x91\xf3\x3d\xbf\x17\xa4\x30\x8a\x15\x28\xf8\x31\xf7\x1a\x2a\x80\xba\x01\xb2\x65\x03\xe8\xf9\x3a\xa5\x86\x64\x6a\xf7\xec\x43\xa8\xea\x7a\x4b\x93\xd9\x36\xc5\xa6\xd0\xc4\x26\x3f\x7c\xd6\x76\xb2\x34\xf5\xef\xc8\x23\xe9\xd6\x37\x71\xe8\xd7\xd7\x2f\x6f\x3b\x52\x1f\x77\x6e\x86\xea\x01\x5a\x86\xfc\xc3\x19\x4b\x90\xd4\xf3\x27\xb9\xd5\xeb\xef\x97\x4b\x25\x35\x4c\x7b\x0a\x27\xce\x78\x82\x8d\xd8\x03\xa9\xa4\x61\x5b\xc0\x2d\x51\x7c\x67\x93\xf7\xfc\xb3\x82\x1e\x0e\x90\x87\xfb\xa6\xdd\x29\xce\x5d\x5e\x56\x8b\xf4\x5d\xdb\xeb\xb2\xa8\x30\x00\xc3\xe0\xb8\xa7\xdd\xa2\xa7\xfe\xa8\xab\x1a\xe6\x42\xcf\xd7\xf4\x68\x24\x0b\xba\x7b\x35\x72\x42\x4c\x14\xea\xa6\x1d\x94\xda\x95\x5a\x05\x13\x34\x7e\x30\x30\x85\x5d\x85\x76\x8d\xe3\x46\xaa\x48\x45\x1d\x7a\xe7\xd8\xeb\x29\x01\x6f\xcb\x98\x25\x49\x43\xd4\x91\x4a\x39\x1a\x00\x8c\xf1\x00\x27\x15\xe0\x5a\x42\xfd\xf0\x8c\xed\xc3\x1b\xa5\x59\x5c\x89\x8c\xcf\x58\x98\x6e\xe4\xa6\xde\x4b\x1c\x5e\x06\x57\xa9\xe3\x28\x46\xef\xc2\xc4\x80\x64\xe0\xdb\x9b\x2c\x6f\xa3\xed\xf0\xe5\xdb\x6d\x70\xd6\xe8\xe3\xb6\xbb\x1d\x1c\x95\x05\x76\xb2\x21\xdd\x72\x9d\x1c\x79\xeb\xb6\xe9\x91\x45\xa3\xef\x3d\xd4\x79\xe9\x42\x93\x15\x4a\xe4\x67\x11\xeb\xd1\x88\x4a\x0d\x91\x0e\x92\xe6\x25\xd4\x6b\xef\x64\x39\x0a\x98\x5b\xb9\xf0\xa7\x34\xf4\xcf\x51\x37\xde\xc0\x09\x69\x63\xbf\xc8\xd1\x7c\xef\xbf\x40\x2f\x36\xb8\x9b\x18\x9b\x3d\xfb\x28\xda\x4a\x01\x55\xa3\xd6\xf4\xd0\x4b\xd7\x7d\x9b\xee\xf1\xfb\xae\x67\xeb\x99\x5d\x01\xa2\x3a\x82\x7c\x3b\x97\xf4\x92\x1f\xe8\x33\x7b\xf7\x93\xdb\x3e\x34\xe4\x21\xad\xe1\x99\xdd\xe0\x6d\x88\xf0\x5c\x08\x48\xb1\xdd\x10\x05\x1e\xcd\xdf\xba\x5d\xa1\x8c\xd5\x72\xe7\x83\x4f\xbc\xb4\x53\xda\x8d\x62\x68\xbb\x0b\xeb\x2e\x99\x25\x00\x79\x0e\x10\x6c\x9a\x81\xbd\x12\x6f\x91\x3c\xcf\xd3\x78\x41\x51\x1e\xc3\x11\xe6\x79\xfe\xee\xd4\x8a\x9d\xe1\xf0\xa7\x77\x10\x9c\xdb\x42\x17\xee\x21\x8b\x79\x1c\xf4\xdd\xd5\x1b\xe6\x1c\x63\x43\x87\x58\x98\x9e\x20\xe0\xcb\x28\xb3\x77\x9e\x43\xb4\xf9\xe8\xdd\xf1\x78\x42\xed\x71\x91\xaa\xea\x6a\xc2\x77\x50\xcb\x8d\x51\x27\x44\x18\x1e\xf5\x20\xeb\x04\x5d\xb7\xaf\x8a\x21\x9d\x03\x9c\xa7\x3d\x98\x12\x7d\x75\xb7\x85\xbf\x1b\x4c\x0c\xa2\x12\x3d\x3e\xc8\x94\x51\x34\x12\x9e\x4f\xee\xd1\x27\x0a\x48\xe4\x85\x3b\xfc\x88\xdf\xd2\x1c\x7d\xb0\xa1\xa0\xeb\x37\xb6\xc2\x9f\xc5\x66\xb7\x81\xb9\xd8\x8a\x79\xa1\x8f\x56\x27\x43\x0d\xf1\x9e\xa8\x76\xf7\xf4\x77\xe0\xc4\xcf\x4a\x48\xd7\x65\x86\x60\xac\x56\x0c\x0b\x7a\x36\x4a\x47\x1e\x9a\x93\xa1\x1c\xf7\x33\x9b\xd9\xfe\xed\x11\x25\xde\x06\x76\xe0\xe2\x2d\x7c\x46\x2d\x02\x7b\xd2\x9e\x8b\x16\x8f\xf5\x1e\xad\x83\x15\xe0\x29\x5d\x2f\xa1\x11\xd5\x4a\xc2\xdd\x4d\xa0\xe2\x70\xa6\xc7\x38\x74\x8e\x99\x40\x37\x73\x87\x08\xbf\xf2\x5c\x6c\xd3\xc0\xee\xb4\x3b\x44\x9a\x76\xfc\xfb\x33\xaa\xf5\x4b\xc6\xdc\x3b\x64\x5a\xca\x34\x56\xda\x74\x67\x94\xc4\x63\x1e\x18\xe4\x2f\x45\xec\xe9\x41\x3e\x16\xb1\x65\x7d\x80\x4f\xe1\x39\x0b\xf0\xe3\xde\x21\x7f\x8a\x95\xd2\x5f\x32\xe6\xde\x21\x23\xd0\x61\xb4\x52\xcf\x75\xe3\x48\x96\x8d\xda\xfa\x30\x1d\xbe\x3e\x3b\x12\x6f\xfd\x8d\x72\x8d\x17\x21\xfb\x81\x1d\x20\x08\x80\xca\x67\xb3\xda\x32\x49\xfb\xdd\x14\x3c\x05\x6b\x91\xac\x5c\xef\x5e\x2d\x53\x30\x09\x6a\x39\xa4\x77\x77\x9a\x57\x33\xa2\x0c\xff\xf5\xc9\xef\x89\xea\xb4\xad\x7a\xb7\x42\xd4\xd6\xeb\x1f\x2b\x75\x1a\x5b\xed\x7b\x7b\xb4\xcf\xeb\xed\xf1\x55\xcc\x70\x16\x4a\x67\xa0\x9a\x79\x64\x1e\x48\x7e\x8b\x63\xd4\x5f\xda\x51\x66\xb0\x50\xba\x5d\x9b\x5b\xbe\xbe\x22\x4c\x36\x30\xe4\xaf\xc6\x00\x90\x1b\x9a\x1f\xfc\x85\x51\x6d\x3f\xd9\x5f\x95\xfd\xec\x2f\x21\xbe\x2d\x05\x56\xf7\xec\xe0\xe1\xb3\x65\xaf\xe7\xbe\xd9\x10\xd4\xef\xdb\x1e\xbf\x6f\xbb\x23\xe0\xd1\xe3\x58\x0b\x0b\x8f\x21\xb9\xe0\x6d\x45\xce\xba\xd4\x26\x69\xc1\x4d\x93\x00\x76\xd2\x42\xc7\x2f\xee\xc7\x70\x0f\x6b\xa1\x10\xc0\x4d\x62\xaa\xd0\x33\xe3\x42\x9d\x69\xa1\x9a\x79\xd8\xc2\x2e\x19\xfe\xaf\xa2\x37\x09\xb3\x97\x2a\x7b\x5a\x54\xfc\x6a\xca\x7b\x7d\xc1\xf1\x82\x54\x33\x07\xda\xeb\xed\x1c\x4c\xb1\x19\x76\xe8\x92\x13\x28\x67\x09\xb7\x61\x94\x96\x66\x9e\x86\x97\xb2\x5b\xff\xdd\xd5\xec\xd3\x03\x4c\x09\xe9\x39\x5a\xbd\x9a\xb1\x29\xf2\xe6\xf4\x0d\xc4\xeb\x26\x28\x34\x66\xda\xd1\x29\xc7\x8d\x4f\xcc\xe5\xb3\x76\x2a\x7e\x5b\xab\x4f\x81\x29\x54\x93\xab\xec\x79\x36\xb9\x6a\x35\x29\xfc\x8f\x46\x88\x38\xc7\x79\xde\xb5\x10\x9f\x42\xf1\x86\xd6\xf5\xae\x85\x8c\x65\x91\xa8\xe6\x0b\x58\x97\x60\x2d\xb6\xc5\x08\x31\x18\xc0\xb4\x23\x7f\x9e\x55\xd1\xb0\xdf\x7b\xcc\xfe\x25\xfc\xf2\xd1\x0e\xaf\xf4\x7b\x2d\xca\xc9\x15\xe9\x4e\x08\xa6\x3d\x33\xfa\x67\x4c\x68\x18\xe5\x8f\x19\x94\x7b\xb2\xbf\x70\x38\xc1\xee\x17\x1a\xdb\x3b\xff\x50\xc0\x35\xa6\x05\x45\x33\xa4\x5e\x36\x70\xa6\x29\xae\x4e\xa1\x7a\x78\x8e\xa7\x5d\x9d\x97\x75\x15\xca\xa2\xc8\x47\xf5\x71\xeb\x3d\xca\xe6\xb3\x19\x5b\xab\x4d\x21\xa9\x04\x6a\xf3\x66\x5f\xd5\x33\x66\xdc\xac\xe5\x65\x0d\x41\xd3\x1b\x24\xea\xb8\xa5\xf1\x92\xb1\xe6\xd2\x4c\x87\xbd\xc4\x4d\x0d\x52\xcf\x9a\xa7\x29\xbc\x3b\xd0\xe8\x71\x2e\x78\x68\x74\xc5\x5c\x33\x60\x84\x4b\x63\xc4\x01\xd2\x76\xa0\x2f\x7c\xac\xf9\xc7\x08\x15\xb7\xe7\xa1\x79\x1b\xf8\x7e\xc9\xcc\xe6\x8b\xba\xda\xcb\x46\x15\x75\xe5\x23\xa1\xbe\xff\xd1\x47\x02\xfe\xa6\xa8\x01\xf5\xdb\x42\x2a\x23\x8c\x07\x5c\x33\xaa\x60\xf5\xa4\xf1\x97\x3b\xfc\xe5\x1f\x7a\xb8\x26\x0d\x12\x54\x7d\xff\x63\x57\xc5\x89\xad\x8e\x30\x8d\x60\xf0\x8a\x60\x3b\x2b\x6c\x98\x7a\x03\x76\xda\xdb\xa6\xde\xca\x46\xc7\x03\x0b\xea\xb0\x7d\x7b\xf0\xa4\x51\xc8\x72\xf1\x43\x53\x6f\x53\xdf\x88\xdb\x42\xba\x73\x9f\xdf\x40\x10\xc8\x8a\x63\x59\x05\x3a\x2e\xb3\x0e\xf5\xfd\x8f\x5e\x2b\x7a\x4e\xc9\x62\x0b\xab\xf0\x81\x71\x27\xd3\xa8\x15\xab\x8d\x77\xf2\x36\xe4\xbe\xef\xfa\xf9\xe7\x2c\x5b\x7a\x9a\x74\x44\x1b\xf1\x4e\x02\xa7\xd2\x80\xf3\xf0\xfc\xc7\x4b\x46\xf3\xb6\x87\x67\xba\x07\x1b\x5e\x92\x40\x1d\x38\x6e\x11\x93\x92\x0a\x1c\xdf\x73\x36\xe2\x78\x2f\x3f\xa7\x08\x20\x37\xae\x19\xbf\xab\xe4\xf3\x46\x0a\x2d\x71\x48\xb2\x85\x92\x81\x0f\xc6\xef\x2f\x9f\xcd\xf8\x31\x82\x7e\xf5\x53\x56\x4b\x15\x71\x3d\x0b\xa2\xa5\xce\xa0\x65\x8f\xf8\x68\x9a\xb7\x82\xd7\x68\x36\xc3\x0d\x5e\x2d\xfa\x54\x33\xfd\x4a\x19\x2e\xb5\x57\x5d\xa3\xfd\x08\x22\x22\xd1\x3e\xac\x44\xf9\x07\x82\x6c\x2f\xc1\x58\x33\x83\xeb\x0c\x1d\x9d\x57\x6a\x72\xe5\x39\x20\xf2\x10\x5e\x0d\xdc\x9e\x75\xcd\x80\xd2\x91\xb3\x9a\x32\x3e\x7d\x74\x34\x35\x52\xd5\xe5\x9e\x0d\x77\x65\x59\x16\x5b\x55\xa8\x3c\xb0\xf2\xb7\xed\x07\xcc\x08\xb1\x9e\xa9\xe2\x33\xc4\xd9\xac\x14\x3f\x1d\x67\x16\xe4\xac\xa8\x14\x05\xd7\x1a\xb4\x13\xe7\x81\x14\xd5\x0a\xb0\x61\x3b\x16\xdf\xb2\xd2\x76\x04\x53\xf7\xe7\x38\xe5\x39\xb5\xb3\xa0\xe6\xee\x81\x33\x1f\x08\x00\x63\x7a\xb5\x60\xc2\xd3\xc0\x96\x66\xe0\x2a\x24\xe1\xc9\x42\xc8\x0d\x1b\x19\x7c\xf3\xa7\x24\xf5\xbc\x25\xcc\x6a\xb7\x7c\x08\xfa\x62\xc2\xa0\xb4\xcd\x51\x0d\xbc\x05\xbd\x31\x8d\xad\x53\x27\x85\x37\x60\xf3\xf2\xf0\x6e\xd4\xa2\xa6\xab\x3b\xbc\xa0\x17\xd7\xbc\xa7\xa3\x8e\x62\xa5\x9f\x34\x7a\x4c\xf0\xcd\x1b\xe2\x51\x4b\x6b\xc9\x89\x55\x5e\xd7\x7f\xc4\x92\xb6\x79\xe4\x98\x3b\xb4\x05\x08\x4e\x06\xcf\x33\xf8\x80\xfe\xec\xf3\xd0\x1d\x6a\xeb\x91\xa8\xb9\x88\x79\x25\xf6\xfe\xe5\x15\xb9\x8b\x9d\x53\x05\x07\x60\x7b\xf6\x99\x01\x6b\x61\x19\x00\x01\xd1\x20\xfb\x73\x36\xde\x21\x40\xbb\xce\x85\x82\x2e\x51\x3d\x02\x0a\x8d\xa2\x03\xa5\x0d\x7d\x70\x19\x14\x9e\x04\x81\x69\x67\xc4\x56\xc5\x3c\x2f\xbc\xde\x79\x33\xb4\x1a\x15\x73\x83\x8f\xec\x53\xed\xe5\x1b\xa7\xd2\x73\x27\xaa\xe4\xc1\x5e\x5d\x03\x4d\xc5\x6d\x50\xe5\xfb\x7e\x6d\x45\x58\xe9\x9b\x01\x65\xc5\x53\x33\xb4\xdb\xd1\xe5\x38\x68\xc1\x21\x1a\x3c\x45\x8d\xfb\x90\x86\xcf\xec\x5f\x0c\xeb\x40\xbc\x51\xe2\x51\x3b\xa0\xac\xc1\x4f\xb7\xf6\x0a\xd1\x76\xff\x59\x00\xdc\xc3\xab\x8f\x95\xe7\xb7\xc1\x96\xd3\x9b\xed\x17\x62\xeb\x99\x75\x45\x03\x82\x4f\xe1\xea\xf9\xf5\x8b\xd8\xe0\x99\x5a\xf5\x8c\x1f\x3e\x64\xcb\xb4\x8e\x16\xdb\xb5\x88\xe3\x34\xf4\x40\xf8\x1d\x3c\x83\x17\xdd\x97\xcf\x10\x73\x14\x29\xaa\x45\x71\x66\x7a\x48\xdd\xfb\x9e\x47\x2d\x3f\xbf\x0b\xe6\x7c\xe8\x10\x47\xe7\xce\x18\x91\x85\x2f\xe9\x58\xb8\x77\x7c\x8f\xf1\x09\xf1\xae\x80\xa7\x70\x78\x33\x78\x17\xfd\x80\x39\x80\x37\x91\xc9\xd5\x09\xd8\x24\x6c\xa1\x70\x3f\x1e\x10\xa7\xec\xf6\xe8\xd2\x69\xd6\xa1\xd3\x2c\xa0\x82\xc4\xfb\x69\x37\x6c\x38\x67\xd2\xcb\x04\x25\x2c\x17\xf8\xca\x2b\x3b\x5c\xc7\xd3\xda\x2e\x9e\x46\x8d\x63\x7e\x67\x34\x4a\xb7\x97\x0f\x5f\x2c\xb5\x6c\xe8\x6a\x43\x7e\xa2\x7b\xd9\x70\xaf\xe1\xd9\x6a\x87\x94\x86\x7c\xc3\xca\x3c\x83\xaa\x4f\xd7\x8e\x46\x64\x5b\xf8\xca\x4f\x37\xb7\xb8\x86\x65\x66\xed\x4e\x8c\x6b\x78\x4a\x47\x6f\xed\x6f\x43\x73\x93\x57\xcc\xf5\x7c\x65\x1d\x3e\x75\x58\x5f\x7c\x3f\x60\xb1\xf7\xd2\x11\x3c\x72\x7c\x06\x1f\x28\xdd\xfc\xf3\x35\xe7\x66\xc6\x7e\x5d\xec\xf8\xb4\xe2\xbc\xd5\x93\xff\x17\xa8\xc9\xfb\x94\xe2\xf6\xb4\xa1\xea\xb9\xda\xdd\x3b\xd4\xa2\x93\x02\xb5\xa2\xbb\x08\xfe\x05\x85\x82\xa2\x9a\x97\x3b\x74\x49\xa6\xd7\xe9\xaa\x86\xa7\x57\x50\x49\xb9\x40\xd7\x47\x7b\xf8\xb3\x11\x58\xdd\xd1\xb0\xb6\xa7\xb3\xb9\x84\x6f\x1d\x5b\x8a\x9c\xdb\x3c\x1e\x5c\x5a\xb6\xfe\xbc\x4f\x01\x14\x72\xb0\xac\xab\x9c\x8f\xc0\x44\xac\x66\xbe\xc5\xb0\xe6\x16\x8c\x61\x5f\x5e\x0c\x73\xdf\x33\x2c\x14\x89\xe7\x5b\x9f\xe8\x1f\x7c\x4d\xc1\xd6\xbf\xf2\x30\x0d\xd3\x75\xf3\x8f\x18\x35\xe4\xdb\xd7\xf6\xa6\x6b\xa5\x7f\x48\xc2\xef\xec\xee\x1f\x84\xef\x6e\x31\x28\xcb\x65\x06\xde\x6b\xb6\xa7\xf4\x75\xf5\x8d\x1e\x9a\xde\x46\x23\xd0\x69\xe4\x95\x8a\xf0\xf8\x5a\x78\xca\x2f\x34\x08\xf0\xed\x5a\xb8\x38\xdf\x1d\xa3\x68\x59\x2e\xfd\xb7\xa1\xb6\x49\xbf\xed\xb3\x37\x07\x7f\xb0\x79\x67\x4a\x99\xf1\xfe\x68\xe1\x29\xa9\xf3\x28\xe4\xa7\xf7\x75\x3a\xe5\xb1\x84\xdd\xd9\xa7\x64\x0d\x02\x28\x90\x8b\xd5\x1a\xdd\xef\xb0\xc8\x39\x0d\xdb\xe7\x08\x96\x57\xeb\x06\x98\x57\xe6\x7d\x2f\xcd\xc9\xa7\x68\x01\xd9\x76\x8b\xbf\x6e\xdb\xd0\xe5\xe4\x09\xf8\x59\x32\xa8\xf5\xed\x44\x42\x6f\x97\xa5\x0f\xc1\xa1\x12\x30\x5c\xc2\x88\xd6\x06\x30\xdd\x13\x54\xbd\xcf\x7f\xfc\xd3\x81\x19\x84\x52\xcd\xc5\xa3\x27\x5d\xcd\x3b\xdc\x13\xb0\x53\x92\xf6\x30\x61\x57\x11\x99\x10\xbe\x95\xdd\x2c\x74\x10\x7c\xcd\xce\x9c\xde\x06\xd0\xe9\x28\x56\x78\x5a\x3b\x48\x43\x24\xbf\xb5\x27\x21\x2d\x02\xa0\x9d\xad\x02\xc1\x1d\x51\xa4\x65\x28\x2a\x10\x7e\xf8\x0a\x6e\x3e\x46\x55\x0e\x4d\xd3\xb3\xe7\xac\x29\x4c\x78\x23\x0e\x2b\x49\x61\x11\x12\xcb\x59\xfc\x90\xc3\xaa\x9c\x7f\x23\xab\xb8\x1e\xf3\x06\xbf\x9e\x9d\x04\x28\x2d\xb4\x2c\xa5\x52\xb3\x42\xcb\x86\xcd\x50\x9d\x3b\x88\xcf\x72\xc8\x7f\xed\xb3\x29\x0d\xe3\xa9\xed\xa7\x6f\x6f\x0e\xc5\x43\x78\xc8\xec\xa0\xa8\x13\x86\xe3\xdc\x4a\xbc\x67\x84\xc9\x04\xfe\xd2\x86\x98\xd3\xb2\x11\xba\x6e\xdc\x88\x33\xec\x14\x4c\x48\x0b\x9b\x1a\x82\x77\x8a\xaf\xba\x69\x62\x1c\x78\xf1\xfd\xfc\x18\x6d\xf1\x15\x01\xad\x53\x03\x17\xb2\x00\x3f\xc6\xad\x6a\x72\x65\x83\xa5\x11\x7d\xd0\xe1\x72\x82\x99\x9a\xef\x49\x36\xf2\x6d\x65\x61\x0a\x5d\xfa\x32\xfc\x96\xfe\x86\xe1\x1a\xa6\x1b\x79\xe0\x68\x6e\x3e\x51\x66\x88\xe8\x7d\x0f\x4f\xf6\x87\x92\x7b\x8d\x2d\x3f\xf3\x34\xeb\x9e\x3a\x25\xed\x08\xea\xee\x18\x77\x64\xd2\x52\x08\x4b\x17\x75\x03\x07\x24\x95\x0f\xe2\xc7\x2b\xd6\x63\x24\x87\xa6\xd0\x12\x74\xcd\xdb\xcc\x5a\xe7\x8b\xf9\x9c\x0c\xde\x77\x7a\x52\x2f\x27\x2c\x6b\x24\x43\x4a\x4b\xcd\x9b\xfa\xee\x40\x0a\x54\xb7\xa1\x1d\x6a\x7a\xf1\x72\x1e\x29\x21\x46\x1e\xa6\x4f\x3a\x49\x32\x9e\x24\x21\x46\x1e\xf0\xec\xea\x73\xc0\x32\x1f\x06\x0f\x32\x33\x04\xae\x76\x10\xca\x88\x3d\x19\x9b\xc3\x1b\xda\xfd\xfc\xf3\xcf\xe1\xb0\x3e\x7e\x9e\xa4\x71\xc3\xc1\x50\x97\xad\xd6\xe8\x60\x9d\xa0\x88\xf9\xa0\x49\xfa\xe7\x3d\x8c\xd5\x6d\x92\x87\x34\x76\xcf\xa6\x19\x0d\xbb\x20\x9b\x19\x20\xb6\xc8\x54\x2b\x10\xf6\xba\xe3\xeb\x0e\x90\xa0\x82\x50\xe4\x5e\x5b\xd5\x1a\xd4\x6e\xbb\x65\x2f\xbb\xa2\x82\x68\xf3\x44\xa3\x6b\xd1\xdb\xeb\x00\xfc\xd0\x4b\xa8\xfe\xf3\x1a\x93\x77\x78\x36\x20\x5c\x4b\xc1\x0f\xbd\x14\x3c\xe0\xba\x81\x54\x33\x2c\x16\x3f\x9c\x91\xd4\x75\x9a\x0e\x07\xaa\x30\x1e\xa9\xbc\x39\x78\x57\x98\x80\x4a\xf5\x43\xde\xd9\xae\xad\x92\x2c\x71\x7f\xc7\xd1\x2e\xdd\x07\x93\x32\x86\x76\x0e\x6f\x9b\x52\x56\xdd\x13\xce\x57\xd1\x51\x44\x56\x1f\x6b\xe1\xb6\xfb\x35\xe5\xc4\x78\xe1\xff\x5b\xcb\x89\x2d\x0b\xf9\x7f\xb4\x9c\x18\x9b\x5a\x32\xbd\x7c\xd0\x8e\x7f\x60\x5a\xfd\xb1\x52\xf9\x5f\x29\xab\x69\x29\xab\xa7\x57\x61\x57\x51\x4f\x64\x26\xc5\xf0\x3a\xaf\x8d\x79\x9e\xfc\x9c\xc4\x0d\x1a\x71\x68\x9b\x04\x23\xe3\xef\xf7\x72\x45\x37\xbc\xb8\xfc\xef\xbb\xda\x8f\xb6\xe1\xd4\xa3\x74\xeb\x73\xc1\x19\x1a\x71\xb8\xbb\x97\xab\x37\x67\x42\xd8\x30\xb0\x96\xe6\xe2\xb9\xb5\xfa\x2d\xec\xa2\x6b\xcd\xd1\xba\xdc\xf7\x64\x70\xa2\x20\x01\xc9\x94\x82\x05\xfc\x7d\x57\xfb\xc1\x30\xcd\xf0\x9e\x16\x6f\x52\xac\x84\xc3\xc0\xba\x19\x0c\xc9\xfd\x9d\x8d\xc3\xbd\xbe\x1b\x3c\x80\x2f\x13\xfd\xcd\xb6\xf8\xef\x29\xfa\x07\xe2\xfd\x09\xf1\xcd\x7c\x4f\x7e\x99\xe4\x65\xa0\xfc\x2a\x92\x97\xf6\xb5\x0a\x0f\x83\xa7\x97\xf6\x35\x5d\xbd\x92\x18\xdf\x44\x8c\x00\xc6\x62\xd9\x19\xf9\xab\x3d\x3c\xde\x4b\xf4\x3a\x8b\x9c\xc7\x48\x60\xfd\x87\xb8\x83\x7d\xb9\x60\xf2\xeb\x48\x74\xae\xe3\x83\xe4\xf0\x5e\x5c\x0d\xa5\x9b\x50\xaa\x83\xba\x02\x9d\x04\xee\x1a\x36\xd6\x5d\xa2\x1f\x23\xa2\xb5\x23\xfa\x2f\x14\xd0\xec\xf6\x78\x9c\x80\xc6\x09\x1c\x37\xe2\x08\xf7\x32\xb0\x56\x7c\xfe\xcd\x37\xe6\x58\xa3\x3b\x38\x76\xf4\xcd\x4e\x04\x0d\xed\x43\x27\xd1\x8a\x6d\xb7\xe4\x30\x9d\x26\x05\x2d\x26\xe5\x9d\x93\x21\x8c\xee\x59\x20\xf3\x85\x16\x87\x86\x49\x43\x84\xa2\xd2\xb5\x89\xc5\x9b\xe7\xb9\xa5\x3d\x27\x44\x06\xb4\x77\x81\x8c\xe9\x6d\xdc\x60\xbf\xc6\x74\x4a\xd3\xb0\x4a\x01\x9c\x0a\x36\xc1\xae\x91\x00\x0f\xd8\x2d\x9f\xf6\x61\x7a\xbe\x43\xfa\x3e\xfb\xfe\x9f\x21\xbf\xfa\xdd\x9d\x92\x64\x79\x86\xfd\x92\xec\x0d\x4f\x3a\x83\xc3\xa3\x24\x5a\x83\xe2\xbd\xc1\xb1\x65\x4c\x21\x88\xc3\x3e\x83\xe4\xb0\x8f\xd9\x46\x70\x7c\x1d\xf6\x29\x06\xc8\xb1\xbf\xf3\x65\xdd\xe4\x41\x13\xbb\xb5\xf7\x80\xbb\xc5\x04\x00\xf1\xba\x44\x16\x1e\xae\xcf\x3e\xb2\x29\x85\xc3\xfe\x42\x69\x7b\x80\x3b\xfa\xb9\x12\xb8\xb1\x65\x93\x6d\x44\x9c\x24\x03\xcf\xb8\xb9\x2b\xf7\xf3\x87\x7f\xae\xe0\x6e\x86\x4b\xe8\x30\x87\xb0\x33\xe5\x69\x5f\x03\x7d\x01\xd2\xed\x22\x53\xdd\x0c\x9f\x92\xfd\xf0\x56\x35\xc1\x44\xbf\x01\xa3\xe6\x89\xb4\x62\x31\x80\xba\x35\x5e\x1e\x18\xb7\x92\x52\x41\xd0\x1d\xd4\x4b\xe0\xb7\xf1\x0c\x12\xf0\xde\x99\xf8\x21\x3d\x24\x39\xe3\x9a\x62\x04\x52\xcf\x33\x85\xba\xf3\xa2\x89\x32\xd1\x7f\x8a\x42\x6e\x18\xaf\xbc\x94\x15\xc9\x66\xc9\x2d\x42\xe7\x8f\x38\x19\x2e\x9a\x8b\x2d\x17\x61\x47\x79\x0e\x90\x7c\x66\x34\x55\x97\x08\xc1\xed\x96\x1b\xa8\x6c\xd2\x06\x9d\x80\xf5\x84\x64\xdd\xe5\xae\x2c\x61\x51\xa8\x6d\x29\x8e\x36\x20\x61\xd8\xa0\x23\xb7\x74\xab\xa0\x61\x4d\xa7\x30\x49\xfb\x8c\xf6\x2e\x95\xf2\x7b\x44\x7c\x97\x44\xa2\x1d\x4a\x23\x0e\x19\x24\x58\xab\xa8\x98\x85\xbe\xc2\xc5\xb0\x4b\x90\x74\x84\x12\x38\x48\x38\x88\x8a\xd3\xe0\x3c\x14\x5b\x4e\x82\x47\x81\xda\x57\xc5\x0f\x4d\xb1\x17\x5a\xfe\x45\x1c\x6c\x7c\x11\x28\x3d\x1b\x73\x93\xa8\x9c\xd4\x95\xa4\xc7\xe1\x7c\x85\x6b\xb2\xd5\x51\x20\x76\xba\xde\x08\x6d\x52\xd1\xa3\xaa\x4c\xb6\x4d\xb9\x95\x84\x9a\xce\x7a\xd6\x70\xf2\xb0\x55\xb1\x90\xb0\xe5\x9e\x01\x0f\xa9\xcc\x45\xaa\xa9\x6d\xa4\x1a\xb4\x85\x1b\x9d\xb8\xc2\x0c\xdd\x5f\xea\xe5\xf2\xe4\xfd\x25\xbe\xbc\xf4\x99\x72\xf7\x5c\x5b\x7e\xc9\x9d\xa5\x5e\x2e\x87\xef\x2c\x9d\x3b\x48\xff\x55\xc5\x67\x6e\x56\x93\x7a\x42\xd5\x7a\x52\x19\xcb\xb7\x04\x7c\x8f\x3e\xf1\x60\xe6\x7d\xb6\xef\x65\x71\x40\x8e\x61\x56\x1a\x18\xb2\x78\x90\x72\x06\x32\x4e\x6d\xee\xfb\x84\xd8\x61\x46\xe4\x1e\x4a\xc5\x58\x42\xc2\x09\x9f\xd0\x08\x84\x05\x88\xe8\x2e\x84\x1f\x38\x9c\x1d\x3f\x71\xac\xd4\xb4\x73\xc0\xf5\xa8\x15\x7b\x83\x4b\x66\xe1\x68\xa1\xd0\xd8\x05\x08\x17\xfc\xb4\xef\x4a\x13\x34\xc9\x62\xd4\x85\x0c\xb6\xc7\x23\xd6\x13\x98\x20\x02\x45\xef\x00\x64\xa5\x06\x35\x3b\xa6\xc6\x7d\x0b\x32\xc2\x4c\xf0\x3f\xa0\xeb\x5e\x5c\x27\xe9\xf9\x8e\x88\xb4\xe2\x8e\x1e\x3b\x3e\x44\x55\x1f\xdb\x34\x8c\xf2\xf1\xe3\x30\xad\x5c\x33\x1a\xd8\x15\x27\x7f\x59\x0b\x6b\x22\x4a\x31\xe6\xac\x31\xa7\x51\xa7\xf1\x33\x3a\x11\x18\x08\x95\x81\xcc\x57\x5e\x6c\x63\xb3\x9b\x5d\xe0\x93\x57\xe3\xe7\xdf\x7c\x93\x7a\x91\x19\x5e\x15\x08\xea\x60\xc2\xc5\x36\x92\x42\x6a\x41\xa1\x99\x12\x2b\x79\xf8\xda\x76\x27\xaa\xe3\x81\x32\x44\xad\x49\xbe\xe4\x64\x61\xec\xa6\x2a\x95\x2a\xea\x4a\xe5\xab\xfa\xe6\xfa\xe3\x4f\x88\x38\x6f\x3e\x7a\xf1\x49\xdb\x8b\xae\x6d\x38\xda\xf3\x43\x0b\x88\xc8\x1f\x00\xbe\xee\xc7\x1a\x0c\x5f\xeb\xd7\x89\x51\xec\xf6\x4c\x7f\x8d\xf8\xa6\x49\x81\xd0\x97\xbb\xea\xb7\x40\xd1\xec\x35\x78\x07\x98\x02\x25\xb5\x17\xed\xd9\x84\x28\x3b\xc2\xa1\x50\x6b\xd0\x75\xbb\x76\xed\x9c\xfd\x07\xc3\x45\xb1\x34\x36\xb1\x20\x88\x7c\x0f\x6b\x8e\x40\x58\x37\x7d\x3a\x42\x5c\x6d\xec\x16\x1a\x39\x97\x14\xa9\x44\xd0\x04\x9e\x20\xd0\x27\x40\xc9\xdf\x74\x9e\xe7\x71\xdb\x57\x35\x7c\x48\x31\xd9\x3f\x0c\xc7\x0b\xf8\x90\x90\xf1\xa4\x10\xec\xa2\xa6\x08\x51\x51\xeb\xc0\x41\xc4\xc3\x7c\x16\xbd\x03\x77\xe7\xba\x90\xcb\xa2\x92\x30\xbe\x2f\xeb\x7a\x93\x22\xa9\xb0\x25\xb0\x49\x08\x28\x61\x21\xc9\x72\xb3\x0e\xd1\xdf\x6a\x18\x17\x4b\xf5\x9d\x49\xa9\xdb\xbb\x50\xde\x8d\xf6\xb8\xf5\xea\x9b\xbc\x4e\x74\x9e\x61\x67\x9e\x7c\xc5\x02\x07\x08\x0d\x94\x5e\x31\xda\xa1\x64\xca\x4a\x21\xd4\x42\x4f\xf9\x38\xde\x83\xf1\x34\x0f\x87\x99\x6f\xc4\x42\xf6\x0e\x72\x32\x61\xbf\x82\xe5\x12\x74\x0d\xf7\x75\xad\x95\x6e\xc4\x36\x33\x91\x94\x64\x45\xab\xe1\x47\x5b\xf2\x9a\x86\x9d\xdc\x20\xed\xfc\x85\x53\xd5\x2d\x30\x08\x92\x1a\x9f\x1e\x6a\xbc\x28\x2b\xa9\x3d\x3f\x16\x9e\x09\xf1\x95\x78\x82\x1f\x10\x8f\x66\xd3\x51\xa7\x3a\xa7\x96\xff\x18\x5e\x0e\x23\xee\xfd\x04\xd3\xb6\x7e\x34\x40\x7f\x83\xd1\x36\x24\x91\xdd\x0c\x82\x7a\xb2\x7a\x16\x41\xbe\x0b\x0c\xc2\xc4\x83\x20\x35\x00\x31\x36\x16\xf6\x5d\x17\xb0\xaa\x35\x5f\xe1\xd4\x4f\xd3\xa4\xa7\x47\x5e\xeb\x9f\x70\xa5\x7f\xea\x7e\xb7\x22\x87\xe5\x0d\x63\xf5\x53\x84\xd6\x38\x54\x40\x5f\xa3\x30\x04\x44\xbc\x18\xed\x8f\x38\x09\xed\xe0\x71\x40\x14\x15\x72\xbb\x1e\xfd\x92\xbf\x29\x13\xef\x87\x5f\xb5\xfd\xeb\x7d\xf6\xf3\x49\x9e\xda\xb9\x98\xd0\xaa\x06\xb9\xd5\x3a\x08\x88\x8d\x08\xbc\x71\xf8\xaa\xdc\x20\x67\x84\x68\x5e\xd7\x7f\x50\x5a\x36\x85\x7a\x30\x81\x67\x27\x13\xd8\x8a\x46\x56\x7a\x2d\x29\xbd\xbb\xae\x89\xbe\x9b\x62\x61\xa2\x19\x98\x4a\x64\xdf\x57\xe8\x1d\xc1\xa2\x64\x86\xb9\x9f\x34\xcb\x58\xa6\xad\xc8\x34\x2d\x4b\x3e\x4c\xb2\xe4\x7f\x25\x69\x1a\x18\x03\x98\xb8\x6f\x27\xf4\xc9\xae\xc6\x2f\xd4\x28\x3b\x38\x37\xd0\xa3\x54\xb6\x67\x92\x92\x7a\x9c\xf2\x79\xbc\x17\xe5\x34\xf1\x00\x7a\x7a\x01\xac\xb5\x4f\x23\xfd\xc4\xa3\x1e\xd1\x83\xd1\x84\x43\x61\xdd\x27\xaa\x30\xd3\x81\xd0\xfa\x38\x04\xfa\x1e\x8d\xa0\x4f\x2d\xa1\x2f\x18\x41\xa4\x9e\xe8\x68\x8c\x03\x45\x6b\x9c\x12\xa4\x7d\x77\x08\x01\xfb\x81\xf8\x3e\x4b\x02\x0d\x97\x79\x36\x5b\x99\xe4\xa6\x18\x72\x52\x2e\x60\xb7\x85\xfb\xa3\x77\x8c\x52\xcb\xb9\xdc\x6a\x44\x09\x08\xa3\xd7\xcd\xa3\x70\xae\xdb\xd8\x72\x45\x1f\xb7\xd1\x23\x68\xff\x41\x77\xb1\x61\x00\xe9\x4f\x89\x73\x12\x70\x3a\xec\xeb\xca\x4e\x0d\xb4\x68\x56\x52\x0f\xe6\x7b\x68\xda\xf0\x2c\xfe\x46\x6b\x55\x3c\x74\x1d\xfb\x39\xd4\x76\xb8\x05\x4e\xc3\xcb\x9a\x8b\x9c\xa5\xcd\xca\x1b\x9b\x2c\xe1\xae\x63\x24\x42\x7d\xfb\x1a\xd4\x1a\x73\x2d\x57\x4f\x48\xf3\xcb\x56\x9e\xcf\xd0\xa6\x21\x23\x91\x56\x8a\x85\x4d\x60\xb0\xae\x17\xaf\xa4\x36\xf5\x39\x28\x33\x7d\xfa\xf6\x35\x9d\xf3\x28\x5d\xb0\x5c\x96\x81\xdc\x73\x12\x52\xce\x5a\x2b\x37\x9a\xbd\x34\x19\x08\xa7\xb8\x11\xf9\x43\x2e\x72\x16\xc7\x28\x9e\xec\xc1\xd0\x14\xb4\x29\x66\x03\xfe\x83\x76\xcb\x15\x9b\x63\x73\x00\x49\x34\xab\xa6\x7b\x72\xe0\x5a\xe6\xdb\xc1\x9b\xeb\x34\xdf\xa6\x03\xb3\x50\x6b\x05\xda\x35\x3d\x0f\x4d\x66\x8d\xd7\x85\xa4\x38\xd7\x36\x1a\xd8\xab\xa3\xe5\x77\x5e\xf8\x23\xb1\x58\x7c\x1b\x7f\xed\xcd\xce\xd7\x0f\xa5\x43\x77\xcb\x71\xe4\x03\x74\xdb\x89\x13\x6c\x82\x08\xf7\x01\xcc\xa0\x4d\xc7\x87\xc6\xba\xee\x13\x8f\x51\x75\x62\xee\x7a\x7b\xbe\x5b\xd9\x18\xdd\x85\x0e\x34\xfd\xdd\x26\x7d\xc5\x91\x75\x98\x15\x2f\x3e\x18\x40\x68\x60\xc3\xd1\x57\x27\x7d\x24\x2e\x5b\xfb\xb9\xc1\xb0\x8a\xbd\xdd\xb4\x71\x16\x7d\xef\xd0\xde\xaa\x2d\x69\x2d\x43\x42\x1a\x98\x63\x55\x94\x51\x4c\xad\x7f\x97\xc7\x3f\x87\x11\x4d\x5d\x5c\x9f\xb7\xfc\xf2\x4b\xd5\xbe\xeb\x9b\x1a\x3c\xa9\x8a\xf2\x49\xd7\x2b\x78\x0e\x53\x78\xeb\x7b\x11\x07\xde\xce\xcc\x56\x20\xcf\xe1\xc9\x6c\xf6\x04\xff\x3b\xcf\x1f\x68\x14\xe3\xb7\xe6\xe9\xcf\x1b\xe3\x42\x56\x9a\x2d\xfb\x7b\x72\x92\xbe\x35\x31\x4f\x4d\xee\xe3\xaf\xff\x14\xa4\x37\xa6\xe6\x51\xb6\xca\x76\x72\xf8\x38\xd9\xe7\xa3\x77\x36\x69\xa5\x6d\xe9\xd7\x3c\x95\xa1\x92\xeb\x7b\x73\xaa\xe4\xe1\x35\xbf\x49\xba\x61\xe1\x02\x65\x94\xd8\x31\x03\x32\x92\x47\x19\x63\x91\xc1\xf6\x61\x95\xe1\xa5\x9a\x1e\xe9\x32\xff\x0e\x1a\x6f\x1f\x0b\xd4\x7f\x7b\xf3\x34\xc3\xac\x04\xf6\x4d\x46\xe9\xdc\xf9\xd9\x0b\xe2\xd8\x70\x96\x95\x6c\xe4\xfc\x95\xf1\x0c\xa0\x4b\x5c\x67\xdf\x76\x8e\x2e\xbe\x71\x51\x2c\x8d\x53\xb1\xae\xfb\x6c\x75\x66\xb3\x42\x91\xec\xf9\xfa\xb8\xc5\x63\x27\xed\x6d\x09\xc8\xf9\xb1\x96\xb9\x46\x8a\x46\x82\x28\x0f\xf8\xa4\x58\x4a\xb1\x84\xaa\x5e\x48\x95\x41\x55\xc3\x7c\x5d\x94\x8b\x46\x56\xf9\xb0\x87\x34\xe1\x8c\x67\x66\x1f\x3c\x78\x02\x30\xc6\xab\x19\x88\x05\xdf\x7b\x26\xac\x5e\xd1\x85\x30\x12\xe4\x82\x2f\xcf\x69\xab\x0b\x63\x01\xc4\x38\xe2\xb7\x71\xbf\xc2\x8b\xe9\x14\xbc\xcc\xeb\x37\x95\x3c\xfc\x89\x3f\xb0\x43\x04\xb5\xee\xa6\x4b\xc0\x60\x8f\xc1\xbd\x20\xb5\x1e\x69\x16\xcb\xe0\xa5\xfb\x74\xaa\x03\xfb\xb1\x4d\xfd\x59\x37\x30\xf4\xed\xe3\x53\x1f\xaf\x5e\x9e\xfa\xfa\xd1\xf5\xa9\xaf\x2f\x5f\x0c\x7e\xa5\xdc\xa1\xa7\x3e\x7e\x7c\xf2\xeb\xd5\xcb\x93\x9f\x3f\xba\x3e\xf9\xf9\xcc\xb8\xb6\xba\xb1\xdf\xa3\x8f\x41\x32\x4e\x22\xd0\x56\x5a\xa4\xe4\x88\x5f\x43\x7d\xaf\x64\xb3\x67\x65\x58\xfb\xd1\x0b\x30\x8b\xc7\xcf\x0d\x78\x66\x5a\xff\x98\xc2\xfe\xd6\xa8\x6f\xb1\x9d\x6c\x89\xbb\x05\xb0\x28\x28\xc7\xfa\xae\x50\x6b\xbb\xb1\xfd\x98\x97\x9c\x5a\xff\x16\x30\x48\xc4\x4e\xb5\xcd\x44\x59\xf2\x81\x0d\x1c\x18\x8e\xc4\x2f\x95\xd1\xe8\xba\x23\xb1\x06\x63\x61\xfa\xb1\xaa\x06\xd7\x0f\x05\x25\xe8\xe4\x20\xc3\x91\x18\x0d\xb5\x17\x1d\x39\x36\xc2\x42\xd0\xd3\x36\xb0\x03\x40\xd0\x35\xec\xbb\x1f\x58\x6e\xd6\xc7\xed\xed\xc0\x0d\x56\x53\x58\xe8\xe8\xea\x7a\xdb\x35\x1f\xf2\x26\xe5\x05\xe2\xeb\x41\xa0\xf7\xe5\xa1\xe7\x4c\xec\xb2\x75\x1b\x70\x7b\xe4\x19\x49\x04\x34\x63\xd2\xbc\x76\x83\x05\xbd\x2f\xd2\xda\xeb\x36\x41\x56\x4f\xf8\x71\xc0\xca\x49\x7c\x29\x0c\x1f\x13\xf6\xa9\x7b\xfc\x43\x78\x37\x7d\x66\x2a\xeb\xc2\x8f\xa6\x78\xf1\xca\x9c\xd3\x31\xf4\xae\xd0\xaf\xbc\x40\xad\x80\x40\x9e\x64\x3d\xeb\x95\x70\x5e\xe9\xb7\xe7\x16\xcb\x26\x19\x66\x3e\xda\xf3\xed\xe5\x8b\x5f\x71\x25\xff\x47\x21\xb9\x37\x19\xbc\x4d\x05\xee\xed\x83\x1e\xdc\x42\x9c\x99\xd9\xe7\x9c\x7d\xc8\x6b\x64\x06\xc5\xa6\x17\x83\x41\x6c\x98\x18\x87\x8d\x84\xa7\x50\x6c\x3e\x9c\x73\x4f\xe3\xe7\xd9\x55\xfa\x3f\x9a\xab\xc4\x4f\xd9\x83\xf8\x12\x25\x62\x4c\xac\xd2\xa0\xee\x00\xe9\xd9\x8f\x06\x1f\xd8\x9a\x76\x11\x67\xd7\x27\x68\xe9\x40\x5d\xec\xc3\xaf\x4b\x7d\x0e\xd4\xe5\x35\x89\x0e\x94\x21\x04\xf2\x27\x6f\xd2\x93\xc9\x25\x28\x7b\x6b\xc7\x9f\xe7\x6e\x93\x9b\x61\x0e\xef\x75\xe8\xe4\x04\xff\xa5\x04\xf9\xeb\xd0\xe3\x2f\xde\xdd\xff\xe9\xc4\xf8\x48\x6a\x3c\x43\x8f\x31\x45\xe2\x7f\x06\xeb\x18\x4a\xa4\x95\x1e\xaa\x33\x4c\x81\xae\x8a\x77\x99\x0a\x16\xa1\xad\x38\x48\xac\x5d\x6a\x1d\x3a\x96\x60\x32\x79\x2f\xda\xf5\x61\x7b\xe8\xef\x27\xe7\x1f\x74\x28\x92\x16\x4b\xff\xa6\x78\xc6\xe3\xa1\xa8\xc0\xde\x1d\x0d\xac\xe0\x9e\x69\x52\xd0\x4e\xaa\xa2\x0c\x0d\xe2\xbc\x3a\x3d\x56\x82\x1e\x75\xf8\xc0\x82\x17\x4e\x87\x18\xd4\xb3\xca\x26\x03\x65\xfe\xcb\x1a\xcb\xf0\x49\x65\xe8\x24\x8d\xa6\x42\x7d\x52\x5e\x07\x16\xd5\x6f\x20\xc9\x4e\xa9\x50\xfd\xe6\xa6\x49\x3b\x76\x36\xf9\x29\x14\x28\xb1\x91\x20\x54\x30\x15\x2b\x75\xf1\xe0\x43\xa9\x8b\xcb\x48\xf4\xba\x05\xd5\x53\x41\xb9\x0a\xb7\x66\xb6\xe6\xa2\xee\x6a\x18\x1c\xc4\x46\xaa\xe6\x5d\xc3\x4b\xc4\xae\xba\x8f\xa8\x08\xdb\xf7\x7e\x31\x7e\x36\xd4\x25\xfc\xe3\x44\x16\x31\x4f\xad\x0c\x89\x81\x63\xad\x73\x5d\x9b\x73\x2f\xa4\xab\xfe\xfe\x57\x8f\xed\x7f\xf5\x3e\xfd\x9b\xbd\xcd\x08\x65\x08\xb7\x7d\x15\x4c\x04\x9b\xe1\x0a\x6e\x55\xf8\x8f\xde\x3a\x1e\x93\x01\xce\xbb\xbe\x51\xa0\x6b\x28\xaa\x45\x31\x17\x5a\x82\xe0\xb0\xe2\xf6\x06\xc8\x77\xb0\xbc\xb7\xbb\xee\x49\x70\xf2\x30\x70\x8f\x23\x69\x6f\x74\x72\x8f\x63\x75\x4f\x85\x80\x4d\xfd\xbb\x3c\xde\x76\x65\xda\xa2\x2a\xb4\xcf\xd8\x5d\x74\xda\xce\x8e\x2b\x34\x7f\xf4\x37\x1d\xe8\x56\x13\x15\xf8\x46\xf9\x5a\x10\xb1\x58\x7c\x81\x9a\x1a\x56\x74\x44\x1d\xd8\x50\x78\x26\x48\xcb\x6d\xf8\xa5\x3d\xd6\xc6\x2e\x82\x6b\x14\xb8\x35\x8d\x9a\xcc\x66\x48\x8c\x84\xe1\x71\x7f\x52\xa7\x0c\xfa\xcb\xd3\x40\xd0\x1b\x66\xc2\x1c\x01\xe5\xb2\x2b\x42\x1b\x05\xea\xb2\x0b\xdf\x29\x0e\x16\xd9\x63\x3b\x06\x48\x6b\xf0\x84\xac\x1d\x4c\xf2\xa9\x7a\x49\x8a\x2a\xba\x10\xfa\x6a\x42\xfb\x7a\xd1\x73\x37\x8c\x07\x6a\xa8\xd5\xb6\x68\xa3\x43\x05\x9f\xeb\x4e\x2c\xa4\xf6\x9b\x8b\x6b\x61\x9c\x0f\xe3\x1e\xdc\x74\x3e\x68\x83\x85\x32\x55\xcb\x05\x99\x43\xfb\x60\xba\x23\xf3\x22\xc1\x04\x35\xfb\x6e\xd1\x9e\xad\x9e\x87\x3b\x1c\xb9\x6d\x05\xba\x3e\xd5\xe7\x45\x90\xdc\x88\x02\x58\xb6\xf4\x31\xd0\xa4\x32\x01\x79\xa2\x95\xea\x24\xa0\x66\x9c\x06\x56\x9c\xa1\xff\x69\x8f\x25\x27\x1b\x46\xef\x03\xc0\x03\x2e\x65\x83\x22\xd6\x79\xd9\xaa\xad\xd1\x3e\xa2\xd3\x1c\xe9\x6d\x2d\xf9\x65\x02\xb2\x31\x54\x7f\x8c\x80\x7c\x86\xcf\x9d\x66\x43\x9c\xd6\x1f\xc7\x81\x20\x44\xa9\xe4\x09\xa6\xf3\xf3\xbb\xb4\xe7\xc6\x38\xcc\x4e\x88\x8b\xf9\xec\xe4\x17\x2b\x8d\xac\x74\x34\xf7\xc3\x2f\x70\x18\xd2\xb6\x47\x26\xd0\x69\x20\x89\xa0\x7e\x88\x8c\xa7\xaa\x05\xec\xa7\xa1\x76\xe9\x11\x8a\x23\xcb\x34\xf6\x74\x58\x96\xc5\x83\x04\x17\x12\x10\x1a\xb9\x53\xd2\x3c\x75\xd9\x1c\x30\xa7\xf8\x4a\x08\x22\x7f\x0f\x02\x8c\x19\xd1\x3e\x3d\x41\xa3\xc2\xbd\xff\x26\xbf\x92\xae\xc6\xb8\x52\x5e\x4a\xac\x7d\xe2\x3a\x3d\x7f\xf3\xa2\x19\x29\x2b\x6f\x8d\x85\xdb\xe5\x33\xc5\x6e\xa9\x4e\x5f\x0c\xb7\x9a\x25\x03\xd3\xc9\xf8\x45\xd6\x5e\x32\x32\x48\x3e\xa4\xcb\x0a\x3d\x5b\x10\xc5\x67\x90\x24\xee\xcf\xc1\xf3\xad\xef\xdf\x09\x81\xbe\xf3\x2f\x90\xea\xba\x81\x7b\xf9\x18\xf2\xd1\x75\x1a\x8e\x0a\xe1\xec\xd3\x36\x44\x33\xaf\xd1\x3e\x7d\x04\x34\x97\x85\x2f\x38\x0a\xfb\xfe\xf5\xae\x70\xda\xbd\x5e\xe3\x41\x4f\xc9\x6c\x8c\xa5\xa4\xac\xe6\x47\x97\x8b\x9a\x5e\x08\x46\xa7\xe5\xa9\x7c\xeb\xde\x95\x2e\x12\xed\x5a\x7b\x86\x3e\xf9\x2e\xf5\x1f\xc9\x68\x9e\x79\x72\x31\x93\x64\x8f\xa0\x52\x56\xa7\x98\xa7\x89\x18\x6f\x4b\xa2\xaa\x0f\x03\x4f\xd4\x31\x5e\xfb\xd2\x67\x27\xb3\x24\x83\xb7\x1e\x69\xca\x33\x64\xd9\xb7\x60\xbe\x9d\x98\xdb\x57\x2c\x79\xf2\xbb\xb5\xc4\x7c\x92\xc9\xdf\xfe\x96\xd0\xff\xff\xed\x6f\x49\xc7\x1c\x70\x28\x4d\x96\x25\xbc\xc1\xdc\x17\xee\x5f\x94\xd4\x82\x6a\x90\xdd\x2a\x46\x61\xa5\xbf\xdb\x80\x7f\x1d\xf6\xd1\x6e\x6e\x5a\x7f\x92\x03\xa3\x44\x6d\x64\x93\x1a\x94\xd0\xb3\x56\x55\x94\x64\xaa\x00\xa2\x2c\xeb\x83\x02\xa1\x94\x71\xb5\x34\xf9\x97\xad\xcc\xcf\x7a\x02\xac\x0f\xf7\x72\x59\x37\xd6\x35\x10\xab\x06\xe6\xc5\x16\x32\x65\x0f\xe5\x3b\x53\xbd\x58\x70\x60\x13\xa1\x6d\xfe\x67\x2d\x6d\x62\xa2\xb6\x83\x00\x06\xbe\xc3\x1a\x53\xe3\x85\x75\x9e\xc1\xbd\x61\x7c\x17\xba\x8e\x35\xa2\xd1\xc5\x7c\x57\x8a\xa6\x35\x9a\x6f\x8d\x05\x1d\x58\xd3\x5b\x0e\x7f\xdc\x69\xd8\xca\x66\xcd\xa9\x8b\xd9\x5c\xe8\x47\xb1\x17\x6a\xde\x14\x5b\xfd\x44\x39\x83\x21\xca\x06\xbd\x96\x4d\xc1\x30\x8b\x0a\x04\xad\x63\x30\x65\xff\x6f\x58\xd5\xdb\xb5\x6c\x7e\xa4\x20\x2e\x8a\x93\xd0\x17\x15\xcc\xeb\xcd\x46\x04\x16\xf4\x20\xdf\x0a\x54\x1f\x92\x42\x66\x21\x2b\x25\x17\xe1\x0a\xe1\xbf\xed\xbf\x5c\x71\xd8\x43\xc0\xac\xb9\xc8\xb2\xd1\x1c\xf5\x16\x94\xf8\x97\x8f\xf2\x1f\xea\x46\xc3\x14\xc6\xdb\x7f\xb9\xca\xed\x4a\x66\xd8\x04\x53\xdd\x0d\x8e\x8f\xdd\x00\x3a\xe3\x01\xd1\x48\xc2\xe8\x58\x2e\x97\xc5\xbc\x90\x95\x2e\x8f\x69\xe8\x44\xfc\xcd\x4e\x90\x65\x79\xb8\x56\x05\xce\x82\xf2\xf2\xe3\x4a\x20\x89\xb0\xa3\x68\x51\x79\xd6\xf0\x1a\x36\xa2\xaa\x64\x13\x91\x8a\xba\xb1\x31\xc9\x79\xb1\x7f\xe0\x10\xe4\x47\xcb\xe7\x58\xca\xca\x20\xb1\xf3\x4b\x32\xf8\x99\x43\xbc\xf7\x5f\xe8\x02\xa6\x1b\x1c\xb1\xc6\x09\xc3\x09\x43\xa3\xb3\x52\xda\x17\x6b\x51\xfd\x57\x3c\x0b\xfd\xd7\xe9\x8b\x23\x9d\xc1\xf0\x89\xa2\x64\xb5\xf8\xbe\x2a\x8f\x19\x65\x17\xc5\xbf\x2e\x3e\x33\x6c\x53\xd2\xd0\xf0\x9f\x51\x0d\x0b\xd2\x24\x57\xf5\x6b\xf8\xd2\xf5\xf0\xc2\x51\x7e\x64\x0e\x00\xfe\x3f\x66\xcd\x1e\xfd\xe2\x14\x2f\x0c\x1e\xaf\x1b\x95\xd9\xc4\xa6\x19\xec\x45\x53\x88\x45\x31\x8f\x56\x86\xeb\xc1\x14\xf8\x8f\x0e\xee\xa9\x35\xa1\x9e\xfe\x8a\xbe\x5b\xa0\x88\x0e\xf3\xe7\xc5\x17\xa7\xcb\x16\xcf\xe5\x6a\xee\xae\xa0\xe3\x34\x72\xf1\xc7\xa3\xf7\x3e\x83\xdf\x36\x05\x9d\x46\x6c\x5e\xf8\xe7\xba\xf1\x3e\x0f\x50\x7a\x6b\x20\x77\x0a\xad\xc6\xbe\xb4\x4f\x7e\xd2\xf5\x16\xea\x25\x18\x31\x8a\x02\x03\xfa\xe3\xcf\xac\x6d\x6a\xaf\xf2\xe5\x04\x5c\x32\x19\x29\x55\x0d\x42\xc3\xd9\x3e\xf4\x71\xdb\x0b\xdf\x93\x0f\x2d\x86\x9c\x99\xa6\xf9\xeb\x36\x8c\x6d\x33\xcb\x60\xd3\xaa\x19\xec\xf8\xe2\x28\x37\x5d\x61\x22\x38\xf5\x8f\x44\xcc\x9f\x9b\xa3\xbf\xd0\x70\x40\xcb\x5e\x5c\x7a\xb2\xb0\x96\x28\x30\x08\x58\xef\x56\x12\x94\xd4\x9f\xf7\xeb\xea\xc5\x3d\x25\xe4\xad\x39\x68\x04\xeb\xb1\x36\x26\x37\x06\xe7\xf4\x87\x0d\x4c\x3b\x9a\x73\xc6\x6b\x2c\x58\x59\x0b\xc7\x3b\x0b\xe1\x0d\x0c\x1d\x1e\xfd\xe2\x55\xf7\x91\x6c\x98\x78\xbf\x35\xa9\xf7\xe0\xfc\x91\x21\x2b\xdd\x14\x7e\xaa\x4b\x37\xfd\x0d\x02\x69\x6d\x3f\x32\x30\x55\xa3\xbb\x9f\x05\x70\x39\x1b\x3b\x75\x99\x0d\x38\xdd\xcf\xef\xf0\x0e\x5e\xd5\x64\x0f\x4f\xe6\x8a\x8a\x9c\x74\xe6\xa5\x14\x55\x0e\xdf\xd5\x28\xfe\xd1\x2b\x98\x28\x61\x5e\x97\x65\xc1\x32\x4d\x27\x40\xd3\x03\x7b\xfc\xdb\xdc\x27\xbc\xfd\xa2\x4e\xab\xa2\xfc\x77\x79\x7c\xa5\xeb\x46\x2e\x2c\xcb\xe8\xd3\x4c\xb9\x20\x04\xd3\xe7\x21\xe1\x3e\x64\x20\x5b\xc2\xb5\x68\x89\x09\x17\x17\xeb\x94\xd3\x59\xdf\x58\x90\xe7\x0e\xd5\x23\x85\x01\x4c\x41\x02\x29\x59\x90\x8a\x2a\x5c\x58\x51\x7e\x67\x3e\x76\x7c\x8d\xa0\xdf\xb3\xea\x81\xac\x58\xdb\x08\x1e\xcb\xe5\xf8\x21\x4d\xfd\xe0\x29\xc2\xdc\x52\x7e\x3b\xe8\x74\xb5\x53\x26\x7a\x0d\x24\x19\x50\x10\x9b\x84\x71\x33\x4d\x3c\x3f\x90\x9e\xd5\xbe\x7b\x90\xc7\x37\x27\xa7\x71\x7b\xf2\x4d\xc6\x45\x04\xbb\x3d\x1b\xad\x8a\x3a\x35\xf5\x63\xe2\x7c\x90\x47\x14\x6f\xa7\x86\x49\x47\x5f\x91\x78\xdc\x67\xfc\x11\x7d\x47\x5b\x7b\xbb\x1e\x8e\xd8\x62\x37\xb5\x4b\x8e\xe8\x6f\xc5\xf6\xac\x4d\xc8\xf0\xbf\xf7\x3e\xbb\x69\xc1\x7a\xd4\x93\x4c\x19\x0f\x32\x96\x90\xde\x47\x6d\x79\x91\x62\xd2\x4f\x81\xe7\x5b\x39\xd6\xfb\x62\x21\x15\x08\x38\x88\x23\x33\xe6\x1f\x25\xd7\x63\xd3\x41\x65\x83\x8a\xb0\x5e\x8e\x6e\x2a\x69\xd6\x42\x98\xe3\xae\x14\x65\xf1\x13\x5f\x89\x60\x59\x54\x85\x5a\x23\xc9\xee\xe8\x7c\xe3\x66\xc6\x7d\xd1\xa9\xf7\xbc\x7b\x16\xce\x8d\x1b\xf1\x10\x69\xb5\xbf\x68\x24\xd9\xee\x4e\x1d\x22\x79\x31\x79\x48\x9c\xcc\x27\xbd\x4c\xb5\x6d\x4c\xf2\xcb\x85\x67\x94\xdf\x97\xa9\xc9\x80\xb9\x5b\x96\x0b\xef\x38\xc1\x8e\x30\xeb\x40\xdd\x00\x7f\x68\x7d\x1c\x6f\xe3\xc4\xc1\x03\x8c\xd7\x53\x99\xb7\x13\x4c\xde\xdb\xca\x29\x48\x10\x95\x0e\x46\x66\xed\x39\x97\x2e\x4f\x79\x1d\x0b\x42\x8a\xe4\x33\xf0\xe9\x68\xb9\xab\x78\x19\x40\x34\xf2\xa6\xf7\x0d\xca\xb9\xfa\xf7\x7b\x36\xb6\x47\x5a\xe0\xb9\xc8\x1a\xf8\xb3\x86\x10\xfe\x28\x3b\xc3\xbb\xe9\x81\x72\x10\x4e\xd3\x91\x41\xa1\xfa\x65\x8c\x4e\xb3\x0c\x92\x4e\x59\xd4\xd4\xd8\x14\x75\xaa\xf1\x85\xfe\x64\xfa\xea\x47\x8f\x1f\xc7\xce\x1b\x06\x09\xf8\x66\x78\x12\x76\xa3\x24\xed\x8f\x73\x95\xef\xae\xde\x04\xf5\xef\xae\xde\x5c\xd0\x84\x09\x37\x6e\x98\x87\xfe\x72\x2d\xa2\xa6\xbe\xe9\x55\x47\xa5\x36\xc8\x07\x3c\xda\x1f\x60\x01\x8f\xbe\x24\x7b\x7a\xdb\xb5\x0c\x77\x15\x87\x6c\x60\x87\xb5\xb6\x9a\xdb\x88\xcc\x52\x8c\x32\x4a\xb9\x44\xdc\x34\xea\x5b\xab\x74\x6a\xdb\xa1\xf7\xf7\x5e\x94\x92\x03\xda\x98\x60\x31\xa6\xa3\xa2\x82\x1f\x55\xaf\x75\x76\x5b\x67\x0a\x3f\x33\x13\x99\x26\xad\x5f\x1d\x0e\x81\x1d\x44\xb2\xa1\xc3\xcb\xe3\x28\xa7\xea\x18\xe1\xa4\xe5\xb4\x76\x66\xa7\x34\xae\xa7\x62\x50\xd5\x4d\xc8\xf4\xad\xe9\xfb\x4d\x72\x1a\x62\xcf\xe5\xe6\xa2\x7e\xd7\x42\xb9\xd5\xb8\xa8\x8f\x76\x82\x27\xea\x1a\xd7\xf8\xa9\x73\xa8\x34\xfe\x94\xa7\x64\x05\x93\x1b\x30\x38\x6a\x5a\x92\xed\x1c\x37\x9d\x7f\x6a\xaa\xf2\x9c\x8f\x19\x5c\x6f\xcc\xdc\xc1\x71\x0f\x16\x7f\x77\x83\xbe\x6b\xbf\xbf\xc1\xe7\xbd\x0c\x4e\x0e\x29\x16\xea\xc2\x7f\x56\x51\x9e\xe7\xc9\xbb\x61\x30\x5e\x42\xc5\x77\x7d\x14\x9a\xb7\x3e\xca\x41\x79\xf7\x6a\xd5\xba\x9a\xe9\xf0\x65\xc0\x08\x17\x64\xb3\x13\x44\xe8\xb9\xe4\x85\xd4\x1c\x52\x46\x05\x3c\xd1\xf5\x84\xc1\x01\x33\xf0\xc1\x97\xd1\xdb\x1e\x2e\xdb\x7d\x84\x0b\xbe\xa7\xdd\x73\x2e\x1c\x7a\xe2\xff\x4c\x2e\x3e\x6e\xfb\x82\xe3\xd0\x78\x38\xbb\x4f\x04\xb4\x07\x07\x5e\x74\xc9\xf4\x71\x86\x28\xa3\x4b\x9f\xeb\xfc\x41\xc4\xef\x6c\xe7\x5f\xe8\x42\x34\xed\xd3\x01\x08\x8e\x65\xa1\x8e\xb8\xfb\xd5\x5a\x77\xf9\xd0\x06\xc4\x40\xbf\x0a\x4c\x26\x50\x37\xc6\x19\x99\xaa\x31\x77\x8f\xa4\xc6\xcf\x4f\xc8\x6e\x86\xba\x5e\xd7\xde\xe9\x94\x9c\x17\xd4\x74\xd3\x15\xd6\x06\xd5\x83\xbf\xe4\x3d\x37\x76\x39\xd4\x9e\x09\xd4\x64\xd2\x42\xc2\x91\xaa\xee\x85\xc2\xbb\x0f\x3f\xf6\x75\xb2\x7d\x7d\x51\x2e\xcc\x51\xd1\x40\x7d\xa8\x8c\x3a\x8a\xba\xe4\x68\xa2\x1f\xbe\x86\xb9\x60\xf5\x07\xdd\x71\x4c\x0d\x05\x45\x05\x5f\xd5\x79\x88\x00\x7b\x19\xf2\x44\xe2\xb0\xc2\xeb\xfa\x3b\x79\x28\x8f\x5f\xd8\xfd\x29\x17\xc3\xe2\x2e\xfa\x21\xee\x8a\x52\x83\xa0\x47\x17\x6e\xc0\x77\x1b\x35\x6f\x84\x9e\xaf\x5d\xde\xe0\xf6\x71\x4c\x47\x21\x76\xcc\xa1\xd0\x52\x00\x8f\x2e\xea\xaa\x4d\x50\x8c\x83\x1c\x7b\xd5\x4f\x8a\xeb\x01\xad\x9c\x3c\xf5\xdb\x27\x94\x53\xe7\x7f\x24\x03\xe4\xdb\x33\x75\xcf\xc0\x79\x2f\x39\x01\x22\x1b\x88\x2e\x8f\x6e\x21\x9b\x18\xed\x16\xec\x34\x39\x0f\x38\x38\xce\xb3\xa4\xe8\x89\xa4\x32\xd0\x92\xf3\x9b\x42\xbd\x73\x42\x19\x0b\x2b\xed\x70\x46\xe7\x61\xf4\x04\xce\x8e\xe3\x39\x75\xff\x15\x4b\x37\x45\x98\x4e\xdd\xdf\xb9\xe1\x6d\x7d\x0a\xac\xa1\x83\x7b\xdc\x8d\xc9\x9d\x9e\xd3\x65\x9c\x16\x08\x5a\xd8\x90\xfc\x2f\xe2\x31\xf1\x49\xdf\xda\x9f\xd9\x81\x5b\xb6\x7c\x12\xe9\x9d\xa4\xcc\x1d\x02\xeb\x86\x39\x21\x2e\xfa\x70\x31\x79\x99\x20\xfb\xc1\x36\x1a\x08\x65\xfd\xd0\x63\x9f\x3d\x0c\xf9\x8c\x21\x7a\x5f\x43\xf3\x50\x6a\xb8\x9b\xd4\x0a\x96\x45\xa3\x34\xc5\x94\xac\x8c\x16\x23\xbf\x14\xca\x87\xaf\x6f\xce\x54\x65\xbe\x84\xbd\xb5\x7b\xbd\x45\x82\x49\x30\x71\x9a\x26\xa9\xf1\x89\x50\x51\x83\x74\x82\x0d\x7f\x31\xcd\xb9\xb9\x9e\x9d\xaa\x3f\xc9\xff\x59\x13\x5c\xc8\xa5\xd8\x95\xc4\x6f\x98\x00\xa0\x36\xd1\xbb\x2f\xdb\x90\xbe\x66\xf7\xcd\x2f\xdb\x6b\xbd\x61\x8e\x78\xbb\x85\xc6\xa4\xef\xb5\xe3\x3a\x41\x90\x1e\xb3\xe9\x82\x59\xda\xd0\xf6\xef\x3b\xd3\xe8\xd6\xe2\x85\x7a\xad\xe6\x0d\xbd\x37\x52\x1c\x58\xf9\x76\x6b\x63\xc9\xb6\x12\x4b\x0e\x7f\xde\x95\x65\xdb\x44\x15\xab\x4a\xe8\x1d\x59\xaf\x68\x51\x94\x6c\x57\xb1\x15\x14\x63\xa8\xa8\xb0\x34\xef\xf4\x23\x02\x03\xfb\xe8\xb0\x27\x0b\xef\xd7\xb5\x89\x9b\xd2\x1e\xac\x8b\x80\x97\xb6\xd7\x9c\xde\x66\x0e\xc9\xf2\x2d\x3b\x9c\x5b\xa6\x73\xd3\xff\x74\x48\x20\x4c\x15\xa3\xb5\xf1\x4a\x7a\xda\x2c\xe8\x5a\xb3\x08\x6f\x33\x46\x4f\xe7\xb5\x1c\x7c\x80\x89\xea\x9d\x50\x00\xf9\x61\x6a\x3a\x23\xf5\x90\xe2\xa5\x40\x41\xa9\x2e\xf5\x54\x0e\x1e\x86\xdf\x03\xbb\xbf\x10\xb3\x21\x56\xdf\x0f\xa3\x97\x60\xf3\xf1\x98\x1c\xc2\x62\x4c\xae\xfe\x53\x01\x3f\x65\x14\x8a\x2c\xb6\x6e\x4e\x99\x27\x3c\xac\x7e\x10\x7a\x6d\x95\xf3\x97\xbd\xa7\xdb\xd8\x4f\xdc\x66\xda\x67\x19\x0f\xcb\xf6\xe9\xaf\x4f\x83\x62\xb0\x89\xd1\x20\xe9\x33\x7c\x10\x9a\xc7\x77\x11\xbf\xf4\x95\x95\x38\xa0\xf3\xed\x86\x1e\xbc\x90\x3c\x79\xde\x30\x05\xf3\xd7\x6d\xac\xcc\xc4\x31\x23\xa6\xe8\x8f\xee\x93\xc4\xf9\x07\x89\x62\x09\x4b\x7b\x29\x76\x01\x5b\xaa\x5a\xdb\x52\xff\x0e\xd7\xfb\xf2\x79\xce\xdc\x9d\xff\xdd\x37\x52\x3c\xdc\x5e\x12\x0a\xf1\x52\xd3\x4e\x16\x48\xf8\x66\x6d\xa2\x03\xdd\x3e\xca\xf6\xd3\x6a\x79\xa3\x88\x58\x17\xfe\x3b\x65\x00\x6a\x51\xc7\x73\x18\xe3\x41\xb3\xb4\x0f\x3e\x8f\xb4\x07\xbd\x7d\x1f\x83\xd0\x78\x53\xb8\x96\xed\xb6\x50\x19\xd8\xa4\x71\x0b\xc5\x57\x02\x84\x33\xa0\xee\xa7\x6e\x92\x85\xd2\xfd\x9f\xc9\xc6\x34\x51\xcd\x3c\x19\x18\xc7\xc9\xb7\x04\xb3\x0e\x49\xef\x13\x82\xb1\x2d\xb9\x80\x90\xdb\xbb\xf3\xe1\x1a\x51\x64\xd7\xa0\xe8\x0a\x4e\xa3\xae\xe8\x46\x8d\x02\x3f\xb1\x38\xe4\x2e\x80\xad\xd4\x7d\xee\x84\x1e\xc2\x69\xf7\xcf\x96\xcc\x72\x5b\x12\xa0\x15\x6b\x7f\x76\xef\x53\x7d\xa1\x45\xc3\xac\x26\x9c\x89\x9b\x30\x41\x9e\x5c\x16\xd8\xa0\xfc\x13\x2f\x24\x6c\x9b\xa2\x6e\x48\x07\x58\x6f\x8f\xbd\x4d\x82\x31\xc3\x34\x1c\xf4\xed\x25\x7d\xb4\x79\xc3\xbb\x1d\xc4\xf2\x6c\xcc\x0d\x6e\xfb\x78\x62\x9f\x23\x54\x5d\x05\xeb\x71\x03\x4a\x6a\x60\x72\xaf\x49\x43\x02\xba\xe6\xdf\x68\xcd\x60\x57\x24\xe0\xc0\xf0\xec\x43\xe2\x7c\x7c\x3f\xff\xf0\x59\xac\x8d\xd9\xb2\x61\x29\x5b\xd1\xa0\x82\xf8\x3d\xf8\x6c\x0b\x23\x40\xea\x49\x7b\x54\xf2\x7b\x1e\xfa\x08\xef\xce\x1a\x74\x5b\x2f\xa3\x9f\xdf\xe1\x24\x8d\xad\xec\xbc\x91\x42\x4b\xdf\x49\xbb\x95\xac\x33\xe5\x8d\x33\x0d\x14\xe1\x97\x79\x8e\x03\xf8\xb5\x42\x43\xdc\xe1\x43\x2f\xa8\xd6\xaa\x4b\xfd\xd2\x60\x2c\xd0\x48\xb1\xc0\x0d\x80\x6f\x96\x4d\x41\x82\x93\x30\xbb\x01\x51\xb5\x5b\xad\xad\x39\x71\x9f\x05\x37\x14\x36\x4e\xf6\x42\x36\xd2\xc4\xdb\xce\x87\x14\xad\xa1\x2d\xf1\xcf\xa3\x13\x77\x9e\x93\x61\x54\x07\x6f\x8d\x1e\x65\x3c\xbc\x79\xcc\xf5\xb1\x9f\x2e\xc6\xa7\x3b\x3c\x79\x8f\x3c\x7d\xd9\xb9\x28\x76\xed\xff\x84\x69\x02\x40\x23\x0e\x4a\xea\x0b\x06\x1f\xa2\xe4\x5d\x87\x6f\x58\x69\x1a\x19\x81\xdc\xdc\xcb\xc5\xc2\x46\xc4\x52\x01\x27\xe9\x0f\xc8\x39\xee\x04\xcf\x8b\x0f\xb3\x28\xf6\x65\x80\x79\xf3\xd2\xb1\xc1\xe0\x9a\xa3\x5e\xa4\x73\x15\x4f\x8d\xd1\xda\x31\xfe\xa3\x13\x07\xf7\x76\x00\x6b\x27\x80\x74\x2e\xf7\xa8\xa6\x36\x16\x9d\xa0\xeb\x45\x7d\x03\x85\xb2\xe1\xe8\x9b\x62\xb5\x8e\xac\x36\x63\x69\xae\x75\xce\x0d\x84\xa6\x01\x8d\x63\x28\xbb\xce\x66\x3f\x2a\xe6\x71\x2e\xea\xc5\xd0\xa2\xee\x21\xaa\x3e\xde\x0f\x69\x35\x07\x09\xa9\x58\xc2\xbe\x0d\x91\x76\x96\x98\xdb\x1c\x61\xfe\x9d\xaa\x5f\x66\x18\x1f\xe8\xf9\xea\xe4\xf0\x0f\x8f\x1c\x2e\x2f\x31\xec\xdb\xc5\x1b\xef\x79\xb9\xfa\xfa\xe9\x73\x71\xeb\x2b\xbb\xf8\xf8\xf3\x16\x4c\x54\x75\x75\xdc\xd4\x3b\x75\x02\x61\xbe\x49\x71\x1c\xe5\x54\xba\x5b\x56\x7a\xf2\xbd\x3b\xde\x3a\x1c\x4e\x60\xc3\x91\x68\x1f\xd3\x88\x5f\xa5\x4e\x35\x1c\xf6\x08\x3c\x33\x91\xd9\xcc\xba\xe6\xb8\x29\x3d\x7a\x4e\xef\x3d\xbc\x1e\x5a\x09\x6b\x0e\xb8\xc3\x8c\x42\x11\x55\xda\xdc\x72\x7b\x51\x16\x0b\x32\x31\xba\x81\x30\x28\x34\x96\xa5\x41\x14\xda\x91\x4b\x25\x01\x41\x38\xff\x28\x8e\xa5\x17\xc6\x32\x72\x78\xc6\x56\xbd\x6f\xc8\x7c\xff\x1c\x8a\xc9\x10\x5a\x5a\x5f\x02\x0f\x85\x8f\x33\x11\xe2\x38\x94\x66\x14\x3a\x32\x0c\xa5\x39\xf8\xf1\xea\xe5\xa9\xaf\x9d\xb0\x73\x41\x1c\xcd\x4b\xa7\xf0\xfc\x9b\x6f\x6e\x2f\x33\xe1\x34\x11\x38\xfb\xc7\xc3\x11\x38\x4f\x7e\xbe\x7a\x79\xf2\xf3\x47\xd7\x27\x3f\xbf\x7c\x71\xf2\x33\x87\xe0\xec\xc1\x46\x4f\x08\xce\x4b\xb0\xf2\x57\x87\x16\x0f\x21\x1d\xe0\xfd\xe1\xff\x86\xe3\xff\x9d\xee\xf3\xf6\x62\x53\xda\x28\x40\xe4\x49\xb0\x49\x72\x8e\x44\xdb\x38\x7b\x7d\x61\x0c\xe3\xa8\x67\xfe\x91\x65\x3a\xc5\x63\x09\x5d\x4a\xd3\xdb\x4b\x06\x84\x1f\xce\x4d\xb5\x8d\x50\xd5\x37\xa4\x53\x41\x53\x06\xbb\x75\xa1\x15\x2e\xeb\x3b\xf6\xd1\x3b\x09\x7b\x36\xc3\x8c\x46\xdf\x5d\x0a\xdb\xb9\x91\x5d\x06\xbb\xd7\xa3\xe4\xa2\x8e\x42\x97\xa7\xcb\x7a\xb3\x6e\x2d\x97\xf5\xd0\x09\x38\x71\xaa\x93\xfe\xf0\x12\x86\xc7\xb7\x6e\xe2\x79\x1e\x18\x19\xf5\x05\xcb\xf7\x24\x0c\x0a\x99\x6f\x7d\xc6\xbb\xc9\xb3\xdb\xe0\xf9\xd6\xbe\x3d\x36\x9d\xee\x58\x4e\x04\x4e\xf9\x67\x83\xb5\x3a\xb3\xf6\x8b\x26\x1e\x19\x67\xf4\x87\x09\x7a\xec\xe1\x39\xf2\x5e\x25\x10\xb2\x89\x33\x1e\x04\x37\xbf\x1d\x01\xc4\x65\xd3\xe8\xb7\xf1\xb5\x40\x10\x28\x46\xc0\x14\xf0\x3f\xae\x8c\xa7\x4e\xe3\x70\x65\x5e\x08\x70\x57\x46\x51\xc8\x61\xca\xd1\xc8\x5d\xe9\xf6\x61\xc5\xba\x6a\x57\x62\xcd\x85\x60\xea\x2c\x87\xdc\xb7\xd6\xa7\xcc\xff\x55\x37\x36\x98\x67\x5b\xfa\x4a\xea\x2f\xc4\x7c\x2d\x6d\x9c\x7a\x80\x7e\xf3\xa2\xdb\x20\xf4\xf9\x87\x1f\x7e\xe8\x25\x4c\x35\x3e\x06\xd6\x46\xc9\x9a\x8c\x4e\x83\x1c\x02\xd6\xf4\xa8\x5d\x42\x93\x6b\xc1\xcb\xfb\xd0\x4a\x6f\xb6\xf6\xc8\x6a\x48\x7a\x46\x1c\x5d\x74\x27\x13\x8f\x3a\xc2\xaa\x66\xf0\x41\xbc\x7c\x0c\xd0\xec\xe9\x9c\xb8\xb0\x50\x3f\xd0\x7a\x8c\xfd\xb8\xea\x8e\x9b\xa6\xb7\x51\xfc\x77\xd7\x4b\x10\xda\x69\x70\xea\xbd\x2d\x95\x94\x8a\xfb\x45\x25\x1a\xfd\xe1\x66\x5e\x2c\xb9\x00\x40\x54\x0b\xf0\xfc\xb5\xce\xb3\xa9\x36\x42\xb6\x50\x6a\xb7\x91\xce\x9d\xfe\x5e\xce\xc5\x4e\xc9\xd6\xea\x8b\xdc\x46\x0c\x04\x05\x94\xbb\x0c\x01\x6d\x94\x2c\x6d\xfc\xec\x36\x9b\x47\xbb\x4b\x3b\xe4\xd3\x9a\x77\x9a\x75\x30\x05\x76\x6b\x19\x33\x07\xf4\x27\x6a\x7d\x73\xdd\x0c\xbd\xa1\x7b\x55\x9c\x53\x51\xb0\x74\xf3\x5d\xd3\xc8\x4a\x63\x9f\x3f\xd3\x1e\x9c\x72\x9b\x8c\x82\xc0\x35\x72\xae\xa7\x0c\xf5\x9d\x59\x5c\x63\x26\xfe\x55\x0d\x6a\x2b\xe7\xa0\xc4\x51\xdd\x98\xf2\xd7\xc1\xb3\xb1\x35\x07\x9f\xd7\x4d\x23\xd5\xb6\xae\x48\x17\x16\x44\x58\xfb\xf0\xb5\xb1\x0f\x37\x40\x4d\x2b\x51\x96\x16\x4b\xb0\x90\xf3\x52\x34\x96\x1c\x4c\x02\xb9\x06\x5b\xd6\x0d\xbc\x36\x6d\xc7\x9c\xbe\x4f\x65\x50\x68\x76\x2b\x9d\xd7\x95\x16\x45\xa5\xa2\xa7\x6c\x84\xfe\x3a\xcd\x2d\x4d\x18\xe5\x85\x94\x95\x47\xbd\xf1\xb3\xc4\x61\x5d\x94\x32\x83\x0f\x2c\xa6\x0a\x95\xb4\xbf\x88\xbc\xa8\x46\x5b\x01\x13\x9b\x3a\xd6\xcf\x5d\x54\xf2\xad\x0e\x96\xd5\x2c\xa0\x92\x61\xf1\xc8\xbf\x8e\x79\x0e\x7f\xb6\xb7\xfe\x90\x58\x92\xb9\x46\x54\xb5\x3f\x58\xb6\xcc\x12\xd9\x57\x6c\x1e\x04\x13\xd9\x75\x58\xa0\x90\x8c\xb2\xba\x93\x7e\xe2\xe4\x37\x27\xbc\x50\x44\x89\x9a\xcf\x23\xb5\x42\x2b\xbd\xa0\x61\x9e\x27\xa0\x6a\x7e\x69\x23\xe5\x28\xa7\xf9\xde\xa0\xe1\x21\x99\x6e\xf9\x5d\xdb\x27\xb9\x81\x53\xb2\x6f\x58\xa1\x27\x5a\xa0\x97\x2f\x96\x60\x2b\xf3\xc1\x30\x3c\x05\x93\xc7\xcd\x54\x43\x9d\xb3\x6b\xea\x0c\x35\x87\x5e\x8a\xa2\x8a\x19\x24\x51\x49\xff\xe3\xd1\x06\xbf\xb5\x4b\x1e\xb5\xe9\xbb\x6f\xb7\x08\x5f\xd0\xde\xd2\x35\x51\x94\x05\x45\x01\xd1\xe8\xcf\xae\x6f\xa4\xff\x2e\xee\xb5\x49\x6f\xcf\x05\xc7\x94\xb9\xe5\x0b\xbd\x7a\x91\xfe\xb9\xb4\xfa\x03\x33\xab\xf4\xd4\xb4\x7e\xd1\xcc\x4e\x4d\xee\xb1\x8f\x3b\xfd\x6f\x3b\xa0\x0e\x05\xd2\xaa\xec\x7d\xba\x33\xee\xae\x24\x9e\xf4\xd7\x08\xe8\xf1\xe1\xb4\x08\x17\xb7\xe8\xd5\x65\xd9\x6e\x86\x75\x5a\x97\x29\xb4\x78\xe8\x4b\x3e\x30\x8c\xa2\xe7\x76\xb8\xde\xd7\xf6\x7c\x5f\xbe\x36\x73\xec\x39\xe0\x7b\x9b\xea\x23\x0c\x68\xdd\xbe\x56\xa7\x95\xa2\x9c\x47\xe7\x75\xc7\x43\xd6\xfd\x1b\x78\x93\xf4\x9b\x8e\x2e\x55\x47\x06\x74\x84\x1c\x3c\x03\x73\x44\xea\xa3\x7f\x3c\x7a\x5b\xa2\x6e\xcc\x1c\xde\xa5\xe7\x15\x93\x9d\x82\x81\x1f\x56\xdc\x3f\x2b\xa7\x0c\x50\xcb\x7f\x17\xde\x72\xc9\x5e\x73\x97\x0f\x63\x35\x76\x5f\xa3\xc4\x24\x8e\x46\x74\x12\x0b\x09\x8b\xdd\xb6\xa4\xe8\xb4\x94\x2d\xe7\x00\x0b\xb9\xd8\x6d\xe3\x28\x73\x42\x73\x79\x06\x1f\x98\x43\x36\xbc\xad\x50\xa9\x33\x70\xe9\x09\x0d\x81\x9f\xfb\x4f\xdb\x0d\x14\xaa\x37\xda\x45\x96\x6c\xa2\x63\x13\xe5\x62\xd2\x64\x93\x93\xd2\xa0\x02\x3e\xae\x06\x9b\xdb\x41\xdc\xc4\xf3\xa4\xb7\x6b\x33\x55\x84\xe3\x12\x01\x3d\x31\xe6\xf1\x6d\x02\x7d\x7f\xd8\x66\xcc\xd8\x22\x72\x62\x68\x05\x42\x24\xf9\x58\x19\x7a\x4a\x5a\xe5\x85\x57\x75\xe3\xc1\x35\x98\x65\x43\xc1\x16\xbd\xf4\xbd\x45\xef\x80\x99\x96\xed\xc2\xb6\xb7\xb7\xcb\xdb\xf0\xee\xd3\xb9\xa0\xb8\xfc\x6d\x26\x17\xf3\x7d\x5d\x97\x00\x10\xb8\x91\xc0\x55\xe6\xa9\x6d\x33\x00\x80\x04\xeb\x25\x19\x8f\xa8\xd9\x85\xa1\x01\xf1\x65\xf8\xb6\x05\x59\x54\x3a\x04\xf7\x71\xd6\xee\x4c\x06\x91\x14\x95\x4e\xb2\xcb\x60\x7d\x3c\x3c\x3c\xd4\xcc\x66\x16\xde\xc7\x97\x0f\xef\xea\x65\x0c\xf2\x3a\xf3\xf5\xb9\x99\x01\x79\xf5\x32\xc9\x2e\x04\xf9\xd1\x75\x0c\xf2\x45\xe6\x2b\x81\x2d\xc8\x8f\xae\x2f\x06\xf9\xf2\x05\xc0\x30\x22\x5f\xbe\xb0\x20\x5f\xbe\xb8\x0c\xe4\x0e\x17\x66\x10\x24\xaa\x67\x19\x97\x3b\xbb\x38\x97\x81\xfc\x78\x70\x79\x48\xdb\x9c\x59\x90\x1f\x5f\x3e\xca\xab\x97\x11\xc8\xeb\x2c\xd0\x50\x67\x06\xa4\x59\x9f\x4b\x40\x7e\x74\x1d\x81\x7c\x91\x05\x5a\x6d\x0b\xf2\xa3\xeb\x8b\x41\xbe\x7c\x11\x81\x0c\x70\xf9\xf2\x85\x05\xf9\xf2\xc5\xc5\x20\x63\x87\xae\x10\x24\xbd\x12\x59\x74\x6e\x75\x93\x64\xe7\xa1\x2e\x8d\xee\xbb\x1f\xaa\xd1\x8c\x33\x54\x53\xf5\x62\xa8\x2f\x5f\x9c\x82\xfa\xf2\x85\x07\xf5\xe5\x8b\x4b\xa0\xce\x9d\x86\x7b\x00\xb0\x53\x81\x23\x6a\x5d\xed\x8b\xb0\x3b\x6f\xf5\xe3\x3e\xec\xab\x97\x11\xec\xab\xeb\x8f\x33\x07\xfb\xea\xfa\xe3\x4b\xc6\xcd\xe7\x26\x0c\x00\xe6\x87\x00\x1c\x31\xd7\x3b\x35\xdc\xc9\xc4\x01\xdd\x05\x2f\x22\x03\x44\xe1\xd7\xc9\x20\xe1\x36\xb9\x29\x48\xb2\xa1\x6e\x46\xee\xd2\xd1\x1b\x1f\xb9\x58\xb2\xdb\x5d\xf7\x4c\x76\xba\x4e\x2d\x9b\x4a\x94\xfc\xfb\x06\xe6\xa2\xaa\x6a\x56\x4e\x41\x7b\x9f\x31\x99\x8c\x11\x04\x82\x4b\xd2\x8e\x82\x85\xdd\xce\xf0\x23\xaa\x59\x6f\x47\xa7\x92\xe6\x72\xdd\x33\xbe\x8e\x04\x8a\x1d\xde\xfc\x10\xb6\x54\xdc\xba\x3d\x32\x12\xe0\xa4\x1f\x63\x18\x8f\xc5\x0e\x31\xf4\x33\xb4\x76\xce\xe3\x20\x38\x67\x4f\xb6\x48\xa3\x83\xb4\x09\x22\xff\x24\xb4\x68\x17\xb6\xb5\x04\x15\x5a\x74\xd2\x40\x86\x59\x20\xfd\x96\x7e\xba\xe4\xc9\xa4\x93\x19\xa4\x4f\x83\x17\x5d\xa2\x26\x13\x6b\xd8\xe6\x39\x6a\x40\x23\xe7\xf5\xaa\x2a\x7e\x62\x87\x85\xb8\x5b\xaf\xbf\x76\x82\x38\xf2\x5b\x57\x1e\x4e\xde\x1b\xd2\xb8\xfb\x90\x41\x2d\xb1\x45\x16\x38\x04\x63\x31\x47\x93\xa6\x4f\xf8\xd3\xcf\x1b\x8a\x06\x4c\x7c\xc1\x0a\xa3\x12\x67\x1c\x31\xac\x83\x44\xf3\x62\x41\xa4\x09\xd3\xe0\x17\xd4\x0d\x58\x21\xcd\x3c\x61\x84\x35\xee\x08\xe4\x1b\x43\x9b\xa2\xc7\xec\xc9\x4c\x45\x78\x0b\x2f\x60\x7a\x66\xe2\xe6\xc1\x83\x60\xf7\x20\xc0\xff\xec\x10\xc1\x24\xd6\x1d\x1b\x0e\x78\xe4\x0f\xc4\x09\x79\x54\xf7\x35\xa5\x1d\x74\x49\x85\x6d\xd1\x70\x9c\x60\xb7\x39\x25\x27\x58\x25\x4a\x2a\x16\x5e\x42\x1b\x1b\xc1\xc9\xdf\xc6\x7e\x6f\x77\xa6\xf1\x9b\xc7\xed\xe9\xeb\x17\x99\xff\x60\x95\x41\x72\x67\xbb\xa3\xce\xdf\x9c\xda\xe4\x01\x87\xb3\x9b\xbb\x67\x48\xe1\x1e\xbe\x6c\xff\x07\x3b\x9d\x11\x75\xd1\x76\x1f\xf1\x93\x63\x3f\xba\x07\x82\x68\x8e\x7c\x17\xe2\xf6\x07\x69\x30\x0c\x36\x6d\x7d\x1f\x97\xfc\xc0\x93\x7c\x3a\xc1\x0e\x21\x42\x94\xa9\x43\x30\xb0\xd6\x5f\xe4\x7c\x8f\x4f\xa7\x89\xf7\x64\x66\x87\xd3\x03\x14\x41\x7e\x3a\x39\x07\xf4\x95\xac\x16\x01\xd0\x2e\x90\x73\x20\xda\xe6\xfd\xc7\xc4\x1d\xd5\x7c\xf3\x9e\x27\x05\x42\xcf\xe0\x02\xba\xf1\xba\xfa\x15\xa8\xa5\x67\x9d\x2f\x22\x9e\xc9\xc4\x15\xae\x25\x8c\x77\xd5\x84\x55\xae\xaa\x06\xa1\x40\xd7\x70\x6f\xd2\xd4\xa3\x55\x26\xde\x12\x53\x6e\xb2\x2c\xe5\x5c\x03\x4e\x9d\x83\x2e\xb7\x8f\x0c\xd4\x4a\xab\xa3\x32\x09\x44\xf4\x71\xfb\x24\xf7\x1f\xc6\x56\xc5\x8f\x05\x45\xef\x7a\x5d\x7f\x55\x13\xf2\x7a\xde\x94\xba\x95\xd2\xf8\x5d\xea\xa6\xfb\x2e\xd5\x2e\x27\x9f\x49\xe0\x6b\xff\xcc\x07\x23\x48\xd9\xe3\xea\x5a\xe9\xe6\x0e\xff\x78\xd3\xd6\x69\xec\xea\xe2\x1f\x77\xa6\xc5\x1b\x76\x4b\xa2\x6f\x85\xc2\x38\xc5\x8d\x09\x51\x26\x17\x70\x7f\x04\x14\xe7\x8a\x52\x36\xcf\xb0\x46\xbe\xaa\x6f\x7e\xff\xdc\xee\x24\x6c\xd2\x65\xe9\x41\x3e\xe2\xcc\xf3\x14\x11\x68\x00\x09\xe2\x20\x8e\x51\x52\x7c\xf3\xce\x64\x16\x93\xd6\x61\xbe\x6b\x94\x64\xfb\x7c\x73\x12\x80\x5d\xc3\x67\x38\x22\xcc\xb4\x67\x22\xbe\xf7\xe7\xfe\x8d\xf4\x7d\xb6\x2b\x5e\xe0\xfc\x07\x0c\x6d\x30\x1e\x58\x33\x3a\xfd\x53\xc7\x4f\x2e\xcc\x6a\x13\xf5\x40\xdf\xbf\x5f\x9e\xe9\xe3\x92\xb8\x98\x1d\xfb\x83\xa8\x27\xfa\xfe\xfd\x72\x6c\x0c\x04\x32\x38\x3f\xad\x47\x85\x6e\x66\xda\x59\x14\x0d\x4c\xe1\x23\x5a\xdd\x5a\xaf\x91\x32\x8c\xbb\x6d\x90\xf6\x3a\xef\xe3\x84\x00\xa6\xf9\xf5\x28\xd0\x3c\x06\x01\x8b\xfb\xea\x5f\x75\x3c\xe9\xa2\xb9\xe3\x58\xbf\x5f\x8e\x17\x45\xf3\x8b\xa7\x1d\x87\x1f\x8d\x7a\xfa\x56\x6c\x4f\xac\xe6\x83\x3c\xa6\x67\x47\xe0\xf6\x87\x09\x03\x6b\x22\xf9\x61\xaf\xd0\x48\xa5\x2f\x8b\xca\x1c\x5c\x26\x02\x38\x4b\xaa\x84\x7b\x22\x49\x4f\xc0\x8a\x83\x04\x0f\x03\x2c\xda\x9a\x06\x6a\xac\x0f\x3e\x1f\x09\x71\x18\xba\xd9\xd6\x21\x68\xeb\x9a\x78\x3e\x9a\xe0\x64\xf2\x9e\xd1\x04\xc9\xc6\xa0\x33\x8b\xf7\xb5\xe6\x04\x60\x0b\x09\xb4\x61\x42\x9b\x22\xa4\xc8\xa9\x0b\x2c\xe2\x97\x26\xef\x02\x3b\x0a\x2c\x32\x87\x9c\xcd\x79\xc4\x27\xe1\x5f\x98\xe2\x5e\xdb\x78\x36\xde\xe9\x81\x6d\xda\xbb\xcb\xe9\xeb\xa6\x67\x5b\x65\x47\xe0\xbf\xf4\xe3\x7b\xd3\xba\x6e\x34\xcc\x77\x1c\xf5\x44\xc0\xa6\xde\xc8\x4a\x67\xf0\xe3\x8e\x5d\xe2\xd0\x4b\x00\xdb\x0b\xf5\x60\x5b\xb7\x40\x85\x7a\xa0\xdd\x57\x49\xca\xdb\x3e\x0e\xe7\xe0\x6f\xb7\xc9\xe4\xee\x6e\x74\x36\xd8\x43\x87\xcb\xf0\xcb\x47\x51\x57\x37\x70\x35\x45\x2e\x91\xc1\xf5\x54\x91\xb0\xff\xd1\x14\x59\x50\xde\x36\xc3\x2e\xe9\x1d\xa6\x6f\x07\xba\xf1\x70\xdd\xf9\xda\x44\x7f\xe9\xe3\x21\x0c\xc8\x3f\x70\x5d\x3e\x53\x33\x1b\x17\x27\x89\x91\x42\x7d\x0e\x22\xc3\xac\x9f\x69\x53\x09\xca\x75\x37\xf5\x78\xca\x83\x24\x32\xe0\x31\xb5\x84\x90\x8e\x46\x7e\x42\x84\x7f\x7b\x05\x4a\xef\x96\x4b\xb8\x97\x65\x7d\x30\xcb\x6e\xeb\xc2\xa7\xf0\x9c\x6f\x62\xae\xe4\x33\xb8\xbe\x7a\xf1\xfb\x17\x1f\x7f\xf4\xf2\xc5\xef\x7d\x92\x30\x76\x76\x7f\xd9\x55\xba\xd8\x48\xf6\x23\x49\x36\xe2\x41\xa2\x78\x79\x43\x96\x50\xf6\xd9\xbc\x11\xd5\x4a\x1a\x25\x48\x60\xb9\xec\x62\xca\xfa\x31\x54\xbb\x39\xc6\xec\x9f\xfe\xe7\xfb\x1d\xe6\x33\xf0\xf4\xfa\x5c\x8c\x6b\xfa\xff\xdd\xc9\x9d\xec\x7c\xc1\x55\xef\xff\x32\x2f\x6b\xd5\x46\x3c\xf6\xbf\xb4\xb1\xa6\x46\xdd\x98\x85\x93\xc9\x9b\x37\xf6\x76\x11\x6d\xc5\xd9\x57\x06\xd5\xd1\x9e\x4c\x47\xfd\xa9\x6d\xff\xdf\x15\x38\xbf\x02\xd6\x13\x46\x2c\x16\x99\x0d\x0d\x56\x68\xb8\x97\xe4\x7c\xfb\x79\xbc\x40\xb4\x30\xce\xce\x14\xa6\x66\x65\xc6\xe4\x79\xf6\x3c\xf5\xbe\xc5\x23\x03\xce\x59\x35\x85\xe7\x19\x6c\x77\x6a\xed\x1b\x26\x12\xc7\x50\xeb\x62\xa9\x4f\x99\xb7\x1b\xed\xc7\xf7\xcb\xbe\x4a\x93\x2b\xaa\x73\x0b\xef\xc2\x31\xf8\x78\xeb\x1d\x1b\xdd\x43\xb6\xa2\x91\x15\xb2\x24\x8b\x02\x0a\x4a\x4d\x17\x9f\x83\x09\x84\xa9\xc5\x83\x34\xf5\xb0\x85\x49\x13\x83\x8c\x1e\x45\xfa\xd9\xcc\x5e\x97\x82\xfb\x86\x2d\xa4\x1b\xfa\xbf\xca\x72\x2b\x9b\xb1\xeb\x2b\x83\x36\xd5\x14\xbb\x80\xe3\x4f\xab\xe9\x71\x53\xd4\x7d\xc9\xa8\x7a\x2f\x6a\x1e\x64\xd3\x88\x9c\xb5\xe9\x38\x19\xcd\x66\x08\x31\xd4\x95\xd8\x92\x0b\xf3\x2c\x18\x86\x67\x12\xbb\xdc\x50\x1b\x58\x8e\x05\xd0\xd3\xca\xbd\xf1\x34\x4f\x61\xcc\x7f\x64\xc0\x0f\x0e\xa9\xd9\x8e\x93\x09\x80\x89\x97\xcf\x11\xd2\x58\x61\x61\x2d\xc8\x92\xad\x68\x36\xb3\xab\xec\xea\xe5\x6c\x66\x3a\x9f\x5d\xbd\xcc\x3e\x99\xcd\xec\x08\x66\x44\xc7\xc9\xc8\x45\x09\x8d\x15\x39\x0c\x82\x44\x81\x5e\x9f\xfa\x2c\xc9\xc0\xce\xaf\x9d\xf0\x63\x7c\xea\x43\x9b\x05\xb6\x71\x7d\x4c\x34\x9d\x81\x7f\x46\xa4\xa9\x6a\x28\x38\x76\xe8\x76\x9a\x64\xdb\xf4\xf6\xfd\x00\x9e\xcf\xfe\x35\xf4\xcf\x6c\x26\x9c\xd7\x7b\x40\x40\x6a\x63\x25\x9a\x5b\xc0\xd3\x6b\xe1\x68\xcc\x2d\x46\x93\xbe\x37\x0e\x1f\xb1\x6e\xcd\xaf\xb5\x6e\xbf\x64\x89\xd1\x4e\xb5\x79\xdf\x25\xfe\x4f\xa1\x86\xc7\x13\x4e\xf3\x7e\x84\xf3\x7e\x34\xe6\xd8\x42\x28\xfb\xdb\xe2\xc8\xca\xd8\x72\x89\x42\x59\xf3\x08\xfc\xd9\x46\x23\x08\x55\xbd\x8e\x57\x5e\xa8\xe9\xe5\xd6\xc4\x5b\xb0\xd9\x77\x98\x52\x02\xa6\x86\xf2\x89\xad\xf7\xf0\x9d\x76\xbb\x91\xb2\xf0\x36\xf0\x66\x43\xe7\x4d\x33\x93\x88\x46\xc3\x5e\xee\x3e\x08\x7f\xc3\x04\xae\xde\x70\x04\xd7\x9c\x10\x73\x41\xf5\x5c\xed\xee\x95\x6e\xc6\xd7\x69\x37\x0e\xa7\x53\xd5\x82\xc9\x7d\x3d\x66\x74\x93\xd9\xc6\xbc\xae\xe6\x42\x8f\x43\x90\x19\x24\x19\x24\xbc\x4a\x69\x12\x99\xb7\x16\x4b\xf8\xc0\x25\xe1\x99\xc2\x55\x34\x37\x67\xc6\x4f\xad\xf9\x56\x67\xaa\x73\x40\x6d\x6b\xe1\xdf\x5e\x6c\x1d\xb8\xcf\xce\x41\x1b\x9f\xe0\x46\x30\xc0\x8e\x5a\xca\xf6\xd6\x28\x9a\x5a\x5f\x20\xcb\x7e\x0d\x2d\xea\x08\x2e\xd2\xd0\xf6\x51\xa0\x55\xd5\xb6\x3a\xbc\xaa\xd6\x32\x4c\xb5\x58\xb4\xa1\xb8\x2b\x79\x70\x13\x01\x2f\x12\x71\xbf\x54\x42\xb2\x0e\xd3\x68\x7a\xbe\xa2\xc1\x54\x3a\x8a\x35\xc2\xc3\xd2\xc3\x85\x2a\xe1\x49\xac\xd1\x20\x39\x34\xb8\x0a\xb8\x0a\xaf\x74\x63\x06\xe5\x5f\x1e\x29\x8f\x4c\x62\x76\x2b\xb9\x12\x3f\xac\x28\x71\x7b\x90\xb0\x1d\x6b\xd9\x8f\xb8\x9a\x79\xd2\x1d\x98\xc2\x2f\xd6\x36\xcb\x84\x9a\xc5\x50\x3a\x18\x49\x67\xe3\x5b\x1e\x67\xf0\x92\x5c\xca\xd5\xee\x9e\x59\xbb\xdc\xd4\x7b\x14\x0b\x13\x5e\xf6\xc4\xea\x1f\xdc\xd0\x43\x39\x2c\x28\xee\xcd\xce\x14\x3e\x9c\xb4\x12\xcf\x60\xe8\x20\xd3\xd2\xa3\xe5\xcd\x7b\xc4\x0e\xf2\x31\x94\x25\x31\x3a\xbc\x22\xf6\xf7\xb9\x1d\x79\x01\x82\x42\x86\x1a\xce\xfc\x51\x5c\xd5\xf0\x1e\x07\x01\x7e\x6e\xc3\x74\xe3\xfe\x37\x53\xc5\x35\x7e\xde\xcb\x00\xfc\xa6\x30\xcc\x03\x6e\xc1\xc7\x5b\x1f\x9d\xf1\xc6\x87\x77\x49\x5f\xb4\xe2\x60\xc7\xfb\x96\x4c\x0c\xe4\xc2\x6d\x3f\x80\xa7\x81\xbd\xef\xef\x7b\x07\xc2\x1d\x39\x17\xd3\xc0\xf0\xb3\x8f\x59\xdb\xfe\x66\xac\x9f\x32\xd7\xa5\x46\x2e\x76\xd5\x42\x54\x1a\xee\x9f\xcd\x6d\x3b\xb0\xee\x00\x82\x82\x4e\xe8\xb5\x54\xf2\x66\x00\xd6\x09\x76\x63\x29\x2c\xe4\x4f\xef\x01\xc0\xf1\xad\x56\x98\xe8\x4f\x32\xeb\x76\xde\x25\x6c\xcb\xd9\xb4\xc8\xcd\x56\x1f\xdd\x8a\x77\xe9\x9e\x13\x69\xb7\xce\x8d\x8e\x01\x90\x70\x78\x96\x80\x12\xaa\x36\x6c\xf6\x62\xe0\xf0\x04\x7e\xfe\x99\x35\xae\x53\x48\xbe\x34\xcd\x8c\xc6\xd3\x2f\xd8\x3e\xac\xa6\x04\xc8\xf8\xff\xb4\xe7\xce\xf8\xe7\x77\xd6\xe6\xb9\xb5\x01\x7a\x67\x7a\x4c\xe1\xdd\x3b\x32\xe7\x31\x5e\x3e\xfc\xfc\xc4\x99\xb4\x48\x7d\x70\x84\x42\x2b\xd8\x55\xe8\xb0\xa3\x24\x7d\xc8\x38\xe7\x75\x51\xe9\xdc\xc3\x98\x9b\xb8\xe4\x0c\x65\x2d\xcc\x6a\xde\x48\x6d\x0f\x01\x73\xa6\x35\xac\x94\xc9\x69\x02\x1c\x0c\x89\x33\xaf\x62\x33\x7a\xe9\x50\xb0\x15\x55\x31\xa7\x97\xba\x1b\x16\xba\x79\x83\xb3\x71\xec\xba\x56\xe4\xbf\xd4\x48\x34\x96\x51\xd8\x4c\x28\x48\x0c\x5c\x6b\xef\x93\x53\x4a\x01\x51\x2d\x60\x5b\x8a\xa2\xe2\xde\xb8\x3d\x3f\x03\x64\x23\x9b\xc2\x3b\x21\x25\x0b\x8e\x8f\xfe\x58\xc0\x9c\x75\x8c\x49\x0e\x7f\xac\xf5\x1a\xd6\x35\x45\xfc\x91\xb0\xc4\x38\x9a\x94\x40\x43\x29\xb1\x92\x0c\xbe\xcd\x2d\x1a\xce\xcc\xa6\x7d\x35\xf9\x5c\x47\xb3\x59\xe3\xa9\xa3\xe2\x53\x02\xb1\xdb\xc3\x72\x2d\x29\x3d\xcf\x02\x9d\x7f\xe6\xa6\x9b\x27\x79\xce\x2b\x63\x08\xca\x94\x87\x74\x35\x0a\x42\xeb\xb2\x37\xa4\x8d\x31\xf4\xf3\x65\x14\xf6\x1e\x04\xf6\x2e\x8b\xba\xf0\xf5\x71\x7e\x4f\x9d\xf2\x33\x1d\x46\x3d\xbc\x6b\x5d\x48\xcd\xf4\x66\x0b\xa9\xe6\x6d\x16\x41\xd6\xce\x58\x9b\x2f\xcb\x0b\xdb\x98\x4b\xc3\xad\x1c\x2f\xf1\xf0\xea\xed\x4a\xe5\xcf\x82\x7f\x38\x37\x8d\xa9\x5d\x80\xd9\xcc\x9a\x75\x79\x45\x66\x5e\x01\xe2\xa8\x5c\xd0\x66\x7e\xf7\x2e\x6d\x27\x15\xa6\x75\xe8\x0b\x0b\x6f\x1d\xe1\xca\xa5\xef\xd7\x8c\xbf\x73\x5c\xea\x36\x96\xb7\x2b\x32\x7f\xd4\x8d\x91\xb3\x3c\x89\x49\x96\x4b\x5f\x0d\x1a\x07\x61\x36\xe4\x3d\xf5\x53\x5a\xdb\xb6\x32\x57\x41\xbb\xe1\x36\x94\xba\x22\xf2\x61\x8e\xeb\x8f\x5b\x4d\x67\x3a\x3c\x1c\x9f\x78\xa2\x51\xf5\x0e\x65\xa8\xfe\x99\x11\xf9\xcd\xfa\x06\xd6\x13\x82\xa5\xeb\x9e\xd1\x8d\xcd\x3a\x0e\x2c\x32\xba\x51\x71\xcd\xf7\xce\xb1\x45\xd2\x68\xc8\x52\x5e\xb5\x96\x09\x31\xa7\x19\x27\x1e\x17\x4d\xd2\xb6\xe1\x0f\x2d\x6f\xec\x6f\xd7\x32\x4f\x7c\x09\x23\xc1\x24\x94\x7b\x4d\x41\x6f\xaa\xb8\x0c\x36\xee\x79\x0d\x9f\x2f\xe5\xb1\x5f\x69\x63\xae\xf7\xe6\xbb\xc9\x0f\xc5\x96\x7d\x06\x7c\x4e\x39\xe9\xbd\xac\x38\xa7\xde\xb8\x62\xc3\x1c\x23\x67\x1b\xf0\xad\x85\x98\xb1\x19\xeb\xb1\x12\xb3\xb3\x3c\x2b\xe3\x7a\x49\x95\xea\x3d\x59\x23\xaa\x62\x21\x5b\xc6\x9d\xe1\xf9\xb9\x2c\xe4\x22\x07\x55\x53\x8c\x65\x6e\x5b\xb7\x55\x38\x3d\xca\xb8\x14\x4a\xf3\xe5\x8d\x4c\x64\xfc\x7d\xcd\x1b\xbf\x9b\x95\xd3\x55\x6b\x51\xb8\x11\x5b\xae\x6e\x43\xfe\xee\xa3\x44\x30\xfb\x34\x6c\x17\xe5\xa4\xe9\xf9\x34\x84\x92\xa0\xee\x70\xf6\xce\x9e\x5a\xbf\x30\x81\xe7\x64\x02\x3d\xe3\xe4\x34\x9e\xb8\xc6\x26\x83\xe7\x64\x72\x3a\x71\x26\x27\xf1\xf4\x6b\xf5\x40\xa5\x54\x9e\x21\x5a\x4e\xa7\xf3\xf4\x6b\xf6\x65\xf4\x8c\xbe\x9f\x48\xea\x79\x7b\xa2\xee\xf9\xc4\x9e\x9d\xd6\x32\x9e\x6d\x80\x3c\xde\x20\x94\xd5\xb3\xaf\xdf\x8b\x73\x78\x0e\x0c\xf9\xe2\x34\x9e\xf1\xa0\xab\x45\x5c\xe4\x92\x79\x06\x1f\xe2\x8a\xdd\xd5\x37\xed\xba\x1f\x6c\x5a\x4f\x4c\xe9\xd9\xf9\xe8\xb2\x7a\xe2\x1f\xdd\xcf\x7e\x52\x4f\x3f\xa1\xe7\xe0\x50\xce\xa5\xf5\x0c\x2a\x07\xb9\x75\xdc\x87\x6e\xae\xdf\xc1\x8b\xeb\xb7\x62\x9b\x01\xf2\x04\x36\x51\xc5\xcd\xd1\x66\x00\x7b\xbc\xa1\x6a\x87\x05\x44\x37\xda\xe1\x2b\x28\xda\xed\x3c\xca\x3c\x31\xbe\xca\xb5\xd9\x47\x2f\x35\x4b\x54\xbc\x97\x84\x02\xe4\x89\x84\x62\xda\xb1\xa5\x98\x93\xa8\x4f\xaf\x9d\x8a\x36\xec\x41\xc2\x5c\x54\xbe\x51\xb9\x69\xec\xa2\xb5\x2a\x6c\xba\x11\x5b\x95\x8f\x7a\x09\x97\xa5\x2e\x44\xa3\x68\x34\xae\x03\xe2\x6a\x56\x68\xd9\xcc\xc8\xc3\x6f\x56\x15\xe5\x94\x6a\xb8\x72\xbc\xdc\x60\xf1\xec\x41\x1e\xf1\xd3\xc8\xa7\x03\x2b\x9e\xfb\x79\x9b\xda\xaf\x49\x36\x1a\x5d\x1a\x28\xd3\xb1\x31\x98\x82\xc6\x4d\x10\x7b\x20\x22\x76\xa2\x3c\x07\xbc\xa3\xe5\x31\x21\x50\x09\xb5\x16\x1a\x68\x72\xe4\x85\x68\xcc\xb0\x47\x03\x3c\xce\xe7\x7e\x3a\x64\x6d\x3d\x1e\xfb\xd0\x48\x5a\x14\x36\x8a\xa9\x6a\xba\x7d\xad\x68\xb1\x4a\x59\xe5\x27\xd3\x4e\xe2\xc8\x21\xc8\xc5\x0b\xc0\xd3\x84\x29\xb6\x86\xa7\xce\xb6\xcd\x7e\x3b\x99\x3e\x39\x8c\x17\xeb\x73\xda\x7d\x1c\x78\xa7\x37\x84\x3f\xda\x33\x35\x37\xf0\x00\x85\x4b\xd1\x39\x0a\x16\xe2\x41\xd1\x3a\xb0\x26\xc3\xcb\x37\x3d\x7e\x68\x2d\xb3\xfc\x9a\xce\x18\x2a\xf5\x9e\x14\xe0\x1f\x83\xc8\x36\x8c\x56\x0d\x3b\xab\x3e\x16\x69\x11\x4a\xbc\x0e\x7a\x70\x32\xea\x5b\xa9\x7e\xbc\xe4\xb0\xa7\x5f\x45\x99\x8f\xfe\x2b\x27\x71\x26\x2d\x76\x67\xb1\xa3\xed\x63\xf6\x86\xac\x16\xc4\x54\xec\x46\xf2\x76\x89\x17\xdd\x75\x74\x3e\x7e\xef\x64\x02\x5f\x57\x4a\x4b\x41\xf0\x4c\x65\x2f\x4b\xf1\x4e\x49\x40\xfb\x93\xb2\x1c\x3f\x59\x49\xfd\xc4\xc4\x42\x25\xc5\xc6\xa1\x66\x6e\xb5\x70\x5e\x16\xde\x78\x6d\x6c\x68\x0a\x92\x35\xa1\x7a\xb6\xda\x6e\x5b\x57\xb4\x2c\xdb\x46\x2a\x59\xe9\x36\x8d\xa7\x1b\x6d\x5d\x95\x47\x90\x7b\xd9\x98\x36\x0a\xea\xca\x64\x9a\xc2\x37\x9d\x91\xb7\x99\x4d\xa4\xe3\x1b\xb8\xbb\x7a\x03\x6b\xad\xb7\x37\xcf\x9e\x95\x3b\x31\xd9\x29\xd9\xa8\xbc\x6e\x56\xcf\xca\x42\x69\x45\x65\xe5\xb3\xeb\xe7\xcf\x7f\x3f\x79\xfe\xfb\x67\x1b\xb5\x7a\xfe\xfc\xea\xe3\xeb\x7c\xad\x37\x65\x9c\xce\xc1\x19\xe6\x0d\x71\xa9\xf4\xd7\x60\x45\xf6\x18\x71\xdb\xfe\x34\xeb\x61\x23\xcb\x62\x09\xad\x10\x70\x10\xaa\x7a\xa2\x41\x49\x0d\x63\x99\xaf\x72\x7b\x85\x79\x40\xbe\x9d\x66\xbd\x74\x8c\x95\x0b\x0d\xaa\xde\xc8\x75\x7d\x88\xb3\x6e\xb9\x31\xb9\x4e\x06\x3c\xcb\x63\x94\x85\x7b\xed\x62\x26\x14\x41\x39\xc9\x8a\x02\x98\xc6\x1a\xa9\xdd\x5a\xfe\x13\x68\x09\xd3\x81\x8d\x16\xa1\xa4\xb5\x16\x1a\xb2\x48\x36\x49\x86\xc2\x1d\x65\xc7\x16\x6c\xaa\xb4\x4b\x3e\xae\x1e\x92\x0e\x5d\x95\x3c\xff\xb4\x13\x87\xa5\xf7\x28\x45\xc2\x54\x9e\x6b\x2b\x36\x5a\xff\x7c\x94\xa7\xb0\xd8\x0a\x8c\xb6\x3c\xf9\x39\x09\x80\x34\x2d\x96\x7a\xd1\xf8\xf7\x5d\xed\xde\xbe\x18\x7d\x38\x1c\x0c\x49\x44\x89\x7d\x22\xf8\x88\x57\xeb\xb2\x19\xa1\xd2\x40\x7a\x92\x3c\x19\x78\x0e\x7e\x38\xd7\x55\x30\xc3\x13\x3d\x3d\x0c\xf7\xe4\xc5\x67\x28\xf0\x2e\xea\xae\x4c\x4d\x68\x64\x4c\x1a\x29\x12\x51\xff\xbe\xab\x83\x1c\x26\x79\xce\x45\x90\x18\xfb\xe0\x7d\x58\x63\x8f\xef\x39\x7b\x53\x25\x83\x64\x88\x6e\x08\x7a\xf2\x2e\xe9\x12\x0f\x2f\x77\x1f\xdd\x90\xe4\x0d\x8b\x5a\x2a\x58\xb5\x71\xcd\xee\xc9\x86\x1e\x3e\x00\x72\x69\xa1\x36\xbf\x4d\xfd\x44\xf4\xee\x5c\x88\xa8\xcb\xed\x65\x43\x58\x34\x0c\xa3\x9a\x44\xa4\xf4\x8d\x22\x10\xd3\x6c\x3d\x86\xfb\xdb\x24\x1e\x29\x32\x1a\x05\x82\x28\xfb\x50\x37\x0f\x50\x54\x20\xcc\x7d\xd5\xc3\x3d\xa2\x9e\x53\xfc\x79\x92\xcb\xd7\x1a\x27\x53\x37\xd0\x3e\x37\x13\x34\x9c\x2a\xdd\x56\x88\x28\x44\xc5\xf6\x7b\xf4\xc3\x9e\x04\xfc\x50\xfd\x56\x7b\x9f\x84\x52\xf5\xbc\x10\x5a\x2e\xf8\x8c\x68\xfb\xa9\x1b\xab\x00\x91\xd5\x02\x0a\x6d\x50\x18\x32\x28\x37\x06\xa5\x85\x96\xa5\x54\x8a\x64\xe6\x38\xd0\x7d\x5f\x70\x0f\xb1\x8d\x1a\x39\x4d\x08\x0a\xdc\x78\x3a\xf8\xda\x90\x87\x9e\xdc\x3a\x28\xf2\x3e\x44\xb9\x69\xed\xb9\x32\x20\xbf\xf7\x25\x28\x08\x4f\x9b\xc1\xa4\x04\x2d\xe4\x53\x77\x87\xc1\xc3\x0a\xf1\x3e\x36\xfc\xa4\x55\xf5\xf7\x08\x3d\xe1\xe8\xbe\x76\x6f\x16\xc7\x7a\xc7\x19\x4e\xf1\x86\x45\x6b\x05\x4a\x96\x6c\x11\x0e\x65\xbd\x2a\xe6\xe1\xad\x49\xbe\xd5\x03\x40\x0f\x12\x2a\x29\x17\xa0\x49\x71\x53\xef\x2a\xed\x9e\x5b\x96\x82\xdc\x14\x84\x36\x09\x11\x10\x65\x73\x51\xc1\xbd\x34\x37\xad\x3c\x80\x24\x52\x58\xd7\x07\x04\x44\x38\x09\xbe\xdd\xbb\x6f\xe6\xda\x40\x1d\xf4\x9f\x2d\x3c\x1d\xa4\xc7\x07\x29\xb7\xb0\xaa\x31\x19\xa6\x0f\x6c\xee\x80\xb1\xaf\x44\x38\x8c\x85\xfb\x6a\x3c\xab\x70\xa4\xc6\x7e\x00\xa7\xa0\x00\x75\x8e\x50\x54\x9c\x77\xb3\x20\x69\x84\xf4\x8d\x39\xfc\x61\xae\x77\x98\x91\x2d\x22\x0a\x17\x79\x70\x2d\xaa\x85\x61\x26\xcb\xa6\xae\x58\x80\x5c\xc9\x0a\xb7\x83\x5c\xc0\xbc\x5e\x48\x52\x86\x1d\xa4\x89\x42\x88\xa8\xed\x00\xab\x71\x9b\x37\x47\x10\xf7\xf5\xce\x20\x78\x2d\x1b\x99\x07\xb4\x0b\x52\x34\xe5\x11\xb4\x6c\x36\x45\x45\x9b\x0d\x07\x20\x50\x1c\xbc\x2f\xe5\x86\x44\x98\x83\x64\x73\x4e\xbb\x38\x45\x05\x48\x17\x1b\xe1\x27\x5c\xe7\x57\x3d\x7e\xd5\xfa\x66\xc7\x8c\x05\xd9\x88\xb1\xad\x35\xf0\x65\x0e\xaf\x70\xdc\x4f\xca\x12\x36\x85\xe2\xa7\x3d\x04\x1a\x22\xf7\x55\x0d\x5f\x23\x36\xaa\x07\x9f\x70\x30\xcd\x86\xa4\xae\x39\x26\x1f\x73\xa9\x7e\x7a\x5b\x52\x06\xa9\x83\xf1\xa3\x40\x06\x2d\x8c\x43\x9c\xe3\x2a\xbc\xe4\xbd\x00\xda\xb8\x7e\xff\x2e\x8f\x7d\xc5\xff\xe1\x67\x33\x34\xf5\x32\xfb\x05\xa6\xd1\xce\xf3\xd8\x52\xc0\x8b\x0a\x0a\x7a\x40\x74\x42\x31\xfe\xe2\x56\x19\x14\x2a\xe2\x35\xc4\x84\x5c\x87\x89\xf9\xab\x27\x60\x91\x19\x8b\xa9\xf3\x1f\xa2\x4c\x7a\x79\xa3\xc7\x29\xfc\x29\x9c\x10\x1d\xff\x62\x6f\x0f\x50\xc4\xa7\x42\xd6\x1e\x08\x19\xef\x4e\x17\x14\x12\xc6\xad\xd8\x86\xa4\x22\xcb\x52\x51\x67\x6e\x23\xa7\x79\x74\x26\x07\x8c\x3a\x03\x9d\x59\xc9\xcf\x3b\x1a\x91\x7d\x47\x97\x25\xef\xe1\xcb\x13\xee\xb0\xe2\x38\xc5\xab\x66\xfd\xe0\xf8\x7d\x9e\xe7\x1c\xeb\xaa\xf3\x58\x86\xa7\x37\xe9\x37\x8c\x64\x1d\x3c\x93\x39\xb0\x58\x8b\x23\x46\xe2\x5f\x9d\x5e\x1f\xd8\x18\x91\xaf\x20\x23\x9f\x15\x76\xef\x69\x6d\x57\x38\xc8\xf8\x8a\xd7\x36\x56\x35\x6f\x19\xa7\x95\x62\x2b\x9e\x5d\xa9\x8b\x6d\x69\x98\x9a\x6a\xab\xeb\xda\xc4\x5e\xfc\xaa\x7e\xa2\x20\x11\x19\xd4\x0f\x70\x83\x51\x40\x51\x54\x7d\x78\x93\xd0\x01\x98\x77\x2c\xf8\x68\x66\x28\xd4\x61\x26\xf6\xe0\x31\xc3\x9f\xa0\x27\xf7\xf4\x5c\xb7\x4e\xde\xb8\x2e\xbb\x74\xf5\xdd\xbb\xb2\x38\x04\xe4\x40\x60\x38\xbc\xe5\xc8\xa3\x7f\x73\x0d\x64\x12\xc4\x36\xa3\xcb\x1d\x44\xf4\x2b\x1f\x18\x80\x59\x9d\xac\x2f\xb7\xdc\x85\xa1\x05\xc3\x4b\x57\xac\x64\x8f\xae\x51\xfd\x2c\x69\xe8\x22\xf5\xe8\xbb\x94\xbf\x8c\x7b\x8a\xe7\x3b\x7c\x54\x46\x2e\x87\xed\x35\xcc\x2e\x45\x27\x9a\xdd\xa9\x84\x24\xdd\x75\xe9\x1f\x55\x54\xc9\xed\x8f\x69\xe2\xfe\x8c\x03\x74\xf2\x4d\xc2\x13\x66\xb5\x7d\x86\x8d\x42\xe0\xd1\x09\x69\xfa\x31\x29\xbc\x0a\x84\x5b\x64\x90\x00\xec\xa7\x89\xa7\x23\xed\x15\xcc\x2e\xa0\x8b\x01\x3a\x68\x6f\xaa\x59\xa4\x5e\x0c\x8d\x5e\xdd\x0e\x5c\xc8\x52\x6a\xd9\xb3\x09\xb1\x04\xd6\x42\xad\x8d\x08\xce\x15\xdb\x8b\xc7\x50\x50\xca\xbe\x27\xca\xc7\xec\x48\xdd\xff\x56\xd6\x57\xeb\x3f\xc4\xce\xc4\xcb\xee\x7e\xf6\x2e\xd2\x30\xb9\xea\xec\xa7\x7e\xa2\x08\x15\x6a\x66\xc6\xcc\x63\x09\x52\x1a\xf6\xd2\xb3\x60\xa3\x81\x5e\x4e\xed\xc2\x2e\x12\x2f\x54\x43\x9e\x22\xf4\xbe\xe1\x0c\xf0\x0d\x79\xb4\x10\x8c\xbc\x7a\xf4\x55\xc8\x03\xaa\xcb\xe8\x53\x80\x6e\x5f\xfb\x39\x7a\x0f\x2c\x87\xe2\x81\xf9\xcf\xe8\xdd\x68\xe4\xf4\x59\x81\xad\x05\xbf\x80\x66\x60\x74\x06\xfc\x56\xc3\x7f\xb5\x26\x00\xc6\x02\x49\x1f\xfd\x07\xe5\xf1\x25\x4d\x60\x8a\xcd\x6c\x37\xe9\xad\x73\x79\x35\x91\x1b\x21\xd9\x04\xba\xb6\xc4\x0f\xaf\xbe\xb1\xae\x88\xa4\xcf\x24\x11\x91\x98\xde\x35\x7b\x1d\xd3\x13\x73\xb1\x64\xc9\x1d\xb1\x41\x35\xcc\xbd\x95\xd8\x48\xdd\xb4\x0e\xb9\x1c\xd2\xea\xa1\xc2\x2b\x53\xb1\x6c\xe5\x73\xf7\x78\x04\x85\x0e\xdc\xc7\xa2\xfe\xc6\xfb\xc0\xeb\xf5\xc8\x4f\x68\x72\xbc\x4f\x9d\x85\x01\xf1\x84\xf9\x42\x68\x91\x74\x0d\x69\xe7\x06\x7b\xcb\x65\x41\xfe\x0f\xf5\x72\xbc\xf7\xb4\x9f\x73\x6e\x5e\xc4\x89\x5b\x7c\x9f\x62\x63\x91\x18\x32\xa3\xb6\xe1\xc7\x67\xda\x7d\x3c\xd4\xf0\xea\xe5\x99\x96\x57\x2f\x87\x9a\x7e\x74\x7d\xa6\xe9\x47\xd7\x43\x4d\xcf\xce\xf3\xe5\x8b\xde\xa6\x14\x69\xf0\x44\x4b\x0c\x66\x37\xd8\xf0\xe3\x73\x2d\x3f\x1e\x6c\x7a\xf5\xf2\x5c\xdb\xab\x97\x83\x8d\x3f\xba\x3e\xd7\xf8\xa3\xeb\xc1\xc6\x2f\x5f\x9c\x6b\x3c\x80\x2a\x1b\x9f\xef\x44\x6b\x13\xa4\x6f\xb8\xf9\xcb\x17\x67\x9b\xbf\x7c\x61\x3e\x05\x07\xe5\xd0\x70\x79\x0f\x06\x09\x1b\x8d\x7b\x56\xcf\x1e\xdf\x55\xf6\xc2\x4d\xfb\x0a\x47\x76\xc3\x2e\x44\xf6\x20\x98\xeb\x63\x1a\xa4\x35\x75\xc1\x0b\x46\x7e\x20\x0d\xda\x9b\x18\x67\x54\x8a\x2a\xe9\x0d\x03\x60\x43\x92\xde\x76\xdb\x55\xbb\xcd\xbd\x6c\x86\x9b\x19\x2c\x74\x1b\xf6\xe8\x5d\x83\x86\x6c\xd7\x15\x85\x7a\x1d\x44\xd7\x65\x88\x72\x18\x32\xfe\x55\xd0\x46\x60\x50\x65\xd1\x75\x73\x70\xaf\xf7\xde\x95\xac\xad\xe8\xc7\xde\x31\x3e\xd5\x89\xf7\xe2\x3f\x1c\x5e\xe1\x94\x9d\x97\xf5\xc8\x83\xc1\xae\x6c\x5c\xbf\xdf\x9e\x0a\xd8\x94\x53\xdb\xf7\x8d\x02\x46\x01\x68\x30\x0a\xd8\x63\xed\x29\xda\xae\x7f\x71\xfc\xa6\x4b\xe3\xfa\xe1\xe9\xf8\xca\xf4\xe8\xf9\x26\x6f\x33\xe3\xd8\x1d\xb9\xe3\xfb\x39\x3a\x5c\xcb\x28\x8a\x92\x34\x4d\xa7\xd6\x21\x6f\xcc\xbf\xbd\xcd\xc4\x05\x30\xb5\x7f\xd4\x0d\x3c\xc7\xf2\x1e\x27\x7a\xfc\xc6\xb5\x9c\xb3\x8c\x6d\xac\x6b\xde\x3e\x16\xfe\x28\xca\xfd\xe3\x8d\xf1\xc6\xa4\x04\xb6\x4d\xec\xf4\x30\xe4\x7f\xb8\xeb\x3b\x63\x8d\x43\x0e\x74\x29\xa1\xfd\xe4\x8d\xd4\x63\x54\xde\x77\x37\xe4\x00\xab\x66\x7d\x8a\xa5\x1d\x95\x0b\x6b\x60\x7e\x7f\x06\x9f\x3c\x7f\xfe\xfb\xab\x4f\x3e\xb9\xfe\xdd\x8b\xdf\xbf\x78\xfe\xc9\x27\xcc\x79\x61\x32\x81\xeb\xff\xdf\xef\x3e\x3a\x17\xe2\x40\x31\x0a\x4a\x59\x9d\x0d\x71\x70\x3a\xb8\xc2\xa7\x76\x40\x71\xcc\x85\xde\xe1\x5d\x38\xac\xb9\xd8\x9e\x1d\x56\x98\xa6\x89\x36\xdd\x1f\xaa\x23\xf9\xc3\x10\xaf\x72\xc1\x7e\x7a\xc3\x47\x78\x5b\x8a\xfd\xf0\x19\x36\x15\xe7\xb3\x99\x99\x93\x5d\x3d\x3f\x16\xb5\xe1\x03\x46\x5a\x0c\xfc\xd5\x28\x32\x0e\xc6\xfa\x32\x1e\x29\x4b\xaf\x43\x2d\x56\xbe\xbb\x1a\x45\xde\x17\x3d\xee\x6a\x5c\xcf\x4f\xe5\x6e\xaa\xf6\xa7\x6a\xef\x56\x37\x55\x13\x53\x35\x49\x7a\x4c\x83\x97\xbe\x6f\x17\xbf\x98\x2d\x7d\x37\x37\x20\xbf\xcf\x55\x27\xdd\x90\x31\xe9\x0a\xa6\xd7\x07\x32\x0b\x40\x16\x8b\xb8\x50\xac\x6e\xdd\x31\x41\x36\xeb\xa1\xb5\x70\x5b\x16\xc4\x2b\x78\x58\xfd\x20\x90\xfd\x98\x00\x44\x8f\xf2\x92\xe3\x36\x59\x3c\x87\x50\xcc\x66\xee\xed\x8d\xe8\xb1\xde\x6b\x9e\xaf\x1a\x77\x88\x95\x07\x5c\xd5\xb8\x1b\xdf\xc5\x2d\x14\x64\xa2\x6a\xe7\xdc\xd9\xda\x09\x46\x54\xe8\x5c\xd9\x2e\x76\x62\xed\xb8\x91\x0c\x9c\x53\x7d\x3e\x06\x3d\xff\x7a\xe2\xb5\x9c\xaa\x88\x41\xa3\xb0\x62\x9e\xe7\x67\xeb\x9e\x5a\xb2\xe1\xb6\x9c\x46\x03\x9f\x72\x78\x82\xc4\x2e\xbe\x68\xa4\xf0\x72\x07\x5b\x74\xe2\x68\x4e\xcc\x2d\x0a\x69\x13\xe5\x29\xed\x1f\x58\xc7\x1e\xd2\xb9\xf1\xd9\x4e\x1d\x62\x97\x8f\xf5\xe2\x5b\x86\x5e\x7c\x27\xdc\xde\xe2\xdd\x74\x7b\x2e\xfc\xa7\xfc\xfb\x4e\x04\x3a\x76\x91\xc1\x3d\x65\xc2\x4b\x4f\x6e\x0e\x03\x4c\xe0\xa7\xfb\x48\xfa\x6c\x1b\x0d\x26\x4c\x0e\x9a\xf7\xa4\xc7\x52\x87\x28\x02\x63\x0b\x5a\x1d\x18\x72\x18\xb7\xcf\xf7\x4f\xa7\x20\xb8\x1f\xdc\xf7\x5f\x3a\x02\x45\x5e\xbb\x69\x48\xb9\x38\x7d\x9e\x7d\x20\xa2\xe4\x7f\xc5\x12\xa0\xaa\x35\x18\x54\x8d\xc5\x5d\xf1\x26\x83\x7b\xfa\x7f\x17\x78\x6e\xf0\x8d\x76\x58\x6d\xd8\xb1\x52\xf0\xf5\xde\x7e\xd8\xd3\xc3\xa9\x24\x33\xa3\xa1\xc4\x32\x43\x49\x65\xba\xd3\x71\x29\xb0\x71\x56\xde\x0f\x4b\x74\xbf\xea\xdc\x7a\x26\xd5\x1f\x2b\xaf\x4d\x24\x69\x3f\x7f\xad\xbe\xe4\x11\x67\x70\x9f\xde\x46\x92\x57\x3f\x3d\xb5\x01\x99\x43\x18\x31\xbd\x13\xa9\x87\x99\xc3\xba\x8d\x7e\x0b\xa2\xcd\x1b\x45\xba\x27\x91\x25\x22\xb9\xb0\xed\x3d\xb7\x75\x96\x27\x98\xc0\x23\x4b\xee\x93\xd4\x05\x6e\x36\x7b\xac\x6e\xe0\x7e\xd8\x4d\xa6\xae\x24\xd5\xa8\x49\xa2\xc5\x4a\x89\xa7\x01\x72\x40\x44\xb5\xe8\x85\xd2\x02\x22\x08\xa2\x91\x01\x88\x70\xc1\x7a\xcf\x2f\x6f\x1c\xdc\x7f\x06\xf6\xef\x5a\xf7\x00\xf2\xb5\xc7\xa1\x42\xd1\x40\xaa\x64\xa1\xd7\xf4\xd0\x46\xb1\xf0\xc8\x3a\x94\xb3\x2e\x9a\xd4\x35\xad\xbb\xad\x87\xfa\xb6\x30\x83\xa4\xfd\x11\x08\xf2\xc2\xbc\x96\x98\x6f\x2c\xca\xde\x47\x85\x67\x51\x1c\x03\x1f\x24\xb6\xde\x29\x55\xfe\x98\xec\xe9\x26\xac\xe2\x4f\x04\xd2\xce\xbd\x2d\xbe\x4f\x03\x4e\x4a\xfd\x24\xf4\x0a\x90\xd0\xca\x62\xd5\x7f\xb4\x45\x5d\xb6\xea\x90\xee\x54\x09\x02\xfe\xd1\x85\x31\xfd\x15\x60\x9c\x1c\xc7\xd0\xe9\x20\x72\xdf\xad\xf1\x1f\x53\xb8\x0f\x0a\xba\x6b\xe2\x7d\x25\x42\x03\x62\x5e\x79\x92\xf6\x8c\x39\xba\xe4\x84\x7d\x5d\x72\x26\xe5\x35\x7d\xa5\xb1\x9b\xbf\x23\x98\x34\x84\x00\xb0\x9f\x28\xf4\xdc\x8d\xa8\xa5\xee\x5d\xe5\x37\x43\xc1\x38\x01\xc8\x73\x88\x60\x93\xc0\x91\xde\x86\xa1\x25\x2d\x66\x5c\x00\x33\x42\x09\xd4\x38\x01\x76\xb3\x22\x26\x60\xfe\xa6\x2b\x7b\x84\x0a\x08\x34\xe5\xee\x40\xe0\x16\x99\x6d\x9a\x85\xcd\x5c\x98\x7b\xd6\xa8\x0b\xa5\x64\x83\x7c\x94\xe6\x06\x85\xe2\xa7\x68\xe3\x24\xed\x19\x01\x15\xa5\x24\x2f\x1d\x9a\xa5\x6b\x05\xf5\xd2\xbc\xd2\xea\x1a\x3f\xa1\x8b\x45\x04\xd2\x63\xd5\x7b\xf3\x40\x4c\x11\x01\x0a\x85\xee\x48\xec\x29\x69\x99\x28\x83\x1a\xdc\xe0\x66\xa6\x5e\x38\x89\x79\x5d\xa1\x83\x3b\x45\xc0\x6c\x4b\x2d\x63\xc3\x55\xf6\x33\x31\x75\xae\xa9\x68\x37\xd3\x0a\x3c\x92\x07\x98\xc6\xdb\x95\x4a\xf9\x30\xed\xb1\xde\x47\x18\x30\xf5\x2b\x79\x9d\xb5\xbc\xd3\x54\x33\x57\xc2\xb2\x3e\xc8\x66\xec\x85\x33\xf1\x02\x52\xf7\x3c\x3b\xd0\xb0\x28\x60\x6b\x12\xa8\x04\x93\x0c\x7e\x9f\x46\x8a\x89\x00\xb1\xdd\x8b\xec\x69\x1c\x26\x79\x8e\x03\xcd\xf3\xc4\x9c\x08\x10\x65\xb2\xba\xb1\xf0\x6d\x62\xcf\x24\xcf\x83\x1e\xbb\xf2\xea\xd9\xe5\xfa\xf9\x1d\x76\xd6\x76\xdd\xb3\x70\xe6\x56\xca\xa4\x15\xdf\x40\x7d\xb2\xe2\x3e\x5f\xef\xb6\xa5\x4c\x83\x7c\xb8\x7e\x54\x88\x9e\xac\xb8\xee\xb3\xc7\xd1\xeb\x07\xff\xf5\x94\xcb\x42\xec\x22\x72\x6f\x4f\xd0\x6e\xdd\xb4\xc5\x11\xd9\x98\x72\xaf\xb6\xf9\xd0\xf6\xea\x0b\x5c\xfe\x04\x90\x26\xc7\x3e\xbd\x7a\x3c\xbc\x6e\x7c\x4a\x34\xfd\xa6\x51\xe8\x71\xe1\x3f\x93\xc1\x5a\xe0\x4a\xc7\xa9\x71\x69\x18\xe3\x0f\x82\x24\xcc\x53\x78\xee\x09\x2b\xb8\x48\xf5\x43\x24\x9d\xc4\xf8\xf1\xda\x73\xfc\x28\x54\x46\x44\xe2\x04\xcf\x90\xe0\xf9\xd3\xec\xdc\xe4\x39\xb1\xe3\xd0\xf6\x68\x07\x86\xf5\xfe\x31\x8d\xde\xfb\x3a\x56\x9e\x5c\xdc\x46\x61\x0f\x1f\x90\x3d\x02\x41\x70\x43\x22\xf4\xbe\x35\x79\xe9\x95\xa3\x03\xd1\x8b\x50\x1a\xad\x8e\xa7\x3b\xf6\xd0\x11\x5b\xe7\xef\x48\x3b\xee\x7c\xc4\x3b\xbc\xc6\x8f\x4b\x43\x37\xfa\xb9\x98\xaf\xb1\x2e\xda\x3f\xe3\x0b\xe7\x4a\x6a\xd8\x6d\x17\x42\x1b\xaf\xba\x36\x48\x82\xa1\x88\x46\x72\x88\x18\x32\x5c\x5c\x14\x8a\x4e\xb4\x42\x03\x1e\x7c\x25\x2c\x8b\xb7\xf8\x69\x5b\x2b\x55\xdc\x97\x94\x06\xc5\xc4\xd4\xd0\xbb\x46\xb6\x86\x57\x34\x43\xba\xcb\x5a\x1b\x55\xb9\xf8\xe3\xf1\x2e\x9a\xc1\x9b\x5b\x9f\xc6\xda\x87\xf0\x62\x49\x25\x7d\x42\x2f\x03\x0e\x0d\x8b\x3c\xec\x7c\xeb\xb2\x50\x4f\x83\xec\xdb\x1e\xa2\xd2\x4e\x4b\xc7\x80\xbe\xed\x4b\x32\x7e\x3b\xea\xb5\xc4\xf1\xfb\x2a\x54\x8f\x61\x5f\x58\xa7\x3f\xd7\x7d\xd4\x6f\x2f\x9c\xb8\x96\x6f\x67\x38\x9b\xf1\x25\xf1\xff\xc8\xa6\xfe\x62\x2d\xe7\x0f\x3d\xb5\x4f\x54\x1e\x1c\xa2\x09\x83\xa0\xfd\x38\x08\x1d\xc8\xb0\xa8\x07\x6d\x38\x8c\x05\x38\x25\x1e\x98\xf6\x99\x62\x99\x1e\xf6\x7e\x0f\xd1\x70\x4e\xe5\xc3\x9c\xe3\xf8\x91\xae\xf7\x1b\x10\x2b\x51\x54\x4a\x83\xde\x64\x70\x58\xcb\x46\x82\xde\x4c\x93\xb4\xdb\x96\xd0\xa9\x37\xe9\x20\x54\x3a\xe3\x4f\xb4\xdd\x77\xdb\xf6\x24\x79\xdd\xbb\xc8\x5c\xb8\xad\xdd\x0f\xe2\xd6\xf8\x91\x12\xdf\xd3\x17\xfc\xcb\x15\xb7\x9c\xc0\xfe\x3d\x10\x10\xd3\x33\x4d\xd5\xf3\xb5\x41\xb2\x45\x02\xe2\xa3\x5a\x20\x8c\xee\x2c\x00\x4c\xe5\x69\x8f\x69\x9e\x97\xe7\xf9\xf6\x51\x29\x7a\x3d\x41\x9a\xa1\xf7\x0d\x3b\x1a\xf3\x87\x55\xad\x3f\x34\xd5\x49\xb2\x6c\x51\xc6\x4f\x3b\xf6\x77\xf0\x98\x1b\x32\x81\x1e\xa2\xb2\xa9\x31\xfd\xa3\xe7\xcf\x75\xd3\x61\x3b\xe0\xad\xcb\xed\xe8\x3c\x0a\x86\xed\x06\xcf\xb3\x38\x98\x42\xfd\xd0\xd5\x52\x3d\xea\xd8\x3c\x37\x9f\xdb\xde\x6b\xb9\xf7\xf0\xd7\x8a\x4b\xf6\x55\x8d\xd6\x01\x37\x10\x1a\x67\x67\x8c\xd1\x04\xff\xeb\x6e\xac\x93\x89\x2f\x43\x41\xa1\xe0\x39\xad\xd5\xdb\x7c\xfc\x3a\xc5\x9d\x56\xcc\xd7\x1c\xe2\x49\x41\x5d\xd1\xc5\xc0\x1e\x01\xbd\x93\x2b\x96\x01\xbc\x9e\x18\x71\x91\x74\x33\xe8\x2d\x51\x58\x2b\xbe\x81\x45\xf1\xa2\xbf\xb0\x27\x7f\xd6\xa7\xbc\x30\xdb\x9a\x66\x80\xb9\xb1\xc5\xfc\x41\xac\xa4\xba\x73\x31\x82\xde\xe4\x88\xb0\x3f\x04\x97\x18\x8c\xed\x32\x46\x15\xfb\x46\xad\x32\xf0\xc2\x0e\x86\xb7\x98\xf4\xf6\xc4\xb5\x31\xbe\x18\x9d\xb8\x0d\x99\x67\xb5\xee\xbd\xfb\x84\x88\x64\xe5\x49\x7b\xe4\xed\x45\x19\x5d\x7a\xcf\xa9\x74\x43\x08\xbd\xf7\x66\x7f\x25\xbb\x77\x8a\x48\x18\x0a\x6f\x01\xf4\xd1\x29\xf4\x94\x16\xf3\x87\x3f\xc9\xad\x5e\x7f\xbf\x5c\x72\x8e\xe0\xe7\xf8\xc4\xb4\x92\xfa\x95\xfb\xe4\xcb\xf8\x9e\x58\x2e\x1b\x2f\x60\xd9\x38\xbd\x35\x0e\xc5\x66\x88\xb2\x69\x72\x82\x3e\xac\xf3\xc6\x78\xe6\xdd\x01\xf6\x0c\xea\x29\x7c\xe0\xc0\xe5\x6a\x5b\x62\x28\xa9\xbf\x55\x49\xea\x45\x2a\x30\x22\x91\xf4\x1d\xe1\x59\x9b\x4b\x87\x1b\x05\x3c\x21\xfb\x79\x05\x42\x83\xb8\x7b\xfe\x86\xe8\x1d\x97\xb1\xf5\xd8\xf5\x9e\xf2\x7e\x32\x87\xa2\xaf\x59\xaa\x10\x3b\x61\x7c\xcd\x0f\x84\x99\x2e\x41\xec\x5e\x4a\xb1\xc9\xe4\xaa\x3b\xc9\x10\x9f\xa6\xa2\xef\x6f\x8d\x44\x06\x9f\x4e\x81\x13\x95\x3b\x8c\x65\xe2\xae\x7a\xe3\x33\x1a\xfc\x1f\x6b\x10\x64\x59\x6c\x9e\xb3\x57\xca\x9a\x5e\xb6\x32\xd0\x35\x09\x9c\x4d\x41\x06\x8a\xfa\xee\xf9\x1b\x8a\xb6\x46\xb8\xc0\x20\xf3\xe4\x8c\x7c\xd4\xc4\x88\x76\x5b\x2b\x4c\x72\x5f\x72\xc1\x8f\xc7\x1c\x87\x4e\x68\x76\x50\x21\x14\x82\xd0\xe8\x97\x9c\xf1\x4e\xa8\x26\x14\xed\xef\x19\xfe\x45\x16\xdb\x93\x09\xb6\xf9\x9a\xad\x3f\x4b\xc9\x9e\x32\x7b\x51\x94\xec\xf0\x70\x20\xb5\xc5\x6e\x63\x92\x56\xd1\xdb\xd0\x64\x42\x95\xe0\xab\x9a\xdf\x8f\x15\xd4\x0d\x77\xaf\x32\xae\xd6\xae\xdd\x73\xaa\xed\xfc\x2c\x6d\x7f\xdf\xa3\xa6\xf0\x50\xa8\xa0\x07\x61\xf2\xef\xb5\x5e\x16\x42\x83\x51\x2a\xe2\x82\xd5\x0d\x4d\x04\xdb\xb3\xa9\x4b\x0d\x4f\xaa\xa2\x7c\x02\xeb\xba\x94\xca\x22\x64\x53\x2c\x16\xa5\xa4\x8e\x3c\x0a\x21\x7c\x1b\x1f\x43\xab\xb7\xd0\x74\x07\x7c\x42\x77\xc0\x27\x3d\x14\xaf\x7d\xd5\x13\x36\x1a\xdc\x1c\xa1\x8e\x8a\xf5\xa7\x38\x63\x87\xc8\xcf\x7d\x63\x50\x98\xc2\x2a\x08\x8a\x62\x47\xb5\xd1\x96\x28\xc9\xd9\x50\x1c\x56\x52\x8f\x37\x9a\x74\xbd\xa5\xac\x92\xb4\x37\x27\x96\x3b\xb8\xb0\x47\x12\x13\x02\x3f\x49\xbb\x4a\xcf\x6c\x84\xfe\xa6\xde\xe0\x42\xf8\xb2\x67\x85\x9b\x43\x77\xbc\x76\xdd\xfb\xa6\x7d\x26\xaa\xa2\x57\xa2\x20\x0b\x36\x92\xf0\x5d\xf1\x26\x1d\xcc\xac\x14\x71\x66\xf4\x46\x13\x15\xae\x7f\x29\x29\xb0\x3e\xec\x2a\x0e\x84\xb1\xf0\xd0\xf5\x13\x4c\x69\x2f\x18\x14\xfd\x34\xb8\x06\xa0\x23\xf8\xf1\x3c\xe2\xc1\xfe\xe4\x31\x8b\xc2\xa6\x84\x62\x27\x2c\x64\xc6\xed\x44\xcd\x95\x89\x06\x52\xf8\x5e\xde\xfd\xb7\x2e\x92\x89\x7a\xaf\xb2\xf1\x08\xf6\x5d\x5c\x15\xd3\xe2\x69\x0f\x07\x6a\x3a\xd6\x0b\xbb\x0a\x0f\xe1\xf7\x26\xe9\x4b\x29\xba\x6d\xca\x1d\x8e\xdb\xad\x64\x52\x34\x30\x76\x66\xb3\x52\xfc\x74\xfc\xb2\x2c\x8b\xad\x2a\x94\x1f\xce\x85\xbc\x99\xfc\x43\x49\xc9\x72\x99\x76\x03\xf0\xb5\x1e\xe2\xe4\x0a\x15\xa6\x34\x42\xe0\x33\x69\xa0\x9b\x39\x7b\xeb\xeb\x04\x88\x36\x76\x4c\xd0\x62\x86\xe2\xbe\xa8\xe6\x32\xc9\x5c\x55\xe3\x75\xd2\x86\x53\x0c\x02\x15\x51\xd6\xaf\x70\x4a\x69\x77\x3d\x26\x13\xa0\x20\x66\xc4\x94\xad\xf7\x22\x79\x4d\x02\xce\x1a\x97\x44\x3d\xdb\x8b\x46\xc1\x42\x2e\x8b\x8a\xb3\x04\x2e\x45\x43\x67\x79\xdd\xc8\x3f\xee\x8a\x52\x17\x95\x1a\xa7\xa3\xf7\x3b\xdf\xed\x46\xfa\x51\xec\x85\x9a\x37\xc5\x56\x3f\xdb\xd4\x3f\x15\x65\x29\x60\x55\xec\xa5\x02\x01\x04\x94\x63\xc9\xc1\xae\x5a\xc8\x06\xf8\x68\xce\x4c\x53\x96\x4d\x6d\x8e\x1a\x0a\x45\xc7\x21\xaf\x8a\x65\xcb\xbf\xf2\x91\x0d\xd4\x51\x16\x15\x7e\x64\xc7\xeb\x8e\x91\x22\x9e\xce\x5a\x1f\x61\xa7\xc8\xcb\xcd\x74\xf8\xcd\x4e\xfc\xdb\xd7\xaf\xf3\x8e\x99\x66\x3c\x5f\x27\xe8\x90\x9c\x19\x4c\x99\xa4\x0f\xf3\xc1\x06\xa8\xa1\xb2\x11\x53\xd8\x9f\xe4\x52\x36\xc6\x73\xc3\x62\x67\x61\xca\x32\xf8\x51\x7d\xd9\x34\xcc\xfa\x7e\x40\x08\xa9\x2f\x1a\xba\x52\xe2\xba\xb6\x91\xcf\x89\x6d\x59\xce\xee\xde\x9f\x4d\xe1\x83\xd9\x6c\xbe\x6b\xbe\xaa\x9b\x7a\xa7\x8b\x4a\xe6\x54\xe3\x15\x63\x3a\xce\x4d\xc6\xbd\xc7\x56\x68\x54\xd8\xc3\xcf\xad\x3b\xe6\xe1\xcb\xa6\xb1\x73\xb4\xbc\x5c\x37\xc7\xa0\x56\xc3\x66\x46\x5b\xf2\xb9\xeb\x08\x29\x3d\xff\x06\x47\x9d\x63\x6e\x1b\x87\xb0\x53\xe9\x1d\xda\x4b\x00\xcd\xa0\x6e\x7e\xd0\xcd\x98\x67\xe8\x87\xe1\x4f\xdf\x05\x23\xad\x1f\x32\x23\x81\x1a\x36\xd2\x48\xd5\x9e\x51\x73\xba\xe7\x8e\x65\xd3\x9c\x53\x94\x3a\xbc\xc8\xa6\xe9\xde\x13\x4f\x4c\xaf\xde\x8e\xbd\x7b\x86\x4f\x31\x1e\x99\x30\x74\x57\x8f\xe9\x34\x5a\xb7\xa8\x0f\xa1\x4a\x29\xb7\x5d\xfe\x19\x3c\x5b\x75\x29\x7d\xda\x2d\x9a\x5c\x51\x9b\x7a\xa7\x65\xf3\x43\x87\xfe\x3b\x7b\x22\xaa\xfc\x1f\x4e\x31\xde\x6e\x91\x5b\x4f\x95\x4f\xff\x1f\xd5\x0c\x26\xd2\x82\xf7\x90\x55\x2c\x3b\x0d\xbb\x14\xdb\xb7\x5d\x23\x9e\xe5\xe3\x3e\xd8\xc2\x11\xf4\xe8\xb1\xcf\xd0\xfb\xfb\x10\xfb\xcd\x8d\xae\xb7\x37\x37\x9d\xf2\x81\xea\x7d\xc7\x7e\xcf\xbf\x62\xd9\xf2\x87\x4b\x13\xb1\xb4\x0d\x86\xe9\xf3\xee\x04\x3f\xc1\x4c\x17\xa7\x93\x93\xbc\xcf\xa8\x5c\x32\xa2\xd3\x35\xe0\xf5\x5a\xb2\xde\x02\x1a\x29\xe6\x6b\xf6\x9e\x03\x5d\x6f\x2f\x68\x6b\xa2\x5f\xf3\x45\x10\xbe\x28\xa5\x68\xa0\x60\x6f\x2f\xba\xe8\x5f\x00\x02\xab\x2b\x10\x78\x82\x30\x37\xfd\xe5\x93\x1a\x3c\x5d\xce\xb4\x63\x66\x1e\x11\xec\xe9\xe4\x04\xb1\xea\xef\xf2\x8f\xd4\x0d\x18\x47\x70\x77\xfc\x04\x5c\xac\x9f\x10\xb8\xc9\x85\x44\x70\x21\xbf\x1c\xec\xec\x3c\x6f\xb8\x64\x57\x5c\x82\xfc\x55\xad\x6b\xa4\x3a\x52\x5b\xcc\xeb\x4a\x17\x55\xbf\x4e\xf6\x42\xfc\x86\x7c\xfa\xbd\x17\xa8\x21\x43\xfe\xb2\xbc\x7b\xfe\x66\x4c\xff\xbd\x7e\x93\x71\xc1\xd5\x9b\x33\x0b\xd5\xf0\x1d\x0f\x53\xe7\x95\x0f\x17\x63\xae\x25\x05\x3c\xaf\x7f\x36\xcd\x39\x3e\x78\xf3\xee\xfc\x8a\xb5\xb2\xce\x25\x8b\xc4\x24\x1f\xb8\x70\xfc\x13\x71\x3d\x7a\x0f\x52\x43\x1c\xf6\x6c\xe8\x0b\xb1\xe9\xcf\x0f\x09\x8b\x7e\x93\x04\xdc\xc8\x79\xbd\x97\x48\x9f\x93\xc9\xe9\x51\x9f\xfc\xe2\x04\xa1\xc9\x64\x59\x54\x18\x1d\x85\xc3\x3d\xa2\xbc\xe3\x29\x28\xcf\xef\xa3\x62\xd9\x33\xcd\x5e\xa2\x39\x71\xac\x23\xc9\xc4\xfc\xab\x4f\x88\xea\xa0\xb3\x57\x28\x19\x38\xd1\x23\x91\xc4\x97\x9e\x2e\x12\x83\x9e\x5e\xdd\xba\x8c\x7d\xd5\x02\x7a\xd1\x46\xaa\x4b\x0e\x9f\xd2\x48\x3a\x4a\x3e\x1f\x9d\x17\x35\x7b\x65\xca\xc9\xc4\x82\x08\x64\x76\x2b\x8a\xfa\xe6\x94\x34\xcd\x8e\x05\x45\x0a\xa3\xf3\x58\xdf\xb7\xb8\x8e\x84\x4f\xb6\xb8\xb1\x51\x07\xd2\xd6\x71\xc9\x50\x60\xf7\xb6\x57\x2c\x87\x29\xbe\x6e\x60\xdc\x4b\x27\x1d\x79\x0c\x26\x70\x9d\xf6\x7a\xd7\x59\xc3\x8a\x70\xe5\x86\xcf\xcc\xe0\x46\xe7\x0b\x9f\x76\x26\x84\x5d\x7f\x1e\x88\x5c\x1f\xd1\xb7\x60\xab\xe2\x65\xfa\xcb\xbd\x28\x59\xc6\x4f\x12\xa3\x2c\x5d\xd5\x3f\xd4\xaa\xc0\xb6\x8a\x5e\x2d\x2b\x58\x15\x64\x80\xd1\xc8\x55\xa1\x34\xed\xd3\x42\x67\xd0\x48\x8a\xa6\xa3\x5c\x36\x8e\xf5\xae\x7a\xb8\x29\x8b\x4a\xb6\x41\x1a\x49\x4f\xe8\x09\x14\xce\x68\x8b\xe3\x67\xbb\xbb\x2c\x70\x88\x23\xf8\xaa\x86\x65\x51\x4a\x86\xa2\xd7\x14\xc6\x69\x23\x89\x91\x92\xd9\x96\xfe\xaa\x0e\x94\x1b\xa9\x5b\x23\x6f\xd4\x3e\xbb\x22\x3d\x8d\x4a\xcf\x7a\x39\x7a\xcd\x0d\xd4\x28\xcd\x93\xa7\x4d\x26\x15\xa0\x45\x9c\x5a\xd7\x07\x05\xc2\x58\x9f\x81\xdc\x8b\x32\x87\x3f\x54\x20\x37\xf7\x12\x8d\x15\x48\xdf\x5b\x69\xd9\xe0\x5d\x5d\x36\x1c\xca\x46\x91\xa8\xa5\xa1\xd0\x8a\x62\x63\xbd\xd2\x0b\xd9\x50\x0a\x8f\x00\x76\x4f\x2e\x06\xfa\xce\xbf\x8c\xbd\x91\x6c\x9a\x7f\x25\x97\xca\xe6\xcf\x75\xf3\xe5\x3e\x34\x3c\x76\x3b\xcb\xbc\x47\x94\x3b\xf1\x67\xb1\x2b\xb5\xfb\x10\x53\x81\x6c\x1a\x43\x82\xde\x40\x4c\x74\xff\xdf\x42\x5f\x6f\x4a\x4a\xc5\xf0\x39\x3d\x85\xfe\xaa\x1e\x3b\xff\x37\xec\x26\x4d\x7b\x20\x9a\x8a\xb1\x4a\x63\xee\x36\x75\xb3\xab\x50\xf5\x81\x2f\x64\x11\x34\x5f\x33\x84\xc3\xb5\x0b\x83\xc2\xf3\x46\x14\x15\xad\x01\x6c\x9b\x7a\x2e\x17\xbb\xa6\xb5\x0f\xa4\x8c\xd2\xf0\x97\x2f\x7f\xf8\x86\xd6\x44\x73\xcc\x51\x93\x97\x46\xb1\x80\xb0\xab\x14\x3c\x99\xd7\x0b\xf9\x84\x83\x69\x82\x7c\x5b\x68\x7a\x21\x19\x99\x94\xd4\xdf\x62\xba\x82\x08\xcb\xd8\x20\xf4\x17\x74\x46\xda\x41\x9b\xa4\x17\xe5\xec\xd8\xe5\x27\xb6\xde\x55\xcc\x55\xf1\xd1\xb3\x0f\x2c\xcd\x12\x5f\x47\x6f\xbc\xab\x14\xe6\xa3\x31\x83\xb0\x93\x62\x50\xa0\x6b\xb8\x3f\x6a\x89\xa3\x1c\x01\x78\xf0\xe9\x4a\x28\x16\x06\xbb\x34\x8b\x30\x1f\x62\x1b\x57\xaa\xad\x87\x03\xc0\xaa\xf4\x1a\x8e\x7f\xe0\x43\x38\xb8\x08\x5f\xb2\xc1\x9c\x95\x38\x78\x36\x4c\xa4\xde\x90\x34\xe8\x8f\xb4\x7d\x01\xeb\x39\x50\xdb\x43\x01\xd1\x82\x5d\xda\x64\x3b\xbe\x4f\xa5\xa7\xb8\xf0\xde\xee\x0d\x46\x60\xcd\x8d\xf0\xc9\xd8\xab\x18\x19\x2d\xc5\xcd\x9b\x5d\xe5\x27\x29\x5a\x38\x7c\x39\xa4\xfa\xb5\xf3\xa1\x7f\xbf\xa8\x6a\x1b\xa5\xab\x3d\x53\xdf\xc6\xf7\x6f\xc6\xe2\x98\x92\x82\x64\x7d\xdb\x31\x1d\xf5\xc0\xfd\x4f\x19\x7f\xbb\x04\x3c\x6a\x5a\x89\x80\xd6\xc9\x85\x37\x28\x09\x6c\x28\xda\xd5\x10\x43\xab\x01\x35\x91\x52\x4d\x51\xc3\x0d\xa9\xc1\x05\x8a\x2c\x43\x51\xb8\xcc\xa7\x09\xea\xec\x84\xba\xa4\x15\xc7\xa2\xee\xb4\xe6\xc6\x55\xcd\x5d\xc3\x42\x6a\x39\xd7\x38\xab\x67\x73\x1c\x29\x0a\xc0\x64\xf3\xe2\xc1\xc6\xe9\xd1\x54\xeb\x87\x34\x9e\x45\x27\x76\xad\x8d\xd7\xdf\xed\xfa\x06\x8e\xe8\x9d\x83\x9b\x96\x70\x15\xcd\xd9\x70\x0b\xda\xcc\x53\x68\x59\x2f\x35\x72\x58\x19\x04\xdb\x3e\x86\xf2\xfb\xa0\x3c\x10\x5f\x20\xdc\x3a\x8e\xe8\x4e\xa9\xbd\x28\x67\x18\xac\x6d\xc6\x91\x1b\xf1\x01\xc8\x14\xf7\x72\xd2\x93\x5a\xa8\x21\xbd\x92\xf7\x3a\x47\x80\x7d\x57\x72\x1e\x5a\x18\x92\x01\x3b\xeb\x37\xe3\x71\x00\x8d\xc8\xac\xc6\xbe\xf1\x89\x2a\x2a\x32\x71\xe5\x93\xa6\x5e\x82\x80\x46\xce\x65\xb1\x97\xcf\x38\xb6\x25\x6c\xc4\x11\xee\x25\xdc\x97\x35\xd9\x82\xe1\xbb\x6b\x24\x4a\xf3\x6b\x31\x6a\x79\x08\x73\xf7\x85\x76\x8c\x95\x5e\x7e\xf9\xb5\xde\x34\xaa\x2b\x27\x2a\xb4\xcb\x64\x94\x9d\x74\xc2\xe0\x7e\xfa\xa2\x6e\xea\x60\x1d\xe7\x8d\x14\x5a\xfa\x78\x8b\xce\x23\xc6\x80\x73\xf5\x0b\x1e\xcc\x66\x33\x51\x96\x33\x04\x96\xc5\x9d\x98\x53\x0c\xbf\x5d\x57\xb5\x96\xea\x2e\xaa\xf0\x66\xfa\xf3\x6c\x56\xd6\xf3\xe9\x07\x21\x14\xca\x63\x95\xcc\xeb\x09\xe2\x6d\xe2\x2f\x45\x87\x40\xf8\x22\xd7\x47\x37\x9d\xb2\xa7\x57\xf6\x0a\xe3\x02\x3d\xae\x25\x28\x54\x9f\xed\x4a\xd9\xf0\xbd\xc5\x3c\xaa\x17\x0a\x56\x16\x3f\xd9\x28\x8c\x87\x57\x90\x09\xaa\x22\x85\x47\x53\x97\x1c\x54\xf1\x60\x02\x52\x9a\xba\x4c\x56\x88\xe8\xa3\x71\xb8\xf4\x5a\xcf\x45\x05\x72\xb9\x94\x73\x3d\x6a\xa3\x6b\x09\x0a\xd4\x69\xe9\x03\x16\x3b\x9c\x2f\xa5\x20\x70\x65\x9f\x4e\xa0\xc6\xcc\x0f\x3c\x63\x2d\xd4\xc3\x8c\x12\x22\x8e\xfb\xf1\x8e\x15\x72\x9e\xd1\xcc\xcd\x72\x9c\x9a\xe6\x94\xdd\x66\xb7\xfd\x93\x14\x0b\x6c\x33\x4e\x1d\xd9\x9a\xbd\x61\x42\x32\x31\x1a\xad\x31\x3a\x67\x6d\x0a\xef\xd0\x1d\x19\x80\x9b\x30\x3f\xbb\x41\x9c\x22\xb7\xf2\xef\x7d\xa9\x93\xc5\x14\xe2\x82\xe4\x2b\xca\xed\x7a\x5f\x4a\x65\xb6\xb4\xa6\x8c\x18\xc6\x7a\x60\xbe\x6b\x1a\x32\x46\x31\x24\xfa\x44\x81\x9a\xd7\x5b\xb2\x27\x68\x1d\x64\x6d\xa0\xd5\x62\xc9\x0f\xee\x19\xf1\x5c\x0e\x81\xe7\xbd\x5a\xae\xa4\x9e\x51\x1f\x9c\xc9\x62\xcc\x59\xeb\x16\x78\x81\x7a\xdc\xdb\x73\xc5\x59\x8d\x58\x36\x5d\x49\x4d\xa5\x63\x02\x94\x41\x11\x9d\x35\x55\x68\x84\x52\x94\xb7\x91\x29\x1d\xdd\x16\xc5\x66\x20\x12\xf9\xbe\xfb\x26\x0d\x53\x28\xac\xb5\x4b\x78\x09\x41\xe8\x96\xa1\xe2\x6c\x69\x9e\xb3\x45\xfc\x4e\xe9\x52\xfd\x9d\xc1\x89\x5d\xac\x55\x5d\x8a\x6a\xf5\x44\xb1\xbe\xeb\x99\xbd\x0b\x2b\xb9\x11\x95\x2e\xe6\xca\x64\x10\xc5\xaa\x14\xc9\xff\xb0\x96\x64\x25\xc2\x4c\x8a\x2a\xa3\x64\x82\xa7\x5c\xa1\xe0\xc3\x45\xd1\xc8\xb9\x2e\x8f\x1f\xf2\x42\x53\xbe\x2a\xce\x3f\xbc\xa4\xbb\xa3\x5b\xb2\xba\x41\x0c\xe6\xf0\xca\x26\x49\xd9\xec\x94\x26\x9b\x77\x8a\x8b\xbb\x90\x72\x6b\x93\x1c\x22\x70\x62\xc4\xc8\x48\x8d\x2d\xb8\xdd\x9a\xde\x18\x0a\x1b\xbb\xda\x48\xec\x85\xfa\x13\x0d\x86\x14\x00\xc1\xc5\x11\x61\x0d\x88\xec\x41\xa3\xcc\xbc\xe8\x52\x92\xab\xb6\x95\x51\x7e\x34\xc5\xea\x95\xf9\x4c\xdf\xbc\x33\xe2\x5e\xae\x8a\xea\x0f\xc6\xa0\x91\xe2\x8e\xe0\x7f\xc8\xa7\x6b\xbb\x95\xa2\xc9\x5b\x30\x5e\x55\xca\x43\xdb\x62\xe8\x89\x99\xda\x93\x24\xa8\x9d\x81\xe7\xa6\xb3\x44\xf7\x02\x7e\x63\xf6\x20\x85\x73\xbb\x97\x2b\x36\xa2\xa4\xc6\x2c\x98\x4b\x92\x9d\xac\x40\x8e\x35\xfa\xcc\x5d\x40\x37\xc5\x06\xea\xe5\xd2\xbe\xb7\xe3\xc6\x66\x56\xec\xd6\x05\xee\xa5\x3e\x48\x89\xc7\xa1\xdf\x52\x54\x50\x54\x25\xbd\xc3\xcf\xe9\x7d\xbe\xb6\x2b\xe5\x92\x05\x3a\xdc\x39\x1f\x23\x33\x91\xd6\xb0\xd0\xa0\x73\xb7\x5a\xe7\x2e\xb8\x31\x3e\xeb\x37\x62\x55\x94\x32\x37\x59\x12\xee\xa5\xd6\x12\x95\x89\x47\xdf\xf6\x47\x34\x0f\xb2\xe9\xc7\x54\x88\xe6\xe5\x13\x23\x7d\x17\x4b\xd7\xea\x94\xed\x4f\x48\x57\x8b\x62\xc1\x4f\xdb\x45\xb5\x30\xcd\x3d\xbe\xca\x06\x9e\xfd\x6e\x84\x74\x68\x09\x05\xc5\x66\x23\x17\x85\xd0\x12\xfe\x2f\xed\x92\x96\xa4\xff\x2f\x5e\x7f\x6b\x0e\xb1\xdc\xfa\x30\xb8\xf8\x4a\xe1\x40\xe6\x94\x67\xd7\x0d\x65\x36\xd3\xf5\x76\x56\x2f\x67\x0c\xb3\xa8\x18\xe1\x2c\x05\xd1\x9f\xad\xf0\xe3\x0c\x73\xcd\xe2\x72\x60\x19\xa8\x97\x01\xa6\x12\xb7\xd4\x44\x69\x34\x3d\xe1\x26\xed\x47\x2c\x69\x56\x52\xf7\xae\xec\x55\x66\x6a\x87\x14\x4a\xcc\x36\x8b\x77\xdf\x8d\x85\x64\x13\xf2\xd3\x2f\x1c\xf5\xa7\x9f\x9a\x73\xcc\x14\xfd\xad\x42\xa3\xc7\x76\x04\xb3\x0c\x2a\xcc\xb4\x1d\x07\x38\xc1\xca\xe1\xea\x63\xb0\x93\xe4\xb2\xc1\x18\x88\xbe\xe0\x82\x45\xa9\x53\x78\xe2\xf7\x4f\xe1\xba\xcf\x14\xa8\xd7\xe0\x94\x6d\x7f\xb1\x6c\xf4\xff\x1f\x00\xda\x39\x8c\xec\xae\x9e\x01\x00"),
 		},
 		"/tsys_test.lua": &vfsgen۰CompressedFileInfo{
 			name:             "tsys_test.lua",
@@ -142,10 +177,10 @@ var preludeFiles = func() http.FileSystem {
 		},
 		"/zgoro.lua": &vfsgen۰CompressedFileInfo{
 			name:             "zgoro.lua",
-			modTime:          time.Date(2018, 3, 25, 8, 16, 52, 0, time.UTC),
-			uncompressedSize: 8076,
+			modTime:          time.Date(2026, 10, 17, 6, 16, 32, 0, time.UTC),
+			uncompressedSize: 8112,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x59\x5f\x6f\xe3\xb8\x11\x7f\xd7\xa7\x98\xe6\x1e\x56\x42\x25\x37\xd9\xc7\x75\xd5\x02\xc5\xdd\x2e\x70\x0f\x2d\x7a\x29\xfa\x12\x04\x06\x23\x8d\x2c\x5e\x68\xd2\x47\x52\xf6\x7a\x03\xdf\x67\x2f\xf8\xc7\x12\x29\xc9\x8e\xb3\x69\x9b\x17\xdb\xe2\x70\x38\xff\x7e\xbf\x19\x2a\x45\x01\xdf\xd6\x42\x8a\x05\xeb\x48\x0e\x9c\x6c\xb0\x86\x3d\xd5\x2d\x10\xf8\xf0\xed\x03\x50\x0e\x42\xd6\x28\x93\xa2\x00\x2d\x80\x09\x52\x03\x23\x4a\x9b\x85\xad\x44\xd6\xd5\x98\x9b\x35\xd2\x68\x94\xa0\xd5\x41\x39\x45\x4a\xc0\x1e\xa1\x25\x3b\x04\xd1\x49\xd0\x87\x2d\xaa\x45\x62\x24\x3f\x0b\x09\xba\x45\x68\x0f\x4f\x92\xd6\x7f\xa2\x5c\xa3\x24\x95\x56\xf6\x54\x23\xc0\x89\xa6\x3b\x84\x2f\x02\xaa\x96\x70\x8e\x4c\xc1\x8e\x12\x90\xd8\x30\xac\xb4\x91\xd8\xa1\x54\x54\x70\x10\x0d\x18\xd3\x3b\x4d\x39\x2a\x20\xbc\x36\x8b\xa7\x4d\x9f\x40\x21\x9e\x76\xad\x4e\x2e\x5a\x13\x74\x27\xcd\xe6\xc6\x79\x0e\x8d\x90\xc0\xc5\x3e\x87\x4e\x51\xbe\xb6\x0a\x8c\xa8\xf1\x4f\x21\xd7\xec\xb0\x30\x9b\x7e\xed\x94\x06\xa5\xbb\x27\x10\x9d\x86\xd5\x6a\x2d\x80\x28\xd8\x12\xa5\x74\x2b\x45\xb7\x6e\x93\xa6\xe3\x95\x36\x76\x99\xc5\xb4\xc9\x61\xb1\x58\x64\x09\x00\x34\xa9\xfd\x86\xbc\x36\xc7\x3f\x94\xe5\x43\x92\x30\x51\x11\x06\x4d\x43\xa1\x04\x89\xbf\x75\x54\x62\x7a\xd3\x34\xf4\x26\x4b\x92\x64\xb5\xe2\xe2\xcb\xc9\x33\x28\xe1\x05\x88\x62\x88\xdb\x12\x1a\xc2\x14\xe6\x80\x5f\xa9\xee\x7f\xd4\xd8\xa0\xbc\xd7\xa4\x7a\x2e\xe1\xe5\x98\xc3\x96\x70\x5a\xf5\xbf\xe1\xb8\x34\x0a\xab\x4e\x86\x1a\xa3\x13\x96\xc9\x6a\xa5\x85\x26\xec\xcb\x10\xcd\x12\x6e\xcd\x63\xb2\x27\xcf\x38\x7d\x5c\xb5\x58\x3d\x7f\x16\xf2\x47\x24\x35\x13\xd5\x33\x94\xa0\x65\x67\x15\x6d\x08\xe5\x9f\x29\xa7\xaa\xc5\x1a\xbc\x8d\xd6\x82\xb5\x30\x3f\x7d\x8c\xd2\xa6\xe3\x39\x10\xb9\x56\x19\x98\x10\x4d\x0c\x28\x27\x4f\xfe\x78\xb7\x74\x92\x23\x9b\xca\xc9\x13\x2f\xe9\x42\xbc\x5a\xad\xc3\x48\x9a\x05\xab\xa5\x22\x8c\x85\x06\x39\x3b\x00\xa0\x28\xb4\x3c\xf8\xef\x4e\x85\xb4\x8e\xbf\x6c\xcd\x96\x74\xd8\xe0\x65\x66\xfe\xce\xaf\xcc\x24\x62\x3d\xa4\xe1\xec\x36\x6f\x87\xb3\x37\xe5\x94\xf9\xd8\x5d\xd8\x42\x1b\x90\x06\x13\x20\x17\xab\xd5\x13\x7b\x86\xdf\x4b\xe0\x94\x19\xec\xf1\xf3\xbb\x4c\xb5\x76\x3c\x8e\x0b\x48\xb4\x88\xf1\x8a\xd2\x6c\x09\xc8\xeb\x0b\x47\x03\x58\xeb\x4c\xcc\x8e\x97\xc5\x9c\xe6\x0b\x32\x06\x33\x67\x17\x83\xd8\x2d\x0c\x22\xfa\x2a\xec\xf7\x66\xc7\x3e\xa9\xd0\x50\x4e\x18\x3b\x24\x43\x05\x5c\x84\x84\x93\xa2\xcd\xf4\x14\x13\x41\xa3\x90\x30\x25\x40\xa1\x86\xa7\x03\xc8\x8e\x6b\xba\xc1\xc5\x17\x61\x44\xd2\x0c\x8a\x22\x09\xec\x7c\xb5\xb8\x8b\xbb\x65\x32\xeb\x97\x03\xfe\xd4\xb3\x79\xfb\xbc\x74\x9c\xe3\x2b\x10\x13\x1d\x4f\x1b\xe0\xc2\x50\x5c\x04\x65\x53\x4a\x33\x7c\x50\xc2\xad\x5f\x9a\x70\xc2\xa4\xd2\x2a\xc1\x95\x60\xb8\x40\x29\x85\x4c\x6f\x1a\xa2\x09\x03\xfb\xe3\x13\x18\x34\x86\x64\x2e\xd1\x73\x1e\x14\x50\x7b\x8d\x7f\xb8\x19\x15\xbc\xf3\x9e\x89\x27\xc2\x16\x5b\x29\x2a\x54\xea\x52\x99\x8f\x65\x6d\x3a\xd3\x8f\x23\xa5\x51\xc9\x0d\x3f\x86\x6f\x45\x61\x7e\xf4\xf5\xd4\x3f\xac\x88\xae\x5a\x48\x51\xca\x2c\xa2\x0f\xf1\x9c\x1b\x2f\xa1\x84\x8e\x6f\x49\xf5\x9c\x4a\x54\x59\x12\xc5\x5a\x8c\xa3\xd5\xe7\x60\xa6\xf6\x22\x6b\x8b\xc2\x80\xa8\x95\x62\x1f\x3b\x61\x43\x6c\x4c\x59\x5e\x76\x66\x78\x60\x05\x8f\xc9\xb9\xfa\xf3\x44\x3e\x0f\xbb\xf9\xc5\xa1\x31\x0d\x54\x10\xae\x0f\x8d\x2a\x5c\x57\x55\x8b\x75\xc7\x30\x0d\x44\xb3\x65\x62\x2d\x4c\x86\xe5\xda\xef\x49\x56\x2b\xd9\xf1\xfb\xe0\xe9\x88\xd2\x7b\x3e\xff\x1e\x32\xdf\xb7\x94\xa1\xc5\x1e\xd4\xe2\x1a\x7e\x0e\x0c\x5c\xa8\x96\x36\x3a\x7d\x95\xa4\xcb\x2b\x89\xf9\x49\x22\x79\xfe\x4e\xaa\x94\x67\x3d\xf4\xdb\x7a\xb2\x1c\x98\x32\x07\x2e\x5c\x51\x27\xce\xd4\x1f\xc2\xe8\xff\x05\x6e\x43\x93\x15\xea\x7f\xd1\x0d\x8a\x4e\xa7\x71\x42\x72\xb8\x75\x11\xf0\x07\xc5\xe8\x89\xcf\x30\xd3\x1f\x62\x6d\x26\x4d\x5f\xd4\x7f\x4d\x5e\x87\xd1\x2c\x86\x06\x00\x9c\xce\x1e\x17\x50\x58\x29\x43\xa1\x81\xd7\x78\x89\x53\x2f\xa2\xe3\x4d\x23\x8a\x8f\x49\x58\x34\xdb\x4e\xb5\x69\x58\xf8\x27\x9e\x8b\xdb\xd5\xa8\x5f\x85\xe6\xc5\xf1\x4f\x87\xe8\xf7\x11\xe8\x73\x15\x4d\x64\x39\xe8\xd3\x30\x76\xad\xfd\x7e\x32\x08\x92\x3f\x9d\xa7\xbe\xa3\x03\x35\x67\x41\x83\xbc\x36\x66\x0e\x74\xf0\xe4\xc7\xcf\xd1\xb9\x6f\x8d\x98\x2d\xb6\x5f\x5c\x13\xff\xc9\xb5\xa7\x8a\x70\x53\x56\xee\x00\xca\xe1\x67\xb2\x23\xf7\x95\xa4\x5b\x0d\x86\x3b\x9e\x48\xf5\x9c\x43\x43\xbf\x9a\xf6\xbf\x97\x64\xbb\xb5\x97\x07\x51\xa3\x11\xee\x13\x78\x93\xc5\x89\x0e\x6d\x1a\x37\x77\xe7\x53\x51\xc0\x5a\x6c\x5b\x94\x3f\x2b\xd8\x0a\xa9\x3f\x99\x84\x62\xb5\x4b\xdc\x47\xe8\xab\xb9\xaa\x38\x7f\x1d\x44\x7e\xeb\xb0\xc3\xfa\xde\xe0\xab\x74\xf7\x18\x93\x6c\x5e\xff\xd3\x3c\x8f\xe8\x88\x36\xa1\xf0\xb4\x67\xfa\xcd\x4f\x5d\xd3\xa0\x74\x35\x39\xc8\xa7\xb6\xda\xb3\xc8\x33\x67\x80\x93\xc7\xfa\xdf\x84\x75\x38\xd8\xe0\xd5\x8c\x0c\x88\x85\xa7\x36\xf8\xe2\x8a\xc4\xf2\x61\x0e\xf2\x07\xd3\xe6\x74\x4a\xc5\x84\x32\xe4\x31\xd1\xe0\xd7\x91\xe1\x66\xf1\x0d\xa5\x48\xb3\x3c\x00\xac\xbd\x96\xf5\x1e\xe8\x96\xaa\x78\x2a\x0c\x33\x16\xdc\x2b\x1a\x7b\x2f\xb3\x13\x71\x90\x11\x85\xac\xc9\x02\x5c\xb0\x66\xb1\x33\x76\xdb\x91\x19\x8e\xcb\x51\xae\x7e\xe2\x5a\x1e\xc2\x8c\xee\x7a\xd8\xf8\x8d\x50\xc2\x6e\xe0\x95\xbe\x37\x46\x66\xf6\x89\xb0\x9f\xde\x5b\x53\x2b\x2e\xef\x43\xf6\xec\x71\x99\xef\xb3\xb6\xb0\x7d\x36\xbc\xbd\xcd\x80\x2b\x1b\xcc\xf9\x5a\xbb\x14\xf1\x79\x24\x59\x5d\xa2\x01\x2f\xef\xaf\xe8\x31\x34\x62\x95\xc1\xbc\x3b\xd7\x82\xdf\x5a\xee\x93\x8a\x9f\xed\xb9\xb6\xc1\x4e\x67\xec\xa0\xf0\x8d\x15\xd9\xd2\xf4\xa9\x3d\x65\xcc\xdd\xb4\xfd\xa8\xef\xe5\x5f\xb3\xf6\x17\x07\xe1\x49\x92\xce\x59\xeb\xe4\xdf\x6c\xad\xd9\x96\xbe\x9c\x2d\xfc\xe3\x5c\x53\xe0\xf5\x38\xdf\x39\xd8\x1a\xfc\xce\xb4\x5b\x8d\x82\x5f\xcc\xfa\x5b\x43\x13\xc7\x65\xca\x19\xa1\xf7\xbb\x81\x30\x8e\x7d\x60\x83\x1b\xe7\x40\x20\x3f\x44\x3c\x05\x7f\xee\x1d\x25\x5b\x52\x51\x7d\x78\x85\x19\x5d\x90\xce\x9c\x60\x3e\xaf\x26\x15\x17\xaa\x1f\x3b\x49\xf9\xfa\x7e\x04\xe8\xa1\xb2\xed\xa1\x43\xa2\xec\x9e\xb0\xdd\x8e\xb5\x98\xa0\xda\x47\xd1\x1d\xf3\x12\x97\x44\xf4\xe9\x08\xec\x34\x24\x9e\x67\x8f\xe1\xdd\x4a\xcc\x89\xa1\x69\xb4\x99\x5a\x37\x19\x7c\xdf\x5e\x4d\x63\x10\xf8\x6f\xc7\xe0\xda\x80\x0c\xab\x68\xde\xa9\xc4\x66\xe3\x06\xc8\xd3\xc5\x80\xd4\x87\xe1\x22\xe2\x1e\xba\x6d\x54\x70\x28\xc1\x8f\x28\x8d\x90\x40\x73\x30\xdb\x4d\xa7\xa7\x5b\x42\xa5\xf2\xda\xc6\x98\x37\x56\x9a\xe8\x8b\xcd\xe6\xe1\xee\x71\xd9\xdf\x15\xd5\x9e\xda\xbb\xa2\x59\x58\x30\xe4\x6b\xdd\xc6\x77\x46\xb3\xb0\x62\x68\x36\xff\x60\xbe\x0f\xb4\x30\xac\x94\xf1\x04\xee\xe6\xe7\x1a\x1b\xd2\x31\x1d\xbd\x7f\x08\x9d\xa0\xd1\xa0\x15\x73\x08\x53\x38\x3a\xe0\x6e\x7a\x80\x9d\x41\x42\xed\x01\x7e\xfa\x12\x35\xc8\xbc\x05\x21\xc7\xc8\x3a\x3d\x3e\x4b\x24\xa7\xc2\x23\xf5\xc1\x95\x39\x2d\xee\xe6\x53\x7c\x85\xf5\x1f\xa7\xd6\xdb\x2a\x1a\x59\xff\x8a\x31\xef\xaf\xc6\x38\x48\x3d\xb1\x9d\x0b\xd2\x45\xfa\x79\x47\x80\xac\xe7\xf6\xc3\x95\x5f\xc8\x50\xc6\x3e\x07\x00\x67\x55\x70\x62\x51\xc0\xaf\x48\xe0\xef\x7f\x03\xd6\x91\x0f\xca\xc0\xbb\xa3\x4c\x53\xbe\xda\x10\xdd\x2e\x24\xe1\xb5\xd8\xa4\x3c\xf3\x34\xa0\x0c\x28\x1e\xee\x72\xfe\x68\xae\x71\x1b\xfb\x4e\x84\x6a\x05\x44\x4a\x72\x50\x8b\x64\x5a\x92\xf6\xdc\x87\x79\xb5\xce\xa8\xec\x71\x09\x31\x63\x0f\xfb\x7f\x37\xc0\x0c\xed\x1d\x00\xe4\x91\xa7\x1e\x7a\xe9\x00\x82\xf0\xff\xc5\xe0\x89\x23\x7b\x53\x8e\xef\x84\xdd\x44\x61\xee\xef\x09\xa9\x67\x9b\xec\xd2\x09\xd7\x40\xc3\xe1\xf9\xa4\xce\x31\xde\xc3\xc7\xc7\x99\x0e\x31\xe7\x55\xff\x5a\x21\x18\xae\x91\x6b\x49\x51\x8d\x49\xf6\xbf\x3f\x73\xf7\xf6\x4c\xe7\x6e\x89\x1b\xb1\xc3\xcf\x52\x6c\x2c\x08\xd5\xec\xff\x00\x1c\xc7\xa3\x9d\xce\x07\x8e\xf7\xe6\x67\xd1\xab\xa0\x60\x82\x81\xd2\x7a\x78\x08\xa8\xbe\x17\xa0\xbc\xc6\xaf\x50\x3a\xc1\x85\xfd\xf5\x8f\x26\x75\xe2\x71\x4c\x69\xe3\x85\x27\x95\xed\xfe\x9c\x06\xb5\x65\xb4\xc2\xd4\x4a\xe6\xf0\xf1\x62\x1b\x3c\x0d\x12\xce\xab\xb3\x8d\x6b\xdc\x9d\x04\x8f\xea\xfe\x22\x28\xe6\xb1\x71\x4d\x1d\x5f\xbc\x0d\x0d\xd3\x67\xff\x17\x24\xd7\x54\x03\xf5\x33\xea\xe8\x1f\x0d\xe3\x2c\x8f\x5f\x28\x5c\x33\xfe\xc4\xff\xe4\xf0\xc9\x77\xa4\xfb\xe2\x41\x11\xd2\x79\x1e\x38\x71\x0c\xf5\xcc\xc8\xce\xdc\xc9\xde\x87\xd5\x4b\x51\x1c\x05\xd0\x6b\x77\x16\x9d\x6b\x7a\xef\xe8\x7b\x33\xaf\x22\xc7\x39\xfb\x1f\x24\x2b\xb8\xed\xc7\xc3\xd6\xd5\x89\xec\x87\x97\x2b\x12\x39\x9a\xc5\x67\x13\x39\x10\xe0\xab\xd7\xed\xa2\x78\x2c\xcb\xc7\xe4\x3f\x01\x00\x00\xff\xff\x84\x0f\x3b\x26\x8c\x1f\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x59\x4f\x6f\xe3\xb8\x15\xbf\xeb\x53\xbc\x66\x0e\x23\xa1\x92\x9b\xcc\x71\x5c\xb5\x40\xb1\x3b\x03\xec\xa1\x45\x37\x45\x2f\x41\x60\x30\xd2\x93\xc5\x86\x26\xbd\x24\xe5\x8c\x27\xf0\x7e\xf6\x82\x7f\x2c\x91\x92\xec\x38\xc9\xb4\xbe\xd8\x22\x1f\x1f\x7f\xef\xdf\x8f\x8f\x72\x51\xc0\xf7\xb5\x90\x62\xc1\x3a\x92\x03\x27\x1b\xac\xe1\x89\xea\x16\x08\x7c\xfc\xfe\x11\x28\x07\x21\x6b\x94\x49\x51\x80\x16\xc0\x04\xa9\x81\x11\xa5\xcd\xc4\x56\x22\xeb\x6a\xcc\xcd\x1c\x69\x34\x4a\xd0\x6a\xaf\x9c\x22\x25\xe0\x09\xa1\x25\x3b\x04\xd1\x49\xd0\xfb\x2d\xaa\x45\x62\x24\xbf\x08\x09\xba\x45\x68\xf7\x0f\x92\xd6\x7f\xa2\x5c\xa3\x24\x95\x56\x76\x57\x23\xc0\x89\xa6\x3b\x84\xaf\x02\xaa\x96\x70\x8e\x4c\xc1\x8e\x12\x90\xd8\x30\xac\xb4\x91\xd8\xa1\x54\x54\x70\x10\x0d\x18\xe8\x9d\xa6\x1c\x15\x10\x5e\x9b\xc9\xe3\xa2\xcf\xa0\x10\x8f\xab\x56\x47\x13\x2d\x04\xdd\x49\xb3\xb8\x71\x96\x43\x23\x24\x70\xf1\x94\x43\xa7\x28\x5f\x5b\x05\x46\xd4\xd8\xa7\x90\x6b\xb6\x5f\x98\x45\xff\xe9\x94\x06\xa5\xbb\x07\x10\x9d\x86\xd5\x6a\x2d\x80\x28\xd8\x12\xa5\x74\x2b\x45\xb7\x6e\x93\xa6\xe3\x95\x36\xb8\xcc\x64\xda\xe4\xb0\x58\x2c\xb2\x04\x00\x9a\xd4\xfe\x42\x5e\x9b\xed\xef\xca\xf2\x2e\x49\x98\xa8\x08\x83\xa6\xa1\x50\x82\xc4\xdf\x3a\x2a\x31\xbd\x6a\x1a\x7a\x95\x25\x49\xb2\x5a\x71\xf1\xf5\x68\x19\x94\xf0\x0c\x44\x31\xc4\x6d\x09\x0d\x61\x0a\x73\xc0\x6f\x54\xf7\x0f\x35\x36\x28\x6f\x35\xa9\x1e\x4b\x78\x3e\xe4\xb0\x25\x9c\x56\xfd\x33\x1c\x96\x46\x61\xd5\xc9\x50\x63\xb4\xc3\x32\x59\xad\xb4\xd0\x84\x7d\x1d\xbc\x59\xc2\xb5\x19\x26\x4f\xe4\x11\xa7\xc3\x55\x8b\xd5\xe3\x17\x21\x7f\x42\x52\x33\x51\x3d\x42\x09\x5a\x76\x56\xd1\x86\x50\xfe\x85\x72\xaa\x5a\xac\xc1\x63\xb4\x08\xd6\xc2\x3c\x7a\x1f\xa5\x4d\xc7\x73\x20\x72\xad\x32\x48\x00\x60\x02\xa0\x9c\x8c\xfc\xf1\x66\xe9\x24\x47\x98\xca\xc9\x88\x97\x74\x2e\x5e\xad\xd6\xa1\x27\xcd\x84\xd5\x52\x11\xc6\x42\x40\x0e\x07\x00\x14\x85\x96\x7b\xff\xdb\xa9\x90\xd6\xf0\xe7\xad\x59\x92\x0e\x0b\xbc\xcc\xcc\xe7\xf4\xcc\x4c\x20\xd6\x43\x18\x4e\x2e\xf3\x38\x1c\xde\x94\x53\xe6\x7d\x77\x66\x09\x6d\x40\x9a\x9a\x00\xb9\x58\xad\x1e\xd8\x23\xfc\x5e\x02\xa7\xcc\xd4\x1e\x3f\xbd\x0a\xc0\xec\x10\xfb\x05\x24\xda\x8a\xf1\x8a\xd2\x6c\x09\xc8\xeb\xe5\x59\x25\x06\x9d\xf1\xd9\xe1\xbc\x98\xd3\x7c\x46\xc6\xd4\xcc\x19\x67\xf6\xbe\x5b\x98\x8a\xe8\xb3\xb0\x5f\x9b\x1d\xfa\xa0\x42\x43\x39\x61\x6c\x9f\x24\x27\x23\x11\x95\x44\xef\xc5\xc9\x2e\xc6\x83\x46\x21\x61\x4a\x80\x42\x0d\x0f\x7b\x90\x1d\xd7\x74\x83\x8b\xaf\xc2\x88\xa4\x19\x14\x45\x12\xe0\x7c\x31\xb9\x8b\x9b\x65\x32\x6b\x97\x2b\xfc\xa9\x65\xf3\xf8\xbc\x74\x1c\xe3\x0b\x2a\x26\xda\x9e\x36\xc0\x85\x86\x51\x29\x9b\x54\x9a\xe1\x83\x12\xae\xfd\xd4\x84\x13\x26\x99\x56\x09\xae\x04\xc3\x05\x4a\x29\x64\x7a\xd5\x10\x4d\x18\xd8\x87\xcf\x60\xaa\x31\x24\x73\x89\x9e\xf3\xa0\x80\xda\x6b\xfc\xc3\xd5\x28\xe1\x9d\xf5\x4c\x3c\x10\xb6\xd8\x4a\x51\xa1\x52\xe7\xd2\x7c\x2c\x6b\xc3\x99\x7e\x1a\x29\x8d\x52\x6e\x78\x18\x7e\x15\x85\x79\xe8\xf3\xa9\x1f\xac\x88\xae\x5a\x48\x51\xca\x2c\xa2\x0f\xf1\x98\x1b\x2b\xa1\x84\x8e\x6f\x49\xf5\x98\x4a\x54\x59\x12\xf9\x5a\x8c\xbd\xd5\xc7\x60\x26\xf7\x22\xb4\x45\x61\x8a\xa8\x95\xe2\x29\x36\xc2\xba\xd8\x40\x59\x9e\x37\x66\x18\xb0\x82\x87\xe4\x54\xfe\x79\x22\x9f\x2f\xbb\xf9\xc9\xe1\x60\x1a\xa8\x20\x9c\x1f\x0e\xaa\x70\x5e\x55\x2d\xd6\x1d\xc3\x34\x10\xcd\x96\x89\x45\x98\x0c\xd3\xb5\x5f\x93\xac\x56\xb2\xe3\xb7\xc1\xe8\x88\xd2\x7b\x3e\x7f\x0b\x99\x3f\xb5\x94\xa1\xad\x3d\xa8\xc5\x25\xfc\x1c\x00\x5c\xa8\x96\x36\x3a\x7d\x91\xa4\xcb\x0b\x89\xf9\x41\x22\x79\x7c\x23\x55\xca\x93\x16\xfa\x65\x3d\x59\x0e\x4c\x99\x03\x17\x2e\xa9\x13\x07\xf5\x43\xe8\xfd\xbf\xc0\x75\x08\x59\xa1\xfe\x17\xdd\xa0\xe8\x74\x1a\x07\x24\x87\x6b\xe7\x01\xbf\x51\x5c\x3d\xf1\x1e\xa6\xfb\x43\xac\x41\x8b\x63\x52\xff\x35\x79\xb9\x8c\x66\x6b\x68\x28\x80\xe3\xde\xe3\x04\x0a\x33\x65\x48\x34\xf0\x1a\xcf\x71\xea\xd9\xea\x78\x55\x8b\xe2\x7d\x12\x26\xcd\xb6\x53\x6d\x1a\x26\xfe\x91\xe7\xe2\xe3\x6a\x74\x5e\x85\xf0\x62\xff\xa7\xd9\x72\xe2\x81\x3e\x56\x51\x47\x96\x83\x3e\x36\x63\x97\xe2\xf7\x9d\x41\x10\xfc\x69\x3f\xf5\x86\x13\xa8\x39\x59\x34\xc8\x6b\x03\x73\xa0\x83\x07\xdf\x7e\x8e\xf6\x7d\xad\xc7\x6c\xb2\xfd\xea\x0e\xf1\x9f\xdd\xf1\x54\x11\xce\x85\x06\xb7\x01\xe5\xf0\x0b\xd9\x91\xdb\x4a\xd2\xad\x06\xc3\x1d\x0f\xa4\x7a\xcc\xa1\xa1\xdf\xcc\xf1\xff\x24\xc9\x76\x6b\x2f\x0f\xa2\x46\x23\xdc\x07\xf0\x2a\xb7\xec\x91\xc5\xe1\x0e\x91\x8d\x8f\x78\x67\x59\x51\xc0\x5a\x6c\x5b\x94\xbf\x28\xd8\x0a\xa9\x3f\x9b\xb0\x62\xb5\x4b\xdc\x57\x68\xb1\xb9\xb0\x64\x30\x14\xca\x6f\x1d\x76\x58\xdf\x22\xaf\xa1\x74\xb7\x19\x13\x72\x5e\xff\xd3\x8c\x47\xa4\x44\x9b\x50\x78\x7a\x72\xfa\xc5\x0f\x5d\xd3\xa0\x74\x99\x39\xc8\xa7\x36\xe7\xb3\xc8\x32\x07\xc0\xc9\x63\xfd\x6f\xc2\x3a\x1c\x30\x78\x35\x23\x00\xb1\xf0\x14\x83\x4f\xb1\x48\x2c\x1f\xba\x21\xbf\x31\x6d\x8e\xbb\x54\x4c\x28\xac\x67\x34\xf8\x79\x64\xb8\x59\x7c\x47\x29\xd2\x2c\x0f\xca\xd6\xe8\x19\x2c\xd0\x2d\x55\x71\x6f\x18\x46\x2c\xb8\x5d\x34\xf6\x76\x66\xfb\xe2\x20\x22\x0a\x59\x93\x05\xd5\xc1\x9a\xc5\xce\xe0\xb6\x8d\x33\x1c\x96\xa3\x58\xfd\xcc\xb5\xdc\x87\x11\xdd\xf5\xc5\xe3\x17\x42\x09\xbb\x81\x5d\xfa\x13\x32\x82\xd9\x07\xc2\x7e\x7b\x6b\x4d\xae\xb8\xb8\x0f\xd1\xb3\xdb\x65\xfe\xb4\xb5\xe9\xed\xa3\xe1\xf1\x36\x43\x75\x59\x67\xce\xe7\xda\x39\x8f\xcf\xd7\x93\xd5\x25\x1a\xf0\xf2\xfe\xa2\x3e\x57\x20\xb1\xe2\xa0\xf7\x9d\x3b\x8e\x5f\x9b\xf4\x93\xbc\x9f\x3d\x7f\xed\x61\x3b\xed\xb7\x83\xf4\x77\xa8\xa1\x28\xe0\x89\x32\xe6\x6e\xdd\xbe\xed\xf7\xf2\x2f\xa1\xfd\xd5\x15\xf2\x24\x54\xa7\xd0\x3a\xf9\x57\xa3\x35\xcb\xd2\xe7\x93\xe9\x7f\x98\x3b\x20\x78\x3d\x8e\x7a\x0e\x36\x13\xdf\x18\x7c\xab\x51\xf0\x0b\x62\xff\x5a\x07\xc5\xde\x99\xf2\x47\xe8\x83\xdd\x40\x1e\x87\xde\xbd\xc1\x1d\x74\x20\x93\x0f\x11\x67\xc1\x9f\x7b\x73\xc9\x96\x54\x54\xef\x5f\x60\x49\xe7\xaa\x13\x3b\x98\xef\x8b\x09\xc6\x39\xec\xa7\x4e\x52\xbe\xbe\x1d\x15\xf7\x90\xdf\x76\xd3\x21\x5c\x76\x4d\x78\x00\x8f\xb5\x40\xe9\x87\xa2\x5b\xe7\x39\x5e\x09\x2d\x71\x99\x70\xb4\xe7\x0c\x93\x0c\x6f\x5b\x62\x7e\x0c\xa1\xd1\x66\x8a\x6e\xd2\x0a\xbf\x35\xa7\x4e\xdc\x7b\x0e\xc1\x75\x02\xcd\xfb\xc1\x28\xd9\xc5\x66\xa3\xb2\x21\x02\x12\x49\xbd\x1f\x2e\x28\x6e\xd0\x2d\xa3\x82\x43\x09\xbe\x75\x69\x84\x04\x9a\x83\x59\x0e\x94\x03\xdd\x12\x2a\x95\xd7\x36\xae\x7f\x83\xd5\xc4\x40\x6c\x36\x77\x37\xf7\xcb\xfe\x0e\xa9\x9e\xa8\xbd\x43\x9a\x89\x05\x43\xbe\xd6\x6d\x7c\x97\x34\x13\x2b\x86\x66\xf1\x07\xf3\x7b\xa0\x88\x61\xa6\x8c\x3b\x73\xab\x18\x6a\x6c\x48\xc7\x74\xf4\x5e\x22\x34\x82\x46\x0d\x58\xcc\x27\x4c\xe1\x68\x83\x9b\xe9\x06\xa6\x3a\x23\xed\x41\x15\xf5\x89\x6a\xea\xf3\x1a\x84\x1c\xd7\xd7\x71\xf8\x24\xa9\x1c\xd3\x8f\xd4\x7b\x97\xec\xb4\xb8\x99\x0f\xf1\x05\xe8\x3f\x4d\xd1\x1b\x84\x63\xf4\x2f\x80\xf9\x51\x39\x19\xbb\xaa\x27\xb9\x53\xae\x3a\x4b\x45\xef\x70\x93\xb5\xdf\x7e\xb9\x24\x0c\xd9\xca\xe0\xb3\x4a\xc1\xa1\x0a\x76\x34\x6f\xc6\x91\xc0\xdf\xff\x06\xac\x23\x1f\x95\x29\xf5\x8e\x32\x4d\xf9\x6a\x43\x74\xbb\x90\x84\xd7\x62\x93\xf2\xcc\x53\x82\x02\xca\xe1\xee\x26\xe7\xf7\xa0\x05\x6c\xec\x1b\x13\xaa\x15\x10\x29\xc9\x5e\x2d\x92\x69\x62\xda\x7d\xef\xe6\xd5\x3a\x50\xd9\xfd\x12\x62\xf6\x1e\xd6\xff\x6e\xca\x33\xc4\x3b\x94\x91\xaf\x3f\x75\xd7\x4b\x07\x85\x08\xff\xdf\x4a\x3c\xf2\x65\x0f\xe5\xf0\xce\xe2\x9b\x28\xcc\xfd\xfd\x21\xf5\x9c\x93\x1d\xde\x59\x20\xae\xaa\x8f\xea\x1c\xef\xdd\x7d\xba\xcf\x96\x67\x40\x1c\x46\x2d\x4a\xdc\x74\x23\xd7\x92\xa2\x1a\x53\xed\x8f\xef\xc5\x7b\x3c\xd3\x7e\x5c\xe2\x46\xec\xf0\x8b\x14\x1b\x5b\x84\x6a\xf6\x1f\x02\xc7\xf4\x68\xbb\xf6\x81\xe9\x3d\xfc\x2c\x7a\x51\x14\x74\x33\x50\x5a\x0b\xf7\x01\xe1\xf7\x02\x94\xd7\xf8\x0d\x4a\x27\xb8\xb0\x4f\xff\x68\x52\x27\x1e\xfb\x94\x36\x5e\x78\x92\xd9\x41\xbb\xb3\x50\x5b\x46\x2b\x4c\xad\x64\x0e\x9f\xce\x1e\x86\xc7\xa6\xc2\x59\x75\xf2\xf8\x1a\x9f\x51\x82\x47\x79\x7f\xb6\x28\xe6\x6b\xe3\x92\x3c\x3e\x7b\x4b\x1a\xfa\xd1\xfe\x13\x04\xd7\x64\x03\xf5\x5d\xeb\xe8\x6f\x88\x71\x94\xc7\xaf\x1b\x2e\x69\x85\xe2\xbf\x40\x7c\xf0\x1d\xe9\x3e\xfb\xa2\x08\xe9\x3c\x0f\x8c\x38\x84\x7a\x66\x64\x67\xee\x6a\xef\xab\xd5\x73\x5e\x1c\x39\x90\x36\x01\xa2\x53\x47\xdf\xbb\x4f\xbf\x99\xd7\x95\xe3\xc8\xfd\x0f\x42\x16\xbc\x0b\x88\x1b\xaf\x8b\xc3\xd9\x37\x32\x17\x84\x73\xd4\x9d\xcf\x86\x73\xa0\xc1\x17\x2f\xe3\x45\x71\x5f\x96\xf7\xc9\x7f\x07\x00\xe5\x93\xa6\x89\xb0\x1f\x00\x00"),
 		},
 		"/zgoro_test.lua": &vfsgen۰CompressedFileInfo{
 			name:             "zgoro_test.lua",
@@ -4406,11 +4441,16 @@ var preludeFiles = func() http.FileSystem {
 		fs["/defer.lua"].(os.FileInfo),
 		fs["/dfs.lua"].(os.FileInfo),
 		fs["/int64.lua"].(os.FileInfo),
+		fs["/interrupt.lua"].(os.FileInfo),
+		fs["/kernel.lua"].(os.FileInfo),
 		fs["/math.lua"].(os.FileInfo),
 		fs["/prelude.lua"].(os.FileInfo),
+		fs["/pretty.lua"].(os.FileInfo),
 		fs["/reflect_goro.lua"].(os.FileInfo),
 		fs["/rune.lua"].(os.FileInfo),
+		fs["/session.lua"].(os.FileInfo),
 		fs["/string.lua"].(os.FileInfo),
+		fs["/timer.lua"].(os.FileInfo),
 		fs["/tsys.lua"].(os.FileInfo),
 		fs["/tsys_test.lua"].(os.FileInfo),
 		fs["/tutil.lua"].(os.FileInfo),
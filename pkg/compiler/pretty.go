@@ -0,0 +1,37 @@
+package compiler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// setPretty is :set, for the limits on how results are
+// shown; see pretty.lua. With no argument it lists them;
+// `:set depth 3` sets one. It returns the limits.
+func setPretty(lvm *LuaVm, arg string) (string, error) {
+	run := ""
+	switch f := strings.Fields(arg); len(f) {
+	case 0:
+	case 2:
+		switch f[0] {
+		case "depth", "elems", "width":
+		default:
+			return "", fmt.Errorf("unknown setting '%s'; have depth, elems and width", f[0])
+		}
+		n, err := strconv.Atoi(f[1])
+		if err != nil || n < 0 {
+			return "", fmt.Errorf("bad %s '%s': want a count, or 0 for no limit", f[0], f[1])
+		}
+		run = fmt.Sprintf(`__gijit_prettySet("%s", %d); `, f[0], n)
+	default:
+		return "", fmt.Errorf("use :set <depth|elems|width> <n>")
+	}
+	t := lvm.goro.newTicket(run+`__gijit_prettyShown = __gijit_prettyShow()`, false)
+	t.varname["__gijit_prettyShown"] = nil
+	t.gettyp = GetString
+	if err := t.Do(); err != nil {
+		return "", err
+	}
+	return t.varname["__gijit_prettyShown"].(string), nil
+}
@@ -0,0 +1,91 @@
+package compiler
+
+import (
+	"context"
+	"testing"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1710ResultsShowInGoSyntax(t *testing.T) {
+
+	cv.Convey(`the value of an expression at the prompt should show as a Go literal, within the limits that :set gives`, t, func() {
+
+		in, err := NewInterpreter(nil)
+		panicOn(err)
+		defer in.Close()
+		ctx := context.Background()
+		show := func(src string) string {
+			res, err := in.Eval(ctx, src)
+			panicOn(err)
+			return res.Text
+		}
+
+		cv.So(show(`1 + 2`), cv.ShouldEqual, "3")
+		cv.So(show(`uint8(7)`), cv.ShouldEqual, "7")
+		cv.So(show(`"a\tb"`), cv.ShouldEqual, `"a\tb"`)
+
+		show(`type T struct{A int; B string; C []float64}`)
+		show(`x := T{1, "x", []float64{1.5}}`)
+		cv.So(show(`x`), cv.ShouldEqual, `T{A: 1, B: "x", C: []float64{1.5}}`)
+		show(`px := &x`)
+		cv.So(show(`px`), cv.ShouldEqual, `&T{A: 1, B: "x", C: []float64{1.5}}`)
+		show(`var i interface{} = x`)
+		cv.So(show(`i`), cv.ShouldEqual, `T{A: 1, B: "x", C: []float64{1.5}}`)
+
+		show(`s := []int{1, 2, 3}`)
+		cv.So(show(`s`), cv.ShouldEqual, `[]int{1, 2, 3}`)
+		show(`a := [2]string{"a", "b"}`)
+		cv.So(show(`a`), cv.ShouldEqual, `[2]string{"a", "b"}`)
+		show(`m := map[int]string{3: "c", 1: "a", 10: "j"}`)
+		cv.So(show(`m`), cv.ShouldEqual, `map[int]string{1: "a", 3: "c", 10: "j"}`)
+
+		// elements of a composite leave off their type.
+		show(`ts := []T{x}`)
+		cv.So(show(`ts`), cv.ShouldEqual, `[]T{{A: 1, B: "x", C: []float64{1.5}}}`)
+
+		show(`var np *T`)
+		cv.So(show(`np`), cv.ShouldEqual, `(*T)(nil)`)
+		show(`var ns []int`)
+		cv.So(show(`ns`), cv.ShouldEqual, `[]int(nil)`)
+		show(`var nm map[string]int`)
+		cv.So(show(`nm`), cv.ShouldEqual, `map[string]int(nil)`)
+		show(`var nf func(int) bool`)
+		cv.So(show(`nf`), cv.ShouldEqual, `(func(int) bool)(nil)`)
+		show(`var e error`)
+		cv.So(show(`e`), cv.ShouldEqual, `nil`)
+
+		// too wide for a line.
+		show(`type N struct{ T T; P *T; Q int }`)
+		show(`n := N{x, &x, 7}`)
+		cv.So(show(`n`), cv.ShouldEqual, `N{
+    T: T{A: 1, B: "x", C: []float64{1.5}},
+    P: &T{A: 1, B: "x", C: []float64{1.5}},
+    Q: 7,
+}`)
+
+		// the limits.
+		out, err := setPretty(in.lvm, "")
+		panicOn(err)
+		cv.So(out, cv.ShouldEqual, "depth 6\nelems 100\nwidth 80")
+
+		_, err = setPretty(in.lvm, " elems 2")
+		panicOn(err)
+		cv.So(show(`s`), cv.ShouldEqual, `[]int{1, 2, ...(1 more)}`)
+
+		_, err = setPretty(in.lvm, " depth 1")
+		panicOn(err)
+		cv.So(show(`x`), cv.ShouldEqual, `T{A: 1, B: "x", C: []float64{...}}`)
+
+		_, err = setPretty(in.lvm, " width 0")
+		panicOn(err)
+		_, err = setPretty(in.lvm, " depth 0")
+		panicOn(err)
+		cv.So(show(`n`), cv.ShouldEqual, `N{T: T{A: 1, B: "x", C: []float64{1.5}}, P: &T{A: 1, B: "x", C: []float64{1.5}}, Q: 7}`)
+
+		_, err = setPretty(in.lvm, " color 2")
+		cv.So(err, cv.ShouldNotBeNil)
+		_, err = setPretty(in.lvm, " depth -1")
+		cv.So(err, cv.ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,253 @@
+package compiler
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/gijit/gi/pkg/ast"
+	"github.com/gijit/gi/pkg/printer"
+	"github.com/gijit/gi/pkg/token"
+	"github.com/gijit/gi/pkg/types"
+)
+
+// Redefinition at the prompt.
+//
+// Lua looks up a top-level func by name on each call,
+// so redefining f is seen at once by a g that calls
+// f. Not so for types: after `type P struct{...}` is
+// entered again, funcs and methods whose signatures
+// name P are still bound to the old P, in the type
+// checker and in Lua alike, and the methods of P are
+// gone. So we keep the source of each top-level func,
+// method and type, and on redefinition recompile those
+// that depend on what changed, in the order they were
+// first entered. Values can't be recompiled; we warn
+// about globals left holding a value of an old type.
+
+// topDecl is a top-level func, method or type
+// declaration, as last entered.
+type topDecl struct {
+	id  string // declID
+	key string // declKey, the form DceDeps uses
+	src string
+}
+
+// declKey names o the way DceDeps does: pkgpath.Name,
+// with "___tilde_" appended for methods.
+func declKey(o types.Object) string {
+	qualifiedName := o.Pkg().Path() + "." + o.Name()
+	if f, ok := o.(*types.Func); ok && f.Type().(*types.Signature).Recv() != nil {
+		//return qualifiedName+"~"
+		return qualifiedName + "___tilde_"
+	}
+	return qualifiedName
+}
+
+// declID is unique among the top-level declarations
+// of a package, telling apart methods of the same
+// name on different types.
+func declID(o types.Object) string {
+	if f, ok := o.(*types.Func); ok {
+		return f.FullName()
+	}
+	return o.Pkg().Path() + "." + o.Name()
+}
+
+// usedDecls finds the package-level names of pkg that
+// node refers to. DceDeps lists only what the generated
+// code needs, and so misses a type that appears
+// only in a signature.
+func usedDecls(node ast.Node, info *types.Info, pkg *types.Package) (deps []string) {
+	ast.Inspect(node, func(n ast.Node) bool {
+		id, ok := n.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		o := info.Uses[id]
+		if o == nil || o.Pkg() != pkg {
+			return true
+		}
+		switch x := o.(type) {
+		case *types.Func:
+			if x.Type().(*types.Signature).Recv() == nil && o.Parent() != pkg.Scope() {
+				return true
+			}
+		case *types.TypeName, *types.Var, *types.Const:
+			if o.Parent() != pkg.Scope() {
+				return true
+			}
+		default:
+			return true
+		}
+		deps = append(deps, declKey(o))
+		return true
+	})
+	return
+}
+
+// mergeDeps returns the sorted union of a and b.
+func mergeDeps(a, b []string) (deps []string) {
+	seen := make(map[string]bool)
+	for _, d := range append(append([]string{}, a...), b...) {
+		if !seen[d] {
+			seen[d] = true
+			deps = append(deps, d)
+		}
+	}
+	sort.Strings(deps)
+	return
+}
+
+// noteTopDecls records the func, method and type
+// declarations in file, which has just been compiled.
+// It returns the keys of those that replace an earlier
+// declaration, and the ids of all of them.
+func (pk *IncrPkg) noteTopDecls(file *ast.File) (redefined []string, fresh map[string]bool) {
+	fresh = make(map[string]bool)
+	defs := pk.Arch.TypesInfo.Defs
+	note := func(name *ast.Ident, node ast.Node) {
+		o := defs[name]
+		if o == nil || isBlank(name) || name.Name == "init" {
+			return
+		}
+		var by bytes.Buffer
+		if printer.Fprint(&by, pk.fileSet, node) != nil {
+			return
+		}
+		d := topDecl{id: declID(o), key: declKey(o), src: by.String()}
+		for i := range pk.topDecls {
+			if pk.topDecls[i].id == d.id {
+				pk.topDecls = append(pk.topDecls[:i], pk.topDecls[i+1:]...)
+				redefined = append(redefined, d.key)
+				break
+			}
+		}
+		pk.topDecls = append(pk.topDecls, d)
+		fresh[d.id] = true
+	}
+	for _, node := range file.Nodes {
+		switch d := node.(type) {
+		case *ast.FuncDecl:
+			note(d.Name, d)
+		case *ast.GenDecl:
+			if d.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range d.Specs {
+				ts := spec.(*ast.TypeSpec)
+				note(ts.Name, &ast.GenDecl{Tok: token.TYPE, Specs: []ast.Spec{ts}})
+			}
+		}
+	}
+	return
+}
+
+// dependents lists, in entry order, the declarations
+// that use any of changed, directly or through
+// one another, leaving out those in fresh.
+func (pk *IncrPkg) dependents(changed []string, fresh map[string]bool) (deps []topDecl) {
+	stale := make(map[string]bool)
+	for _, k := range changed {
+		stale[k] = true
+	}
+	picked := make(map[string]bool)
+	for again := true; again; {
+		again = false
+		for _, d := range pk.topDecls {
+			if fresh[d.id] || picked[d.id] {
+				continue
+			}
+			for _, dep := range pk.Arch.DeclDeps[d.id] {
+				if stale[dep] {
+					picked[d.id] = true
+					stale[d.key] = true
+					again = true
+					break
+				}
+			}
+		}
+	}
+	for _, d := range pk.topDecls {
+		if picked[d.id] {
+			deps = append(deps, d)
+		}
+	}
+	return
+}
+
+// recompileDependents translates again the declarations
+// that depend on redefined, returning the Lua for those
+// that still type check, and warnings for those that don't.
+func (tr *IncrState) recompileDependents(redefined []string, fresh map[string]bool) (lua []byte, warnings []string) {
+	tr.recompiling = true
+	defer func() { tr.recompiling = false }()
+
+	for _, d := range tr.CurPkg.dependents(redefined, fresh) {
+		by, err := tr.TrWithPrepend([]byte(d.src), false)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("warning: '%s' no longer compiles after the redefinition, and keeps its old definition: %v", d.id, err))
+			continue
+		}
+		lua = append(lua, by...)
+	}
+	return
+}
+
+// obsoleteValues warns about the user globals whose
+// type involves a type since redefined.
+func (pk *IncrPkg) obsoleteValues() (warnings []string) {
+	if pk.Arch == nil {
+		return
+	}
+	scope := pk.Arch.Pkg.Scope()
+	for _, nm := range userGlobals(scope) {
+		if old := oldNamed(scope.Lookup(nm).Type(), scope, make(map[types.Type]bool)); old != nil {
+			warnings = append(warnings, fmt.Sprintf("warning: '%s' holds a value of type '%s' from before that type was redefined", nm, old.Obj().Name()))
+		}
+	}
+	return
+}
+
+// oldNamed finds a named type in typ that is no
+// longer what its name refers to in scope.
+func oldNamed(typ types.Type, scope *types.Scope, seen map[types.Type]bool) *types.Named {
+	if seen[typ] {
+		return nil
+	}
+	seen[typ] = true
+	switch t := typ.(type) {
+	case *types.Named:
+		o := t.Obj()
+		if o.Pkg() == nil || o.Pkg().Scope() != scope {
+			return nil
+		}
+		if o.Parent() != nil && o.Parent() != scope {
+			// declared inside a func.
+			return nil
+		}
+		if scope.Lookup(o.Name()) != o {
+			return t
+		}
+	case *types.Pointer:
+		return oldNamed(t.Elem(), scope, seen)
+	case *types.Slice:
+		return oldNamed(t.Elem(), scope, seen)
+	case *types.Array:
+		return oldNamed(t.Elem(), scope, seen)
+	case *types.Chan:
+		return oldNamed(t.Elem(), scope, seen)
+	case *types.Map:
+		if old := oldNamed(t.Key(), scope, seen); old != nil {
+			return old
+		}
+		return oldNamed(t.Elem(), scope, seen)
+	case *types.Struct:
+		for i := 0; i < t.NumFields(); i++ {
+			if old := oldNamed(t.Field(i).Type(), scope, seen); old != nil {
+				return old
+			}
+		}
+	}
+	return nil
+}
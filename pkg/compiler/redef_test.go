@@ -68,3 +68,43 @@ func Test301RedefinitionOfStruct(t *testing.T) {
 		//fmt.Printf("\n pass j=%v complete.\n", j)
 	})
 }
+
+func Test302RedefinitionRecompilesDependents(t *testing.T) {
+
+	cv.Convey(`when a struct type is redefined, funcs and methods that use it should be recompiled against the new type, and globals still holding the old type should be reported`, t, func() {
+
+		vm, err := NewLuaVmWithPrelude(nil)
+		panicOn(err)
+		defer vm.Close()
+		inc := NewIncrState(vm, nil)
+
+		code := `
+type P struct { X int }
+func show(p P) int { return p.X }
+func (p P) Get() int { return p.X }
+type Q struct { P; Z int }
+func (q *Q) Sum() int { return q.X + q.Z }
+p := P{X:3}
+`
+		LuaRunAndReport(vm, string(inc.trMust([]byte(code))))
+		cv.So(inc.CurPkg.obsoleteValues(), cv.ShouldBeEmpty)
+
+		LuaRunAndReport(vm, string(inc.trMust([]byte(`type P struct { X, Y int }`))))
+
+		// show, Get, Q and Sum now see the new P.
+		code = `
+q := P{X:4, Y:5}
+c := q.Get()
+d := show(q)
+qq := &Q{P:q, Z:10}
+e := qq.Sum()
+`
+		LuaRunAndReport(vm, string(inc.trMust([]byte(code))))
+		LuaMustInt64(vm, "c", 4)
+		LuaMustInt64(vm, "d", 4)
+		LuaMustInt64(vm, "e", 14)
+
+		cv.So(inc.CurPkg.obsoleteValues(), cv.ShouldResemble, []string{
+			"warning: 'p' holds a value of type 'P' from before that type was redefined"})
+	})
+}
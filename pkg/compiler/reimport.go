@@ -0,0 +1,241 @@
+package compiler
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/gijit/gi/pkg/types"
+)
+
+// Reloading source-imported packages.
+//
+// A package imported from source is compiled once and
+// its Archive cached in Session.Archives, so an edit to
+// its files would otherwise go unseen until restart. We
+// watch the directory of each such package; when a .go
+// file there changes, the next line at the prompt first
+// drops the cached archives of that package and of the
+// source packages built on it, then imports them again
+// under the names the prompt gave them. That recompiles
+// them, re-runs __init(), and rebinds the package table.
+// :reimport <path> does the same on demand.
+
+// srcWatch tracks the source-imported packages.
+type srcWatch struct {
+	mut     sync.Mutex
+	dirs    map[string]string // import path -> directory
+	changed map[string]string // import path -> changed file
+}
+
+// noteSourcePackage records that path was just built
+// from the files in dir, and starts watching dir.
+func (ic *IncrState) noteSourcePackage(path, dir string) {
+	w := &ic.srcWatch
+	w.mut.Lock()
+	defer w.mut.Unlock()
+
+	if w.dirs == nil {
+		w.dirs = make(map[string]string)
+	}
+	w.dirs[path] = dir
+
+	if ic.cfg.NoWatch {
+		return
+	}
+	s := ic.Session
+	if s.Watcher == nil {
+		watcher, err := fsnotify.NewWatcher()
+		if err != nil {
+			ic.warnf("warning: cannot watch source packages for changes: %v\n", err)
+			return
+		}
+		s.Watcher = watcher
+		go ic.watchSources(watcher)
+	}
+	if err := s.Watcher.Add(dir); err != nil {
+		ic.warnf("warning: cannot watch '%s' for changes: %v\n", dir, err)
+	}
+}
+
+// watchSources notes which packages have changed,
+// until watcher is closed. The reload itself waits
+// for the next line, so it never races with one.
+func (ic *IncrState) watchSources(watcher *fsnotify.Watcher) {
+	w := &ic.srcWatch
+	for {
+		select {
+		case ev, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			if !isSourceChange(ev) {
+				continue
+			}
+			dir := filepath.Dir(ev.Name)
+			w.mut.Lock()
+			for path, d := range w.dirs {
+				if d == dir {
+					if w.changed == nil {
+						w.changed = make(map[string]string)
+					}
+					w.changed[path] = ev.Name
+				}
+			}
+			w.mut.Unlock()
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			ic.warnf("watcher error: %v\n", err)
+		}
+	}
+}
+
+// isSourceChange is true when ev creates, writes,
+// removes or renames a Go source file. Editors'
+// dot files are ignored.
+func isSourceChange(ev fsnotify.Event) bool {
+	if ev.Op&(fsnotify.Create|fsnotify.Write|fsnotify.Remove|fsnotify.Rename) == 0 || filepath.Base(ev.Name)[0] == '.' {
+		return false
+	}
+	return strings.HasSuffix(ev.Name, ".go") || strings.HasSuffix(ev.Name, ".inc.gijit")
+}
+
+// ReloadChanged reimports the source packages whose
+// files have changed since it was last called, and
+// returns the Lua that rebinds them.
+func (ic *IncrState) ReloadChanged() ([]byte, error) {
+	w := &ic.srcWatch
+	w.mut.Lock()
+	changed := w.changed
+	w.changed = nil
+	w.mut.Unlock()
+
+	var paths []string
+	for path := range changed {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var lua []byte
+	for _, path := range paths {
+		ic.warnf("reloading package '%s' after a change to '%s'\n", path, filepath.Base(changed[path]))
+		by, err := ic.Reimport(path)
+		if err != nil {
+			return lua, fmt.Errorf("could not reload package '%s': %v", path, err)
+		}
+		lua = append(lua, by...)
+	}
+	return lua, nil
+}
+
+// Reimport compiles the source package path again,
+// along with the source packages that import it, and
+// returns the Lua that re-runs their __init() and
+// rebinds them under the names the prompt imported
+// them as, or again into the prompt's scope for a dot
+// import. A package the prompt hasn't imported
+// directly is rebuilt when next imported.
+func (ic *IncrState) Reimport(path string) ([]byte, error) {
+	ic.srcWatch.mut.Lock()
+	_, known := ic.srcWatch.dirs[path]
+	ic.srcWatch.mut.Unlock()
+	if !known {
+		return nil, fmt.Errorf("'%s' is not a source-imported package", path)
+	}
+	stale := ic.dropSourceArchives(path)
+
+	if ic.CurPkg.Arch == nil {
+		return nil, nil
+	}
+	mainPkg := ic.CurPkg.Arch.Pkg
+
+	// the import specs below re-add the new packages.
+	imports := mainPkg.Imports()
+	var keep []*types.Package
+	for _, p := range imports {
+		if !stale[p.Path()] {
+			keep = append(keep, p)
+		}
+	}
+
+	var src bytes.Buffer
+	scope := mainPkg.Scope()
+	dot := make(map[string]bool)
+	for _, nm := range scope.Names() {
+		switch o := scope.Lookup(nm).(type) {
+		case *types.PkgName:
+			if stale[o.Imported().Path()] {
+				fmt.Fprintf(&src, "import %s %q\n", nm, o.Imported().Path())
+			}
+		default:
+			// a dot import puts the package's own
+			// objects into ours.
+			p := o.Pkg()
+			if p != nil && p != mainPkg && stale[p.Path()] && !dot[p.Path()] {
+				dot[p.Path()] = true
+				fmt.Fprintf(&src, "import . %q\n", p.Path())
+			}
+		}
+	}
+	if src.Len() == 0 {
+		return nil, nil
+	}
+
+	mainPkg.SetImports(keep)
+	lua, err := ic.Tr(src.Bytes())
+	if err != nil {
+		mainPkg.SetImports(imports)
+		return nil, err
+	}
+	return lua, nil
+}
+
+// dropSourceArchives forgets the compiled source
+// package path, and every source package that imports
+// it, directly or not, so the next import of any of
+// them compiles it afresh. It returns their paths.
+func (ic *IncrState) dropSourceArchives(path string) (stale map[string]bool) {
+	ic.srcWatch.mut.Lock()
+	dirs := ic.srcWatch.dirs
+	ic.srcWatch.mut.Unlock()
+
+	s := ic.Session
+	stale = map[string]bool{path: true}
+	for again := true; again; {
+		again = false
+		for _, a := range s.Archives {
+			if stale[a.ImportPath] {
+				continue
+			}
+			if _, ok := dirs[a.ImportPath]; !ok {
+				continue
+			}
+			for _, imp := range a.Imports {
+				if stale[imp] {
+					stale[a.ImportPath] = true
+					again = true
+					break
+				}
+			}
+		}
+	}
+
+	for k, a := range s.Archives {
+		if stale[k] || stale[a.ImportPath] {
+			delete(s.Archives, k)
+			delete(s.Types, k)
+			delete(ic.CurPkg.importContext.Packages, k)
+		}
+	}
+	for p := range stale {
+		delete(s.Types, p)
+		delete(ic.CurPkg.importContext.Packages, p)
+	}
+	return
+}
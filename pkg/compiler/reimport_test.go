@@ -0,0 +1,104 @@
+package compiler
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1650ReimportChangedSourcePackage(t *testing.T) {
+
+	cv.Convey(`a source-imported package should be rebuilt when its files change on disk, or on :reimport`, t, func() {
+
+		// a package under this one, so that it is on the GOPATH.
+		dir, err := ioutil.TempDir(".", "spkg_reimport")
+		panicOn(err)
+		defer os.RemoveAll(dir)
+		path := "github.com/gijit/gi/pkg/compiler/" + filepath.Base(dir)
+		write := func(n int) {
+			src := fmt.Sprintf("package spkg_reimport\n\nfunc Fish(numPole int) int {\n\treturn numPole * %d\n}\n", n)
+			panicOn(ioutil.WriteFile(filepath.Join(dir, "fish.go"), []byte(src), 0644))
+		}
+		write(2)
+
+		vm, err := NewLuaVmWithPrelude(nil)
+		panicOn(err)
+		defer vm.Close()
+		inc := NewIncrState(vm, nil)
+
+		translation := inc.trMust([]byte(`import spk "` + path + `"; a := spk.Fish(3)`))
+		LuaRunAndReport(vm, string(translation))
+		LuaMustInt64(vm, "a", 6)
+
+		// the watcher notes the change; the reload
+		// waits for ReloadChanged.
+		write(3)
+		var lua []byte
+		for i := 0; i < 100 && len(lua) == 0; i++ {
+			time.Sleep(50 * time.Millisecond)
+			lua, err = inc.ReloadChanged()
+			panicOn(err)
+		}
+		cv.So(len(lua), cv.ShouldBeGreaterThan, 0)
+		LuaRunAndReport(vm, string(lua))
+
+		translation = inc.trMust([]byte(`b := spk.Fish(3)`))
+		LuaRunAndReport(vm, string(translation))
+		LuaMustInt64(vm, "b", 9)
+
+		write(4)
+		lua, err = inc.Reimport(path)
+		panicOn(err)
+		LuaRunAndReport(vm, string(lua))
+
+		translation = inc.trMust([]byte(`c := spk.Fish(3)`))
+		LuaRunAndReport(vm, string(translation))
+		LuaMustInt64(vm, "c", 12)
+
+		_, err = inc.Reimport("fmt")
+		cv.So(err, cv.ShouldNotBeNil)
+	})
+}
+
+func Test1651ReimportDotImportedPackage(t *testing.T) {
+
+	cv.Convey(`a dot-imported source package should have its names bound afresh on :reimport`, t, func() {
+
+		dir, err := ioutil.TempDir(".", "spkg_reimport")
+		panicOn(err)
+		defer os.RemoveAll(dir)
+		path := "github.com/gijit/gi/pkg/compiler/" + filepath.Base(dir)
+		write := func(n int) {
+			src := fmt.Sprintf("package spkg_reimport\n\ntype Pole struct{ N int }\n\nfunc Fish(numPole int) int {\n\treturn numPole * %d\n}\n", n)
+			panicOn(ioutil.WriteFile(filepath.Join(dir, "fish.go"), []byte(src), 0644))
+		}
+		write(2)
+
+		cfg := NewGIConfig()
+		cfg.NoWatch = true
+		vm, err := NewLuaVmWithPrelude(cfg)
+		panicOn(err)
+		defer vm.Close()
+		inc := NewIncrState(vm, cfg)
+
+		translation := inc.trMust([]byte(`import . "` + path + `"; a := Fish(3)`))
+		LuaRunAndReport(vm, string(translation))
+		LuaMustInt64(vm, "a", 6)
+
+		write(3)
+		lua, err := inc.Reimport(path)
+		panicOn(err)
+		cv.So(len(lua), cv.ShouldBeGreaterThan, 0)
+		LuaRunAndReport(vm, string(lua))
+
+		translation = inc.trMust([]byte(`b := Fish(3); p := Pole{N: 4}; c := p.N`))
+		LuaRunAndReport(vm, string(translation))
+		LuaMustInt64(vm, "b", 9)
+		LuaMustInt64(vm, "c", 4)
+	})
+}
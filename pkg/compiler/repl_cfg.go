@@ -17,8 +17,20 @@ type GIConfig struct {
 	NoLiner        bool // for under test/emacs
 	NoPrelude      bool
 	NoLuar         bool
+	NoWatch        bool // don't reload source imports when they change
+
+	CacheDir   string // where compiled source imports are kept; "" for nowhere
+	ClearCache bool   // gi -clear-cache: empty CacheDir, then exit
 
 	Dev bool // dev mode, don't use statically cached prelude
+
+	KernelConnFile string // gi -kernel: serve Jupyter, not a prompt
+
+	EvalSrc string   // gi -e: evaluate this, then exit
+	RunArgs []string // gi run: the .go files or package dir, then the program's args
+
+	BuildArgs []string // gi build: the .go files or package dir to bundle
+	BuildOut  string   // gi build -o: the .lua file to write
 }
 
 var defaultTestMode bool // set to true by init() for tests, in repl_test.go.
@@ -39,6 +51,11 @@ func (c *GIConfig) DefineFlags(fs *flag.FlagSet) {
 	fs.BoolVar(&c.IsTestMode, "t", false, "load test mode functions and types")
 	fs.BoolVar(&c.NoLiner, "no-liner", false, "turn off liner, e.g. under emacs")
 	fs.BoolVar(&c.NoPrelude, "np", false, "no prelude; skip loading the prelude .lua files and Luar. implies -r raw mode too.")
+	fs.StringVar(&c.KernelConnFile, "kernel", "", "run as a Jupyter kernel on the sockets named in this connection file, instead of at a prompt. Jupyter supplies the file; see the README for a kernel.json.")
+	fs.BoolVar(&c.NoWatch, "no-watch", false, "don't watch source-imported packages for changes; use :reimport to reload one.")
+	fs.StringVar(&c.CacheDir, "cache", DefaultCacheDir(), "directory to cache compiled source-imported packages in, so that the next start need not compile them again. Empty for no cache.")
+	fs.BoolVar(&c.ClearCache, "clear-cache", false, "remove the cache of compiled source-imported packages, then exit.")
+	fs.StringVar(&c.EvalSrc, "e", "", "evaluate this Go source, as if typed at the prompt, then exit.")
 	fs.BoolVar(&c.Dev, "d", false, "dev mode uses the pkg/compiler/prelude/*.lua files, skipping the statically cached pkg/compiler/prelude_static.go version.")
 }
 
@@ -50,6 +67,18 @@ func (c *GIConfig) ValidateConfig() error {
 		c.RawLua = true
 	}
 
+	if c.EvalSrc != "" || c.RunArgs != nil || c.BuildArgs != nil {
+		// gi -e, gi run and gi build are for scripts.
+		c.Quiet = true
+		c.NoLiner = true
+	}
+
+	if c.KernelConnFile != "" {
+		// no terminal to talk to.
+		c.Quiet = true
+		c.NoLiner = true
+	}
+
 	if c.PreludePath == "" {
 		// just use the statically embedded prelude from build time.
 	}
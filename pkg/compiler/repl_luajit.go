@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"runtime"
 	"strconv"
 	"strings"
@@ -167,6 +168,7 @@ func NewRepl(cfg *GIConfig) *Repl {
 
 	if !r.cfg.NoLiner {
 		r.prompter = NewPrompter(r.goPrompt)
+		r.prompter.prompter.SetWordCompleter(r.complete)
 		for i := range r.history {
 			r.prompter.prompter.AppendHistory(r.history[i])
 		}
@@ -178,6 +180,22 @@ func NewRepl(cfg *GIConfig) *Repl {
 }
 
 func (r *Repl) Loop() {
+	if r.cfg.KernelConnFile != "" {
+		err := r.ServeKernel(r.cfg.KernelConnFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "gi -kernel: '%v'\n", err)
+		}
+		return
+	}
+	if r.cfg.BuildArgs != nil {
+		os.Exit(r.BuildBundle())
+	}
+	if r.cfg.EvalSrc != "" || r.cfg.RunArgs != nil {
+		status := r.RunScript()
+		// os.Exit won't flush what Lua's print buffered.
+		LuaRun(r.lvm, `io.stdout:flush()`, true)
+		os.Exit(status)
+	}
 	for {
 		src, err := r.Read()
 		if err == io.EOF {
@@ -346,7 +364,7 @@ readtop:
 		r.displayCmd(`lst`)
 		goto readtop
 	case ":stacks":
-		showLuaStacks(r.lvm.vm)
+		showLuaStacks(r.lvm.vm, r.inc.srcMaps.goPositions)
 		goto readtop
 	case ":r":
 		r.cfg.RawLua = true
@@ -406,17 +424,108 @@ these special commands.
  :rm 3-4         Remove commands 3-4 from history.
  :do <path>      Run dofile(path) on a .lua file.
  :source <path>  Re-play Go code from a file.
+ :save <path>    Save declarations and variables to a file.
+ :load <path>    Restore a session saved with :save.
+ :export <dir>   Write the session out as a Go program.
+ :type <expr>    Show the static type of a Go expression.
+ :describe <T>   Show type T and the method sets of T and *T.
+ :doc <pkg.Name> Show the documentation of an imported package or name.
+ :reimport <pkg> Reload a source-imported package from disk.
+ :set depth 3    Limit how results are shown: depth, elems, width.
  :ls             List all global user variables.
  :gls            List all global variables (include __ prefixed).
  :stacks         Show lua stacks for each coroutine.
  = 3 + 4         Calculate the expression after the '=' (one line).
  ==              Multiple entry calculator mode. ':' to exit.
+ <tab>           Complete a name, package member, field or method.
  import "fmt"    Import the binary, pre-compiled package.
  ctrl-d to exit  History is saved in ~/.gitit.hist
 `)
 		return "", nil
 	}
 
+	if strings.HasPrefix(low, ":save ") || strings.HasPrefix(low, ":load ") {
+		// keep the case of the path, unlike low.
+		fn := strings.TrimSpace(string(cmd[5:]))
+		home := os.Getenv("HOME")
+		if home != "" {
+			fn = strings.Replace(fn, "~/", home+"/", 1)
+		}
+		if low[:5] == ":save" {
+			err = r.inc.SaveSession(fn)
+			if err == nil {
+				fmt.Printf("session saved to '%s'.\n", fn)
+			}
+		} else {
+			err = r.inc.LoadSession(fn)
+			if err == nil {
+				fmt.Printf("session loaded from '%s'.\n", fn)
+			}
+		}
+		if err != nil {
+			fmt.Printf("error: '%v'\n", err)
+		}
+		return "", nil
+	}
+
+	if strings.HasPrefix(low, ":export ") {
+		// keep the case of the path, unlike low.
+		dir := strings.TrimSpace(string(cmd[len(":export "):]))
+		home := os.Getenv("HOME")
+		if home != "" {
+			dir = strings.Replace(dir, "~/", home+"/", 1)
+		}
+		err = r.inc.ExportProgram(dir)
+		if err != nil {
+			fmt.Printf("error: '%v'\n", err)
+		} else {
+			fmt.Printf("program written to '%s'.\n", filepath.Join(dir, "main.go"))
+		}
+		return "", nil
+	}
+
+	if strings.HasPrefix(low, ":type ") || strings.HasPrefix(low, ":describe ") || strings.HasPrefix(low, ":doc ") {
+		// keep the case of the argument, unlike low.
+		what := strings.Fields(low)[0]
+		arg := strings.TrimSpace(string(cmd[len(what):]))
+		var out string
+		switch what {
+		case ":type":
+			out, err = r.inc.TypeOf(arg)
+		case ":describe":
+			out, err = r.inc.Describe(arg)
+		case ":doc":
+			out, err = r.inc.Doc(arg)
+		}
+		if err != nil {
+			fmt.Printf("error: '%v'\n", err)
+		} else {
+			fmt.Printf("%s\n", strings.TrimRight(out, "\n"))
+		}
+		return "", nil
+	}
+
+	if low == ":set" || strings.HasPrefix(low, ":set ") {
+		out, err := setPretty(r.lvm, low[len(":set"):])
+		if err != nil {
+			fmt.Printf("error: '%v'\n", err)
+		} else {
+			fmt.Printf("%s\n", out)
+		}
+		return "", nil
+	}
+
+	if strings.HasPrefix(low, ":reimport ") {
+		// keep the case of the path, unlike low.
+		path := strings.Trim(strings.TrimSpace(string(cmd[len(":reimport"):])), `"`)
+		lua, err := r.inc.Reimport(path)
+		if err == nil {
+			fmt.Printf("reimported package '%s'.\n", path)
+		}
+		r.runReimport(lua, err)
+		return "", nil
+	}
+
 	r.isDo = strings.HasPrefix(low, ":do")
 	r.isSource = strings.HasPrefix(low, ":source")
 	if r.isDo || r.isSource {
@@ -429,7 +538,8 @@ these special commands.
 			nm = "source Go files"
 		}
 
-		files := strings.TrimSpace(low[off:])
+		// keep the case of the paths, unlike low.
+		files := strings.TrimSpace(string(cmd[off:]))
 		splt := strings.Split(files, ",")
 		var final, show []string
 		for i := range splt {
@@ -449,10 +559,13 @@ these special commands.
 			if r.isDo {
 				err = LuaDoUserFiles(r.lvm, final)
 			} else {
-				by, err = sourceGoFiles(final)
+				var sourced []sourcedFile
+				by, sourced, err = sourceGoFiles(final)
 				if err != nil {
 					fmt.Printf("error during %s: '%v'\n", action, err)
 				} else {
+					// so type errors name the files.
+					r.inc.sourced = sourced
 					src = string(by)
 					return src, nil
 				}
@@ -506,7 +619,15 @@ func (r *Repl) Eval(src string) error {
 		r.prevSrc = ""
 
 		r.setPrompt()
+		r.reloadChanged()
+		r.inc.histLine = len(r.history) + 1
 		translation, err := TranslateAndCatchPanic(r.inc, []byte(src))
+		r.inc.sourced = nil
+		if _, ok := err.(TypeErrors); ok {
+			// they show the source already.
+			fmt.Println(err)
+			return err
+		}
 		if err != nil {
 			fmt.Printf("oops: '%v' on input '%s'\n", err, strings.TrimSpace(src))
 			translation = "\n"
@@ -545,10 +666,12 @@ func (r *Repl) Eval(src string) error {
 	}
 	r.t0 = time.Now()
 
-	useEval := !r.cfg.RawLua
-	err := LuaRun(r.lvm, use, useEval)
+	err := r.runLua(use)
 	if err != nil {
-		fmt.Printf("error from LuaRun: supplied lua with: '%s'\nlua stack:\n%v\n", use[:len(use)-1], err)
+		if verb.Verbose {
+			fmt.Printf("supplied lua with: '%s'\n", use[:len(use)-1])
+		}
+		fmt.Printf("error from LuaRun: %v\n", r.inc.srcMaps.goPositions(err.Error()))
 		return nil
 	}
 	r.t1 = time.Now()
@@ -559,13 +682,47 @@ func (r *Repl) Eval(src string) error {
 	return nil
 }
 
+// reloadChanged reimports the source packages
+// edited on disk since the last line, if any.
+func (r *Repl) reloadChanged() {
+	lua, err := r.inc.ReloadChanged()
+	r.runReimport(lua, err)
+}
+
+// runReimport runs the Lua from a reimport.
+func (r *Repl) runReimport(lua []byte, err error) {
+	if len(lua) > 0 {
+		if err := r.runLua(string(lua)); err != nil {
+			fmt.Printf("error: '%v'\n", err)
+		}
+	}
+	if err != nil {
+		fmt.Printf("error: '%v'\n", err)
+	}
+}
+
+// runLua runs use, the translation of a line from
+// the prompt (or raw Lua under :r), on the VM.
+func (r *Repl) runLua(use string) error {
+	useEval := !r.cfg.RawLua
+
+	// a receive or select may block here until
+	// it can complete; let Ctrl-C abandon it.
+	stopCatching := r.lvm.catchInterrupts()
+	defer stopCatching()
+	return LuaRun(r.lvm, use, useEval)
+}
+
 // :ls, :gls, :lst, :glst implementation
 func (r *Repl) displayCmd(cmd string) {
 	err := LuaRun(r.lvm, `__`+cmd+`()`, true)
 	panicOn(err)
 }
 
-func showLuaStacks(vm *golua.State) {
+// showLuaStacks prints the value stack of each
+// coroutine, then its call frames, with their chunk
+// lines rewritten by goPositions when it isn't nil.
+func showLuaStacks(vm *golua.State, goPositions func(string) string) {
 	top := vm.GetTop()
 	vm.GetGlobal("__all_coro")
 	if vm.IsNil(-1) {
@@ -588,6 +745,19 @@ func showLuaStacks(vm *golua.State) {
 		fmt.Printf("        __all_coro %v: '%s' (%s)\n", i, name, status)
 		fmt.Printf("===================================\n"+
 			"%s\n", DumpLuaStackAsString(thr, ignoreTopmost))
+
+		frameTop := vm.GetTop()
+		vm.GetGlobal("debug")
+		vm.GetField(-1, "traceback")
+		vm.PushValue(-3)
+		if vm.Call(1, 1) == nil {
+			tb := vm.ToString(-1)
+			if goPositions != nil {
+				tb = goPositions(tb)
+			}
+			fmt.Printf("%s\n", tb)
+		}
+		vm.SetTop(frameTop)
 	})
 	vm.SetTop(top)
 }
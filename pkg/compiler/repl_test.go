@@ -1004,8 +1004,8 @@ m := 1%a
 		cv.So(string(translation), matchesLuaSrc,
 			`
 	a = 0LL;
-    b = __integerByZeroCheck(1LL / a);
-    m = __integerByZeroCheck(1LL % a);
+    b = __integerQuo(1LL, a);
+    m = __integerRem(1LL, a);
 `)
 
 		codeWithCatch := `
@@ -1269,3 +1269,50 @@ b := len(a[2])
 		cv.So(true, cv.ShouldBeTrue)
 	})
 }
+
+func Test1994ClosuresShareTheLocalsTheyCapture(t *testing.T) {
+
+	cv.Convey(`a closure, or a pointer, should share the local it captures or points to with the enclosing func; a local declared in a loop body should be a new one each time around, while the loop variable is one for the whole loop, as before Go 1.22`, t, func() {
+
+		code := `
+func f() int {
+	y := 1
+	q := &y
+	*q = 5
+	g := func() { y++ }
+	g()
+	return y
+}
+func h() int {
+	var gs []func() int
+	for i := 0; i < 3; i++ {
+		k := i
+		gs = append(gs, func() int { return k })
+	}
+	return gs[0]() + 10*gs[1]() + 100*gs[2]()
+}
+func m() int {
+	var gs []func() int
+	for i := 0; i < 3; i++ {
+		gs = append(gs, func() int { return i })
+	}
+	return gs[0]() + gs[1]() + gs[2]()
+}
+b := f()
+c := h()
+d := m()
+`
+		// expect b 6, c 210, d 9
+		vm, err := NewLuaVmWithPrelude(nil)
+		panicOn(err)
+		defer vm.Close()
+		inc := NewIncrState(vm, nil)
+
+		translation := inc.trMust([]byte(code))
+		fmt.Printf("\n translation='%s'\n", translation)
+		LuaRunAndReport(vm, string(translation))
+		LuaMustInt64(vm, "b", 6)
+		LuaMustInt64(vm, "c", 210)
+		LuaMustInt64(vm, "d", 9)
+	})
+}
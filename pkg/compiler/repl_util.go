@@ -144,25 +144,29 @@ func getHistoryRange(lows string, history []string) (slc []int, err error) {
 	return num, nil
 }
 
-func sourceGoFiles(files []string) ([]byte, error) {
+// sourceGoFiles reads files into one input, and says
+// which lines of it came from which file.
+func sourceGoFiles(files []string) ([]byte, []sourcedFile, error) {
 	var buf bytes.Buffer
+	var sourced []sourcedFile
 	for _, f := range files {
 		fd, err := os.Open(f)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		defer fd.Close()
 		by, err := ioutil.ReadAll(fd)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		_, err = io.Copy(&buf, bytes.NewBuffer(by))
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		fmt.Fprintf(&buf, "\n")
+		sourced = append(sourced, sourcedFile{name: f, lines: bytes.Count(by, []byte("\n")) + 1})
 	}
 	bb := buf.Bytes()
 	fmt.Printf("sourceGoFiles() returning '%s'\n", string(bb))
-	return bb, nil
+	return bb, sourced, nil
 }
@@ -0,0 +1,94 @@
+package compiler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1720FailedInputLeavesNoTrace(t *testing.T) {
+
+	cv.Convey(`an input that fails to type check should leave nothing behind: not its declarations, not its methods, and not a redefinition; so the predeclared type names may be shadowed like anywhere in Go`, t, func() {
+
+		in, err := NewInterpreter(nil)
+		panicOn(err)
+		defer in.Close()
+		ctx := context.Background()
+		show := func(src string) string {
+			res, err := in.Eval(ctx, src)
+			panicOn(err)
+			return res.Text
+		}
+		fails := func(src string) {
+			_, err := in.Eval(ctx, src)
+			cv.So(err, cv.ShouldNotBeNil)
+		}
+
+		// z is declared before the error.
+		fails(`z := 1; q := nope`)
+		fails(`z`)
+		show(`z := "ok"`)
+		cv.So(show(`z`), cv.ShouldEqual, `"ok"`)
+
+		// the failed redefinition keeps the old g.
+		show(`func g() int { return 7 }`)
+		fails(`func g() string { return nope }`)
+		cv.So(show(`g() + 1`), cv.ShouldEqual, "8")
+
+		show(`type T struct{ A int }`)
+		fails(`func (t T) M() int { return nope }`)
+		show(`var t T`)
+		fails(`t.M()`)
+		show(`func (t T) M() int { return t.A + 1 }`)
+		cv.So(show(`t.M()`), cv.ShouldEqual, "1")
+
+		// shadowing int used to be barred, lest a typo
+		// like this one upset the checker.
+		fails(`var int w`)
+		show(`var y int = 2`)
+		cv.So(show(`y`), cv.ShouldEqual, "2")
+		show(`func h() int { var int int = 3; return int + 1 }`)
+		cv.So(show(`h()`), cv.ShouldEqual, "4")
+		show(`float64 := 2.5`)
+		cv.So(show(`float64 * 2`), cv.ShouldEqual, "5")
+	})
+}
+
+func Test1721SnapshotsCostLittleUntilAnInputDeclares(t *testing.T) {
+
+	cv.Convey(`taking the snapshot of an input should not copy the archive's maps: an input that declares no func or type should leave them as they were, and one that fails should get back the very maps it started with`, t, func() {
+
+		vm, err := NewLuaVmWithPrelude(nil)
+		panicOn(err)
+		defer vm.Close()
+		inc := NewIncrState(vm, nil)
+		mapOf := func(m map[string]string) uintptr {
+			return reflect.ValueOf(m).Pointer()
+		}
+
+		_, err = inc.Tr([]byte(`func f() int { return 1 }`))
+		panicOn(err)
+		arch := inc.CurPkg.Arch
+		m := mapOf(arch.FuncSrcCache)
+
+		_, err = inc.Tr([]byte(`x := f()`))
+		panicOn(err)
+		cv.So(mapOf(arch.FuncSrcCache), cv.ShouldEqual, m)
+
+		_, err = inc.Tr([]byte(`func k() int { return nope }`))
+		cv.So(err, cv.ShouldNotBeNil)
+		cv.So(mapOf(arch.FuncSrcCache), cv.ShouldEqual, m)
+		_, has := arch.FuncSrcCache["k"]
+		cv.So(has, cv.ShouldBeFalse)
+		cv.So(arch.Pkg.Scope().Lookup("k"), cv.ShouldBeNil)
+
+		_, err = inc.Tr([]byte(`func g() int { return x + 1 }`))
+		panicOn(err)
+		cv.So(mapOf(arch.FuncSrcCache), cv.ShouldNotEqual, m)
+		cv.So(arch.FuncSrcCache["f"], cv.ShouldNotEqual, "")
+		cv.So(arch.FuncSrcCache["g"], cv.ShouldNotEqual, "")
+		cv.So(arch.Pkg.Scope().Lookup("g"), cv.ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,201 @@
+package compiler
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"strconv"
+	"strings"
+
+	"github.com/gijit/gi/pkg/ast"
+	"github.com/gijit/gi/pkg/compiler/shadow"
+	"github.com/gijit/gi/pkg/parser"
+	"github.com/gijit/gi/pkg/token"
+	"github.com/gijit/gi/pkg/types"
+	"github.com/gijit/gi/pkg/verb"
+)
+
+// Script mode: `gi run main.go [args]` compiles a
+// whole package main and runs it, and `gi -e src`
+// evaluates src as if typed at the prompt. Neither
+// comes back to a prompt. A script may begin with
+// `#!/path/to/gi run`. The exit status is 0 on
+// success, 1 if the Go doesn't compile, and 2 if it
+// panics, as for a Go binary.
+
+// RunScript carries out gi run or gi -e, returning
+// the exit status.
+func (r *Repl) RunScript() int {
+	if r.cfg.RunArgs == nil {
+		return r.evalScript(r.cfg.EvalSrc)
+	}
+
+	files, progArgs, err := splitRunArgs(r.cfg.RunArgs)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "gi run: %v\n", err)
+		return 1
+	}
+	lua, err := r.inc.CompileProgram(files)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "gi run: %v\n", err)
+		return 1
+	}
+	setProgramArgs(append([]string{files[0]}, progArgs...))
+
+	return r.runScriptLua(string(lua))
+}
+
+// evalScript runs src, Go unless in raw mode, for gi -e.
+func (r *Repl) evalScript(src string) int {
+	use := src
+	if !r.cfg.RawLua {
+		var err error
+		use, err = TranslateAndCatchPanic(r.inc, []byte(src))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "gi -e: %v\n", err)
+			return 1
+		}
+	}
+	return r.runScriptLua(use)
+}
+
+// runScriptLua runs use, returning 2 if it fails.
+// The eval coroutine reports (and prints) a failure
+// in __lastEvalErr, rather than back to LuaRun.
+func (r *Repl) runScriptLua(use string) int {
+	err := r.runLua(use)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", r.inc.srcMaps.goPositions(err.Error()))
+		return 2
+	}
+	if r.cfg.RawLua {
+		return 0
+	}
+	t := r.lvm.goro.newTicket(`__lastEvalErr = tostring(__lastEvalErr)`, false)
+	t.varname["__lastEvalErr"] = nil
+	t.gettyp = GetString
+	if t.Do() != nil || t.varname["__lastEvalErr"] != "" {
+		return 2
+	}
+	return 0
+}
+
+// splitRunArgs divides the arguments to gi run the way
+// go run does: the leading .go files, or else a package
+// directory, then the arguments for the program itself.
+func splitRunArgs(args []string) (files, progArgs []string, err error) {
+	n := 0
+	for n < len(args) && strings.HasSuffix(args[n], ".go") {
+		n++
+	}
+	if n > 0 {
+		return args[:n], args[n:], nil
+	}
+	if len(args) == 0 {
+		return nil, nil, fmt.Errorf("no go files listed")
+	}
+	if fi, err := os.Stat(args[0]); err == nil && !fi.IsDir() {
+		// a #! script needn't end in .go.
+		return args[:1], args[1:], nil
+	}
+	pkg, err := NewBuildContext("", nil).ImportDir(args[0], 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, name := range pkg.GoFiles {
+		files = append(files, filepath.Join(pkg.Dir, name))
+	}
+	return files, args[1:], nil
+}
+
+// setProgramArgs makes args the os.Args seen by the
+// program. The os shadow package copied os.Args at
+// init, so it is updated too.
+func setProgramArgs(args []string) {
+	os.Args = args
+	if e := shadow.Lookup("os"); e != nil {
+		e.Pkg["Args"] = args
+	}
+}
+
+// CompileProgram compiles the package main in files
+// into a Lua program that loads its imports and then
+// runs its init()s and main().
+func (ic *IncrState) CompileProgram(files []string) ([]byte, error) {
+	prog, _, err := ic.compileProgram(files, false)
+	return prog, err
+}
+
+// compileProgram returns the Lua that loads the imports
+// of the package main in files and then runs the package
+// itself, as one chunk. With noBinary, importing a binary
+// (shadow) package is an error.
+func (ic *IncrState) compileProgram(files []string, noBinary bool) (prog []byte, pkg *types.Package, err error) {
+	defer func() {
+		recov := recover()
+		if recov != nil {
+			msg := fmt.Sprintf("problem detected during Go static type checking: '%v'", recov)
+			if verb.Verbose {
+				msg += fmt.Sprintf("\n%s\n", string(debug.Stack()))
+			}
+			err = errors.New(msg)
+		}
+	}()
+
+	fileSet := token.NewFileSet()
+	var parsed []*ast.File
+	var specs bytes.Buffer
+	seen := make(map[string]bool)
+	for _, fn := range files {
+		src, err := ioutil.ReadFile(fn)
+		if err != nil {
+			return nil, nil, err
+		}
+		if bytes.HasPrefix(src, []byte("#!")) {
+			// keep the line count, for positions.
+			src[0], src[1] = '/', '/'
+		}
+		f, err := parser.ParseFile(fileSet, fn, src, 0)
+		if err != nil {
+			return nil, nil, err
+		}
+		if f.Name.Name != "main" {
+			return nil, nil, fmt.Errorf("%s is in package %s, not main", fn, f.Name.Name)
+		}
+		for _, spec := range f.Imports {
+			path, _ := strconv.Unquote(spec.Path.Value)
+			if noBinary && shadow.Lookup(path) != nil {
+				return nil, nil, binaryImportError(path)
+			}
+			if !seen[path] {
+				seen[path] = true
+				// a blank import loads and binds the package
+				// without claiming a name at the prompt.
+				fmt.Fprintf(&specs, "import _ %q\n", path)
+			}
+		}
+		parsed = append(parsed, f)
+	}
+
+	res, w := ic.srcMaps.newChunk(fileSet, 0)
+	if specs.Len() > 0 {
+		ic.unchunked = true
+		imports, err := ic.Tr(specs.Bytes())
+		ic.unchunked = false
+		if err != nil {
+			return nil, nil, err
+		}
+		// the marks are into ic's own FileSet, not
+		// fileSet, and name no line of files: drop them.
+		(&SourceMapFilter{Writer: w}).Write(imports)
+	}
+	pkg, err = ic.writeFullPackage(w, fileSet, parsed, "main", 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	return res.Bytes(), pkg, nil
+}
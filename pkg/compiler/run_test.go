@@ -0,0 +1,57 @@
+package compiler
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1660RunWholeProgram(t *testing.T) {
+
+	cv.Convey(`gi run should compile a whole package main, #! line and all, and run its inits and main`, t, func() {
+
+		dir, err := ioutil.TempDir("", "gi-run")
+		panicOn(err)
+		defer os.RemoveAll(dir)
+
+		prog := filepath.Join(dir, "fish")
+		panicOn(ioutil.WriteFile(prog, []byte(`#!/usr/local/bin/gi run
+package main
+
+import "github.com/gijit/gi/pkg/compiler/spkg_tst"
+
+var poles = 1
+
+func init() { poles++ }
+
+var caught int
+
+func main() {
+	caught = spkg_tst.Fish(poles)
+}
+`), 0644))
+
+		files, progArgs, err := splitRunArgs([]string{prog, "-x", "y.go"})
+		panicOn(err)
+		cv.So(files, cv.ShouldResemble, []string{prog})
+		cv.So(progArgs, cv.ShouldResemble, []string{"-x", "y.go"})
+
+		vm, err := NewLuaVmWithPrelude(nil)
+		panicOn(err)
+		defer vm.Close()
+		inc := NewIncrState(vm, nil)
+
+		lua, err := inc.CompileProgram(files)
+		panicOn(err)
+		panicOn(vm.goro.newTicket(string(lua), true).Do())
+		LuaMustEvalToInt64(vm, `__packages["main"].caught`, 4)
+
+		lib := filepath.Join(dir, "lib.go")
+		panicOn(ioutil.WriteFile(lib, []byte("package lib\n"), 0644))
+		_, err = inc.CompileProgram([]string{lib})
+		cv.So(err.Error(), cv.ShouldContainSubstring, "is in package lib, not main")
+	})
+}
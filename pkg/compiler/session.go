@@ -0,0 +1,382 @@
+package compiler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gijit/gi/pkg/ast"
+	"github.com/gijit/gi/pkg/parser"
+	"github.com/gijit/gi/pkg/printer"
+	"github.com/gijit/gi/pkg/token"
+	"github.com/gijit/gi/pkg/types"
+)
+
+// SavedSession is the on-disk form of a REPL
+// session, written by :save and read by :load.
+//
+// Rather than the Lua heap, we keep Go source:
+// the top-level declarations in the order they
+// were entered, and each user global rendered as
+// a `var name T = literal` with its current value.
+// Replaying that through the incremental compiler
+// restores the type checker and the Lua VM together.
+type SavedSession struct {
+	Version int
+	Entries []SavedEntry
+
+	FuncSrcCache map[string]string
+}
+
+// SavedEntry is one step of a SavedSession. Exactly
+// one of Src or Var is set.
+type SavedEntry struct {
+	// Src is an import, type, const, or func declaration.
+	Src string `json:",omitempty"`
+
+	// Var is a user global, declared at the point
+	// where it first appeared.
+	Var *SavedVar `json:",omitempty"`
+}
+
+// SavedVar is a user global variable and its value.
+type SavedVar struct {
+	Name string
+	Type string
+
+	// Value is a Go expression for the value at
+	// save time.
+	Value string
+}
+
+const savedSessionVersion = 1
+
+// declLogEntry records what one successful Tr added
+// to the package: declaration source, the names of
+// newly declared globals, or a top-level statement.
+type declLogEntry struct {
+	src  string
+	vars []string
+
+	// for :export; see export.go.
+	consts  []string          // the names src declares, if a const
+	imports []*ast.ImportSpec // the specs of src, if an import
+	stmt    string            // the statement, as it goes in main()
+	sets    []*types.Var      // the globals stmt declares
+}
+
+// recordDecls appends file's top-level declarations
+// and statements (and any user globals new since
+// before) to the log that SaveSession and
+// ExportProgram write out.
+func (pk *IncrPkg) recordDecls(file *ast.File, before map[string]bool) {
+	for _, node := range file.Nodes {
+		_, isStmt := node.(ast.Stmt)
+		if d, ok := node.(*ast.GenDecl); isStmt || ok && d.Tok == token.VAR {
+			// the value of a var is captured at save
+			// time; :export wants the statement.
+			stmt, sets := pk.exportStmt(node)
+			if stmt != "" {
+				pk.declLog = append(pk.declLog, declLogEntry{stmt: stmt, sets: sets})
+			}
+			continue
+		}
+		var e declLogEntry
+		switch d := node.(type) {
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				switch sp := spec.(type) {
+				case *ast.ValueSpec:
+					for _, id := range sp.Names {
+						e.consts = append(e.consts, id.Name)
+					}
+				case *ast.ImportSpec:
+					e.imports = append(e.imports, sp)
+				}
+			}
+		case *ast.FuncDecl:
+		default:
+			continue
+		}
+		var by bytes.Buffer
+		err := printer.Fprint(&by, pk.fileSet, node)
+		if err != nil {
+			continue
+		}
+		e.src = by.String()
+		pk.declLog = append(pk.declLog, e)
+	}
+	var vars []string
+	for _, nm := range userGlobals(pk.Arch.Pkg.Scope()) {
+		if !before[nm] {
+			vars = append(vars, nm)
+		}
+	}
+	if len(vars) > 0 {
+		pk.declLog = append(pk.declLog, declLogEntry{vars: vars})
+	}
+}
+
+// userGlobals lists the variables at package scope,
+// leaving out our own __ prefixed helpers.
+func userGlobals(scope *types.Scope) (names []string) {
+	for _, nm := range scope.Names() {
+		if nm == "_" || strings.HasPrefix(nm, "__") {
+			continue
+		}
+		if _, isVar := scope.Lookup(nm).(*types.Var); isVar {
+			names = append(names, nm)
+		}
+	}
+	return
+}
+
+// SaveSession writes the declarations and user globals
+// of the current package to path.
+//
+// Values are saved as Go literals. A pointer to a
+// composite value is written as &T{...}, so pointers
+// that shared a target no longer do after :load. A func
+// that is one of the package's top-level funcs is
+// written as a func literal with that func's source.
+// Other values have no literal: a non-nil channel, a
+// closure, a pointer to a basic value or a cycle of
+// pointers. For a global holding one of those, we warn,
+// and :load gives it its zero value.
+func (ic *IncrState) SaveSession(path string) error {
+	pk := ic.CurPkg
+	sess := &SavedSession{
+		Version: savedSessionVersion,
+	}
+	if pk.Arch == nil {
+		return writeSession(path, sess)
+	}
+	sess.FuncSrcCache = pk.Arch.FuncSrcCache
+
+	scope := pk.Arch.Pkg.Scope()
+	mainPkg := pk.Arch.Pkg
+	qual := func(p *types.Package) string {
+		if p == mainPkg {
+			return ""
+		}
+		return p.Name()
+	}
+	seen := make(map[string]bool)
+	for _, e := range pk.declLog {
+		if e.src != "" {
+			sess.Entries = append(sess.Entries, SavedEntry{Src: e.src})
+			continue
+		}
+		for _, nm := range e.vars {
+			if seen[nm] {
+				continue
+			}
+			seen[nm] = true
+			v, ok := scope.Lookup(nm).(*types.Var)
+			if !ok {
+				// no longer a variable.
+				continue
+			}
+			sv := &SavedVar{
+				Name:  nm,
+				Type:  types.TypeString(v.Type(), qual),
+				Value: ic.goLiteralFor(nm, v.Type()),
+			}
+			if sv.Value == "" {
+				ic.warnf("save: there is no Go literal for the value of '%s' (%s); it will load as the zero value\n", nm, sv.Type)
+			}
+			sess.Entries = append(sess.Entries, SavedEntry{Var: sv})
+		}
+	}
+	return writeSession(path, sess)
+}
+
+func writeSession(path string, sess *SavedSession) error {
+	by, err := json.MarshalIndent(sess, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, by, 0600)
+}
+
+// goLiteralFor asks the Lua side to render the
+// current value of global nm as Go source.
+// It returns "" if there is no such rendering.
+func (ic *IncrState) goLiteralFor(nm string, typ types.Type) string {
+	// the Lua side goes by the type a value carries,
+	// but some carry none, or the wrong one; tell it
+	// the type where it matters.
+	kind := ""
+	switch u := typ.Underlying().(type) {
+	case *types.Basic:
+		// basic values are not boxed.
+		switch u.Kind() {
+		case types.Byte:
+			kind = "uint8"
+		case types.Rune:
+			kind = "int32"
+		default:
+			kind = u.Name()
+		}
+	case *types.Struct:
+		// a struct value looks like a pointer to it.
+		if n, ok := typ.(*types.Named); ok && n.Obj().Pkg() == ic.CurPkg.Arch.Pkg {
+			kind = n.Obj().Name()
+		}
+	case *types.Pointer, *types.Chan, *types.Signature, *types.Map, *types.Interface:
+		// nil values carry no type.
+		if ic.goLiteral(nm+" == nil", "bool") == "true" {
+			return "nil"
+		}
+		switch u := u.(type) {
+		case *types.Chan:
+			return ""
+		case *types.Signature:
+			return ic.funcLiteralFor(nm, typ)
+		case *types.Pointer:
+			if _, isArray := u.Elem().Underlying().(*types.Array); isArray {
+				// a pointer to an array is the array itself.
+				lit := ic.goLiteral(nm, "")
+				if lit == "" {
+					return ""
+				}
+				return "&" + lit
+			}
+		}
+	}
+	return ic.goLiteral(nm, kind)
+}
+
+// funcLiteralFor finds which top-level func of the
+// package global nm holds, and gives its source as a
+// func literal of type typ. It returns "" if nm holds
+// none of them, as for a closure.
+func (ic *IncrState) funcLiteralFor(nm string, typ types.Type) string {
+	scope := ic.CurPkg.Arch.Pkg.Scope()
+	var names []string
+	for fn := range ic.CurPkg.Arch.FuncSrcCache {
+		f, ok := scope.Lookup(fn).(*types.Func)
+		if ok && types.Identical(f.Type(), typ) {
+			names = append(names, fn)
+		}
+	}
+	if len(names) == 0 {
+		return ""
+	}
+	sort.Strings(names)
+	i, err := strconv.Atoi(ic.goLiteral(fmt.Sprintf("[]interface{}{%s, %s}", nm, strings.Join(names, ", ")), "func"))
+	if err != nil || i < 1 || i > len(names) {
+		return ""
+	}
+
+	// the cached source is the func's declaration;
+	// drop its name to make it a literal.
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", "package main\n"+ic.CurPkg.Arch.FuncSrcCache[names[i-1]], 0)
+	if err != nil || len(file.Nodes) != 1 {
+		return ""
+	}
+	d, ok := file.Nodes[0].(*ast.FuncDecl)
+	if !ok || d.Recv != nil {
+		return ""
+	}
+	var by bytes.Buffer
+	err = printer.Fprint(&by, fset, &ast.FuncLit{Type: d.Type, Body: d.Body})
+	if err != nil {
+		return ""
+	}
+	return by.String()
+}
+
+// goLiteral renders the value of the Go expression x
+// as Go source, or gives "".
+func (ic *IncrState) goLiteral(x, kind string) string {
+	translation, err := ic.TrWithPrepend([]byte(fmt.Sprintf("__gijit_goLiteral(%s, %q)", x, kind)), false)
+	if err != nil {
+		return ""
+	}
+	t := ic.goro.newTicket(string(translation), false)
+	t.varname["__gijit_lastLiteral"] = nil
+	t.gettyp = GetString
+	err = t.Do()
+	if err != nil {
+		return ""
+	}
+	lit, _ := t.varname["__gijit_lastLiteral"].(string)
+	return lit
+}
+
+// LoadSession replays a session written by SaveSession
+// into the current package. Entries that no longer
+// compile are reported and skipped, so one stale
+// declaration does not lose the rest of the session.
+func (ic *IncrState) LoadSession(path string) error {
+	by, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var sess SavedSession
+	err = json.Unmarshal(by, &sess)
+	if err != nil {
+		return fmt.Errorf("could not read session file '%s': '%v'", path, err)
+	}
+	if sess.Version != savedSessionVersion {
+		return fmt.Errorf("session file '%s' has version %v; we only read version %v", path, sess.Version, savedSessionVersion)
+	}
+
+	nfail := 0
+	for _, e := range sess.Entries {
+		if e.Var == nil {
+			err = ic.replay(e.Src)
+			if err != nil {
+				nfail++
+				ic.warnf("load: skipping '%s': '%v'\n", strings.TrimSpace(e.Src), err)
+			}
+			continue
+		}
+		v := e.Var
+		decl := fmt.Sprintf("var %s %s", v.Name, v.Type)
+		if v.Value != "" {
+			src := decl + " = " + v.Value
+			if strings.HasPrefix(v.Value, "func(") {
+				// a func literal cannot yet initialize
+				// a global; assign it instead.
+				src = fmt.Sprintf("%s; %s = %s", decl, v.Name, v.Value)
+			}
+			err = ic.replay(src)
+			if err == nil {
+				continue
+			}
+			ic.warnf("load: could not restore the value of '%s', using the zero value: '%v'\n", v.Name, err)
+		}
+		err = ic.replay(decl)
+		if err != nil {
+			nfail++
+			ic.warnf("load: skipping variable '%s': '%v'\n", v.Name, err)
+		}
+	}
+	if ic.CurPkg.Arch != nil {
+		for k, v := range sess.FuncSrcCache {
+			if _, already := ic.CurPkg.Arch.FuncSrcCache[k]; !already {
+				ic.CurPkg.Arch.FuncSrcCache[k] = v
+			}
+		}
+	}
+	if nfail > 0 {
+		return fmt.Errorf("%v of %v entries in '%s' could not be restored", nfail, len(sess.Entries), path)
+	}
+	return nil
+}
+
+// replay translates and runs src, as if typed at the prompt.
+func (ic *IncrState) replay(src string) error {
+	translation, err := ic.TrWithPrepend([]byte(src), false)
+	if err != nil {
+		return err
+	}
+	return ic.goro.newTicket(string(translation), true).Do()
+}
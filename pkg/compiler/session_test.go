@@ -0,0 +1,122 @@
+package compiler
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1600SaveAndLoadSession(t *testing.T) {
+
+	cv.Convey(`:save should write out declarations and variable values, and :load into a fresh vm should restore them`, t, func() {
+
+		dir, err := ioutil.TempDir("", "gijit-session-test")
+		panicOn(err)
+		defer os.RemoveAll(dir)
+		fn := filepath.Join(dir, "sess.json")
+
+		code := []string{
+			`total := 10`,
+			`type Point struct { X int; Y float64; Name string }`,
+			`func addToTotal(n int) int { total += n; return total }`,
+			`pts := []Point{{X: 1, Y: 2.5, Name: "a\"b"}, {X: 3}}`,
+			`m := map[string]int{"a": 1, "b": 2}`,
+			`var any interface{} = "hi"`,
+			`ignored := addToTotal(5)`,
+		}
+
+		vm, err := NewLuaVmWithPrelude(nil)
+		panicOn(err)
+		defer vm.Close()
+		inc := NewIncrState(vm, nil)
+		for _, c := range code {
+			translation := inc.trMust([]byte(c))
+			LuaRunAndReport(vm, string(translation))
+		}
+		panicOn(inc.SaveSession(fn))
+
+		vm2, err := NewLuaVmWithPrelude(nil)
+		panicOn(err)
+		defer vm2.Close()
+		inc2 := NewIncrState(vm2, nil)
+		panicOn(inc2.LoadSession(fn))
+
+		translation := inc2.trMust([]byte(`a := addToTotal(1); b := pts[0].Name; c := len(pts); d := m["b"]; e := pts[0].Y; f := any.(string)`))
+		LuaRunAndReport(vm2, string(translation))
+
+		LuaMustInt64(vm2, "a", 16)
+		LuaMustString(vm2, "b", `a"b`)
+		LuaMustInt(vm2, "c", 2)
+		LuaMustInt64(vm2, "d", 2)
+		LuaMustFloat64(vm2, "e", 2.5)
+		LuaMustString(vm2, "f", "hi")
+	})
+}
+
+func Test1601SaveSkipsOnlyValuesWithoutLiterals(t *testing.T) {
+
+	cv.Convey(`:save should write pointers to composite values as &T{...} and top-level funcs from their source; a global with no literal, like a channel or a closure, should get a warning and load as its zero value, without losing the other globals`, t, func() {
+
+		dir, err := ioutil.TempDir("", "gijit-session-test")
+		panicOn(err)
+		defer os.RemoveAll(dir)
+		fn := filepath.Join(dir, "sess.json")
+
+		code := []string{
+			`type Point struct { X int; Next *Point }`,
+			`func one() int { return 1 }`,
+			`var p *Point`,
+			`var f func() int`,
+			`var mm map[string]int`,
+			`var e error`,
+			`pt := Point{X: 7}`,
+			`q := &Point{X: 1, Next: &Point{X: 2}}`,
+			`s := &[]int{3, 4}`,
+			`arr := &[2]int{5, 6}`,
+			`g := one`,
+			`ch := make(chan int)`,
+			`h := func() int { return 2 }`,
+			`r := &Point{X: 8}; r.Next = r`,
+		}
+
+		vm, err := NewLuaVmWithPrelude(nil)
+		panicOn(err)
+		defer vm.Close()
+		inc := NewIncrState(vm, nil)
+		var warnings bytes.Buffer
+		inc.warn = func(s string) { warnings.WriteString(s) }
+		for _, c := range code {
+			translation := inc.trMust([]byte(c))
+			LuaRunAndReport(vm, string(translation))
+		}
+		panicOn(inc.SaveSession(fn))
+		cv.So(warnings.String(), cv.ShouldContainSubstring, "'ch' (chan int)")
+		cv.So(warnings.String(), cv.ShouldContainSubstring, "'h' (func() int)")
+		cv.So(warnings.String(), cv.ShouldContainSubstring, "'r' (*Point)")
+		cv.So(warnings.String(), cv.ShouldNotContainSubstring, "'q'")
+		cv.So(warnings.String(), cv.ShouldNotContainSubstring, "'g'")
+
+		vm2, err := NewLuaVmWithPrelude(nil)
+		panicOn(err)
+		defer vm2.Close()
+		inc2 := NewIncrState(vm2, nil)
+		panicOn(inc2.LoadSession(fn))
+
+		translation := inc2.trMust([]byte(`a := p == nil && f == nil && mm == nil && e == nil && ch == nil && h == nil && r == nil; b := pt.X; c := pt.Next == nil; d := q.X*10 + q.Next.X; k := q.Next.Next == nil; l := (*s)[1] + (*arr)[0]`))
+		LuaRunAndReport(vm2, string(translation))
+		// a call through a func value may block, so it
+		// must go through the scheduler.
+		panicOn(inc2.replay(`m := g()`))
+		LuaMustBool(vm2, "a", true)
+		LuaMustInt64(vm2, "b", 7)
+		LuaMustBool(vm2, "c", true)
+		LuaMustInt64(vm2, "d", 12)
+		LuaMustBool(vm2, "k", true)
+		LuaMustInt64(vm2, "l", 9)
+		LuaMustInt64(vm2, "m", 1)
+	})
+}
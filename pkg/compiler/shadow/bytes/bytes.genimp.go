@@ -1,22 +1,34 @@
 package shadow_bytes
 
-import "bytes"
+import (
+	"bytes"
+
+	"github.com/gijit/gi/pkg/compiler/shadow"
+)
 
 var Pkg = make(map[string]interface{})
 var Ctor = make(map[string]interface{})
 
 func init() {
     Ctor["Buffer"] = GijitShadow_NewStruct_Buffer
+    Pkg["Clone"] = bytes.Clone
     Pkg["Compare"] = bytes.Compare
     Pkg["Contains"] = bytes.Contains
     Pkg["ContainsAny"] = bytes.ContainsAny
+    Pkg["ContainsFunc"] = bytes.ContainsFunc
     Pkg["ContainsRune"] = bytes.ContainsRune
     Pkg["Count"] = bytes.Count
+    Pkg["Cut"] = bytes.Cut
+    Pkg["CutLast"] = bytes.CutLast
+    Pkg["CutPrefix"] = bytes.CutPrefix
+    Pkg["CutSuffix"] = bytes.CutSuffix
     Pkg["Equal"] = bytes.Equal
     Pkg["EqualFold"] = bytes.EqualFold
     Pkg["ErrTooLarge"] = bytes.ErrTooLarge
     Pkg["Fields"] = bytes.Fields
     Pkg["FieldsFunc"] = bytes.FieldsFunc
+    Pkg["FieldsFuncSeq"] = bytes.FieldsFuncSeq
+    Pkg["FieldsSeq"] = bytes.FieldsSeq
     Pkg["HasPrefix"] = bytes.HasPrefix
     Pkg["HasSuffix"] = bytes.HasSuffix
     Pkg["Index"] = bytes.Index
@@ -29,6 +41,7 @@ func init() {
     Pkg["LastIndexAny"] = bytes.LastIndexAny
     Pkg["LastIndexByte"] = bytes.LastIndexByte
     Pkg["LastIndexFunc"] = bytes.LastIndexFunc
+    Pkg["Lines"] = bytes.Lines
     Pkg["Map"] = bytes.Map
     Pkg["MinRead"] = bytes.MinRead
     Pkg["NewBuffer"] = bytes.NewBuffer
@@ -37,11 +50,14 @@ func init() {
     Ctor["Reader"] = GijitShadow_NewStruct_Reader
     Pkg["Repeat"] = bytes.Repeat
     Pkg["Replace"] = bytes.Replace
+    Pkg["ReplaceAll"] = bytes.ReplaceAll
     Pkg["Runes"] = bytes.Runes
     Pkg["Split"] = bytes.Split
     Pkg["SplitAfter"] = bytes.SplitAfter
     Pkg["SplitAfterN"] = bytes.SplitAfterN
+    Pkg["SplitAfterSeq"] = bytes.SplitAfterSeq
     Pkg["SplitN"] = bytes.SplitN
+    Pkg["SplitSeq"] = bytes.SplitSeq
     Pkg["Title"] = bytes.Title
     Pkg["ToLower"] = bytes.ToLower
     Pkg["ToLowerSpecial"] = bytes.ToLowerSpecial
@@ -49,6 +65,7 @@ func init() {
     Pkg["ToTitleSpecial"] = bytes.ToTitleSpecial
     Pkg["ToUpper"] = bytes.ToUpper
     Pkg["ToUpperSpecial"] = bytes.ToUpperSpecial
+    Pkg["ToValidUTF8"] = bytes.ToValidUTF8
     Pkg["Trim"] = bytes.Trim
     Pkg["TrimFunc"] = bytes.TrimFunc
     Pkg["TrimLeft"] = bytes.TrimLeft
@@ -59,6 +76,7 @@ func init() {
     Pkg["TrimSpace"] = bytes.TrimSpace
     Pkg["TrimSuffix"] = bytes.TrimSuffix
 
+    shadow.Register("bytes", "bytes", Pkg, Ctor, InitLua)
 }
 func GijitShadow_NewStruct_Buffer(src *bytes.Buffer) *bytes.Buffer {
     if src == nil {
@@ -1,17 +1,28 @@
 package shadow_binary
 
-import "encoding/binary"
+import (
+	"encoding/binary"
+
+	"github.com/gijit/gi/pkg/compiler/shadow"
+)
 
 var Pkg = make(map[string]interface{})
 var Ctor = make(map[string]interface{})
 
 func init() {
+    Pkg["Append"] = binary.Append
+    Pkg["AppendByteOrder"] = GijitShadow_InterfaceConvertTo2_AppendByteOrder
+    Pkg["AppendUvarint"] = binary.AppendUvarint
+    Pkg["AppendVarint"] = binary.AppendVarint
     Pkg["BigEndian"] = binary.BigEndian
     Pkg["ByteOrder"] = GijitShadow_InterfaceConvertTo2_ByteOrder
+    Pkg["Decode"] = binary.Decode
+    Pkg["Encode"] = binary.Encode
     Pkg["LittleEndian"] = binary.LittleEndian
     Pkg["MaxVarintLen16"] = binary.MaxVarintLen16
     Pkg["MaxVarintLen32"] = binary.MaxVarintLen32
     Pkg["MaxVarintLen64"] = binary.MaxVarintLen64
+    Pkg["NativeEndian"] = binary.NativeEndian
     Pkg["PutUvarint"] = binary.PutUvarint
     Pkg["PutVarint"] = binary.PutVarint
     Pkg["Read"] = binary.Read
@@ -22,7 +33,18 @@ func init() {
     Pkg["Varint"] = binary.Varint
     Pkg["Write"] = binary.Write
 
+    shadow.Register("encoding/binary", "binary", Pkg, Ctor, InitLua)
+}
+func GijitShadow_InterfaceConvertTo2_AppendByteOrder(x interface{}) (y binary.AppendByteOrder, b bool) {
+	y, b = x.(binary.AppendByteOrder)
+	return
 }
+
+func GijitShadow_InterfaceConvertTo1_AppendByteOrder(x interface{}) binary.AppendByteOrder {
+	return x.(binary.AppendByteOrder)
+}
+
+
 func GijitShadow_InterfaceConvertTo2_ByteOrder(x interface{}) (y binary.ByteOrder, b bool) {
 	y, b = x.(binary.ByteOrder)
 	return
@@ -38,38 +60,4 @@ func GijitShadow_InterfaceConvertTo1_ByteOrder(x interface{}) binary.ByteOrder {
   return `
 __type__.binary ={};
 
------------------
--- struct BigEndian
------------------
-
-__type__.binary.BigEndian = {
- id=0,
- __name = "native_Go_struct_type_wrapper",
- __native_type = "BigEndian",
- __str = "BigEndian",
- exported = true,
- __call = function(t, src)
-   return __ctor__binary.BigEndian(src)
- end,
-};
-setmetatable(__type__.binary.BigEndian, __type__.binary.BigEndian);
-
-
------------------
--- struct LittleEndian
------------------
-
-__type__.binary.LittleEndian = {
- id=0,
- __name = "native_Go_struct_type_wrapper",
- __native_type = "LittleEndian",
- __str = "LittleEndian",
- exported = true,
- __call = function(t, src)
-   return __ctor__binary.LittleEndian(src)
- end,
-};
-setmetatable(__type__.binary.LittleEndian, __type__.binary.LittleEndian);
-
-
 `}
\ No newline at end of file
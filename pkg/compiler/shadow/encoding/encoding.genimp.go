@@ -1,17 +1,34 @@
 package shadow_encoding
 
-import "encoding"
+import (
+	"encoding"
+
+	"github.com/gijit/gi/pkg/compiler/shadow"
+)
 
 var Pkg = make(map[string]interface{})
 var Ctor = make(map[string]interface{})
 
 func init() {
+    Pkg["BinaryAppender"] = GijitShadow_InterfaceConvertTo2_BinaryAppender
     Pkg["BinaryMarshaler"] = GijitShadow_InterfaceConvertTo2_BinaryMarshaler
     Pkg["BinaryUnmarshaler"] = GijitShadow_InterfaceConvertTo2_BinaryUnmarshaler
+    Pkg["TextAppender"] = GijitShadow_InterfaceConvertTo2_TextAppender
     Pkg["TextMarshaler"] = GijitShadow_InterfaceConvertTo2_TextMarshaler
     Pkg["TextUnmarshaler"] = GijitShadow_InterfaceConvertTo2_TextUnmarshaler
 
+    shadow.Register("encoding", "encoding", Pkg, Ctor, InitLua)
+}
+func GijitShadow_InterfaceConvertTo2_BinaryAppender(x interface{}) (y encoding.BinaryAppender, b bool) {
+	y, b = x.(encoding.BinaryAppender)
+	return
 }
+
+func GijitShadow_InterfaceConvertTo1_BinaryAppender(x interface{}) encoding.BinaryAppender {
+	return x.(encoding.BinaryAppender)
+}
+
+
 func GijitShadow_InterfaceConvertTo2_BinaryMarshaler(x interface{}) (y encoding.BinaryMarshaler, b bool) {
 	y, b = x.(encoding.BinaryMarshaler)
 	return
@@ -32,6 +49,16 @@ func GijitShadow_InterfaceConvertTo1_BinaryUnmarshaler(x interface{}) encoding.B
 }
 
 
+func GijitShadow_InterfaceConvertTo2_TextAppender(x interface{}) (y encoding.TextAppender, b bool) {
+	y, b = x.(encoding.TextAppender)
+	return
+}
+
+func GijitShadow_InterfaceConvertTo1_TextAppender(x interface{}) encoding.TextAppender {
+	return x.(encoding.TextAppender)
+}
+
+
 func GijitShadow_InterfaceConvertTo2_TextMarshaler(x interface{}) (y encoding.TextMarshaler, b bool) {
 	y, b = x.(encoding.TextMarshaler)
 	return
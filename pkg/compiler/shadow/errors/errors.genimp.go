@@ -1,13 +1,23 @@
 package shadow_errors
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/gijit/gi/pkg/compiler/shadow"
+)
 
 var Pkg = make(map[string]interface{})
 var Ctor = make(map[string]interface{})
 
 func init() {
+    Pkg["As"] = errors.As
+    Pkg["ErrUnsupported"] = errors.ErrUnsupported
+    Pkg["Is"] = errors.Is
+    Pkg["Join"] = errors.Join
     Pkg["New"] = errors.New
+    Pkg["Unwrap"] = errors.Unwrap
 
+    shadow.Register("errors", "errors", Pkg, Ctor, InitLua)
 }
 
  func InitLua() string {
@@ -1,12 +1,20 @@
 package shadow_fmt
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/gijit/gi/pkg/compiler/shadow"
+)
 
 var Pkg = make(map[string]interface{})
 var Ctor = make(map[string]interface{})
 
 func init() {
+    Pkg["Append"] = fmt.Append
+    Pkg["Appendf"] = fmt.Appendf
+    Pkg["Appendln"] = fmt.Appendln
     Pkg["Errorf"] = fmt.Errorf
+    Pkg["FormatString"] = fmt.FormatString
     Pkg["Formatter"] = GijitShadow_InterfaceConvertTo2_Formatter
     Pkg["Fprint"] = fmt.Fprint
     Pkg["Fprintf"] = fmt.Fprintf
@@ -32,6 +40,7 @@ func init() {
     Pkg["State"] = GijitShadow_InterfaceConvertTo2_State
     Pkg["Stringer"] = GijitShadow_InterfaceConvertTo2_Stringer
 
+    shadow.Register("fmt", "fmt", Pkg, Ctor, InitLua)
 }
 func GijitShadow_InterfaceConvertTo2_Formatter(x interface{}) (y fmt.Formatter, b bool) {
 	y, b = x.(fmt.Formatter)
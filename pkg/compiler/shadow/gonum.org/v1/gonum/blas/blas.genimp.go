@@ -1,27 +1,54 @@
 package shadow_blas
 
-import "gonum.org/v1/gonum/blas"
+import (
+	"gonum.org/v1/gonum/blas"
+
+	"github.com/gijit/gi/pkg/compiler/shadow"
+)
 
 var Pkg = make(map[string]interface{})
+var Ctor = make(map[string]interface{})
 
 func init() {
-	Pkg["Complex128"] = GijitShadow_InterfaceConvertTo2_Complex128
-	Pkg["Complex128Level1"] = GijitShadow_InterfaceConvertTo2_Complex128Level1
-	Pkg["Complex128Level2"] = GijitShadow_InterfaceConvertTo2_Complex128Level2
-	Pkg["Complex128Level3"] = GijitShadow_InterfaceConvertTo2_Complex128Level3
-	Pkg["Complex64"] = GijitShadow_InterfaceConvertTo2_Complex64
-	Pkg["Complex64Level1"] = GijitShadow_InterfaceConvertTo2_Complex64Level1
-	Pkg["Complex64Level2"] = GijitShadow_InterfaceConvertTo2_Complex64Level2
-	Pkg["Complex64Level3"] = GijitShadow_InterfaceConvertTo2_Complex64Level3
-	Pkg["Float32"] = GijitShadow_InterfaceConvertTo2_Float32
-	Pkg["Float32Level1"] = GijitShadow_InterfaceConvertTo2_Float32Level1
-	Pkg["Float32Level2"] = GijitShadow_InterfaceConvertTo2_Float32Level2
-	Pkg["Float32Level3"] = GijitShadow_InterfaceConvertTo2_Float32Level3
-	Pkg["Float64"] = GijitShadow_InterfaceConvertTo2_Float64
-	Pkg["Float64Level1"] = GijitShadow_InterfaceConvertTo2_Float64Level1
-	Pkg["Float64Level2"] = GijitShadow_InterfaceConvertTo2_Float64Level2
-	Pkg["Float64Level3"] = GijitShadow_InterfaceConvertTo2_Float64Level3
-
+    Pkg["All"] = blas.All
+    Pkg["Complex128"] = GijitShadow_InterfaceConvertTo2_Complex128
+    Pkg["Complex128Level1"] = GijitShadow_InterfaceConvertTo2_Complex128Level1
+    Pkg["Complex128Level2"] = GijitShadow_InterfaceConvertTo2_Complex128Level2
+    Pkg["Complex128Level3"] = GijitShadow_InterfaceConvertTo2_Complex128Level3
+    Pkg["Complex64"] = GijitShadow_InterfaceConvertTo2_Complex64
+    Pkg["Complex64Level1"] = GijitShadow_InterfaceConvertTo2_Complex64Level1
+    Pkg["Complex64Level2"] = GijitShadow_InterfaceConvertTo2_Complex64Level2
+    Pkg["Complex64Level3"] = GijitShadow_InterfaceConvertTo2_Complex64Level3
+    Pkg["ConjTrans"] = blas.ConjTrans
+    Ctor["Diag"] = GijitShadow_NewNamed_Diag
+    Pkg["Diagonal"] = blas.Diagonal
+    Ctor["DrotmParams"] = GijitShadow_NewStruct_DrotmParams
+    Ctor["Flag"] = GijitShadow_NewNamed_Flag
+    Pkg["Float32"] = GijitShadow_InterfaceConvertTo2_Float32
+    Pkg["Float32Level1"] = GijitShadow_InterfaceConvertTo2_Float32Level1
+    Pkg["Float32Level2"] = GijitShadow_InterfaceConvertTo2_Float32Level2
+    Pkg["Float32Level3"] = GijitShadow_InterfaceConvertTo2_Float32Level3
+    Pkg["Float64"] = GijitShadow_InterfaceConvertTo2_Float64
+    Pkg["Float64Level1"] = GijitShadow_InterfaceConvertTo2_Float64Level1
+    Pkg["Float64Level2"] = GijitShadow_InterfaceConvertTo2_Float64Level2
+    Pkg["Float64Level3"] = GijitShadow_InterfaceConvertTo2_Float64Level3
+    Pkg["Identity"] = blas.Identity
+    Pkg["Left"] = blas.Left
+    Pkg["Lower"] = blas.Lower
+    Pkg["NoTrans"] = blas.NoTrans
+    Pkg["NonUnit"] = blas.NonUnit
+    Pkg["OffDiagonal"] = blas.OffDiagonal
+    Pkg["Rescaling"] = blas.Rescaling
+    Pkg["Right"] = blas.Right
+    Ctor["Side"] = GijitShadow_NewNamed_Side
+    Ctor["SrotmParams"] = GijitShadow_NewStruct_SrotmParams
+    Pkg["Trans"] = blas.Trans
+    Ctor["Transpose"] = GijitShadow_NewNamed_Transpose
+    Pkg["Unit"] = blas.Unit
+    Ctor["Uplo"] = GijitShadow_NewNamed_Uplo
+    Pkg["Upper"] = blas.Upper
+
+    shadow.Register("gonum.org/v1/gonum/blas", "blas", Pkg, Ctor, InitLua)
 }
 func GijitShadow_InterfaceConvertTo2_Complex128(x interface{}) (y blas.Complex128, b bool) {
 	y, b = x.(blas.Complex128)
@@ -32,6 +59,7 @@ func GijitShadow_InterfaceConvertTo1_Complex128(x interface{}) blas.Complex128 {
 	return x.(blas.Complex128)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_Complex128Level1(x interface{}) (y blas.Complex128Level1, b bool) {
 	y, b = x.(blas.Complex128Level1)
 	return
@@ -41,6 +69,7 @@ func GijitShadow_InterfaceConvertTo1_Complex128Level1(x interface{}) blas.Comple
 	return x.(blas.Complex128Level1)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_Complex128Level2(x interface{}) (y blas.Complex128Level2, b bool) {
 	y, b = x.(blas.Complex128Level2)
 	return
@@ -50,6 +79,7 @@ func GijitShadow_InterfaceConvertTo1_Complex128Level2(x interface{}) blas.Comple
 	return x.(blas.Complex128Level2)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_Complex128Level3(x interface{}) (y blas.Complex128Level3, b bool) {
 	y, b = x.(blas.Complex128Level3)
 	return
@@ -59,6 +89,7 @@ func GijitShadow_InterfaceConvertTo1_Complex128Level3(x interface{}) blas.Comple
 	return x.(blas.Complex128Level3)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_Complex64(x interface{}) (y blas.Complex64, b bool) {
 	y, b = x.(blas.Complex64)
 	return
@@ -68,6 +99,7 @@ func GijitShadow_InterfaceConvertTo1_Complex64(x interface{}) blas.Complex64 {
 	return x.(blas.Complex64)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_Complex64Level1(x interface{}) (y blas.Complex64Level1, b bool) {
 	y, b = x.(blas.Complex64Level1)
 	return
@@ -77,6 +109,7 @@ func GijitShadow_InterfaceConvertTo1_Complex64Level1(x interface{}) blas.Complex
 	return x.(blas.Complex64Level1)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_Complex64Level2(x interface{}) (y blas.Complex64Level2, b bool) {
 	y, b = x.(blas.Complex64Level2)
 	return
@@ -86,6 +119,7 @@ func GijitShadow_InterfaceConvertTo1_Complex64Level2(x interface{}) blas.Complex
 	return x.(blas.Complex64Level2)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_Complex64Level3(x interface{}) (y blas.Complex64Level3, b bool) {
 	y, b = x.(blas.Complex64Level3)
 	return
@@ -95,10 +129,26 @@ func GijitShadow_InterfaceConvertTo1_Complex64Level3(x interface{}) blas.Complex
 	return x.(blas.Complex64Level3)
 }
 
-func GijitShadow_NewStruct_DrotmParams() *blas.DrotmParams {
-	return &blas.DrotmParams{}
+
+func GijitShadow_NewNamed_Diag(src blas.Diag) blas.Diag {
+    return src
+}
+
+
+func GijitShadow_NewStruct_DrotmParams(src *blas.DrotmParams) *blas.DrotmParams {
+    if src == nil {
+	   return &blas.DrotmParams{}
+    }
+    a := *src
+    return &a
+}
+
+
+func GijitShadow_NewNamed_Flag(src blas.Flag) blas.Flag {
+    return src
 }
 
+
 func GijitShadow_InterfaceConvertTo2_Float32(x interface{}) (y blas.Float32, b bool) {
 	y, b = x.(blas.Float32)
 	return
@@ -108,6 +158,7 @@ func GijitShadow_InterfaceConvertTo1_Float32(x interface{}) blas.Float32 {
 	return x.(blas.Float32)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_Float32Level1(x interface{}) (y blas.Float32Level1, b bool) {
 	y, b = x.(blas.Float32Level1)
 	return
@@ -117,6 +168,7 @@ func GijitShadow_InterfaceConvertTo1_Float32Level1(x interface{}) blas.Float32Le
 	return x.(blas.Float32Level1)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_Float32Level2(x interface{}) (y blas.Float32Level2, b bool) {
 	y, b = x.(blas.Float32Level2)
 	return
@@ -126,6 +178,7 @@ func GijitShadow_InterfaceConvertTo1_Float32Level2(x interface{}) blas.Float32Le
 	return x.(blas.Float32Level2)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_Float32Level3(x interface{}) (y blas.Float32Level3, b bool) {
 	y, b = x.(blas.Float32Level3)
 	return
@@ -135,6 +188,7 @@ func GijitShadow_InterfaceConvertTo1_Float32Level3(x interface{}) blas.Float32Le
 	return x.(blas.Float32Level3)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_Float64(x interface{}) (y blas.Float64, b bool) {
 	y, b = x.(blas.Float64)
 	return
@@ -144,6 +198,7 @@ func GijitShadow_InterfaceConvertTo1_Float64(x interface{}) blas.Float64 {
 	return x.(blas.Float64)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_Float64Level1(x interface{}) (y blas.Float64Level1, b bool) {
 	y, b = x.(blas.Float64Level1)
 	return
@@ -153,6 +208,7 @@ func GijitShadow_InterfaceConvertTo1_Float64Level1(x interface{}) blas.Float64Le
 	return x.(blas.Float64Level1)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_Float64Level2(x interface{}) (y blas.Float64Level2, b bool) {
 	y, b = x.(blas.Float64Level2)
 	return
@@ -162,6 +218,7 @@ func GijitShadow_InterfaceConvertTo1_Float64Level2(x interface{}) blas.Float64Le
 	return x.(blas.Float64Level2)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_Float64Level3(x interface{}) (y blas.Float64Level3, b bool) {
 	y, b = x.(blas.Float64Level3)
 	return
@@ -171,6 +228,153 @@ func GijitShadow_InterfaceConvertTo1_Float64Level3(x interface{}) blas.Float64Le
 	return x.(blas.Float64Level3)
 }
 
-func GijitShadow_NewStruct_SrotmParams() *blas.SrotmParams {
-	return &blas.SrotmParams{}
+
+func GijitShadow_NewNamed_Side(src blas.Side) blas.Side {
+    return src
+}
+
+
+func GijitShadow_NewStruct_SrotmParams(src *blas.SrotmParams) *blas.SrotmParams {
+    if src == nil {
+	   return &blas.SrotmParams{}
+    }
+    a := *src
+    return &a
+}
+
+
+func GijitShadow_NewNamed_Transpose(src blas.Transpose) blas.Transpose {
+    return src
+}
+
+
+func GijitShadow_NewNamed_Uplo(src blas.Uplo) blas.Uplo {
+    return src
 }
+
+
+
+ func InitLua() string {
+  return `
+__type__.blas ={};
+
+-----------------
+-- struct DrotmParams
+-----------------
+
+__type__.blas.DrotmParams = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "DrotmParams",
+ __str = "DrotmParams",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__blas.DrotmParams(src)
+ end,
+};
+setmetatable(__type__.blas.DrotmParams, __type__.blas.DrotmParams);
+
+
+-----------------
+-- struct SrotmParams
+-----------------
+
+__type__.blas.SrotmParams = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "SrotmParams",
+ __str = "SrotmParams",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__blas.SrotmParams(src)
+ end,
+};
+setmetatable(__type__.blas.SrotmParams, __type__.blas.SrotmParams);
+
+
+-----------------
+-- named type Diag
+-----------------
+
+__type__.blas.Diag = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "Diag",
+ __str = "Diag",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__blas.Diag(src)
+ end,
+};
+setmetatable(__type__.blas.Diag, __type__.blas.Diag);
+
+
+-----------------
+-- named type Flag
+-----------------
+
+__type__.blas.Flag = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "Flag",
+ __str = "Flag",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__blas.Flag(src)
+ end,
+};
+setmetatable(__type__.blas.Flag, __type__.blas.Flag);
+
+
+-----------------
+-- named type Side
+-----------------
+
+__type__.blas.Side = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "Side",
+ __str = "Side",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__blas.Side(src)
+ end,
+};
+setmetatable(__type__.blas.Side, __type__.blas.Side);
+
+
+-----------------
+-- named type Transpose
+-----------------
+
+__type__.blas.Transpose = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "Transpose",
+ __str = "Transpose",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__blas.Transpose(src)
+ end,
+};
+setmetatable(__type__.blas.Transpose, __type__.blas.Transpose);
+
+
+-----------------
+-- named type Uplo
+-----------------
+
+__type__.blas.Uplo = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "Uplo",
+ __str = "Uplo",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__blas.Uplo(src)
+ end,
+};
+setmetatable(__type__.blas.Uplo, __type__.blas.Uplo);
+
+
+`}
\ No newline at end of file
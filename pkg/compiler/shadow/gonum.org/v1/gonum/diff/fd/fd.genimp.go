@@ -1,36 +1,141 @@
 package shadow_fd
 
-import "gonum.org/v1/gonum/diff/fd"
+import (
+	"gonum.org/v1/gonum/diff/fd"
+
+	"github.com/gijit/gi/pkg/compiler/shadow"
+)
 
 var Pkg = make(map[string]interface{})
+var Ctor = make(map[string]interface{})
 
 func init() {
-	Pkg["Backward"] = fd.Backward
-	Pkg["Backward2nd"] = fd.Backward2nd
-	Pkg["Central"] = fd.Central
-	Pkg["Central2nd"] = fd.Central2nd
-	Pkg["CrossLaplacian"] = fd.CrossLaplacian
-	Pkg["Derivative"] = fd.Derivative
-	Pkg["Forward"] = fd.Forward
-	Pkg["Forward2nd"] = fd.Forward2nd
-	Pkg["Gradient"] = fd.Gradient
-	Pkg["Hessian"] = fd.Hessian
-	Pkg["Jacobian"] = fd.Jacobian
-	Pkg["Laplacian"] = fd.Laplacian
+    Pkg["Backward"] = fd.Backward
+    Pkg["Backward2nd"] = fd.Backward2nd
+    Pkg["Central"] = fd.Central
+    Pkg["Central2nd"] = fd.Central2nd
+    Pkg["CrossLaplacian"] = fd.CrossLaplacian
+    Pkg["Derivative"] = fd.Derivative
+    Ctor["Formula"] = GijitShadow_NewStruct_Formula
+    Pkg["Forward"] = fd.Forward
+    Pkg["Forward2nd"] = fd.Forward2nd
+    Pkg["Gradient"] = fd.Gradient
+    Pkg["Hessian"] = fd.Hessian
+    Pkg["Jacobian"] = fd.Jacobian
+    Ctor["JacobianSettings"] = GijitShadow_NewStruct_JacobianSettings
+    Pkg["Laplacian"] = fd.Laplacian
+    Ctor["Point"] = GijitShadow_NewStruct_Point
+    Ctor["Settings"] = GijitShadow_NewStruct_Settings
 
+    shadow.Register("gonum.org/v1/gonum/diff/fd", "fd", Pkg, Ctor, InitLua)
 }
-func GijitShadow_NewStruct_Formula() *fd.Formula {
-	return &fd.Formula{}
+func GijitShadow_NewStruct_Formula(src *fd.Formula) *fd.Formula {
+    if src == nil {
+	   return &fd.Formula{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_JacobianSettings() *fd.JacobianSettings {
-	return &fd.JacobianSettings{}
+
+func GijitShadow_NewStruct_JacobianSettings(src *fd.JacobianSettings) *fd.JacobianSettings {
+    if src == nil {
+	   return &fd.JacobianSettings{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_Point() *fd.Point {
-	return &fd.Point{}
+
+func GijitShadow_NewStruct_Point(src *fd.Point) *fd.Point {
+    if src == nil {
+	   return &fd.Point{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_Settings() *fd.Settings {
-	return &fd.Settings{}
+
+func GijitShadow_NewStruct_Settings(src *fd.Settings) *fd.Settings {
+    if src == nil {
+	   return &fd.Settings{}
+    }
+    a := *src
+    return &a
 }
+
+
+
+ func InitLua() string {
+  return `
+__type__.fd ={};
+
+-----------------
+-- struct Formula
+-----------------
+
+__type__.fd.Formula = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "Formula",
+ __str = "Formula",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__fd.Formula(src)
+ end,
+};
+setmetatable(__type__.fd.Formula, __type__.fd.Formula);
+
+
+-----------------
+-- struct JacobianSettings
+-----------------
+
+__type__.fd.JacobianSettings = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "JacobianSettings",
+ __str = "JacobianSettings",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__fd.JacobianSettings(src)
+ end,
+};
+setmetatable(__type__.fd.JacobianSettings, __type__.fd.JacobianSettings);
+
+
+-----------------
+-- struct Point
+-----------------
+
+__type__.fd.Point = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "Point",
+ __str = "Point",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__fd.Point(src)
+ end,
+};
+setmetatable(__type__.fd.Point, __type__.fd.Point);
+
+
+-----------------
+-- struct Settings
+-----------------
+
+__type__.fd.Settings = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "Settings",
+ __str = "Settings",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__fd.Settings(src)
+ end,
+};
+setmetatable(__type__.fd.Settings, __type__.fd.Settings);
+
+
+`}
\ No newline at end of file
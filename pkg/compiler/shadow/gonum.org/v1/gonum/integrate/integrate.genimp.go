@@ -1,10 +1,22 @@
 package shadow_integrate
 
-import "gonum.org/v1/gonum/integrate"
+import (
+	"gonum.org/v1/gonum/integrate"
+
+	"github.com/gijit/gi/pkg/compiler/shadow"
+)
 
 var Pkg = make(map[string]interface{})
+var Ctor = make(map[string]interface{})
 
 func init() {
-	Pkg["Trapezoidal"] = integrate.Trapezoidal
+    Pkg["Trapezoidal"] = integrate.Trapezoidal
 
+    shadow.Register("gonum.org/v1/gonum/integrate", "integrate", Pkg, Ctor, InitLua)
 }
+
+ func InitLua() string {
+  return `
+__type__.integrate ={};
+
+`}
\ No newline at end of file
@@ -1,15 +1,88 @@
 package shadow_lapack
 
-import "gonum.org/v1/gonum/lapack"
+import (
+	"gonum.org/v1/gonum/lapack"
+
+	"github.com/gijit/gi/pkg/compiler/shadow"
+)
 
 var Pkg = make(map[string]interface{})
+var Ctor = make(map[string]interface{})
 
 func init() {
-	Pkg["Complex128"] = GijitShadow_InterfaceConvertTo2_Complex128
-	Pkg["Float64"] = GijitShadow_InterfaceConvertTo2_Float64
-	Pkg["None"] = lapack.None
+    Pkg["AllEV"] = lapack.AllEV
+    Pkg["AllEVMulQ"] = lapack.AllEVMulQ
+    Pkg["ApplyP"] = lapack.ApplyP
+    Pkg["ApplyQ"] = lapack.ApplyQ
+    Pkg["Backward"] = lapack.Backward
+    Pkg["Bottom"] = lapack.Bottom
+    Pkg["ColumnWise"] = lapack.ColumnWise
+    Ctor["Comp"] = GijitShadow_NewNamed_Comp
+    Pkg["Complex128"] = GijitShadow_InterfaceConvertTo2_Complex128
+    Pkg["ComputeEV"] = lapack.ComputeEV
+    Pkg["ComputeLeftEV"] = lapack.ComputeLeftEV
+    Pkg["ComputeRightEV"] = lapack.ComputeRightEV
+    Ctor["DecompUpdate"] = GijitShadow_NewNamed_DecompUpdate
+    Ctor["Direct"] = GijitShadow_NewNamed_Direct
+    Ctor["EVComp"] = GijitShadow_NewNamed_EVComp
+    Ctor["EVJob"] = GijitShadow_NewNamed_EVJob
+    Ctor["EVSide"] = GijitShadow_NewNamed_EVSide
+    Pkg["EigenvaluesAndSchur"] = lapack.EigenvaluesAndSchur
+    Pkg["EigenvaluesOnly"] = lapack.EigenvaluesOnly
+    Pkg["Float64"] = GijitShadow_InterfaceConvertTo2_Float64
+    Pkg["Forward"] = lapack.Forward
+    Ctor["GSVDJob"] = GijitShadow_NewNamed_GSVDJob
+    Pkg["GSVDNone"] = lapack.GSVDNone
+    Pkg["GSVDQ"] = lapack.GSVDQ
+    Pkg["GSVDU"] = lapack.GSVDU
+    Pkg["GSVDUnit"] = lapack.GSVDUnit
+    Pkg["GSVDV"] = lapack.GSVDV
+    Pkg["General"] = lapack.General
+    Pkg["HessEV"] = lapack.HessEV
+    Ctor["HowMany"] = GijitShadow_NewNamed_HowMany
+    Ctor["Job"] = GijitShadow_NewNamed_Job
+    Pkg["LeftEV"] = lapack.LeftEV
+    Ctor["LeftEVJob"] = GijitShadow_NewNamed_LeftEVJob
+    Pkg["LowerTri"] = lapack.LowerTri
+    Ctor["MatrixNorm"] = GijitShadow_NewNamed_MatrixNorm
+    Ctor["MatrixType"] = GijitShadow_NewNamed_MatrixType
+    Pkg["MaxAbs"] = lapack.MaxAbs
+    Pkg["MaxColumnSum"] = lapack.MaxColumnSum
+    Pkg["MaxRowSum"] = lapack.MaxRowSum
+    Pkg["None"] = lapack.None
+    Pkg["NormFrob"] = lapack.NormFrob
+    Pkg["OriginalEV"] = lapack.OriginalEV
+    Pkg["Permute"] = lapack.Permute
+    Pkg["PermuteScale"] = lapack.PermuteScale
+    Ctor["Pivot"] = GijitShadow_NewNamed_Pivot
+    Pkg["RightEV"] = lapack.RightEV
+    Ctor["RightEVJob"] = GijitShadow_NewNamed_RightEVJob
+    Pkg["RightLeftEV"] = lapack.RightLeftEV
+    Pkg["RowWise"] = lapack.RowWise
+    Pkg["SVDAll"] = lapack.SVDAll
+    Pkg["SVDInPlace"] = lapack.SVDInPlace
+    Ctor["SVDJob"] = GijitShadow_NewNamed_SVDJob
+    Pkg["SVDNone"] = lapack.SVDNone
+    Pkg["SVDOverwrite"] = lapack.SVDOverwrite
+    Pkg["Scale"] = lapack.Scale
+    Pkg["SelectedEV"] = lapack.SelectedEV
+    Ctor["Sort"] = GijitShadow_NewNamed_Sort
+    Pkg["SortDecreasing"] = lapack.SortDecreasing
+    Pkg["SortIncreasing"] = lapack.SortIncreasing
+    Ctor["StoreV"] = GijitShadow_NewNamed_StoreV
+    Pkg["Top"] = lapack.Top
+    Pkg["TridiagEV"] = lapack.TridiagEV
+    Pkg["UpdateSchur"] = lapack.UpdateSchur
+    Pkg["UpperTri"] = lapack.UpperTri
+    Pkg["Variable"] = lapack.Variable
 
+    shadow.Register("gonum.org/v1/gonum/lapack", "lapack", Pkg, Ctor, InitLua)
+}
+func GijitShadow_NewNamed_Comp(src lapack.Comp) lapack.Comp {
+    return src
 }
+
+
 func GijitShadow_InterfaceConvertTo2_Complex128(x interface{}) (y lapack.Complex128, b bool) {
 	y, b = x.(lapack.Complex128)
 	return
@@ -19,6 +92,32 @@ func GijitShadow_InterfaceConvertTo1_Complex128(x interface{}) lapack.Complex128
 	return x.(lapack.Complex128)
 }
 
+
+func GijitShadow_NewNamed_DecompUpdate(src lapack.DecompUpdate) lapack.DecompUpdate {
+    return src
+}
+
+
+func GijitShadow_NewNamed_Direct(src lapack.Direct) lapack.Direct {
+    return src
+}
+
+
+func GijitShadow_NewNamed_EVComp(src lapack.EVComp) lapack.EVComp {
+    return src
+}
+
+
+func GijitShadow_NewNamed_EVJob(src lapack.EVJob) lapack.EVJob {
+    return src
+}
+
+
+func GijitShadow_NewNamed_EVSide(src lapack.EVSide) lapack.EVSide {
+    return src
+}
+
+
 func GijitShadow_InterfaceConvertTo2_Float64(x interface{}) (y lapack.Float64, b bool) {
 	y, b = x.(lapack.Float64)
 	return
@@ -27,3 +126,355 @@ func GijitShadow_InterfaceConvertTo2_Float64(x interface{}) (y lapack.Float64, b
 func GijitShadow_InterfaceConvertTo1_Float64(x interface{}) lapack.Float64 {
 	return x.(lapack.Float64)
 }
+
+
+func GijitShadow_NewNamed_GSVDJob(src lapack.GSVDJob) lapack.GSVDJob {
+    return src
+}
+
+
+func GijitShadow_NewNamed_HowMany(src lapack.HowMany) lapack.HowMany {
+    return src
+}
+
+
+func GijitShadow_NewNamed_Job(src lapack.Job) lapack.Job {
+    return src
+}
+
+
+func GijitShadow_NewNamed_LeftEVJob(src lapack.LeftEVJob) lapack.LeftEVJob {
+    return src
+}
+
+
+func GijitShadow_NewNamed_MatrixNorm(src lapack.MatrixNorm) lapack.MatrixNorm {
+    return src
+}
+
+
+func GijitShadow_NewNamed_MatrixType(src lapack.MatrixType) lapack.MatrixType {
+    return src
+}
+
+
+func GijitShadow_NewNamed_Pivot(src lapack.Pivot) lapack.Pivot {
+    return src
+}
+
+
+func GijitShadow_NewNamed_RightEVJob(src lapack.RightEVJob) lapack.RightEVJob {
+    return src
+}
+
+
+func GijitShadow_NewNamed_SVDJob(src lapack.SVDJob) lapack.SVDJob {
+    return src
+}
+
+
+func GijitShadow_NewNamed_Sort(src lapack.Sort) lapack.Sort {
+    return src
+}
+
+
+func GijitShadow_NewNamed_StoreV(src lapack.StoreV) lapack.StoreV {
+    return src
+}
+
+
+
+ func InitLua() string {
+  return `
+__type__.lapack ={};
+
+-----------------
+-- named type Comp
+-----------------
+
+__type__.lapack.Comp = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "Comp",
+ __str = "Comp",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__lapack.Comp(src)
+ end,
+};
+setmetatable(__type__.lapack.Comp, __type__.lapack.Comp);
+
+
+-----------------
+-- named type DecompUpdate
+-----------------
+
+__type__.lapack.DecompUpdate = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "DecompUpdate",
+ __str = "DecompUpdate",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__lapack.DecompUpdate(src)
+ end,
+};
+setmetatable(__type__.lapack.DecompUpdate, __type__.lapack.DecompUpdate);
+
+
+-----------------
+-- named type Direct
+-----------------
+
+__type__.lapack.Direct = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "Direct",
+ __str = "Direct",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__lapack.Direct(src)
+ end,
+};
+setmetatable(__type__.lapack.Direct, __type__.lapack.Direct);
+
+
+-----------------
+-- named type EVComp
+-----------------
+
+__type__.lapack.EVComp = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "EVComp",
+ __str = "EVComp",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__lapack.EVComp(src)
+ end,
+};
+setmetatable(__type__.lapack.EVComp, __type__.lapack.EVComp);
+
+
+-----------------
+-- named type EVJob
+-----------------
+
+__type__.lapack.EVJob = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "EVJob",
+ __str = "EVJob",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__lapack.EVJob(src)
+ end,
+};
+setmetatable(__type__.lapack.EVJob, __type__.lapack.EVJob);
+
+
+-----------------
+-- named type EVSide
+-----------------
+
+__type__.lapack.EVSide = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "EVSide",
+ __str = "EVSide",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__lapack.EVSide(src)
+ end,
+};
+setmetatable(__type__.lapack.EVSide, __type__.lapack.EVSide);
+
+
+-----------------
+-- named type GSVDJob
+-----------------
+
+__type__.lapack.GSVDJob = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "GSVDJob",
+ __str = "GSVDJob",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__lapack.GSVDJob(src)
+ end,
+};
+setmetatable(__type__.lapack.GSVDJob, __type__.lapack.GSVDJob);
+
+
+-----------------
+-- named type HowMany
+-----------------
+
+__type__.lapack.HowMany = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "HowMany",
+ __str = "HowMany",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__lapack.HowMany(src)
+ end,
+};
+setmetatable(__type__.lapack.HowMany, __type__.lapack.HowMany);
+
+
+-----------------
+-- named type Job
+-----------------
+
+__type__.lapack.Job = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "Job",
+ __str = "Job",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__lapack.Job(src)
+ end,
+};
+setmetatable(__type__.lapack.Job, __type__.lapack.Job);
+
+
+-----------------
+-- named type LeftEVJob
+-----------------
+
+__type__.lapack.LeftEVJob = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "LeftEVJob",
+ __str = "LeftEVJob",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__lapack.LeftEVJob(src)
+ end,
+};
+setmetatable(__type__.lapack.LeftEVJob, __type__.lapack.LeftEVJob);
+
+
+-----------------
+-- named type MatrixNorm
+-----------------
+
+__type__.lapack.MatrixNorm = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "MatrixNorm",
+ __str = "MatrixNorm",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__lapack.MatrixNorm(src)
+ end,
+};
+setmetatable(__type__.lapack.MatrixNorm, __type__.lapack.MatrixNorm);
+
+
+-----------------
+-- named type MatrixType
+-----------------
+
+__type__.lapack.MatrixType = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "MatrixType",
+ __str = "MatrixType",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__lapack.MatrixType(src)
+ end,
+};
+setmetatable(__type__.lapack.MatrixType, __type__.lapack.MatrixType);
+
+
+-----------------
+-- named type Pivot
+-----------------
+
+__type__.lapack.Pivot = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "Pivot",
+ __str = "Pivot",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__lapack.Pivot(src)
+ end,
+};
+setmetatable(__type__.lapack.Pivot, __type__.lapack.Pivot);
+
+
+-----------------
+-- named type RightEVJob
+-----------------
+
+__type__.lapack.RightEVJob = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "RightEVJob",
+ __str = "RightEVJob",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__lapack.RightEVJob(src)
+ end,
+};
+setmetatable(__type__.lapack.RightEVJob, __type__.lapack.RightEVJob);
+
+
+-----------------
+-- named type SVDJob
+-----------------
+
+__type__.lapack.SVDJob = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "SVDJob",
+ __str = "SVDJob",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__lapack.SVDJob(src)
+ end,
+};
+setmetatable(__type__.lapack.SVDJob, __type__.lapack.SVDJob);
+
+
+-----------------
+-- named type Sort
+-----------------
+
+__type__.lapack.Sort = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "Sort",
+ __str = "Sort",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__lapack.Sort(src)
+ end,
+};
+setmetatable(__type__.lapack.Sort, __type__.lapack.Sort);
+
+
+-----------------
+-- named type StoreV
+-----------------
+
+__type__.lapack.StoreV = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "StoreV",
+ __str = "StoreV",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__lapack.StoreV(src)
+ end,
+};
+setmetatable(__type__.lapack.StoreV, __type__.lapack.StoreV);
+
+
+`}
\ No newline at end of file
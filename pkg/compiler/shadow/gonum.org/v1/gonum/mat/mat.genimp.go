@@ -1,102 +1,151 @@
 package shadow_mat
 
-import "gonum.org/v1/gonum/mat"
+import (
+	"gonum.org/v1/gonum/mat"
+
+	"github.com/gijit/gi/pkg/compiler/shadow"
+)
 
 var Pkg = make(map[string]interface{})
+var Ctor = make(map[string]interface{})
 
 func init() {
-	Pkg["BandWidther"] = GijitShadow_InterfaceConvertTo2_BandWidther
-	Pkg["Banded"] = GijitShadow_InterfaceConvertTo2_Banded
-	Pkg["CMatrix"] = GijitShadow_InterfaceConvertTo2_CMatrix
-	Pkg["Cloner"] = GijitShadow_InterfaceConvertTo2_Cloner
-	Pkg["Col"] = mat.Col
-	Pkg["ColNonZeroDoer"] = GijitShadow_InterfaceConvertTo2_ColNonZeroDoer
-	Pkg["ColViewer"] = GijitShadow_InterfaceConvertTo2_ColViewer
-	Pkg["Cond"] = mat.Cond
-	Pkg["ConditionTolerance"] = mat.ConditionTolerance
-	Pkg["Copier"] = GijitShadow_InterfaceConvertTo2_Copier
-	Pkg["DenseCopyOf"] = mat.DenseCopyOf
-	Pkg["Det"] = mat.Det
-	Pkg["Dot"] = mat.Dot
-	Pkg["DotByte"] = mat.DotByte
-	Pkg["Equal"] = mat.Equal
-	Pkg["EqualApprox"] = mat.EqualApprox
-	Pkg["ErrBandSet"] = mat.ErrBandSet
-	Pkg["ErrColAccess"] = mat.ErrColAccess
-	Pkg["ErrColLength"] = mat.ErrColLength
-	Pkg["ErrFailedEigen"] = mat.ErrFailedEigen
-	Pkg["ErrIllegalStride"] = mat.ErrIllegalStride
-	Pkg["ErrIndexOutOfRange"] = mat.ErrIndexOutOfRange
-	Pkg["ErrNormOrder"] = mat.ErrNormOrder
-	Pkg["ErrNotPSD"] = mat.ErrNotPSD
-	Pkg["ErrPivot"] = mat.ErrPivot
-	Pkg["ErrRowAccess"] = mat.ErrRowAccess
-	Pkg["ErrRowLength"] = mat.ErrRowLength
-	Pkg["ErrShape"] = mat.ErrShape
-	Pkg["ErrSingular"] = mat.ErrSingular
-	Pkg["ErrSliceLengthMismatch"] = mat.ErrSliceLengthMismatch
-	Pkg["ErrSquare"] = mat.ErrSquare
-	Pkg["ErrTriangle"] = mat.ErrTriangle
-	Pkg["ErrTriangleSet"] = mat.ErrTriangleSet
-	Pkg["ErrVectorAccess"] = mat.ErrVectorAccess
-	Pkg["ErrZeroLength"] = mat.ErrZeroLength
-	Pkg["Excerpt"] = mat.Excerpt
-	Pkg["Formatted"] = mat.Formatted
-	Pkg["Grower"] = GijitShadow_InterfaceConvertTo2_Grower
-	Pkg["Inner"] = mat.Inner
-	Pkg["LogDet"] = mat.LogDet
-	Pkg["Matrix"] = GijitShadow_InterfaceConvertTo2_Matrix
-	Pkg["Max"] = mat.Max
-	Pkg["Maybe"] = mat.Maybe
-	Pkg["MaybeComplex"] = mat.MaybeComplex
-	Pkg["MaybeFloat"] = mat.MaybeFloat
-	Pkg["Min"] = mat.Min
-	Pkg["Mutable"] = GijitShadow_InterfaceConvertTo2_Mutable
-	Pkg["MutableBanded"] = GijitShadow_InterfaceConvertTo2_MutableBanded
-	Pkg["MutableSymBanded"] = GijitShadow_InterfaceConvertTo2_MutableSymBanded
-	Pkg["MutableSymmetric"] = GijitShadow_InterfaceConvertTo2_MutableSymmetric
-	Pkg["MutableTriangular"] = GijitShadow_InterfaceConvertTo2_MutableTriangular
-	Pkg["NewBandDense"] = mat.NewBandDense
-	Pkg["NewDense"] = mat.NewDense
-	Pkg["NewDiagonal"] = mat.NewDiagonal
-	Pkg["NewDiagonalRect"] = mat.NewDiagonalRect
-	Pkg["NewSymBandDense"] = mat.NewSymBandDense
-	Pkg["NewSymDense"] = mat.NewSymDense
-	Pkg["NewTriDense"] = mat.NewTriDense
-	Pkg["NewVecDense"] = mat.NewVecDense
-	Pkg["NonZeroDoer"] = GijitShadow_InterfaceConvertTo2_NonZeroDoer
-	Pkg["Norm"] = mat.Norm
-	Pkg["Prefix"] = mat.Prefix
-	Pkg["RawBander"] = GijitShadow_InterfaceConvertTo2_RawBander
-	Pkg["RawColViewer"] = GijitShadow_InterfaceConvertTo2_RawColViewer
-	Pkg["RawMatrixSetter"] = GijitShadow_InterfaceConvertTo2_RawMatrixSetter
-	Pkg["RawMatrixer"] = GijitShadow_InterfaceConvertTo2_RawMatrixer
-	Pkg["RawRowViewer"] = GijitShadow_InterfaceConvertTo2_RawRowViewer
-	Pkg["RawSymBander"] = GijitShadow_InterfaceConvertTo2_RawSymBander
-	Pkg["RawSymmetricer"] = GijitShadow_InterfaceConvertTo2_RawSymmetricer
-	Pkg["RawTriangular"] = GijitShadow_InterfaceConvertTo2_RawTriangular
-	Pkg["RawVectorer"] = GijitShadow_InterfaceConvertTo2_RawVectorer
-	Pkg["Reseter"] = GijitShadow_InterfaceConvertTo2_Reseter
-	Pkg["Row"] = mat.Row
-	Pkg["RowNonZeroDoer"] = GijitShadow_InterfaceConvertTo2_RowNonZeroDoer
-	Pkg["RowViewer"] = GijitShadow_InterfaceConvertTo2_RowViewer
-	Pkg["Squeeze"] = mat.Squeeze
-	Pkg["Sum"] = mat.Sum
-	Pkg["Symmetric"] = GijitShadow_InterfaceConvertTo2_Symmetric
-	Pkg["Trace"] = mat.Trace
-	Pkg["Triangular"] = GijitShadow_InterfaceConvertTo2_Triangular
-	Pkg["Unconjugator"] = GijitShadow_InterfaceConvertTo2_Unconjugator
-	Pkg["UntransposeBander"] = GijitShadow_InterfaceConvertTo2_UntransposeBander
-	Pkg["UntransposeTrier"] = GijitShadow_InterfaceConvertTo2_UntransposeTrier
-	Pkg["Untransposer"] = GijitShadow_InterfaceConvertTo2_Untransposer
-	Pkg["VecDenseCopyOf"] = mat.VecDenseCopyOf
-	Pkg["Vector"] = GijitShadow_InterfaceConvertTo2_Vector
-
-}
-func GijitShadow_NewStruct_BandDense() *mat.BandDense {
-	return &mat.BandDense{}
+    Ctor["BandDense"] = GijitShadow_NewStruct_BandDense
+    Pkg["BandWidther"] = GijitShadow_InterfaceConvertTo2_BandWidther
+    Pkg["Banded"] = GijitShadow_InterfaceConvertTo2_Banded
+    Pkg["CMatrix"] = GijitShadow_InterfaceConvertTo2_CMatrix
+    Ctor["Cholesky"] = GijitShadow_NewStruct_Cholesky
+    Pkg["Cloner"] = GijitShadow_InterfaceConvertTo2_Cloner
+    Pkg["Col"] = mat.Col
+    Pkg["ColNonZeroDoer"] = GijitShadow_InterfaceConvertTo2_ColNonZeroDoer
+    Pkg["ColViewer"] = GijitShadow_InterfaceConvertTo2_ColViewer
+    Pkg["Cond"] = mat.Cond
+    Pkg["CondNorm"] = mat.CondNorm
+    Pkg["CondNormTrans"] = mat.CondNormTrans
+    Ctor["Condition"] = GijitShadow_NewNamed_Condition
+    Pkg["ConditionTolerance"] = mat.ConditionTolerance
+    Ctor["Conjugate"] = GijitShadow_NewStruct_Conjugate
+    Pkg["Copier"] = GijitShadow_InterfaceConvertTo2_Copier
+    Ctor["Dense"] = GijitShadow_NewStruct_Dense
+    Pkg["DenseCopyOf"] = mat.DenseCopyOf
+    Pkg["Det"] = mat.Det
+    Pkg["Dot"] = mat.Dot
+    Pkg["DotByte"] = mat.DotByte
+    Ctor["Eigen"] = GijitShadow_NewStruct_Eigen
+    Ctor["EigenSym"] = GijitShadow_NewStruct_EigenSym
+    Pkg["Equal"] = mat.Equal
+    Pkg["EqualApprox"] = mat.EqualApprox
+    Pkg["ErrBandSet"] = mat.ErrBandSet
+    Pkg["ErrColAccess"] = mat.ErrColAccess
+    Pkg["ErrColLength"] = mat.ErrColLength
+    Pkg["ErrFailedEigen"] = mat.ErrFailedEigen
+    Pkg["ErrIllegalStride"] = mat.ErrIllegalStride
+    Pkg["ErrIndexOutOfRange"] = mat.ErrIndexOutOfRange
+    Pkg["ErrNormOrder"] = mat.ErrNormOrder
+    Pkg["ErrNotPSD"] = mat.ErrNotPSD
+    Pkg["ErrPivot"] = mat.ErrPivot
+    Pkg["ErrRowAccess"] = mat.ErrRowAccess
+    Pkg["ErrRowLength"] = mat.ErrRowLength
+    Pkg["ErrShape"] = mat.ErrShape
+    Pkg["ErrSingular"] = mat.ErrSingular
+    Pkg["ErrSliceLengthMismatch"] = mat.ErrSliceLengthMismatch
+    Pkg["ErrSquare"] = mat.ErrSquare
+    Pkg["ErrTriangle"] = mat.ErrTriangle
+    Pkg["ErrTriangleSet"] = mat.ErrTriangleSet
+    Pkg["ErrVectorAccess"] = mat.ErrVectorAccess
+    Pkg["ErrZeroLength"] = mat.ErrZeroLength
+    Ctor["Error"] = GijitShadow_NewStruct_Error
+    Ctor["ErrorStack"] = GijitShadow_NewStruct_ErrorStack
+    Pkg["Excerpt"] = mat.Excerpt
+    Ctor["FormatOption"] = GijitShadow_NewNamed_FormatOption
+    Pkg["Formatted"] = mat.Formatted
+    Ctor["GSVD"] = GijitShadow_NewStruct_GSVD
+    Ctor["GSVDKind"] = GijitShadow_NewNamed_GSVDKind
+    Pkg["GSVDNone"] = mat.GSVDNone
+    Pkg["GSVDQ"] = mat.GSVDQ
+    Pkg["GSVDU"] = mat.GSVDU
+    Pkg["GSVDV"] = mat.GSVDV
+    Pkg["Grower"] = GijitShadow_InterfaceConvertTo2_Grower
+    Ctor["HOGSVD"] = GijitShadow_NewStruct_HOGSVD
+    Pkg["Inner"] = mat.Inner
+    Ctor["LQ"] = GijitShadow_NewStruct_LQ
+    Ctor["LU"] = GijitShadow_NewStruct_LU
+    Pkg["LogDet"] = mat.LogDet
+    Pkg["Lower"] = mat.Lower
+    Pkg["Matrix"] = GijitShadow_InterfaceConvertTo2_Matrix
+    Pkg["Max"] = mat.Max
+    Pkg["Maybe"] = mat.Maybe
+    Pkg["MaybeComplex"] = mat.MaybeComplex
+    Pkg["MaybeFloat"] = mat.MaybeFloat
+    Pkg["Min"] = mat.Min
+    Pkg["Mutable"] = GijitShadow_InterfaceConvertTo2_Mutable
+    Pkg["MutableBanded"] = GijitShadow_InterfaceConvertTo2_MutableBanded
+    Pkg["MutableSymBanded"] = GijitShadow_InterfaceConvertTo2_MutableSymBanded
+    Pkg["MutableSymmetric"] = GijitShadow_InterfaceConvertTo2_MutableSymmetric
+    Pkg["MutableTriangular"] = GijitShadow_InterfaceConvertTo2_MutableTriangular
+    Pkg["NewBandDense"] = mat.NewBandDense
+    Pkg["NewDense"] = mat.NewDense
+    Pkg["NewDiagonal"] = mat.NewDiagonal
+    Pkg["NewDiagonalRect"] = mat.NewDiagonalRect
+    Pkg["NewSymBandDense"] = mat.NewSymBandDense
+    Pkg["NewSymDense"] = mat.NewSymDense
+    Pkg["NewTriDense"] = mat.NewTriDense
+    Pkg["NewVecDense"] = mat.NewVecDense
+    Pkg["NonZeroDoer"] = GijitShadow_InterfaceConvertTo2_NonZeroDoer
+    Pkg["Norm"] = mat.Norm
+    Pkg["Prefix"] = mat.Prefix
+    Ctor["QR"] = GijitShadow_NewStruct_QR
+    Pkg["RawBander"] = GijitShadow_InterfaceConvertTo2_RawBander
+    Pkg["RawColViewer"] = GijitShadow_InterfaceConvertTo2_RawColViewer
+    Pkg["RawMatrixSetter"] = GijitShadow_InterfaceConvertTo2_RawMatrixSetter
+    Pkg["RawMatrixer"] = GijitShadow_InterfaceConvertTo2_RawMatrixer
+    Pkg["RawRowViewer"] = GijitShadow_InterfaceConvertTo2_RawRowViewer
+    Pkg["RawSymBander"] = GijitShadow_InterfaceConvertTo2_RawSymBander
+    Pkg["RawSymmetricer"] = GijitShadow_InterfaceConvertTo2_RawSymmetricer
+    Pkg["RawTriangular"] = GijitShadow_InterfaceConvertTo2_RawTriangular
+    Pkg["RawVectorer"] = GijitShadow_InterfaceConvertTo2_RawVectorer
+    Pkg["Reseter"] = GijitShadow_InterfaceConvertTo2_Reseter
+    Pkg["Row"] = mat.Row
+    Pkg["RowNonZeroDoer"] = GijitShadow_InterfaceConvertTo2_RowNonZeroDoer
+    Pkg["RowViewer"] = GijitShadow_InterfaceConvertTo2_RowViewer
+    Ctor["SVD"] = GijitShadow_NewStruct_SVD
+    Pkg["SVDFull"] = mat.SVDFull
+    Ctor["SVDKind"] = GijitShadow_NewNamed_SVDKind
+    Pkg["SVDNone"] = mat.SVDNone
+    Pkg["SVDThin"] = mat.SVDThin
+    Pkg["Squeeze"] = mat.Squeeze
+    Pkg["Sum"] = mat.Sum
+    Ctor["SymBandDense"] = GijitShadow_NewStruct_SymBandDense
+    Ctor["SymDense"] = GijitShadow_NewStruct_SymDense
+    Pkg["Symmetric"] = GijitShadow_InterfaceConvertTo2_Symmetric
+    Pkg["Trace"] = mat.Trace
+    Ctor["Transpose"] = GijitShadow_NewStruct_Transpose
+    Ctor["TransposeBand"] = GijitShadow_NewStruct_TransposeBand
+    Ctor["TransposeTri"] = GijitShadow_NewStruct_TransposeTri
+    Ctor["TransposeVec"] = GijitShadow_NewStruct_TransposeVec
+    Ctor["TriDense"] = GijitShadow_NewStruct_TriDense
+    Ctor["TriKind"] = GijitShadow_NewNamed_TriKind
+    Pkg["Triangular"] = GijitShadow_InterfaceConvertTo2_Triangular
+    Pkg["Unconjugator"] = GijitShadow_InterfaceConvertTo2_Unconjugator
+    Pkg["UntransposeBander"] = GijitShadow_InterfaceConvertTo2_UntransposeBander
+    Pkg["UntransposeTrier"] = GijitShadow_InterfaceConvertTo2_UntransposeTrier
+    Pkg["Untransposer"] = GijitShadow_InterfaceConvertTo2_Untransposer
+    Pkg["Upper"] = mat.Upper
+    Ctor["VecDense"] = GijitShadow_NewStruct_VecDense
+    Pkg["VecDenseCopyOf"] = mat.VecDenseCopyOf
+    Pkg["Vector"] = GijitShadow_InterfaceConvertTo2_Vector
+
+    shadow.Register("gonum.org/v1/gonum/mat", "mat", Pkg, Ctor, InitLua)
+}
+func GijitShadow_NewStruct_BandDense(src *mat.BandDense) *mat.BandDense {
+    if src == nil {
+	   return &mat.BandDense{}
+    }
+    a := *src
+    return &a
 }
 
+
 func GijitShadow_InterfaceConvertTo2_BandWidther(x interface{}) (y mat.BandWidther, b bool) {
 	y, b = x.(mat.BandWidther)
 	return
@@ -106,6 +155,7 @@ func GijitShadow_InterfaceConvertTo1_BandWidther(x interface{}) mat.BandWidther
 	return x.(mat.BandWidther)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_Banded(x interface{}) (y mat.Banded, b bool) {
 	y, b = x.(mat.Banded)
 	return
@@ -115,6 +165,7 @@ func GijitShadow_InterfaceConvertTo1_Banded(x interface{}) mat.Banded {
 	return x.(mat.Banded)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_CMatrix(x interface{}) (y mat.CMatrix, b bool) {
 	y, b = x.(mat.CMatrix)
 	return
@@ -124,10 +175,16 @@ func GijitShadow_InterfaceConvertTo1_CMatrix(x interface{}) mat.CMatrix {
 	return x.(mat.CMatrix)
 }
 
-func GijitShadow_NewStruct_Cholesky() *mat.Cholesky {
-	return &mat.Cholesky{}
+
+func GijitShadow_NewStruct_Cholesky(src *mat.Cholesky) *mat.Cholesky {
+    if src == nil {
+	   return &mat.Cholesky{}
+    }
+    a := *src
+    return &a
 }
 
+
 func GijitShadow_InterfaceConvertTo2_Cloner(x interface{}) (y mat.Cloner, b bool) {
 	y, b = x.(mat.Cloner)
 	return
@@ -137,6 +194,7 @@ func GijitShadow_InterfaceConvertTo1_Cloner(x interface{}) mat.Cloner {
 	return x.(mat.Cloner)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_ColNonZeroDoer(x interface{}) (y mat.ColNonZeroDoer, b bool) {
 	y, b = x.(mat.ColNonZeroDoer)
 	return
@@ -146,6 +204,7 @@ func GijitShadow_InterfaceConvertTo1_ColNonZeroDoer(x interface{}) mat.ColNonZer
 	return x.(mat.ColNonZeroDoer)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_ColViewer(x interface{}) (y mat.ColViewer, b bool) {
 	y, b = x.(mat.ColViewer)
 	return
@@ -155,10 +214,21 @@ func GijitShadow_InterfaceConvertTo1_ColViewer(x interface{}) mat.ColViewer {
 	return x.(mat.ColViewer)
 }
 
-func GijitShadow_NewStruct_Conjugate() *mat.Conjugate {
-	return &mat.Conjugate{}
+
+func GijitShadow_NewNamed_Condition(src mat.Condition) mat.Condition {
+    return src
 }
 
+
+func GijitShadow_NewStruct_Conjugate(src *mat.Conjugate) *mat.Conjugate {
+    if src == nil {
+	   return &mat.Conjugate{}
+    }
+    a := *src
+    return &a
+}
+
+
 func GijitShadow_InterfaceConvertTo2_Copier(x interface{}) (y mat.Copier, b bool) {
 	y, b = x.(mat.Copier)
 	return
@@ -168,30 +238,71 @@ func GijitShadow_InterfaceConvertTo1_Copier(x interface{}) mat.Copier {
 	return x.(mat.Copier)
 }
 
-func GijitShadow_NewStruct_Dense() *mat.Dense {
-	return &mat.Dense{}
+
+func GijitShadow_NewStruct_Dense(src *mat.Dense) *mat.Dense {
+    if src == nil {
+	   return &mat.Dense{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_Eigen() *mat.Eigen {
-	return &mat.Eigen{}
+
+func GijitShadow_NewStruct_Eigen(src *mat.Eigen) *mat.Eigen {
+    if src == nil {
+	   return &mat.Eigen{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_EigenSym() *mat.EigenSym {
-	return &mat.EigenSym{}
+
+func GijitShadow_NewStruct_EigenSym(src *mat.EigenSym) *mat.EigenSym {
+    if src == nil {
+	   return &mat.EigenSym{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_Error() *mat.Error {
-	return &mat.Error{}
+
+func GijitShadow_NewStruct_Error(src *mat.Error) *mat.Error {
+    if src == nil {
+	   return &mat.Error{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_ErrorStack() *mat.ErrorStack {
-	return &mat.ErrorStack{}
+
+func GijitShadow_NewStruct_ErrorStack(src *mat.ErrorStack) *mat.ErrorStack {
+    if src == nil {
+	   return &mat.ErrorStack{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_GSVD() *mat.GSVD {
-	return &mat.GSVD{}
+
+func GijitShadow_NewNamed_FormatOption(src mat.FormatOption) mat.FormatOption {
+    return src
 }
 
+
+func GijitShadow_NewStruct_GSVD(src *mat.GSVD) *mat.GSVD {
+    if src == nil {
+	   return &mat.GSVD{}
+    }
+    a := *src
+    return &a
+}
+
+
+func GijitShadow_NewNamed_GSVDKind(src mat.GSVDKind) mat.GSVDKind {
+    return src
+}
+
+
 func GijitShadow_InterfaceConvertTo2_Grower(x interface{}) (y mat.Grower, b bool) {
 	y, b = x.(mat.Grower)
 	return
@@ -201,18 +312,34 @@ func GijitShadow_InterfaceConvertTo1_Grower(x interface{}) mat.Grower {
 	return x.(mat.Grower)
 }
 
-func GijitShadow_NewStruct_HOGSVD() *mat.HOGSVD {
-	return &mat.HOGSVD{}
+
+func GijitShadow_NewStruct_HOGSVD(src *mat.HOGSVD) *mat.HOGSVD {
+    if src == nil {
+	   return &mat.HOGSVD{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_LQ() *mat.LQ {
-	return &mat.LQ{}
+
+func GijitShadow_NewStruct_LQ(src *mat.LQ) *mat.LQ {
+    if src == nil {
+	   return &mat.LQ{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_LU() *mat.LU {
-	return &mat.LU{}
+
+func GijitShadow_NewStruct_LU(src *mat.LU) *mat.LU {
+    if src == nil {
+	   return &mat.LU{}
+    }
+    a := *src
+    return &a
 }
 
+
 func GijitShadow_InterfaceConvertTo2_Matrix(x interface{}) (y mat.Matrix, b bool) {
 	y, b = x.(mat.Matrix)
 	return
@@ -222,6 +349,7 @@ func GijitShadow_InterfaceConvertTo1_Matrix(x interface{}) mat.Matrix {
 	return x.(mat.Matrix)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_Mutable(x interface{}) (y mat.Mutable, b bool) {
 	y, b = x.(mat.Mutable)
 	return
@@ -231,6 +359,7 @@ func GijitShadow_InterfaceConvertTo1_Mutable(x interface{}) mat.Mutable {
 	return x.(mat.Mutable)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_MutableBanded(x interface{}) (y mat.MutableBanded, b bool) {
 	y, b = x.(mat.MutableBanded)
 	return
@@ -240,6 +369,7 @@ func GijitShadow_InterfaceConvertTo1_MutableBanded(x interface{}) mat.MutableBan
 	return x.(mat.MutableBanded)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_MutableSymBanded(x interface{}) (y mat.MutableSymBanded, b bool) {
 	y, b = x.(mat.MutableSymBanded)
 	return
@@ -249,6 +379,7 @@ func GijitShadow_InterfaceConvertTo1_MutableSymBanded(x interface{}) mat.Mutable
 	return x.(mat.MutableSymBanded)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_MutableSymmetric(x interface{}) (y mat.MutableSymmetric, b bool) {
 	y, b = x.(mat.MutableSymmetric)
 	return
@@ -258,6 +389,7 @@ func GijitShadow_InterfaceConvertTo1_MutableSymmetric(x interface{}) mat.Mutable
 	return x.(mat.MutableSymmetric)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_MutableTriangular(x interface{}) (y mat.MutableTriangular, b bool) {
 	y, b = x.(mat.MutableTriangular)
 	return
@@ -267,6 +399,7 @@ func GijitShadow_InterfaceConvertTo1_MutableTriangular(x interface{}) mat.Mutabl
 	return x.(mat.MutableTriangular)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_NonZeroDoer(x interface{}) (y mat.NonZeroDoer, b bool) {
 	y, b = x.(mat.NonZeroDoer)
 	return
@@ -276,10 +409,16 @@ func GijitShadow_InterfaceConvertTo1_NonZeroDoer(x interface{}) mat.NonZeroDoer
 	return x.(mat.NonZeroDoer)
 }
 
-func GijitShadow_NewStruct_QR() *mat.QR {
-	return &mat.QR{}
+
+func GijitShadow_NewStruct_QR(src *mat.QR) *mat.QR {
+    if src == nil {
+	   return &mat.QR{}
+    }
+    a := *src
+    return &a
 }
 
+
 func GijitShadow_InterfaceConvertTo2_RawBander(x interface{}) (y mat.RawBander, b bool) {
 	y, b = x.(mat.RawBander)
 	return
@@ -289,6 +428,7 @@ func GijitShadow_InterfaceConvertTo1_RawBander(x interface{}) mat.RawBander {
 	return x.(mat.RawBander)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_RawColViewer(x interface{}) (y mat.RawColViewer, b bool) {
 	y, b = x.(mat.RawColViewer)
 	return
@@ -298,6 +438,7 @@ func GijitShadow_InterfaceConvertTo1_RawColViewer(x interface{}) mat.RawColViewe
 	return x.(mat.RawColViewer)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_RawMatrixSetter(x interface{}) (y mat.RawMatrixSetter, b bool) {
 	y, b = x.(mat.RawMatrixSetter)
 	return
@@ -307,6 +448,7 @@ func GijitShadow_InterfaceConvertTo1_RawMatrixSetter(x interface{}) mat.RawMatri
 	return x.(mat.RawMatrixSetter)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_RawMatrixer(x interface{}) (y mat.RawMatrixer, b bool) {
 	y, b = x.(mat.RawMatrixer)
 	return
@@ -316,6 +458,7 @@ func GijitShadow_InterfaceConvertTo1_RawMatrixer(x interface{}) mat.RawMatrixer
 	return x.(mat.RawMatrixer)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_RawRowViewer(x interface{}) (y mat.RawRowViewer, b bool) {
 	y, b = x.(mat.RawRowViewer)
 	return
@@ -325,6 +468,7 @@ func GijitShadow_InterfaceConvertTo1_RawRowViewer(x interface{}) mat.RawRowViewe
 	return x.(mat.RawRowViewer)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_RawSymBander(x interface{}) (y mat.RawSymBander, b bool) {
 	y, b = x.(mat.RawSymBander)
 	return
@@ -334,6 +478,7 @@ func GijitShadow_InterfaceConvertTo1_RawSymBander(x interface{}) mat.RawSymBande
 	return x.(mat.RawSymBander)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_RawSymmetricer(x interface{}) (y mat.RawSymmetricer, b bool) {
 	y, b = x.(mat.RawSymmetricer)
 	return
@@ -343,6 +488,7 @@ func GijitShadow_InterfaceConvertTo1_RawSymmetricer(x interface{}) mat.RawSymmet
 	return x.(mat.RawSymmetricer)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_RawTriangular(x interface{}) (y mat.RawTriangular, b bool) {
 	y, b = x.(mat.RawTriangular)
 	return
@@ -352,6 +498,7 @@ func GijitShadow_InterfaceConvertTo1_RawTriangular(x interface{}) mat.RawTriangu
 	return x.(mat.RawTriangular)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_RawVectorer(x interface{}) (y mat.RawVectorer, b bool) {
 	y, b = x.(mat.RawVectorer)
 	return
@@ -361,6 +508,7 @@ func GijitShadow_InterfaceConvertTo1_RawVectorer(x interface{}) mat.RawVectorer
 	return x.(mat.RawVectorer)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_Reseter(x interface{}) (y mat.Reseter, b bool) {
 	y, b = x.(mat.Reseter)
 	return
@@ -370,6 +518,7 @@ func GijitShadow_InterfaceConvertTo1_Reseter(x interface{}) mat.Reseter {
 	return x.(mat.Reseter)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_RowNonZeroDoer(x interface{}) (y mat.RowNonZeroDoer, b bool) {
 	y, b = x.(mat.RowNonZeroDoer)
 	return
@@ -379,6 +528,7 @@ func GijitShadow_InterfaceConvertTo1_RowNonZeroDoer(x interface{}) mat.RowNonZer
 	return x.(mat.RowNonZeroDoer)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_RowViewer(x interface{}) (y mat.RowViewer, b bool) {
 	y, b = x.(mat.RowViewer)
 	return
@@ -388,18 +538,39 @@ func GijitShadow_InterfaceConvertTo1_RowViewer(x interface{}) mat.RowViewer {
 	return x.(mat.RowViewer)
 }
 
-func GijitShadow_NewStruct_SVD() *mat.SVD {
-	return &mat.SVD{}
+
+func GijitShadow_NewStruct_SVD(src *mat.SVD) *mat.SVD {
+    if src == nil {
+	   return &mat.SVD{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_SymBandDense() *mat.SymBandDense {
-	return &mat.SymBandDense{}
+
+func GijitShadow_NewNamed_SVDKind(src mat.SVDKind) mat.SVDKind {
+    return src
 }
 
-func GijitShadow_NewStruct_SymDense() *mat.SymDense {
-	return &mat.SymDense{}
+
+func GijitShadow_NewStruct_SymBandDense(src *mat.SymBandDense) *mat.SymBandDense {
+    if src == nil {
+	   return &mat.SymBandDense{}
+    }
+    a := *src
+    return &a
 }
 
+
+func GijitShadow_NewStruct_SymDense(src *mat.SymDense) *mat.SymDense {
+    if src == nil {
+	   return &mat.SymDense{}
+    }
+    a := *src
+    return &a
+}
+
+
 func GijitShadow_InterfaceConvertTo2_Symmetric(x interface{}) (y mat.Symmetric, b bool) {
 	y, b = x.(mat.Symmetric)
 	return
@@ -409,26 +580,57 @@ func GijitShadow_InterfaceConvertTo1_Symmetric(x interface{}) mat.Symmetric {
 	return x.(mat.Symmetric)
 }
 
-func GijitShadow_NewStruct_Transpose() *mat.Transpose {
-	return &mat.Transpose{}
+
+func GijitShadow_NewStruct_Transpose(src *mat.Transpose) *mat.Transpose {
+    if src == nil {
+	   return &mat.Transpose{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_TransposeBand() *mat.TransposeBand {
-	return &mat.TransposeBand{}
+
+func GijitShadow_NewStruct_TransposeBand(src *mat.TransposeBand) *mat.TransposeBand {
+    if src == nil {
+	   return &mat.TransposeBand{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_TransposeTri() *mat.TransposeTri {
-	return &mat.TransposeTri{}
+
+func GijitShadow_NewStruct_TransposeTri(src *mat.TransposeTri) *mat.TransposeTri {
+    if src == nil {
+	   return &mat.TransposeTri{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_TransposeVec() *mat.TransposeVec {
-	return &mat.TransposeVec{}
+
+func GijitShadow_NewStruct_TransposeVec(src *mat.TransposeVec) *mat.TransposeVec {
+    if src == nil {
+	   return &mat.TransposeVec{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_TriDense() *mat.TriDense {
-	return &mat.TriDense{}
+
+func GijitShadow_NewStruct_TriDense(src *mat.TriDense) *mat.TriDense {
+    if src == nil {
+	   return &mat.TriDense{}
+    }
+    a := *src
+    return &a
 }
 
+
+func GijitShadow_NewNamed_TriKind(src mat.TriKind) mat.TriKind {
+    return src
+}
+
+
 func GijitShadow_InterfaceConvertTo2_Triangular(x interface{}) (y mat.Triangular, b bool) {
 	y, b = x.(mat.Triangular)
 	return
@@ -438,6 +640,7 @@ func GijitShadow_InterfaceConvertTo1_Triangular(x interface{}) mat.Triangular {
 	return x.(mat.Triangular)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_Unconjugator(x interface{}) (y mat.Unconjugator, b bool) {
 	y, b = x.(mat.Unconjugator)
 	return
@@ -447,6 +650,7 @@ func GijitShadow_InterfaceConvertTo1_Unconjugator(x interface{}) mat.Unconjugato
 	return x.(mat.Unconjugator)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_UntransposeBander(x interface{}) (y mat.UntransposeBander, b bool) {
 	y, b = x.(mat.UntransposeBander)
 	return
@@ -456,6 +660,7 @@ func GijitShadow_InterfaceConvertTo1_UntransposeBander(x interface{}) mat.Untran
 	return x.(mat.UntransposeBander)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_UntransposeTrier(x interface{}) (y mat.UntransposeTrier, b bool) {
 	y, b = x.(mat.UntransposeTrier)
 	return
@@ -465,6 +670,7 @@ func GijitShadow_InterfaceConvertTo1_UntransposeTrier(x interface{}) mat.Untrans
 	return x.(mat.UntransposeTrier)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_Untransposer(x interface{}) (y mat.Untransposer, b bool) {
 	y, b = x.(mat.Untransposer)
 	return
@@ -474,10 +680,16 @@ func GijitShadow_InterfaceConvertTo1_Untransposer(x interface{}) mat.Untranspose
 	return x.(mat.Untransposer)
 }
 
-func GijitShadow_NewStruct_VecDense() *mat.VecDense {
-	return &mat.VecDense{}
+
+func GijitShadow_NewStruct_VecDense(src *mat.VecDense) *mat.VecDense {
+    if src == nil {
+	   return &mat.VecDense{}
+    }
+    a := *src
+    return &a
 }
 
+
 func GijitShadow_InterfaceConvertTo2_Vector(x interface{}) (y mat.Vector, b bool) {
 	y, b = x.(mat.Vector)
 	return
@@ -486,3 +698,470 @@ func GijitShadow_InterfaceConvertTo2_Vector(x interface{}) (y mat.Vector, b bool
 func GijitShadow_InterfaceConvertTo1_Vector(x interface{}) mat.Vector {
 	return x.(mat.Vector)
 }
+
+
+
+ func InitLua() string {
+  return `
+__type__.mat ={};
+
+-----------------
+-- struct BandDense
+-----------------
+
+__type__.mat.BandDense = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "BandDense",
+ __str = "BandDense",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__mat.BandDense(src)
+ end,
+};
+setmetatable(__type__.mat.BandDense, __type__.mat.BandDense);
+
+
+-----------------
+-- struct Cholesky
+-----------------
+
+__type__.mat.Cholesky = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "Cholesky",
+ __str = "Cholesky",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__mat.Cholesky(src)
+ end,
+};
+setmetatable(__type__.mat.Cholesky, __type__.mat.Cholesky);
+
+
+-----------------
+-- struct Conjugate
+-----------------
+
+__type__.mat.Conjugate = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "Conjugate",
+ __str = "Conjugate",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__mat.Conjugate(src)
+ end,
+};
+setmetatable(__type__.mat.Conjugate, __type__.mat.Conjugate);
+
+
+-----------------
+-- struct Dense
+-----------------
+
+__type__.mat.Dense = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "Dense",
+ __str = "Dense",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__mat.Dense(src)
+ end,
+};
+setmetatable(__type__.mat.Dense, __type__.mat.Dense);
+
+
+-----------------
+-- struct Eigen
+-----------------
+
+__type__.mat.Eigen = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "Eigen",
+ __str = "Eigen",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__mat.Eigen(src)
+ end,
+};
+setmetatable(__type__.mat.Eigen, __type__.mat.Eigen);
+
+
+-----------------
+-- struct EigenSym
+-----------------
+
+__type__.mat.EigenSym = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "EigenSym",
+ __str = "EigenSym",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__mat.EigenSym(src)
+ end,
+};
+setmetatable(__type__.mat.EigenSym, __type__.mat.EigenSym);
+
+
+-----------------
+-- struct Error
+-----------------
+
+__type__.mat.Error = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "Error",
+ __str = "Error",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__mat.Error(src)
+ end,
+};
+setmetatable(__type__.mat.Error, __type__.mat.Error);
+
+
+-----------------
+-- struct ErrorStack
+-----------------
+
+__type__.mat.ErrorStack = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "ErrorStack",
+ __str = "ErrorStack",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__mat.ErrorStack(src)
+ end,
+};
+setmetatable(__type__.mat.ErrorStack, __type__.mat.ErrorStack);
+
+
+-----------------
+-- struct GSVD
+-----------------
+
+__type__.mat.GSVD = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "GSVD",
+ __str = "GSVD",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__mat.GSVD(src)
+ end,
+};
+setmetatable(__type__.mat.GSVD, __type__.mat.GSVD);
+
+
+-----------------
+-- struct HOGSVD
+-----------------
+
+__type__.mat.HOGSVD = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "HOGSVD",
+ __str = "HOGSVD",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__mat.HOGSVD(src)
+ end,
+};
+setmetatable(__type__.mat.HOGSVD, __type__.mat.HOGSVD);
+
+
+-----------------
+-- struct LQ
+-----------------
+
+__type__.mat.LQ = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "LQ",
+ __str = "LQ",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__mat.LQ(src)
+ end,
+};
+setmetatable(__type__.mat.LQ, __type__.mat.LQ);
+
+
+-----------------
+-- struct LU
+-----------------
+
+__type__.mat.LU = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "LU",
+ __str = "LU",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__mat.LU(src)
+ end,
+};
+setmetatable(__type__.mat.LU, __type__.mat.LU);
+
+
+-----------------
+-- struct QR
+-----------------
+
+__type__.mat.QR = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "QR",
+ __str = "QR",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__mat.QR(src)
+ end,
+};
+setmetatable(__type__.mat.QR, __type__.mat.QR);
+
+
+-----------------
+-- struct SVD
+-----------------
+
+__type__.mat.SVD = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "SVD",
+ __str = "SVD",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__mat.SVD(src)
+ end,
+};
+setmetatable(__type__.mat.SVD, __type__.mat.SVD);
+
+
+-----------------
+-- struct SymBandDense
+-----------------
+
+__type__.mat.SymBandDense = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "SymBandDense",
+ __str = "SymBandDense",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__mat.SymBandDense(src)
+ end,
+};
+setmetatable(__type__.mat.SymBandDense, __type__.mat.SymBandDense);
+
+
+-----------------
+-- struct SymDense
+-----------------
+
+__type__.mat.SymDense = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "SymDense",
+ __str = "SymDense",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__mat.SymDense(src)
+ end,
+};
+setmetatable(__type__.mat.SymDense, __type__.mat.SymDense);
+
+
+-----------------
+-- struct Transpose
+-----------------
+
+__type__.mat.Transpose = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "Transpose",
+ __str = "Transpose",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__mat.Transpose(src)
+ end,
+};
+setmetatable(__type__.mat.Transpose, __type__.mat.Transpose);
+
+
+-----------------
+-- struct TransposeBand
+-----------------
+
+__type__.mat.TransposeBand = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "TransposeBand",
+ __str = "TransposeBand",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__mat.TransposeBand(src)
+ end,
+};
+setmetatable(__type__.mat.TransposeBand, __type__.mat.TransposeBand);
+
+
+-----------------
+-- struct TransposeTri
+-----------------
+
+__type__.mat.TransposeTri = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "TransposeTri",
+ __str = "TransposeTri",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__mat.TransposeTri(src)
+ end,
+};
+setmetatable(__type__.mat.TransposeTri, __type__.mat.TransposeTri);
+
+
+-----------------
+-- struct TransposeVec
+-----------------
+
+__type__.mat.TransposeVec = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "TransposeVec",
+ __str = "TransposeVec",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__mat.TransposeVec(src)
+ end,
+};
+setmetatable(__type__.mat.TransposeVec, __type__.mat.TransposeVec);
+
+
+-----------------
+-- struct TriDense
+-----------------
+
+__type__.mat.TriDense = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "TriDense",
+ __str = "TriDense",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__mat.TriDense(src)
+ end,
+};
+setmetatable(__type__.mat.TriDense, __type__.mat.TriDense);
+
+
+-----------------
+-- struct VecDense
+-----------------
+
+__type__.mat.VecDense = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "VecDense",
+ __str = "VecDense",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__mat.VecDense(src)
+ end,
+};
+setmetatable(__type__.mat.VecDense, __type__.mat.VecDense);
+
+
+-----------------
+-- named type Condition
+-----------------
+
+__type__.mat.Condition = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "Condition",
+ __str = "Condition",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__mat.Condition(src)
+ end,
+};
+setmetatable(__type__.mat.Condition, __type__.mat.Condition);
+
+
+-----------------
+-- named type FormatOption
+-----------------
+
+__type__.mat.FormatOption = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "FormatOption",
+ __str = "FormatOption",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__mat.FormatOption(src)
+ end,
+};
+setmetatable(__type__.mat.FormatOption, __type__.mat.FormatOption);
+
+
+-----------------
+-- named type GSVDKind
+-----------------
+
+__type__.mat.GSVDKind = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "GSVDKind",
+ __str = "GSVDKind",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__mat.GSVDKind(src)
+ end,
+};
+setmetatable(__type__.mat.GSVDKind, __type__.mat.GSVDKind);
+
+
+-----------------
+-- named type SVDKind
+-----------------
+
+__type__.mat.SVDKind = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "SVDKind",
+ __str = "SVDKind",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__mat.SVDKind(src)
+ end,
+};
+setmetatable(__type__.mat.SVDKind, __type__.mat.SVDKind);
+
+
+-----------------
+-- named type TriKind
+-----------------
+
+__type__.mat.TriKind = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "TriKind",
+ __str = "TriKind",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__mat.TriKind(src)
+ end,
+};
+setmetatable(__type__.mat.TriKind, __type__.mat.TriKind);
+
+
+`}
\ No newline at end of file
@@ -1,50 +1,130 @@
 package shadow_optimize
 
-import "gonum.org/v1/gonum/optimize"
+import (
+	"gonum.org/v1/gonum/optimize"
+
+	"github.com/gijit/gi/pkg/compiler/shadow"
+)
 
 var Pkg = make(map[string]interface{})
+var Ctor = make(map[string]interface{})
 
 func init() {
-	Pkg["ArmijoConditionMet"] = optimize.ArmijoConditionMet
-	Pkg["CGVariant"] = GijitShadow_InterfaceConvertTo2_CGVariant
-	Pkg["DefaultSettings"] = optimize.DefaultSettings
-	Pkg["DefaultSettingsGlobal"] = optimize.DefaultSettingsGlobal
-	Pkg["ErrLinesearcherBound"] = optimize.ErrLinesearcherBound
-	Pkg["ErrLinesearcherFailure"] = optimize.ErrLinesearcherFailure
-	Pkg["ErrNoProgress"] = optimize.ErrNoProgress
-	Pkg["ErrNonDescentDirection"] = optimize.ErrNonDescentDirection
-	Pkg["ErrZeroDimensional"] = optimize.ErrZeroDimensional
-	Pkg["Global"] = optimize.Global
-	Pkg["GlobalMethod"] = GijitShadow_InterfaceConvertTo2_GlobalMethod
-	Pkg["Linesearcher"] = GijitShadow_InterfaceConvertTo2_Linesearcher
-	Pkg["Local"] = optimize.Local
-	Pkg["Method"] = GijitShadow_InterfaceConvertTo2_Method
-	Pkg["Needser"] = GijitShadow_InterfaceConvertTo2_Needser
-	Pkg["NewPrinter"] = optimize.NewPrinter
-	Pkg["NewStatus"] = optimize.NewStatus
-	Pkg["NextDirectioner"] = GijitShadow_InterfaceConvertTo2_NextDirectioner
-	Pkg["Recorder"] = GijitShadow_InterfaceConvertTo2_Recorder
-	Pkg["Statuser"] = GijitShadow_InterfaceConvertTo2_Statuser
-	Pkg["StepSizer"] = GijitShadow_InterfaceConvertTo2_StepSizer
-	Pkg["StrongWolfeConditionsMet"] = optimize.StrongWolfeConditionsMet
-	Pkg["WeakWolfeConditionsMet"] = optimize.WeakWolfeConditionsMet
-
-}
-func GijitShadow_NewStruct_BFGS() *optimize.BFGS {
-	return &optimize.BFGS{}
-}
-
-func GijitShadow_NewStruct_Backtracking() *optimize.Backtracking {
-	return &optimize.Backtracking{}
-}
-
-func GijitShadow_NewStruct_Bisection() *optimize.Bisection {
-	return &optimize.Bisection{}
+    Pkg["ArmijoConditionMet"] = optimize.ArmijoConditionMet
+    Ctor["BFGS"] = GijitShadow_NewStruct_BFGS
+    Ctor["Backtracking"] = GijitShadow_NewStruct_Backtracking
+    Ctor["Bisection"] = GijitShadow_NewStruct_Bisection
+    Ctor["CG"] = GijitShadow_NewStruct_CG
+    Pkg["CGVariant"] = GijitShadow_InterfaceConvertTo2_CGVariant
+    Ctor["CmaEsChol"] = GijitShadow_NewStruct_CmaEsChol
+    Ctor["ConstantStepSize"] = GijitShadow_NewStruct_ConstantStepSize
+    Ctor["DaiYuan"] = GijitShadow_NewStruct_DaiYuan
+    Pkg["DefaultSettings"] = optimize.DefaultSettings
+    Pkg["DefaultSettingsGlobal"] = optimize.DefaultSettingsGlobal
+    Ctor["ErrFunc"] = GijitShadow_NewNamed_ErrFunc
+    Ctor["ErrGrad"] = GijitShadow_NewStruct_ErrGrad
+    Pkg["ErrLinesearcherBound"] = optimize.ErrLinesearcherBound
+    Pkg["ErrLinesearcherFailure"] = optimize.ErrLinesearcherFailure
+    Pkg["ErrNoProgress"] = optimize.ErrNoProgress
+    Pkg["ErrNonDescentDirection"] = optimize.ErrNonDescentDirection
+    Pkg["ErrZeroDimensional"] = optimize.ErrZeroDimensional
+    Pkg["Failure"] = optimize.Failure
+    Ctor["FirstOrderStepSize"] = GijitShadow_NewStruct_FirstOrderStepSize
+    Ctor["FletcherReeves"] = GijitShadow_NewStruct_FletcherReeves
+    Pkg["FuncEvaluation"] = optimize.FuncEvaluation
+    Ctor["FunctionConverge"] = GijitShadow_NewStruct_FunctionConverge
+    Pkg["FunctionConvergence"] = optimize.FunctionConvergence
+    Pkg["FunctionEvaluationLimit"] = optimize.FunctionEvaluationLimit
+    Pkg["FunctionNegativeInfinity"] = optimize.FunctionNegativeInfinity
+    Pkg["FunctionThreshold"] = optimize.FunctionThreshold
+    Pkg["Global"] = optimize.Global
+    Pkg["GlobalMethod"] = GijitShadow_InterfaceConvertTo2_GlobalMethod
+    Ctor["GlobalTask"] = GijitShadow_NewStruct_GlobalTask
+    Pkg["GradEvaluation"] = optimize.GradEvaluation
+    Ctor["GradientDescent"] = GijitShadow_NewStruct_GradientDescent
+    Pkg["GradientEvaluationLimit"] = optimize.GradientEvaluationLimit
+    Pkg["GradientThreshold"] = optimize.GradientThreshold
+    Ctor["GuessAndCheck"] = GijitShadow_NewStruct_GuessAndCheck
+    Ctor["HagerZhang"] = GijitShadow_NewStruct_HagerZhang
+    Pkg["HessEvaluation"] = optimize.HessEvaluation
+    Pkg["HessianEvaluationLimit"] = optimize.HessianEvaluationLimit
+    Ctor["HestenesStiefel"] = GijitShadow_NewStruct_HestenesStiefel
+    Pkg["InitIteration"] = optimize.InitIteration
+    Pkg["IterationLimit"] = optimize.IterationLimit
+    Ctor["LBFGS"] = GijitShadow_NewStruct_LBFGS
+    Ctor["LinesearchMethod"] = GijitShadow_NewStruct_LinesearchMethod
+    Pkg["Linesearcher"] = GijitShadow_InterfaceConvertTo2_Linesearcher
+    Pkg["Local"] = optimize.Local
+    Ctor["Location"] = GijitShadow_NewStruct_Location
+    Pkg["MajorIteration"] = optimize.MajorIteration
+    Pkg["Method"] = GijitShadow_InterfaceConvertTo2_Method
+    Pkg["MethodConverge"] = optimize.MethodConverge
+    Pkg["MethodDone"] = optimize.MethodDone
+    Ctor["MoreThuente"] = GijitShadow_NewStruct_MoreThuente
+    Pkg["Needser"] = GijitShadow_InterfaceConvertTo2_Needser
+    Ctor["NelderMead"] = GijitShadow_NewStruct_NelderMead
+    Pkg["NewPrinter"] = optimize.NewPrinter
+    Pkg["NewStatus"] = optimize.NewStatus
+    Ctor["Newton"] = GijitShadow_NewStruct_Newton
+    Pkg["NextDirectioner"] = GijitShadow_InterfaceConvertTo2_NextDirectioner
+    Pkg["NoOperation"] = optimize.NoOperation
+    Pkg["NotTerminated"] = optimize.NotTerminated
+    Ctor["Operation"] = GijitShadow_NewNamed_Operation
+    Ctor["PolakRibierePolyak"] = GijitShadow_NewStruct_PolakRibierePolyak
+    Pkg["PostIteration"] = optimize.PostIteration
+    Ctor["Printer"] = GijitShadow_NewStruct_Printer
+    Ctor["Problem"] = GijitShadow_NewStruct_Problem
+    Ctor["QuadraticStepSize"] = GijitShadow_NewStruct_QuadraticStepSize
+    Pkg["Recorder"] = GijitShadow_InterfaceConvertTo2_Recorder
+    Ctor["Result"] = GijitShadow_NewStruct_Result
+    Pkg["RuntimeLimit"] = optimize.RuntimeLimit
+    Ctor["Settings"] = GijitShadow_NewStruct_Settings
+    Ctor["Stats"] = GijitShadow_NewStruct_Stats
+    Ctor["Status"] = GijitShadow_NewNamed_Status
+    Pkg["Statuser"] = GijitShadow_InterfaceConvertTo2_Statuser
+    Pkg["StepConvergence"] = optimize.StepConvergence
+    Pkg["StepSizer"] = GijitShadow_InterfaceConvertTo2_StepSizer
+    Pkg["StrongWolfeConditionsMet"] = optimize.StrongWolfeConditionsMet
+    Pkg["Success"] = optimize.Success
+    Pkg["WeakWolfeConditionsMet"] = optimize.WeakWolfeConditionsMet
+
+    shadow.Register("gonum.org/v1/gonum/optimize", "optimize", Pkg, Ctor, InitLua)
+}
+func GijitShadow_NewStruct_BFGS(src *optimize.BFGS) *optimize.BFGS {
+    if src == nil {
+	   return &optimize.BFGS{}
+    }
+    a := *src
+    return &a
+}
+
+
+func GijitShadow_NewStruct_Backtracking(src *optimize.Backtracking) *optimize.Backtracking {
+    if src == nil {
+	   return &optimize.Backtracking{}
+    }
+    a := *src
+    return &a
+}
+
+
+func GijitShadow_NewStruct_Bisection(src *optimize.Bisection) *optimize.Bisection {
+    if src == nil {
+	   return &optimize.Bisection{}
+    }
+    a := *src
+    return &a
+}
+
+
+func GijitShadow_NewStruct_CG(src *optimize.CG) *optimize.CG {
+    if src == nil {
+	   return &optimize.CG{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_CG() *optimize.CG {
-	return &optimize.CG{}
-}
 
 func GijitShadow_InterfaceConvertTo2_CGVariant(x interface{}) (y optimize.CGVariant, b bool) {
 	y, b = x.(optimize.CGVariant)
@@ -55,34 +135,75 @@ func GijitShadow_InterfaceConvertTo1_CGVariant(x interface{}) optimize.CGVariant
 	return x.(optimize.CGVariant)
 }
 
-func GijitShadow_NewStruct_CmaEsChol() *optimize.CmaEsChol {
-	return &optimize.CmaEsChol{}
+
+func GijitShadow_NewStruct_CmaEsChol(src *optimize.CmaEsChol) *optimize.CmaEsChol {
+    if src == nil {
+	   return &optimize.CmaEsChol{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_ConstantStepSize() *optimize.ConstantStepSize {
-	return &optimize.ConstantStepSize{}
+
+func GijitShadow_NewStruct_ConstantStepSize(src *optimize.ConstantStepSize) *optimize.ConstantStepSize {
+    if src == nil {
+	   return &optimize.ConstantStepSize{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_DaiYuan() *optimize.DaiYuan {
-	return &optimize.DaiYuan{}
+
+func GijitShadow_NewStruct_DaiYuan(src *optimize.DaiYuan) *optimize.DaiYuan {
+    if src == nil {
+	   return &optimize.DaiYuan{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_ErrGrad() *optimize.ErrGrad {
-	return &optimize.ErrGrad{}
+
+func GijitShadow_NewNamed_ErrFunc(src optimize.ErrFunc) optimize.ErrFunc {
+    return src
 }
 
-func GijitShadow_NewStruct_FirstOrderStepSize() *optimize.FirstOrderStepSize {
-	return &optimize.FirstOrderStepSize{}
+
+func GijitShadow_NewStruct_ErrGrad(src *optimize.ErrGrad) *optimize.ErrGrad {
+    if src == nil {
+	   return &optimize.ErrGrad{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_FletcherReeves() *optimize.FletcherReeves {
-	return &optimize.FletcherReeves{}
+
+func GijitShadow_NewStruct_FirstOrderStepSize(src *optimize.FirstOrderStepSize) *optimize.FirstOrderStepSize {
+    if src == nil {
+	   return &optimize.FirstOrderStepSize{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_FunctionConverge() *optimize.FunctionConverge {
-	return &optimize.FunctionConverge{}
+
+func GijitShadow_NewStruct_FletcherReeves(src *optimize.FletcherReeves) *optimize.FletcherReeves {
+    if src == nil {
+	   return &optimize.FletcherReeves{}
+    }
+    a := *src
+    return &a
+}
+
+
+func GijitShadow_NewStruct_FunctionConverge(src *optimize.FunctionConverge) *optimize.FunctionConverge {
+    if src == nil {
+	   return &optimize.FunctionConverge{}
+    }
+    a := *src
+    return &a
 }
 
+
 func GijitShadow_InterfaceConvertTo2_GlobalMethod(x interface{}) (y optimize.GlobalMethod, b bool) {
 	y, b = x.(optimize.GlobalMethod)
 	return
@@ -92,34 +213,70 @@ func GijitShadow_InterfaceConvertTo1_GlobalMethod(x interface{}) optimize.Global
 	return x.(optimize.GlobalMethod)
 }
 
-func GijitShadow_NewStruct_GlobalTask() *optimize.GlobalTask {
-	return &optimize.GlobalTask{}
+
+func GijitShadow_NewStruct_GlobalTask(src *optimize.GlobalTask) *optimize.GlobalTask {
+    if src == nil {
+	   return &optimize.GlobalTask{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_GradientDescent() *optimize.GradientDescent {
-	return &optimize.GradientDescent{}
+
+func GijitShadow_NewStruct_GradientDescent(src *optimize.GradientDescent) *optimize.GradientDescent {
+    if src == nil {
+	   return &optimize.GradientDescent{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_GuessAndCheck() *optimize.GuessAndCheck {
-	return &optimize.GuessAndCheck{}
+
+func GijitShadow_NewStruct_GuessAndCheck(src *optimize.GuessAndCheck) *optimize.GuessAndCheck {
+    if src == nil {
+	   return &optimize.GuessAndCheck{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_HagerZhang() *optimize.HagerZhang {
-	return &optimize.HagerZhang{}
+
+func GijitShadow_NewStruct_HagerZhang(src *optimize.HagerZhang) *optimize.HagerZhang {
+    if src == nil {
+	   return &optimize.HagerZhang{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_HestenesStiefel() *optimize.HestenesStiefel {
-	return &optimize.HestenesStiefel{}
+
+func GijitShadow_NewStruct_HestenesStiefel(src *optimize.HestenesStiefel) *optimize.HestenesStiefel {
+    if src == nil {
+	   return &optimize.HestenesStiefel{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_LBFGS() *optimize.LBFGS {
-	return &optimize.LBFGS{}
+
+func GijitShadow_NewStruct_LBFGS(src *optimize.LBFGS) *optimize.LBFGS {
+    if src == nil {
+	   return &optimize.LBFGS{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_LinesearchMethod() *optimize.LinesearchMethod {
-	return &optimize.LinesearchMethod{}
+
+func GijitShadow_NewStruct_LinesearchMethod(src *optimize.LinesearchMethod) *optimize.LinesearchMethod {
+    if src == nil {
+	   return &optimize.LinesearchMethod{}
+    }
+    a := *src
+    return &a
 }
 
+
 func GijitShadow_InterfaceConvertTo2_Linesearcher(x interface{}) (y optimize.Linesearcher, b bool) {
 	y, b = x.(optimize.Linesearcher)
 	return
@@ -129,10 +286,16 @@ func GijitShadow_InterfaceConvertTo1_Linesearcher(x interface{}) optimize.Linese
 	return x.(optimize.Linesearcher)
 }
 
-func GijitShadow_NewStruct_Location() *optimize.Location {
-	return &optimize.Location{}
+
+func GijitShadow_NewStruct_Location(src *optimize.Location) *optimize.Location {
+    if src == nil {
+	   return &optimize.Location{}
+    }
+    a := *src
+    return &a
 }
 
+
 func GijitShadow_InterfaceConvertTo2_Method(x interface{}) (y optimize.Method, b bool) {
 	y, b = x.(optimize.Method)
 	return
@@ -142,10 +305,16 @@ func GijitShadow_InterfaceConvertTo1_Method(x interface{}) optimize.Method {
 	return x.(optimize.Method)
 }
 
-func GijitShadow_NewStruct_MoreThuente() *optimize.MoreThuente {
-	return &optimize.MoreThuente{}
+
+func GijitShadow_NewStruct_MoreThuente(src *optimize.MoreThuente) *optimize.MoreThuente {
+    if src == nil {
+	   return &optimize.MoreThuente{}
+    }
+    a := *src
+    return &a
 }
 
+
 func GijitShadow_InterfaceConvertTo2_Needser(x interface{}) (y optimize.Needser, b bool) {
 	y, b = x.(optimize.Needser)
 	return
@@ -155,14 +324,25 @@ func GijitShadow_InterfaceConvertTo1_Needser(x interface{}) optimize.Needser {
 	return x.(optimize.Needser)
 }
 
-func GijitShadow_NewStruct_NelderMead() *optimize.NelderMead {
-	return &optimize.NelderMead{}
+
+func GijitShadow_NewStruct_NelderMead(src *optimize.NelderMead) *optimize.NelderMead {
+    if src == nil {
+	   return &optimize.NelderMead{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_Newton() *optimize.Newton {
-	return &optimize.Newton{}
+
+func GijitShadow_NewStruct_Newton(src *optimize.Newton) *optimize.Newton {
+    if src == nil {
+	   return &optimize.Newton{}
+    }
+    a := *src
+    return &a
 }
 
+
 func GijitShadow_InterfaceConvertTo2_NextDirectioner(x interface{}) (y optimize.NextDirectioner, b bool) {
 	y, b = x.(optimize.NextDirectioner)
 	return
@@ -172,22 +352,48 @@ func GijitShadow_InterfaceConvertTo1_NextDirectioner(x interface{}) optimize.Nex
 	return x.(optimize.NextDirectioner)
 }
 
-func GijitShadow_NewStruct_PolakRibierePolyak() *optimize.PolakRibierePolyak {
-	return &optimize.PolakRibierePolyak{}
+
+func GijitShadow_NewNamed_Operation(src optimize.Operation) optimize.Operation {
+    return src
+}
+
+
+func GijitShadow_NewStruct_PolakRibierePolyak(src *optimize.PolakRibierePolyak) *optimize.PolakRibierePolyak {
+    if src == nil {
+	   return &optimize.PolakRibierePolyak{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_Printer() *optimize.Printer {
-	return &optimize.Printer{}
+
+func GijitShadow_NewStruct_Printer(src *optimize.Printer) *optimize.Printer {
+    if src == nil {
+	   return &optimize.Printer{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_Problem() *optimize.Problem {
-	return &optimize.Problem{}
+
+func GijitShadow_NewStruct_Problem(src *optimize.Problem) *optimize.Problem {
+    if src == nil {
+	   return &optimize.Problem{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_QuadraticStepSize() *optimize.QuadraticStepSize {
-	return &optimize.QuadraticStepSize{}
+
+func GijitShadow_NewStruct_QuadraticStepSize(src *optimize.QuadraticStepSize) *optimize.QuadraticStepSize {
+    if src == nil {
+	   return &optimize.QuadraticStepSize{}
+    }
+    a := *src
+    return &a
 }
 
+
 func GijitShadow_InterfaceConvertTo2_Recorder(x interface{}) (y optimize.Recorder, b bool) {
 	y, b = x.(optimize.Recorder)
 	return
@@ -197,18 +403,39 @@ func GijitShadow_InterfaceConvertTo1_Recorder(x interface{}) optimize.Recorder {
 	return x.(optimize.Recorder)
 }
 
-func GijitShadow_NewStruct_Result() *optimize.Result {
-	return &optimize.Result{}
+
+func GijitShadow_NewStruct_Result(src *optimize.Result) *optimize.Result {
+    if src == nil {
+	   return &optimize.Result{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_Settings() *optimize.Settings {
-	return &optimize.Settings{}
+
+func GijitShadow_NewStruct_Settings(src *optimize.Settings) *optimize.Settings {
+    if src == nil {
+	   return &optimize.Settings{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_Stats() *optimize.Stats {
-	return &optimize.Stats{}
+
+func GijitShadow_NewStruct_Stats(src *optimize.Stats) *optimize.Stats {
+    if src == nil {
+	   return &optimize.Stats{}
+    }
+    a := *src
+    return &a
 }
 
+
+func GijitShadow_NewNamed_Status(src optimize.Status) optimize.Status {
+    return src
+}
+
+
 func GijitShadow_InterfaceConvertTo2_Statuser(x interface{}) (y optimize.Statuser, b bool) {
 	y, b = x.(optimize.Statuser)
 	return
@@ -218,6 +445,7 @@ func GijitShadow_InterfaceConvertTo1_Statuser(x interface{}) optimize.Statuser {
 	return x.(optimize.Statuser)
 }
 
+
 func GijitShadow_InterfaceConvertTo2_StepSizer(x interface{}) (y optimize.StepSizer, b bool) {
 	y, b = x.(optimize.StepSizer)
 	return
@@ -226,3 +454,555 @@ func GijitShadow_InterfaceConvertTo2_StepSizer(x interface{}) (y optimize.StepSi
 func GijitShadow_InterfaceConvertTo1_StepSizer(x interface{}) optimize.StepSizer {
 	return x.(optimize.StepSizer)
 }
+
+
+
+ func InitLua() string {
+  return `
+__type__.optimize ={};
+
+-----------------
+-- struct BFGS
+-----------------
+
+__type__.optimize.BFGS = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "BFGS",
+ __str = "BFGS",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__optimize.BFGS(src)
+ end,
+};
+setmetatable(__type__.optimize.BFGS, __type__.optimize.BFGS);
+
+
+-----------------
+-- struct Backtracking
+-----------------
+
+__type__.optimize.Backtracking = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "Backtracking",
+ __str = "Backtracking",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__optimize.Backtracking(src)
+ end,
+};
+setmetatable(__type__.optimize.Backtracking, __type__.optimize.Backtracking);
+
+
+-----------------
+-- struct Bisection
+-----------------
+
+__type__.optimize.Bisection = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "Bisection",
+ __str = "Bisection",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__optimize.Bisection(src)
+ end,
+};
+setmetatable(__type__.optimize.Bisection, __type__.optimize.Bisection);
+
+
+-----------------
+-- struct CG
+-----------------
+
+__type__.optimize.CG = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "CG",
+ __str = "CG",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__optimize.CG(src)
+ end,
+};
+setmetatable(__type__.optimize.CG, __type__.optimize.CG);
+
+
+-----------------
+-- struct CmaEsChol
+-----------------
+
+__type__.optimize.CmaEsChol = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "CmaEsChol",
+ __str = "CmaEsChol",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__optimize.CmaEsChol(src)
+ end,
+};
+setmetatable(__type__.optimize.CmaEsChol, __type__.optimize.CmaEsChol);
+
+
+-----------------
+-- struct ConstantStepSize
+-----------------
+
+__type__.optimize.ConstantStepSize = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "ConstantStepSize",
+ __str = "ConstantStepSize",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__optimize.ConstantStepSize(src)
+ end,
+};
+setmetatable(__type__.optimize.ConstantStepSize, __type__.optimize.ConstantStepSize);
+
+
+-----------------
+-- struct DaiYuan
+-----------------
+
+__type__.optimize.DaiYuan = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "DaiYuan",
+ __str = "DaiYuan",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__optimize.DaiYuan(src)
+ end,
+};
+setmetatable(__type__.optimize.DaiYuan, __type__.optimize.DaiYuan);
+
+
+-----------------
+-- struct ErrGrad
+-----------------
+
+__type__.optimize.ErrGrad = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "ErrGrad",
+ __str = "ErrGrad",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__optimize.ErrGrad(src)
+ end,
+};
+setmetatable(__type__.optimize.ErrGrad, __type__.optimize.ErrGrad);
+
+
+-----------------
+-- struct FirstOrderStepSize
+-----------------
+
+__type__.optimize.FirstOrderStepSize = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "FirstOrderStepSize",
+ __str = "FirstOrderStepSize",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__optimize.FirstOrderStepSize(src)
+ end,
+};
+setmetatable(__type__.optimize.FirstOrderStepSize, __type__.optimize.FirstOrderStepSize);
+
+
+-----------------
+-- struct FletcherReeves
+-----------------
+
+__type__.optimize.FletcherReeves = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "FletcherReeves",
+ __str = "FletcherReeves",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__optimize.FletcherReeves(src)
+ end,
+};
+setmetatable(__type__.optimize.FletcherReeves, __type__.optimize.FletcherReeves);
+
+
+-----------------
+-- struct FunctionConverge
+-----------------
+
+__type__.optimize.FunctionConverge = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "FunctionConverge",
+ __str = "FunctionConverge",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__optimize.FunctionConverge(src)
+ end,
+};
+setmetatable(__type__.optimize.FunctionConverge, __type__.optimize.FunctionConverge);
+
+
+-----------------
+-- struct GlobalTask
+-----------------
+
+__type__.optimize.GlobalTask = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "GlobalTask",
+ __str = "GlobalTask",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__optimize.GlobalTask(src)
+ end,
+};
+setmetatable(__type__.optimize.GlobalTask, __type__.optimize.GlobalTask);
+
+
+-----------------
+-- struct GradientDescent
+-----------------
+
+__type__.optimize.GradientDescent = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "GradientDescent",
+ __str = "GradientDescent",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__optimize.GradientDescent(src)
+ end,
+};
+setmetatable(__type__.optimize.GradientDescent, __type__.optimize.GradientDescent);
+
+
+-----------------
+-- struct GuessAndCheck
+-----------------
+
+__type__.optimize.GuessAndCheck = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "GuessAndCheck",
+ __str = "GuessAndCheck",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__optimize.GuessAndCheck(src)
+ end,
+};
+setmetatable(__type__.optimize.GuessAndCheck, __type__.optimize.GuessAndCheck);
+
+
+-----------------
+-- struct HagerZhang
+-----------------
+
+__type__.optimize.HagerZhang = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "HagerZhang",
+ __str = "HagerZhang",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__optimize.HagerZhang(src)
+ end,
+};
+setmetatable(__type__.optimize.HagerZhang, __type__.optimize.HagerZhang);
+
+
+-----------------
+-- struct HestenesStiefel
+-----------------
+
+__type__.optimize.HestenesStiefel = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "HestenesStiefel",
+ __str = "HestenesStiefel",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__optimize.HestenesStiefel(src)
+ end,
+};
+setmetatable(__type__.optimize.HestenesStiefel, __type__.optimize.HestenesStiefel);
+
+
+-----------------
+-- struct LBFGS
+-----------------
+
+__type__.optimize.LBFGS = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "LBFGS",
+ __str = "LBFGS",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__optimize.LBFGS(src)
+ end,
+};
+setmetatable(__type__.optimize.LBFGS, __type__.optimize.LBFGS);
+
+
+-----------------
+-- struct LinesearchMethod
+-----------------
+
+__type__.optimize.LinesearchMethod = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "LinesearchMethod",
+ __str = "LinesearchMethod",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__optimize.LinesearchMethod(src)
+ end,
+};
+setmetatable(__type__.optimize.LinesearchMethod, __type__.optimize.LinesearchMethod);
+
+
+-----------------
+-- struct Location
+-----------------
+
+__type__.optimize.Location = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "Location",
+ __str = "Location",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__optimize.Location(src)
+ end,
+};
+setmetatable(__type__.optimize.Location, __type__.optimize.Location);
+
+
+-----------------
+-- struct MoreThuente
+-----------------
+
+__type__.optimize.MoreThuente = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "MoreThuente",
+ __str = "MoreThuente",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__optimize.MoreThuente(src)
+ end,
+};
+setmetatable(__type__.optimize.MoreThuente, __type__.optimize.MoreThuente);
+
+
+-----------------
+-- struct NelderMead
+-----------------
+
+__type__.optimize.NelderMead = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "NelderMead",
+ __str = "NelderMead",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__optimize.NelderMead(src)
+ end,
+};
+setmetatable(__type__.optimize.NelderMead, __type__.optimize.NelderMead);
+
+
+-----------------
+-- struct Newton
+-----------------
+
+__type__.optimize.Newton = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "Newton",
+ __str = "Newton",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__optimize.Newton(src)
+ end,
+};
+setmetatable(__type__.optimize.Newton, __type__.optimize.Newton);
+
+
+-----------------
+-- struct PolakRibierePolyak
+-----------------
+
+__type__.optimize.PolakRibierePolyak = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "PolakRibierePolyak",
+ __str = "PolakRibierePolyak",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__optimize.PolakRibierePolyak(src)
+ end,
+};
+setmetatable(__type__.optimize.PolakRibierePolyak, __type__.optimize.PolakRibierePolyak);
+
+
+-----------------
+-- struct Printer
+-----------------
+
+__type__.optimize.Printer = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "Printer",
+ __str = "Printer",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__optimize.Printer(src)
+ end,
+};
+setmetatable(__type__.optimize.Printer, __type__.optimize.Printer);
+
+
+-----------------
+-- struct Problem
+-----------------
+
+__type__.optimize.Problem = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "Problem",
+ __str = "Problem",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__optimize.Problem(src)
+ end,
+};
+setmetatable(__type__.optimize.Problem, __type__.optimize.Problem);
+
+
+-----------------
+-- struct QuadraticStepSize
+-----------------
+
+__type__.optimize.QuadraticStepSize = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "QuadraticStepSize",
+ __str = "QuadraticStepSize",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__optimize.QuadraticStepSize(src)
+ end,
+};
+setmetatable(__type__.optimize.QuadraticStepSize, __type__.optimize.QuadraticStepSize);
+
+
+-----------------
+-- struct Result
+-----------------
+
+__type__.optimize.Result = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "Result",
+ __str = "Result",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__optimize.Result(src)
+ end,
+};
+setmetatable(__type__.optimize.Result, __type__.optimize.Result);
+
+
+-----------------
+-- struct Settings
+-----------------
+
+__type__.optimize.Settings = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "Settings",
+ __str = "Settings",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__optimize.Settings(src)
+ end,
+};
+setmetatable(__type__.optimize.Settings, __type__.optimize.Settings);
+
+
+-----------------
+-- struct Stats
+-----------------
+
+__type__.optimize.Stats = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "Stats",
+ __str = "Stats",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__optimize.Stats(src)
+ end,
+};
+setmetatable(__type__.optimize.Stats, __type__.optimize.Stats);
+
+
+-----------------
+-- named type ErrFunc
+-----------------
+
+__type__.optimize.ErrFunc = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "ErrFunc",
+ __str = "ErrFunc",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__optimize.ErrFunc(src)
+ end,
+};
+setmetatable(__type__.optimize.ErrFunc, __type__.optimize.ErrFunc);
+
+
+-----------------
+-- named type Operation
+-----------------
+
+__type__.optimize.Operation = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "Operation",
+ __str = "Operation",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__optimize.Operation(src)
+ end,
+};
+setmetatable(__type__.optimize.Operation, __type__.optimize.Operation);
+
+
+-----------------
+-- named type Status
+-----------------
+
+__type__.optimize.Status = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "Status",
+ __str = "Status",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__optimize.Status(src)
+ end,
+};
+setmetatable(__type__.optimize.Status, __type__.optimize.Status);
+
+
+`}
\ No newline at end of file
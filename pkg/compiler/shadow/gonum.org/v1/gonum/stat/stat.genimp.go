@@ -1,56 +1,139 @@
 package shadow_stat
 
-import "gonum.org/v1/gonum/stat"
+import (
+	"gonum.org/v1/gonum/stat"
+
+	"github.com/gijit/gi/pkg/compiler/shadow"
+)
 
 var Pkg = make(map[string]interface{})
+var Ctor = make(map[string]interface{})
 
 func init() {
-	Pkg["Bhattacharyya"] = stat.Bhattacharyya
-	Pkg["BivariateMoment"] = stat.BivariateMoment
-	Pkg["CDF"] = stat.CDF
-	Pkg["ChiSquare"] = stat.ChiSquare
-	Pkg["CircularMean"] = stat.CircularMean
-	Pkg["Correlation"] = stat.Correlation
-	Pkg["CorrelationMatrix"] = stat.CorrelationMatrix
-	Pkg["Covariance"] = stat.Covariance
-	Pkg["CovarianceMatrix"] = stat.CovarianceMatrix
-	Pkg["CrossEntropy"] = stat.CrossEntropy
-	Pkg["Entropy"] = stat.Entropy
-	Pkg["ExKurtosis"] = stat.ExKurtosis
-	Pkg["GeometricMean"] = stat.GeometricMean
-	Pkg["HarmonicMean"] = stat.HarmonicMean
-	Pkg["Hellinger"] = stat.Hellinger
-	Pkg["Histogram"] = stat.Histogram
-	Pkg["JensenShannon"] = stat.JensenShannon
-	Pkg["Kendall"] = stat.Kendall
-	Pkg["KolmogorovSmirnov"] = stat.KolmogorovSmirnov
-	Pkg["KullbackLeibler"] = stat.KullbackLeibler
-	Pkg["LinearRegression"] = stat.LinearRegression
-	Pkg["Mahalanobis"] = stat.Mahalanobis
-	Pkg["Mean"] = stat.Mean
-	Pkg["MeanStdDev"] = stat.MeanStdDev
-	Pkg["MeanVariance"] = stat.MeanVariance
-	Pkg["Mode"] = stat.Mode
-	Pkg["Moment"] = stat.Moment
-	Pkg["MomentAbout"] = stat.MomentAbout
-	Pkg["Quantile"] = stat.Quantile
-	Pkg["RNoughtSquared"] = stat.RNoughtSquared
-	Pkg["ROC"] = stat.ROC
-	Pkg["RSquared"] = stat.RSquared
-	Pkg["RSquaredFrom"] = stat.RSquaredFrom
-	Pkg["Skew"] = stat.Skew
-	Pkg["SortWeighted"] = stat.SortWeighted
-	Pkg["SortWeightedLabeled"] = stat.SortWeightedLabeled
-	Pkg["StdDev"] = stat.StdDev
-	Pkg["StdErr"] = stat.StdErr
-	Pkg["StdScore"] = stat.StdScore
-	Pkg["Variance"] = stat.Variance
+    Pkg["Bhattacharyya"] = stat.Bhattacharyya
+    Pkg["BivariateMoment"] = stat.BivariateMoment
+    Ctor["CC"] = GijitShadow_NewStruct_CC
+    Pkg["CDF"] = stat.CDF
+    Pkg["ChiSquare"] = stat.ChiSquare
+    Pkg["CircularMean"] = stat.CircularMean
+    Pkg["Correlation"] = stat.Correlation
+    Pkg["CorrelationMatrix"] = stat.CorrelationMatrix
+    Pkg["Covariance"] = stat.Covariance
+    Pkg["CovarianceMatrix"] = stat.CovarianceMatrix
+    Pkg["CrossEntropy"] = stat.CrossEntropy
+    Ctor["CumulantKind"] = GijitShadow_NewNamed_CumulantKind
+    Pkg["Empirical"] = stat.Empirical
+    Pkg["Entropy"] = stat.Entropy
+    Pkg["ExKurtosis"] = stat.ExKurtosis
+    Pkg["GeometricMean"] = stat.GeometricMean
+    Pkg["HarmonicMean"] = stat.HarmonicMean
+    Pkg["Hellinger"] = stat.Hellinger
+    Pkg["Histogram"] = stat.Histogram
+    Pkg["JensenShannon"] = stat.JensenShannon
+    Pkg["Kendall"] = stat.Kendall
+    Pkg["KolmogorovSmirnov"] = stat.KolmogorovSmirnov
+    Pkg["KullbackLeibler"] = stat.KullbackLeibler
+    Pkg["LinearRegression"] = stat.LinearRegression
+    Pkg["Mahalanobis"] = stat.Mahalanobis
+    Pkg["Mean"] = stat.Mean
+    Pkg["MeanStdDev"] = stat.MeanStdDev
+    Pkg["MeanVariance"] = stat.MeanVariance
+    Pkg["Mode"] = stat.Mode
+    Pkg["Moment"] = stat.Moment
+    Pkg["MomentAbout"] = stat.MomentAbout
+    Ctor["PC"] = GijitShadow_NewStruct_PC
+    Pkg["Quantile"] = stat.Quantile
+    Pkg["RNoughtSquared"] = stat.RNoughtSquared
+    Pkg["ROC"] = stat.ROC
+    Pkg["RSquared"] = stat.RSquared
+    Pkg["RSquaredFrom"] = stat.RSquaredFrom
+    Pkg["Skew"] = stat.Skew
+    Pkg["SortWeighted"] = stat.SortWeighted
+    Pkg["SortWeightedLabeled"] = stat.SortWeightedLabeled
+    Pkg["StdDev"] = stat.StdDev
+    Pkg["StdErr"] = stat.StdErr
+    Pkg["StdScore"] = stat.StdScore
+    Pkg["Variance"] = stat.Variance
 
+    shadow.Register("gonum.org/v1/gonum/stat", "stat", Pkg, Ctor, InitLua)
 }
-func GijitShadow_NewStruct_CC() *stat.CC {
-	return &stat.CC{}
+func GijitShadow_NewStruct_CC(src *stat.CC) *stat.CC {
+    if src == nil {
+	   return &stat.CC{}
+    }
+    a := *src
+    return &a
 }
 
-func GijitShadow_NewStruct_PC() *stat.PC {
-	return &stat.PC{}
+
+func GijitShadow_NewNamed_CumulantKind(src stat.CumulantKind) stat.CumulantKind {
+    return src
+}
+
+
+func GijitShadow_NewStruct_PC(src *stat.PC) *stat.PC {
+    if src == nil {
+	   return &stat.PC{}
+    }
+    a := *src
+    return &a
 }
+
+
+
+ func InitLua() string {
+  return `
+__type__.stat ={};
+
+-----------------
+-- struct CC
+-----------------
+
+__type__.stat.CC = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "CC",
+ __str = "CC",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__stat.CC(src)
+ end,
+};
+setmetatable(__type__.stat.CC, __type__.stat.CC);
+
+
+-----------------
+-- struct PC
+-----------------
+
+__type__.stat.PC = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "PC",
+ __str = "PC",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__stat.PC(src)
+ end,
+};
+setmetatable(__type__.stat.PC, __type__.stat.PC);
+
+
+-----------------
+-- named type CumulantKind
+-----------------
+
+__type__.stat.CumulantKind = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "CumulantKind",
+ __str = "CumulantKind",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__stat.CumulantKind(src)
+ end,
+};
+setmetatable(__type__.stat.CumulantKind, __type__.stat.CumulantKind);
+
+
+`}
\ No newline at end of file
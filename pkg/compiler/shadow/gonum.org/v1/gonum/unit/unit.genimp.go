@@ -1,41 +1,162 @@
 package shadow_unit
 
-import "gonum.org/v1/gonum/unit"
+import (
+	"gonum.org/v1/gonum/unit"
+
+	"github.com/gijit/gi/pkg/compiler/shadow"
+)
 
 var Pkg = make(map[string]interface{})
+var Ctor = make(map[string]interface{})
 
 func init() {
-	Pkg["Atto"] = unit.Atto
-	Pkg["Centi"] = unit.Centi
-	Pkg["Deca"] = unit.Deca
-	Pkg["Deci"] = unit.Deci
-	Pkg["DimensionsMatch"] = unit.DimensionsMatch
-	Pkg["Exa"] = unit.Exa
-	Pkg["Femto"] = unit.Femto
-	Pkg["Giga"] = unit.Giga
-	Pkg["Hecto"] = unit.Hecto
-	Pkg["Kilo"] = unit.Kilo
-	Pkg["Mega"] = unit.Mega
-	Pkg["Micro"] = unit.Micro
-	Pkg["Milli"] = unit.Milli
-	Pkg["Nano"] = unit.Nano
-	Pkg["New"] = unit.New
-	Pkg["NewDimension"] = unit.NewDimension
-	Pkg["Peta"] = unit.Peta
-	Pkg["Pico"] = unit.Pico
-	Pkg["SymbolExists"] = unit.SymbolExists
-	Pkg["Tera"] = unit.Tera
-	Pkg["Uniter"] = GijitShadow_InterfaceConvertTo2_Uniter
-	Pkg["Yocto"] = unit.Yocto
-	Pkg["Yotta"] = unit.Yotta
-	Pkg["Zepto"] = unit.Zepto
-	Pkg["Zetta"] = unit.Zetta
-
-}
-func GijitShadow_NewStruct_Unit() *unit.Unit {
-	return &unit.Unit{}
+    Pkg["AngleDim"] = unit.AngleDim
+    Pkg["Atto"] = unit.Atto
+    Pkg["Attogram"] = unit.Attogram
+    Pkg["Attometer"] = unit.Attometer
+    Pkg["Attosecond"] = unit.Attosecond
+    Pkg["Centi"] = unit.Centi
+    Pkg["Centigram"] = unit.Centigram
+    Pkg["Centimeter"] = unit.Centimeter
+    Pkg["Centisecond"] = unit.Centisecond
+    Pkg["CurrentDim"] = unit.CurrentDim
+    Pkg["Deca"] = unit.Deca
+    Pkg["Decagram"] = unit.Decagram
+    Pkg["Decameter"] = unit.Decameter
+    Pkg["Decasecond"] = unit.Decasecond
+    Pkg["Deci"] = unit.Deci
+    Pkg["Decigram"] = unit.Decigram
+    Pkg["Decimeter"] = unit.Decimeter
+    Pkg["Decisecond"] = unit.Decisecond
+    Ctor["Dimension"] = GijitShadow_NewNamed_Dimension
+    Ctor["Dimensions"] = GijitShadow_NewNamed_Dimensions
+    Pkg["DimensionsMatch"] = unit.DimensionsMatch
+    Ctor["Dimless"] = GijitShadow_NewNamed_Dimless
+    Pkg["Exa"] = unit.Exa
+    Pkg["Exagram"] = unit.Exagram
+    Pkg["Exameter"] = unit.Exameter
+    Pkg["Exasecond"] = unit.Exasecond
+    Pkg["Femto"] = unit.Femto
+    Pkg["Femtogram"] = unit.Femtogram
+    Pkg["Femtometer"] = unit.Femtometer
+    Pkg["Femtosecond"] = unit.Femtosecond
+    Pkg["Giga"] = unit.Giga
+    Pkg["Gigagram"] = unit.Gigagram
+    Pkg["Gigameter"] = unit.Gigameter
+    Pkg["Gigasecond"] = unit.Gigasecond
+    Pkg["Gram"] = unit.Gram
+    Pkg["Hecto"] = unit.Hecto
+    Pkg["Hectogram"] = unit.Hectogram
+    Pkg["Hectometer"] = unit.Hectometer
+    Pkg["Hectosecond"] = unit.Hectosecond
+    Pkg["Hour"] = unit.Hour
+    Pkg["Kilo"] = unit.Kilo
+    Pkg["Kilogram"] = unit.Kilogram
+    Pkg["Kilometer"] = unit.Kilometer
+    Pkg["Kilosecond"] = unit.Kilosecond
+    Ctor["Length"] = GijitShadow_NewNamed_Length
+    Pkg["LengthDim"] = unit.LengthDim
+    Pkg["LuminousIntensityDim"] = unit.LuminousIntensityDim
+    Ctor["Mass"] = GijitShadow_NewNamed_Mass
+    Pkg["MassDim"] = unit.MassDim
+    Pkg["Mega"] = unit.Mega
+    Pkg["Megagram"] = unit.Megagram
+    Pkg["Megameter"] = unit.Megameter
+    Pkg["Megasecond"] = unit.Megasecond
+    Pkg["Meter"] = unit.Meter
+    Pkg["Micro"] = unit.Micro
+    Pkg["Microgram"] = unit.Microgram
+    Pkg["Micrometer"] = unit.Micrometer
+    Pkg["Microsecond"] = unit.Microsecond
+    Pkg["Milli"] = unit.Milli
+    Pkg["Milligram"] = unit.Milligram
+    Pkg["Millimeter"] = unit.Millimeter
+    Pkg["Millisecond"] = unit.Millisecond
+    Pkg["Minute"] = unit.Minute
+    Pkg["Nano"] = unit.Nano
+    Pkg["Nanogram"] = unit.Nanogram
+    Pkg["Nanometer"] = unit.Nanometer
+    Pkg["Nanosecond"] = unit.Nanosecond
+    Pkg["New"] = unit.New
+    Pkg["NewDimension"] = unit.NewDimension
+    Pkg["One"] = unit.One
+    Pkg["Peta"] = unit.Peta
+    Pkg["Petagram"] = unit.Petagram
+    Pkg["Petameter"] = unit.Petameter
+    Pkg["Petasecond"] = unit.Petasecond
+    Pkg["Pico"] = unit.Pico
+    Pkg["Picogram"] = unit.Picogram
+    Pkg["Picometer"] = unit.Picometer
+    Pkg["Picosecond"] = unit.Picosecond
+    Pkg["Second"] = unit.Second
+    Pkg["SymbolExists"] = unit.SymbolExists
+    Pkg["TemperatureDim"] = unit.TemperatureDim
+    Pkg["Tera"] = unit.Tera
+    Pkg["Teragram"] = unit.Teragram
+    Pkg["Terameter"] = unit.Terameter
+    Pkg["Terasecond"] = unit.Terasecond
+    Ctor["Time"] = GijitShadow_NewNamed_Time
+    Pkg["TimeDim"] = unit.TimeDim
+    Ctor["Unit"] = GijitShadow_NewStruct_Unit
+    Pkg["Uniter"] = GijitShadow_InterfaceConvertTo2_Uniter
+    Pkg["Yocto"] = unit.Yocto
+    Pkg["Yoctogram"] = unit.Yoctogram
+    Pkg["Yoctometer"] = unit.Yoctometer
+    Pkg["Yoctosecond"] = unit.Yoctosecond
+    Pkg["Yotta"] = unit.Yotta
+    Pkg["Yottagram"] = unit.Yottagram
+    Pkg["Yottameter"] = unit.Yottameter
+    Pkg["Yottasecond"] = unit.Yottasecond
+    Pkg["Zepto"] = unit.Zepto
+    Pkg["Zeptogram"] = unit.Zeptogram
+    Pkg["Zeptometer"] = unit.Zeptometer
+    Pkg["Zeptosecond"] = unit.Zeptosecond
+    Pkg["Zetta"] = unit.Zetta
+    Pkg["Zettagram"] = unit.Zettagram
+    Pkg["Zettameter"] = unit.Zettameter
+    Pkg["Zettasecond"] = unit.Zettasecond
+
+    shadow.Register("gonum.org/v1/gonum/unit", "unit", Pkg, Ctor, InitLua)
+}
+func GijitShadow_NewNamed_Dimension(src unit.Dimension) unit.Dimension {
+    return src
+}
+
+
+func GijitShadow_NewNamed_Dimensions(src unit.Dimensions) unit.Dimensions {
+    return src
+}
+
+
+func GijitShadow_NewNamed_Dimless(src unit.Dimless) unit.Dimless {
+    return src
+}
+
+
+func GijitShadow_NewNamed_Length(src unit.Length) unit.Length {
+    return src
 }
 
+
+func GijitShadow_NewNamed_Mass(src unit.Mass) unit.Mass {
+    return src
+}
+
+
+func GijitShadow_NewNamed_Time(src unit.Time) unit.Time {
+    return src
+}
+
+
+func GijitShadow_NewStruct_Unit(src *unit.Unit) *unit.Unit {
+    if src == nil {
+	   return &unit.Unit{}
+    }
+    a := *src
+    return &a
+}
+
+
 func GijitShadow_InterfaceConvertTo2_Uniter(x interface{}) (y unit.Uniter, b bool) {
 	y, b = x.(unit.Uniter)
 	return
@@ -44,3 +165,130 @@ func GijitShadow_InterfaceConvertTo2_Uniter(x interface{}) (y unit.Uniter, b boo
 func GijitShadow_InterfaceConvertTo1_Uniter(x interface{}) unit.Uniter {
 	return x.(unit.Uniter)
 }
+
+
+
+ func InitLua() string {
+  return `
+__type__.unit ={};
+
+-----------------
+-- struct Unit
+-----------------
+
+__type__.unit.Unit = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "Unit",
+ __str = "Unit",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__unit.Unit(src)
+ end,
+};
+setmetatable(__type__.unit.Unit, __type__.unit.Unit);
+
+
+-----------------
+-- named type Dimension
+-----------------
+
+__type__.unit.Dimension = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "Dimension",
+ __str = "Dimension",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__unit.Dimension(src)
+ end,
+};
+setmetatable(__type__.unit.Dimension, __type__.unit.Dimension);
+
+
+-----------------
+-- named type Dimensions
+-----------------
+
+__type__.unit.Dimensions = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "Dimensions",
+ __str = "Dimensions",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__unit.Dimensions(src)
+ end,
+};
+setmetatable(__type__.unit.Dimensions, __type__.unit.Dimensions);
+
+
+-----------------
+-- named type Dimless
+-----------------
+
+__type__.unit.Dimless = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "Dimless",
+ __str = "Dimless",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__unit.Dimless(src)
+ end,
+};
+setmetatable(__type__.unit.Dimless, __type__.unit.Dimless);
+
+
+-----------------
+-- named type Length
+-----------------
+
+__type__.unit.Length = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "Length",
+ __str = "Length",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__unit.Length(src)
+ end,
+};
+setmetatable(__type__.unit.Length, __type__.unit.Length);
+
+
+-----------------
+-- named type Mass
+-----------------
+
+__type__.unit.Mass = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "Mass",
+ __str = "Mass",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__unit.Mass(src)
+ end,
+};
+setmetatable(__type__.unit.Mass, __type__.unit.Mass);
+
+
+-----------------
+-- named type Time
+-----------------
+
+__type__.unit.Time = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "Time",
+ __str = "Time",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__unit.Time(src)
+ end,
+};
+setmetatable(__type__.unit.Time, __type__.unit.Time);
+
+
+`}
\ No newline at end of file
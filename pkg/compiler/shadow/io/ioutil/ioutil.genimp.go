@@ -1,6 +1,10 @@
 package shadow_ioutil
 
-import "io/ioutil"
+import (
+	"io/ioutil"
+
+	"github.com/gijit/gi/pkg/compiler/shadow"
+)
 
 var Pkg = make(map[string]interface{})
 var Ctor = make(map[string]interface{})
@@ -15,6 +19,7 @@ func init() {
     Pkg["TempFile"] = ioutil.TempFile
     Pkg["WriteFile"] = ioutil.WriteFile
 
+    shadow.Register("io/ioutil", "ioutil", Pkg, Ctor, InitLua)
 }
 
  func InitLua() string {
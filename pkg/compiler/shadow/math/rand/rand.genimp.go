@@ -1,6 +1,10 @@
 package shadow_rand
 
-import "math/rand"
+import (
+	"math/rand"
+
+	"github.com/gijit/gi/pkg/compiler/shadow"
+)
 
 var Pkg = make(map[string]interface{})
 var Ctor = make(map[string]interface{})
@@ -30,6 +34,7 @@ func init() {
     Pkg["Uint64"] = rand.Uint64
     Ctor["Zipf"] = GijitShadow_NewStruct_Zipf
 
+    shadow.Register("math/rand", "rand", Pkg, Ctor, InitLua)
 }
 func GijitShadow_NewStruct_Rand(src *rand.Rand) *rand.Rand {
     if src == nil {
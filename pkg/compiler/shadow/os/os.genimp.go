@@ -1,6 +1,10 @@
 package shadow_os
 
-import "os"
+import (
+	"os"
+
+	"github.com/gijit/gi/pkg/compiler/shadow"
+)
 
 var Pkg = make(map[string]interface{})
 var Ctor = make(map[string]interface{})
@@ -12,21 +16,29 @@ func init() {
     Pkg["Chown"] = os.Chown
     Pkg["Chtimes"] = os.Chtimes
     Pkg["Clearenv"] = os.Clearenv
+    Pkg["CopyFS"] = os.CopyFS
     Pkg["Create"] = os.Create
+    Pkg["CreateTemp"] = os.CreateTemp
     Pkg["DevNull"] = os.DevNull
+    Pkg["DirEntry"] = GijitShadow_InterfaceConvertTo2_DirEntry
+    Pkg["DirFS"] = os.DirFS
     Pkg["Environ"] = os.Environ
     Pkg["ErrClosed"] = os.ErrClosed
+    Pkg["ErrDeadlineExceeded"] = os.ErrDeadlineExceeded
     Pkg["ErrExist"] = os.ErrExist
     Pkg["ErrInvalid"] = os.ErrInvalid
     Pkg["ErrNoDeadline"] = os.ErrNoDeadline
+    Pkg["ErrNoHandle"] = os.ErrNoHandle
     Pkg["ErrNotExist"] = os.ErrNotExist
     Pkg["ErrPermission"] = os.ErrPermission
+    Pkg["ErrProcessDone"] = os.ErrProcessDone
     Pkg["Executable"] = os.Executable
     Pkg["Exit"] = os.Exit
     Pkg["Expand"] = os.Expand
     Pkg["ExpandEnv"] = os.ExpandEnv
     Ctor["File"] = GijitShadow_NewStruct_File
     Pkg["FileInfo"] = GijitShadow_InterfaceConvertTo2_FileInfo
+    Ctor["FileMode"] = GijitShadow_NewNamed_FileMode
     Pkg["FindProcess"] = os.FindProcess
     Pkg["Getegid"] = os.Getegid
     Pkg["Getenv"] = os.Getenv
@@ -53,6 +65,22 @@ func init() {
     Pkg["Lstat"] = os.Lstat
     Pkg["Mkdir"] = os.Mkdir
     Pkg["MkdirAll"] = os.MkdirAll
+    Pkg["MkdirTemp"] = os.MkdirTemp
+    Pkg["ModeAppend"] = os.ModeAppend
+    Pkg["ModeCharDevice"] = os.ModeCharDevice
+    Pkg["ModeDevice"] = os.ModeDevice
+    Pkg["ModeDir"] = os.ModeDir
+    Pkg["ModeExclusive"] = os.ModeExclusive
+    Pkg["ModeIrregular"] = os.ModeIrregular
+    Pkg["ModeNamedPipe"] = os.ModeNamedPipe
+    Pkg["ModePerm"] = os.ModePerm
+    Pkg["ModeSetgid"] = os.ModeSetgid
+    Pkg["ModeSetuid"] = os.ModeSetuid
+    Pkg["ModeSocket"] = os.ModeSocket
+    Pkg["ModeSticky"] = os.ModeSticky
+    Pkg["ModeSymlink"] = os.ModeSymlink
+    Pkg["ModeTemporary"] = os.ModeTemporary
+    Pkg["ModeType"] = os.ModeType
     Pkg["NewFile"] = os.NewFile
     Pkg["NewSyscallError"] = os.NewSyscallError
     Pkg["O_APPEND"] = os.O_APPEND
@@ -65,6 +93,8 @@ func init() {
     Pkg["O_WRONLY"] = os.O_WRONLY
     Pkg["Open"] = os.Open
     Pkg["OpenFile"] = os.OpenFile
+    Pkg["OpenInRoot"] = os.OpenInRoot
+    Pkg["OpenRoot"] = os.OpenRoot
     Ctor["PathError"] = GijitShadow_NewStruct_PathError
     Pkg["PathListSeparator"] = os.PathListSeparator
     Pkg["PathSeparator"] = os.PathSeparator
@@ -72,10 +102,13 @@ func init() {
     Ctor["ProcAttr"] = GijitShadow_NewStruct_ProcAttr
     Ctor["Process"] = GijitShadow_NewStruct_Process
     Ctor["ProcessState"] = GijitShadow_NewStruct_ProcessState
+    Pkg["ReadDir"] = os.ReadDir
+    Pkg["ReadFile"] = os.ReadFile
     Pkg["Readlink"] = os.Readlink
     Pkg["Remove"] = os.Remove
     Pkg["RemoveAll"] = os.RemoveAll
     Pkg["Rename"] = os.Rename
+    Ctor["Root"] = GijitShadow_NewStruct_Root
     Pkg["SEEK_CUR"] = os.SEEK_CUR
     Pkg["SEEK_END"] = os.SEEK_END
     Pkg["SEEK_SET"] = os.SEEK_SET
@@ -92,8 +125,23 @@ func init() {
     Pkg["TempDir"] = os.TempDir
     Pkg["Truncate"] = os.Truncate
     Pkg["Unsetenv"] = os.Unsetenv
+    Pkg["UserCacheDir"] = os.UserCacheDir
+    Pkg["UserConfigDir"] = os.UserConfigDir
+    Pkg["UserHomeDir"] = os.UserHomeDir
+    Pkg["WriteFile"] = os.WriteFile
+
+    shadow.Register("os", "os", Pkg, Ctor, InitLua)
+}
+func GijitShadow_InterfaceConvertTo2_DirEntry(x interface{}) (y os.DirEntry, b bool) {
+	y, b = x.(os.DirEntry)
+	return
+}
 
+func GijitShadow_InterfaceConvertTo1_DirEntry(x interface{}) os.DirEntry {
+	return x.(os.DirEntry)
 }
+
+
 func GijitShadow_NewStruct_File(src *os.File) *os.File {
     if src == nil {
 	   return &os.File{}
@@ -113,6 +161,11 @@ func GijitShadow_InterfaceConvertTo1_FileInfo(x interface{}) os.FileInfo {
 }
 
 
+func GijitShadow_NewNamed_FileMode(src os.FileMode) os.FileMode {
+    return src
+}
+
+
 func GijitShadow_NewStruct_LinkError(src *os.LinkError) *os.LinkError {
     if src == nil {
 	   return &os.LinkError{}
@@ -158,6 +211,15 @@ func GijitShadow_NewStruct_ProcessState(src *os.ProcessState) *os.ProcessState {
 }
 
 
+func GijitShadow_NewStruct_Root(src *os.Root) *os.Root {
+    if src == nil {
+	   return &os.Root{}
+    }
+    a := *src
+    return &a
+}
+
+
 func GijitShadow_InterfaceConvertTo2_Signal(x interface{}) (y os.Signal, b bool) {
 	y, b = x.(os.Signal)
 	return
@@ -284,6 +346,23 @@ __type__.os.ProcessState = {
 setmetatable(__type__.os.ProcessState, __type__.os.ProcessState);
 
 
+-----------------
+-- struct Root
+-----------------
+
+__type__.os.Root = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "Root",
+ __str = "Root",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__os.Root(src)
+ end,
+};
+setmetatable(__type__.os.Root, __type__.os.Root);
+
+
 -----------------
 -- struct SyscallError
 -----------------
@@ -301,4 +380,21 @@ __type__.os.SyscallError = {
 setmetatable(__type__.os.SyscallError, __type__.os.SyscallError);
 
 
+-----------------
+-- named type FileMode
+-----------------
+
+__type__.os.FileMode = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "FileMode",
+ __str = "FileMode",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__os.FileMode(src)
+ end,
+};
+setmetatable(__type__.os.FileMode, __type__.os.FileMode);
+
+
 `}
\ No newline at end of file
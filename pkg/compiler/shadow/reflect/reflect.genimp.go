@@ -1,6 +1,10 @@
 package shadow_reflect
 
-import "reflect"
+import (
+	"reflect"
+
+	"github.com/gijit/gi/pkg/compiler/shadow"
+)
 
 var Pkg = make(map[string]interface{})
 var Ctor = make(map[string]interface{})
@@ -8,38 +12,100 @@ var Ctor = make(map[string]interface{})
 func init() {
     Pkg["Append"] = reflect.Append
     Pkg["AppendSlice"] = reflect.AppendSlice
+    Pkg["Array"] = reflect.Array
     Pkg["ArrayOf"] = reflect.ArrayOf
+    Pkg["Bool"] = reflect.Bool
+    Pkg["BothDir"] = reflect.BothDir
+    Pkg["Chan"] = reflect.Chan
+    Ctor["ChanDir"] = GijitShadow_NewNamed_ChanDir
     Pkg["ChanOf"] = reflect.ChanOf
+    Pkg["Complex128"] = reflect.Complex128
+    Pkg["Complex64"] = reflect.Complex64
     Pkg["Copy"] = reflect.Copy
     Pkg["DeepEqual"] = reflect.DeepEqual
+    Pkg["Float32"] = reflect.Float32
+    Pkg["Float64"] = reflect.Float64
+    Pkg["Func"] = reflect.Func
     Pkg["FuncOf"] = reflect.FuncOf
     Pkg["Indirect"] = reflect.Indirect
+    Pkg["Int"] = reflect.Int
+    Pkg["Int16"] = reflect.Int16
+    Pkg["Int32"] = reflect.Int32
+    Pkg["Int64"] = reflect.Int64
+    Pkg["Int8"] = reflect.Int8
+    Pkg["Interface"] = reflect.Interface
+    Pkg["Invalid"] = reflect.Invalid
+    Ctor["Kind"] = GijitShadow_NewNamed_Kind
     Pkg["MakeChan"] = reflect.MakeChan
     Pkg["MakeFunc"] = reflect.MakeFunc
     Pkg["MakeMap"] = reflect.MakeMap
     Pkg["MakeMapWithSize"] = reflect.MakeMapWithSize
     Pkg["MakeSlice"] = reflect.MakeSlice
+    Pkg["Map"] = reflect.Map
+    Ctor["MapIter"] = GijitShadow_NewStruct_MapIter
     Pkg["MapOf"] = reflect.MapOf
     Ctor["Method"] = GijitShadow_NewStruct_Method
     Pkg["New"] = reflect.New
     Pkg["NewAt"] = reflect.NewAt
+    Pkg["Pointer"] = reflect.Pointer
+    Pkg["PointerTo"] = reflect.PointerTo
+    Pkg["Ptr"] = reflect.Ptr
     Pkg["PtrTo"] = reflect.PtrTo
+    Pkg["RecvDir"] = reflect.RecvDir
     Pkg["Select"] = reflect.Select
     Ctor["SelectCase"] = GijitShadow_NewStruct_SelectCase
+    Pkg["SelectDefault"] = reflect.SelectDefault
+    Ctor["SelectDir"] = GijitShadow_NewNamed_SelectDir
+    Pkg["SelectRecv"] = reflect.SelectRecv
+    Pkg["SelectSend"] = reflect.SelectSend
+    Pkg["SendDir"] = reflect.SendDir
+    Pkg["Slice"] = reflect.Slice
+    Pkg["SliceAt"] = reflect.SliceAt
     Ctor["SliceHeader"] = GijitShadow_NewStruct_SliceHeader
     Pkg["SliceOf"] = reflect.SliceOf
+    Pkg["String"] = reflect.String
     Ctor["StringHeader"] = GijitShadow_NewStruct_StringHeader
+    Pkg["Struct"] = reflect.Struct
     Ctor["StructField"] = GijitShadow_NewStruct_StructField
     Pkg["StructOf"] = reflect.StructOf
+    Ctor["StructTag"] = GijitShadow_NewNamed_StructTag
     Pkg["Swapper"] = reflect.Swapper
     Pkg["Type"] = GijitShadow_InterfaceConvertTo2_Type
     Pkg["TypeOf"] = reflect.TypeOf
+    Pkg["Uint"] = reflect.Uint
+    Pkg["Uint16"] = reflect.Uint16
+    Pkg["Uint32"] = reflect.Uint32
+    Pkg["Uint64"] = reflect.Uint64
+    Pkg["Uint8"] = reflect.Uint8
+    Pkg["Uintptr"] = reflect.Uintptr
+    Pkg["UnsafePointer"] = reflect.UnsafePointer
     Ctor["Value"] = GijitShadow_NewStruct_Value
     Ctor["ValueError"] = GijitShadow_NewStruct_ValueError
     Pkg["ValueOf"] = reflect.ValueOf
+    Pkg["VisibleFields"] = reflect.VisibleFields
     Pkg["Zero"] = reflect.Zero
 
+    shadow.Register("reflect", "reflect", Pkg, Ctor, InitLua)
+}
+func GijitShadow_NewNamed_ChanDir(src reflect.ChanDir) reflect.ChanDir {
+    return src
+}
+
+
+func GijitShadow_NewNamed_Kind(src reflect.Kind) reflect.Kind {
+    return src
+}
+
+
+func GijitShadow_NewStruct_MapIter(src *reflect.MapIter) *reflect.MapIter {
+    if src == nil {
+	   return &reflect.MapIter{}
+    }
+    a := *src
+    return &a
 }
+
+
 func GijitShadow_NewStruct_Method(src *reflect.Method) *reflect.Method {
     if src == nil {
 	   return &reflect.Method{}
@@ -58,6 +124,11 @@ func GijitShadow_NewStruct_SelectCase(src *reflect.SelectCase) *reflect.SelectCa
 }
 
 
+func GijitShadow_NewNamed_SelectDir(src reflect.SelectDir) reflect.SelectDir {
+    return src
+}
+
+
 func GijitShadow_NewStruct_SliceHeader(src *reflect.SliceHeader) *reflect.SliceHeader {
     if src == nil {
 	   return &reflect.SliceHeader{}
@@ -85,6 +156,11 @@ func GijitShadow_NewStruct_StructField(src *reflect.StructField) *reflect.Struct
 }
 
 
+func GijitShadow_NewNamed_StructTag(src reflect.StructTag) reflect.StructTag {
+    return src
+}
+
+
 func GijitShadow_InterfaceConvertTo2_Type(x interface{}) (y reflect.Type, b bool) {
 	y, b = x.(reflect.Type)
 	return
@@ -118,6 +194,23 @@ func GijitShadow_NewStruct_ValueError(src *reflect.ValueError) *reflect.ValueErr
   return `
 __type__.reflect ={};
 
+-----------------
+-- struct MapIter
+-----------------
+
+__type__.reflect.MapIter = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "MapIter",
+ __str = "MapIter",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__reflect.MapIter(src)
+ end,
+};
+setmetatable(__type__.reflect.MapIter, __type__.reflect.MapIter);
+
+
 -----------------
 -- struct Method
 -----------------
@@ -237,4 +330,72 @@ __type__.reflect.ValueError = {
 setmetatable(__type__.reflect.ValueError, __type__.reflect.ValueError);
 
 
+-----------------
+-- named type ChanDir
+-----------------
+
+__type__.reflect.ChanDir = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "ChanDir",
+ __str = "ChanDir",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__reflect.ChanDir(src)
+ end,
+};
+setmetatable(__type__.reflect.ChanDir, __type__.reflect.ChanDir);
+
+
+-----------------
+-- named type Kind
+-----------------
+
+__type__.reflect.Kind = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "Kind",
+ __str = "Kind",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__reflect.Kind(src)
+ end,
+};
+setmetatable(__type__.reflect.Kind, __type__.reflect.Kind);
+
+
+-----------------
+-- named type SelectDir
+-----------------
+
+__type__.reflect.SelectDir = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "SelectDir",
+ __str = "SelectDir",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__reflect.SelectDir(src)
+ end,
+};
+setmetatable(__type__.reflect.SelectDir, __type__.reflect.SelectDir);
+
+
+-----------------
+-- named type StructTag
+-----------------
+
+__type__.reflect.StructTag = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "StructTag",
+ __str = "StructTag",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__reflect.StructTag(src)
+ end,
+};
+setmetatable(__type__.reflect.StructTag, __type__.reflect.StructTag);
+
+
 `}
\ No newline at end of file
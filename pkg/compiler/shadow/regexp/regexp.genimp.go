@@ -1,6 +1,10 @@
 package shadow_regexp
 
-import "regexp"
+import (
+	"regexp"
+
+	"github.com/gijit/gi/pkg/compiler/shadow"
+)
 
 var Pkg = make(map[string]interface{})
 var Ctor = make(map[string]interface{})
@@ -16,6 +20,7 @@ func init() {
     Pkg["QuoteMeta"] = regexp.QuoteMeta
     Ctor["Regexp"] = GijitShadow_NewStruct_Regexp
 
+    shadow.Register("regexp", "regexp", Pkg, Ctor, InitLua)
 }
 func GijitShadow_NewStruct_Regexp(src *regexp.Regexp) *regexp.Regexp {
     if src == nil {
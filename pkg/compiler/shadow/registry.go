@@ -0,0 +1,80 @@
+// Package shadow holds the registry of binary (shadowed)
+// Go packages that can be imported from inside the REPL.
+//
+// The generated shadow_* packages underneath this
+// directory call Register from their init(), as can
+// any program that embeds pkg/compiler and wants
+// to expose its own Go libraries to gijit.
+package shadow
+
+import (
+	"sort"
+	"sync"
+)
+
+// PathPrefix is prepended to the import path of a shadowed
+// package to form the path the type checker sees. It marks
+// the package as binary (Luar-called) rather than source.
+const PathPrefix = "github.com/gijit/gi/pkg/compiler/shadow/"
+
+// Entry describes one shadowed package.
+type Entry struct {
+	// Path is the import path, e.g. "math/rand".
+	Path string
+
+	// Name is the package name bound in Lua, e.g. "rand".
+	Name string
+
+	// Pkg maps exported identifiers to their Go values.
+	Pkg map[string]interface{}
+
+	// Ctor maps struct type names to their copy constructors.
+	// May be nil.
+	Ctor map[string]interface{}
+
+	// InitLua returns Lua source to run at import time.
+	// May be nil.
+	InitLua func() string
+}
+
+var mut sync.Mutex
+var registry = make(map[string]*Entry)
+
+// Register makes the package at importPath, whose package
+// clause says name, available to `import` statements
+// inside the REPL. A later Register of the same path
+// replaces the earlier one.
+func Register(importPath, name string, pkg, ctor map[string]interface{}, initLua func() string) {
+	if pkg == nil {
+		pkg = make(map[string]interface{})
+	}
+	mut.Lock()
+	registry[importPath] = &Entry{
+		Path:    importPath,
+		Name:    name,
+		Pkg:     pkg,
+		Ctor:    ctor,
+		InitLua: initLua,
+	}
+	mut.Unlock()
+}
+
+// Lookup returns the Entry registered for importPath,
+// or nil if there is none.
+func Lookup(importPath string) *Entry {
+	mut.Lock()
+	defer mut.Unlock()
+	return registry[importPath]
+}
+
+// Paths returns the registered import paths in sorted order.
+func Paths() []string {
+	mut.Lock()
+	defer mut.Unlock()
+	r := make([]string, 0, len(registry))
+	for k := range registry {
+		r = append(r, k)
+	}
+	sort.Strings(r)
+	return r
+}
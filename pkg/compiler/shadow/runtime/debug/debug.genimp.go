@@ -1,24 +1,64 @@
 package shadow_debug
 
-import "runtime/debug"
+import (
+	"runtime/debug"
+
+	"github.com/gijit/gi/pkg/compiler/shadow"
+)
 
 var Pkg = make(map[string]interface{})
 var Ctor = make(map[string]interface{})
 
 func init() {
+    Ctor["BuildInfo"] = GijitShadow_NewStruct_BuildInfo
+    Ctor["BuildSetting"] = GijitShadow_NewStruct_BuildSetting
+    Ctor["CrashOptions"] = GijitShadow_NewStruct_CrashOptions
     Pkg["FreeOSMemory"] = debug.FreeOSMemory
     Ctor["GCStats"] = GijitShadow_NewStruct_GCStats
+    Ctor["Module"] = GijitShadow_NewStruct_Module
+    Pkg["ParseBuildInfo"] = debug.ParseBuildInfo
     Pkg["PrintStack"] = debug.PrintStack
+    Pkg["ReadBuildInfo"] = debug.ReadBuildInfo
     Pkg["ReadGCStats"] = debug.ReadGCStats
+    Pkg["SetCrashOutput"] = debug.SetCrashOutput
     Pkg["SetGCPercent"] = debug.SetGCPercent
     Pkg["SetMaxStack"] = debug.SetMaxStack
     Pkg["SetMaxThreads"] = debug.SetMaxThreads
+    Pkg["SetMemoryLimit"] = debug.SetMemoryLimit
     Pkg["SetPanicOnFault"] = debug.SetPanicOnFault
     Pkg["SetTraceback"] = debug.SetTraceback
     Pkg["Stack"] = debug.Stack
     Pkg["WriteHeapDump"] = debug.WriteHeapDump
 
+    shadow.Register("runtime/debug", "debug", Pkg, Ctor, InitLua)
+}
+func GijitShadow_NewStruct_BuildInfo(src *debug.BuildInfo) *debug.BuildInfo {
+    if src == nil {
+	   return &debug.BuildInfo{}
+    }
+    a := *src
+    return &a
 }
+
+
+func GijitShadow_NewStruct_BuildSetting(src *debug.BuildSetting) *debug.BuildSetting {
+    if src == nil {
+	   return &debug.BuildSetting{}
+    }
+    a := *src
+    return &a
+}
+
+
+func GijitShadow_NewStruct_CrashOptions(src *debug.CrashOptions) *debug.CrashOptions {
+    if src == nil {
+	   return &debug.CrashOptions{}
+    }
+    a := *src
+    return &a
+}
+
+
 func GijitShadow_NewStruct_GCStats(src *debug.GCStats) *debug.GCStats {
     if src == nil {
 	   return &debug.GCStats{}
@@ -28,11 +68,71 @@ func GijitShadow_NewStruct_GCStats(src *debug.GCStats) *debug.GCStats {
 }
 
 
+func GijitShadow_NewStruct_Module(src *debug.Module) *debug.Module {
+    if src == nil {
+	   return &debug.Module{}
+    }
+    a := *src
+    return &a
+}
+
+
 
  func InitLua() string {
   return `
 __type__.debug ={};
 
+-----------------
+-- struct BuildInfo
+-----------------
+
+__type__.debug.BuildInfo = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "BuildInfo",
+ __str = "BuildInfo",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__debug.BuildInfo(src)
+ end,
+};
+setmetatable(__type__.debug.BuildInfo, __type__.debug.BuildInfo);
+
+
+-----------------
+-- struct BuildSetting
+-----------------
+
+__type__.debug.BuildSetting = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "BuildSetting",
+ __str = "BuildSetting",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__debug.BuildSetting(src)
+ end,
+};
+setmetatable(__type__.debug.BuildSetting, __type__.debug.BuildSetting);
+
+
+-----------------
+-- struct CrashOptions
+-----------------
+
+__type__.debug.CrashOptions = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "CrashOptions",
+ __str = "CrashOptions",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__debug.CrashOptions(src)
+ end,
+};
+setmetatable(__type__.debug.CrashOptions, __type__.debug.CrashOptions);
+
+
 -----------------
 -- struct GCStats
 -----------------
@@ -50,4 +150,21 @@ __type__.debug.GCStats = {
 setmetatable(__type__.debug.GCStats, __type__.debug.GCStats);
 
 
+-----------------
+-- struct Module
+-----------------
+
+__type__.debug.Module = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "Module",
+ __str = "Module",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__debug.Module(src)
+ end,
+};
+setmetatable(__type__.debug.Module, __type__.debug.Module);
+
+
 `}
\ No newline at end of file
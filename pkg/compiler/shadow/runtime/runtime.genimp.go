@@ -1,6 +1,10 @@
 package shadow_runtime
 
-import "runtime"
+import (
+	"runtime"
+
+	"github.com/gijit/gi/pkg/compiler/shadow"
+)
 
 var Pkg = make(map[string]interface{})
 var Ctor = make(map[string]interface{})
@@ -13,6 +17,7 @@ func init() {
     Pkg["Caller"] = runtime.Caller
     Pkg["Callers"] = runtime.Callers
     Pkg["CallersFrames"] = runtime.CallersFrames
+    Ctor["Cleanup"] = GijitShadow_NewStruct_Cleanup
     Pkg["Compiler"] = runtime.Compiler
     Pkg["Error"] = GijitShadow_InterfaceConvertTo2_Error
     Ctor["Frame"] = GijitShadow_NewStruct_Frame
@@ -37,11 +42,14 @@ func init() {
     Pkg["NumCPU"] = runtime.NumCPU
     Pkg["NumCgoCall"] = runtime.NumCgoCall
     Pkg["NumGoroutine"] = runtime.NumGoroutine
+    Ctor["PanicNilError"] = GijitShadow_NewStruct_PanicNilError
+    Ctor["Pinner"] = GijitShadow_NewStruct_Pinner
     Pkg["ReadMemStats"] = runtime.ReadMemStats
     Pkg["ReadTrace"] = runtime.ReadTrace
     Pkg["SetBlockProfileRate"] = runtime.SetBlockProfileRate
     Pkg["SetCPUProfileRate"] = runtime.SetCPUProfileRate
     Pkg["SetCgoTraceback"] = runtime.SetCgoTraceback
+    Pkg["SetDefaultGOMAXPROCS"] = runtime.SetDefaultGOMAXPROCS
     Pkg["SetFinalizer"] = runtime.SetFinalizer
     Pkg["SetMutexProfileFraction"] = runtime.SetMutexProfileFraction
     Pkg["Stack"] = runtime.Stack
@@ -53,6 +61,7 @@ func init() {
     Pkg["UnlockOSThread"] = runtime.UnlockOSThread
     Pkg["Version"] = runtime.Version
 
+    shadow.Register("runtime", "runtime", Pkg, Ctor, InitLua)
 }
 func GijitShadow_NewStruct_BlockProfileRecord(src *runtime.BlockProfileRecord) *runtime.BlockProfileRecord {
     if src == nil {
@@ -63,6 +72,15 @@ func GijitShadow_NewStruct_BlockProfileRecord(src *runtime.BlockProfileRecord) *
 }
 
 
+func GijitShadow_NewStruct_Cleanup(src *runtime.Cleanup) *runtime.Cleanup {
+    if src == nil {
+	   return &runtime.Cleanup{}
+    }
+    a := *src
+    return &a
+}
+
+
 func GijitShadow_InterfaceConvertTo2_Error(x interface{}) (y runtime.Error, b bool) {
 	y, b = x.(runtime.Error)
 	return
@@ -118,6 +136,24 @@ func GijitShadow_NewStruct_MemStats(src *runtime.MemStats) *runtime.MemStats {
 }
 
 
+func GijitShadow_NewStruct_PanicNilError(src *runtime.PanicNilError) *runtime.PanicNilError {
+    if src == nil {
+	   return &runtime.PanicNilError{}
+    }
+    a := *src
+    return &a
+}
+
+
+func GijitShadow_NewStruct_Pinner(src *runtime.Pinner) *runtime.Pinner {
+    if src == nil {
+	   return &runtime.Pinner{}
+    }
+    a := *src
+    return &a
+}
+
+
 func GijitShadow_NewStruct_StackRecord(src *runtime.StackRecord) *runtime.StackRecord {
     if src == nil {
 	   return &runtime.StackRecord{}
@@ -158,6 +194,23 @@ __type__.runtime.BlockProfileRecord = {
 setmetatable(__type__.runtime.BlockProfileRecord, __type__.runtime.BlockProfileRecord);
 
 
+-----------------
+-- struct Cleanup
+-----------------
+
+__type__.runtime.Cleanup = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "Cleanup",
+ __str = "Cleanup",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__runtime.Cleanup(src)
+ end,
+};
+setmetatable(__type__.runtime.Cleanup, __type__.runtime.Cleanup);
+
+
 -----------------
 -- struct Frame
 -----------------
@@ -243,6 +296,40 @@ __type__.runtime.MemStats = {
 setmetatable(__type__.runtime.MemStats, __type__.runtime.MemStats);
 
 
+-----------------
+-- struct PanicNilError
+-----------------
+
+__type__.runtime.PanicNilError = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "PanicNilError",
+ __str = "PanicNilError",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__runtime.PanicNilError(src)
+ end,
+};
+setmetatable(__type__.runtime.PanicNilError, __type__.runtime.PanicNilError);
+
+
+-----------------
+-- struct Pinner
+-----------------
+
+__type__.runtime.Pinner = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "Pinner",
+ __str = "Pinner",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__runtime.Pinner(src)
+ end,
+};
+setmetatable(__type__.runtime.Pinner, __type__.runtime.Pinner);
+
+
 -----------------
 -- struct StackRecord
 -----------------
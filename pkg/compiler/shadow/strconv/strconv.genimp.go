@@ -1,6 +1,10 @@
 package shadow_strconv
 
-import "strconv"
+import (
+	"strconv"
+
+	"github.com/gijit/gi/pkg/compiler/shadow"
+)
 
 var Pkg = make(map[string]interface{})
 var Ctor = make(map[string]interface{})
@@ -21,6 +25,7 @@ func init() {
     Pkg["ErrRange"] = strconv.ErrRange
     Pkg["ErrSyntax"] = strconv.ErrSyntax
     Pkg["FormatBool"] = strconv.FormatBool
+    Pkg["FormatComplex"] = strconv.FormatComplex
     Pkg["FormatFloat"] = strconv.FormatFloat
     Pkg["FormatInt"] = strconv.FormatInt
     Pkg["FormatUint"] = strconv.FormatUint
@@ -30,6 +35,7 @@ func init() {
     Pkg["Itoa"] = strconv.Itoa
     Ctor["NumError"] = GijitShadow_NewStruct_NumError
     Pkg["ParseBool"] = strconv.ParseBool
+    Pkg["ParseComplex"] = strconv.ParseComplex
     Pkg["ParseFloat"] = strconv.ParseFloat
     Pkg["ParseInt"] = strconv.ParseInt
     Pkg["ParseUint"] = strconv.ParseUint
@@ -39,9 +45,11 @@ func init() {
     Pkg["QuoteRuneToGraphic"] = strconv.QuoteRuneToGraphic
     Pkg["QuoteToASCII"] = strconv.QuoteToASCII
     Pkg["QuoteToGraphic"] = strconv.QuoteToGraphic
+    Pkg["QuotedPrefix"] = strconv.QuotedPrefix
     Pkg["Unquote"] = strconv.Unquote
     Pkg["UnquoteChar"] = strconv.UnquoteChar
 
+    shadow.Register("strconv", "strconv", Pkg, Ctor, InitLua)
 }
 func GijitShadow_NewStruct_NumError(src *strconv.NumError) *strconv.NumError {
     if src == nil {
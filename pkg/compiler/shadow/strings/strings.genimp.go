@@ -1,20 +1,32 @@
 package shadow_strings
 
-import "strings"
+import (
+	"strings"
+
+	"github.com/gijit/gi/pkg/compiler/shadow"
+)
 
 var Pkg = make(map[string]interface{})
 var Ctor = make(map[string]interface{})
 
 func init() {
     Ctor["Builder"] = GijitShadow_NewStruct_Builder
+    Pkg["Clone"] = strings.Clone
     Pkg["Compare"] = strings.Compare
     Pkg["Contains"] = strings.Contains
     Pkg["ContainsAny"] = strings.ContainsAny
+    Pkg["ContainsFunc"] = strings.ContainsFunc
     Pkg["ContainsRune"] = strings.ContainsRune
     Pkg["Count"] = strings.Count
+    Pkg["Cut"] = strings.Cut
+    Pkg["CutLast"] = strings.CutLast
+    Pkg["CutPrefix"] = strings.CutPrefix
+    Pkg["CutSuffix"] = strings.CutSuffix
     Pkg["EqualFold"] = strings.EqualFold
     Pkg["Fields"] = strings.Fields
     Pkg["FieldsFunc"] = strings.FieldsFunc
+    Pkg["FieldsFuncSeq"] = strings.FieldsFuncSeq
+    Pkg["FieldsSeq"] = strings.FieldsSeq
     Pkg["HasPrefix"] = strings.HasPrefix
     Pkg["HasSuffix"] = strings.HasSuffix
     Pkg["Index"] = strings.Index
@@ -27,17 +39,21 @@ func init() {
     Pkg["LastIndexAny"] = strings.LastIndexAny
     Pkg["LastIndexByte"] = strings.LastIndexByte
     Pkg["LastIndexFunc"] = strings.LastIndexFunc
+    Pkg["Lines"] = strings.Lines
     Pkg["Map"] = strings.Map
     Pkg["NewReader"] = strings.NewReader
     Pkg["NewReplacer"] = strings.NewReplacer
     Ctor["Reader"] = GijitShadow_NewStruct_Reader
     Pkg["Repeat"] = strings.Repeat
     Pkg["Replace"] = strings.Replace
+    Pkg["ReplaceAll"] = strings.ReplaceAll
     Ctor["Replacer"] = GijitShadow_NewStruct_Replacer
     Pkg["Split"] = strings.Split
     Pkg["SplitAfter"] = strings.SplitAfter
     Pkg["SplitAfterN"] = strings.SplitAfterN
+    Pkg["SplitAfterSeq"] = strings.SplitAfterSeq
     Pkg["SplitN"] = strings.SplitN
+    Pkg["SplitSeq"] = strings.SplitSeq
     Pkg["Title"] = strings.Title
     Pkg["ToLower"] = strings.ToLower
     Pkg["ToLowerSpecial"] = strings.ToLowerSpecial
@@ -45,6 +61,7 @@ func init() {
     Pkg["ToTitleSpecial"] = strings.ToTitleSpecial
     Pkg["ToUpper"] = strings.ToUpper
     Pkg["ToUpperSpecial"] = strings.ToUpperSpecial
+    Pkg["ToValidUTF8"] = strings.ToValidUTF8
     Pkg["Trim"] = strings.Trim
     Pkg["TrimFunc"] = strings.TrimFunc
     Pkg["TrimLeft"] = strings.TrimLeft
@@ -55,6 +72,7 @@ func init() {
     Pkg["TrimSpace"] = strings.TrimSpace
     Pkg["TrimSuffix"] = strings.TrimSuffix
 
+    shadow.Register("strings", "strings", Pkg, Ctor, InitLua)
 }
 func GijitShadow_NewStruct_Builder(src *strings.Builder) *strings.Builder {
     if src == nil {
@@ -1,6 +1,10 @@
 package shadow_atomic
 
-import "sync/atomic"
+import (
+	"sync/atomic"
+
+	"github.com/gijit/gi/pkg/compiler/shadow"
+)
 
 var Pkg = make(map[string]interface{})
 var Ctor = make(map[string]interface{})
@@ -11,18 +15,31 @@ func init() {
     Pkg["AddUint32"] = atomic.AddUint32
     Pkg["AddUint64"] = atomic.AddUint64
     Pkg["AddUintptr"] = atomic.AddUintptr
+    Pkg["AndInt32"] = atomic.AndInt32
+    Pkg["AndInt64"] = atomic.AndInt64
+    Pkg["AndUint32"] = atomic.AndUint32
+    Pkg["AndUint64"] = atomic.AndUint64
+    Pkg["AndUintptr"] = atomic.AndUintptr
+    Ctor["Bool"] = GijitShadow_NewStruct_Bool
     Pkg["CompareAndSwapInt32"] = atomic.CompareAndSwapInt32
     Pkg["CompareAndSwapInt64"] = atomic.CompareAndSwapInt64
     Pkg["CompareAndSwapPointer"] = atomic.CompareAndSwapPointer
     Pkg["CompareAndSwapUint32"] = atomic.CompareAndSwapUint32
     Pkg["CompareAndSwapUint64"] = atomic.CompareAndSwapUint64
     Pkg["CompareAndSwapUintptr"] = atomic.CompareAndSwapUintptr
+    Ctor["Int32"] = GijitShadow_NewStruct_Int32
+    Ctor["Int64"] = GijitShadow_NewStruct_Int64
     Pkg["LoadInt32"] = atomic.LoadInt32
     Pkg["LoadInt64"] = atomic.LoadInt64
     Pkg["LoadPointer"] = atomic.LoadPointer
     Pkg["LoadUint32"] = atomic.LoadUint32
     Pkg["LoadUint64"] = atomic.LoadUint64
     Pkg["LoadUintptr"] = atomic.LoadUintptr
+    Pkg["OrInt32"] = atomic.OrInt32
+    Pkg["OrInt64"] = atomic.OrInt64
+    Pkg["OrUint32"] = atomic.OrUint32
+    Pkg["OrUint64"] = atomic.OrUint64
+    Pkg["OrUintptr"] = atomic.OrUintptr
     Pkg["StoreInt32"] = atomic.StoreInt32
     Pkg["StoreInt64"] = atomic.StoreInt64
     Pkg["StorePointer"] = atomic.StorePointer
@@ -35,9 +52,67 @@ func init() {
     Pkg["SwapUint32"] = atomic.SwapUint32
     Pkg["SwapUint64"] = atomic.SwapUint64
     Pkg["SwapUintptr"] = atomic.SwapUintptr
+    Ctor["Uint32"] = GijitShadow_NewStruct_Uint32
+    Ctor["Uint64"] = GijitShadow_NewStruct_Uint64
+    Ctor["Uintptr"] = GijitShadow_NewStruct_Uintptr
     Ctor["Value"] = GijitShadow_NewStruct_Value
 
+    shadow.Register("sync/atomic", "atomic", Pkg, Ctor, InitLua)
+}
+func GijitShadow_NewStruct_Bool(src *atomic.Bool) *atomic.Bool {
+    if src == nil {
+	   return &atomic.Bool{}
+    }
+    a := *src
+    return &a
+}
+
+
+func GijitShadow_NewStruct_Int32(src *atomic.Int32) *atomic.Int32 {
+    if src == nil {
+	   return &atomic.Int32{}
+    }
+    a := *src
+    return &a
+}
+
+
+func GijitShadow_NewStruct_Int64(src *atomic.Int64) *atomic.Int64 {
+    if src == nil {
+	   return &atomic.Int64{}
+    }
+    a := *src
+    return &a
+}
+
+
+func GijitShadow_NewStruct_Uint32(src *atomic.Uint32) *atomic.Uint32 {
+    if src == nil {
+	   return &atomic.Uint32{}
+    }
+    a := *src
+    return &a
 }
+
+
+func GijitShadow_NewStruct_Uint64(src *atomic.Uint64) *atomic.Uint64 {
+    if src == nil {
+	   return &atomic.Uint64{}
+    }
+    a := *src
+    return &a
+}
+
+
+func GijitShadow_NewStruct_Uintptr(src *atomic.Uintptr) *atomic.Uintptr {
+    if src == nil {
+	   return &atomic.Uintptr{}
+    }
+    a := *src
+    return &a
+}
+
+
 func GijitShadow_NewStruct_Value(src *atomic.Value) *atomic.Value {
     if src == nil {
 	   return &atomic.Value{}
@@ -52,6 +127,108 @@ func GijitShadow_NewStruct_Value(src *atomic.Value) *atomic.Value {
   return `
 __type__.atomic ={};
 
+-----------------
+-- struct Bool
+-----------------
+
+__type__.atomic.Bool = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "Bool",
+ __str = "Bool",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__atomic.Bool(src)
+ end,
+};
+setmetatable(__type__.atomic.Bool, __type__.atomic.Bool);
+
+
+-----------------
+-- struct Int32
+-----------------
+
+__type__.atomic.Int32 = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "Int32",
+ __str = "Int32",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__atomic.Int32(src)
+ end,
+};
+setmetatable(__type__.atomic.Int32, __type__.atomic.Int32);
+
+
+-----------------
+-- struct Int64
+-----------------
+
+__type__.atomic.Int64 = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "Int64",
+ __str = "Int64",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__atomic.Int64(src)
+ end,
+};
+setmetatable(__type__.atomic.Int64, __type__.atomic.Int64);
+
+
+-----------------
+-- struct Uint32
+-----------------
+
+__type__.atomic.Uint32 = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "Uint32",
+ __str = "Uint32",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__atomic.Uint32(src)
+ end,
+};
+setmetatable(__type__.atomic.Uint32, __type__.atomic.Uint32);
+
+
+-----------------
+-- struct Uint64
+-----------------
+
+__type__.atomic.Uint64 = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "Uint64",
+ __str = "Uint64",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__atomic.Uint64(src)
+ end,
+};
+setmetatable(__type__.atomic.Uint64, __type__.atomic.Uint64);
+
+
+-----------------
+-- struct Uintptr
+-----------------
+
+__type__.atomic.Uintptr = {
+ id=0,
+ __name = "native_Go_struct_type_wrapper",
+ __native_type = "Uintptr",
+ __str = "Uintptr",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__atomic.Uintptr(src)
+ end,
+};
+setmetatable(__type__.atomic.Uintptr, __type__.atomic.Uintptr);
+
+
 -----------------
 -- struct Value
 -----------------
@@ -1,6 +1,10 @@
 package shadow_sync
 
-import "sync"
+import (
+	"sync"
+
+	"github.com/gijit/gi/pkg/compiler/shadow"
+)
 
 var Pkg = make(map[string]interface{})
 var Ctor = make(map[string]interface{})
@@ -12,10 +16,12 @@ func init() {
     Ctor["Mutex"] = GijitShadow_NewStruct_Mutex
     Pkg["NewCond"] = sync.NewCond
     Ctor["Once"] = GijitShadow_NewStruct_Once
+    Pkg["OnceFunc"] = sync.OnceFunc
     Ctor["Pool"] = GijitShadow_NewStruct_Pool
     Ctor["RWMutex"] = GijitShadow_NewStruct_RWMutex
     Ctor["WaitGroup"] = GijitShadow_NewStruct_WaitGroup
 
+    shadow.Register("sync", "sync", Pkg, Ctor, InitLua)
 }
 func GijitShadow_NewStruct_Cond(src *sync.Cond) *sync.Cond {
     if src == nil {
@@ -1,6 +1,10 @@
 package shadow_time
 
-import "time"
+import (
+	"time"
+
+	"github.com/gijit/gi/pkg/compiler/shadow"
+)
 
 var Pkg = make(map[string]interface{})
 var Ctor = make(map[string]interface{})
@@ -9,16 +13,39 @@ func init() {
     Pkg["ANSIC"] = time.ANSIC
     Pkg["After"] = time.After
     Pkg["AfterFunc"] = time.AfterFunc
+    Pkg["April"] = time.April
+    Pkg["August"] = time.August
     Pkg["Date"] = time.Date
+    Pkg["DateOnly"] = time.DateOnly
+    Pkg["DateTime"] = time.DateTime
+    Pkg["December"] = time.December
+    Ctor["Duration"] = GijitShadow_NewNamed_Duration
+    Pkg["February"] = time.February
     Pkg["FixedZone"] = time.FixedZone
+    Pkg["Friday"] = time.Friday
+    Pkg["Hour"] = time.Hour
+    Pkg["January"] = time.January
+    Pkg["July"] = time.July
+    Pkg["June"] = time.June
     Pkg["Kitchen"] = time.Kitchen
+    Pkg["Layout"] = time.Layout
     Pkg["LoadLocation"] = time.LoadLocation
     Pkg["LoadLocationFromTZData"] = time.LoadLocationFromTZData
     Pkg["Local"] = time.Local
     Ctor["Location"] = GijitShadow_NewStruct_Location
+    Pkg["March"] = time.March
+    Pkg["May"] = time.May
+    Pkg["Microsecond"] = time.Microsecond
+    Pkg["Millisecond"] = time.Millisecond
+    Pkg["Minute"] = time.Minute
+    Pkg["Monday"] = time.Monday
+    Ctor["Month"] = GijitShadow_NewNamed_Month
+    Pkg["Nanosecond"] = time.Nanosecond
     Pkg["NewTicker"] = time.NewTicker
     Pkg["NewTimer"] = time.NewTimer
+    Pkg["November"] = time.November
     Pkg["Now"] = time.Now
+    Pkg["October"] = time.October
     Pkg["Parse"] = time.Parse
     Pkg["ParseDuration"] = time.ParseDuration
     Ctor["ParseError"] = GijitShadow_NewStruct_ParseError
@@ -31,22 +58,39 @@ func init() {
     Pkg["RFC822Z"] = time.RFC822Z
     Pkg["RFC850"] = time.RFC850
     Pkg["RubyDate"] = time.RubyDate
+    Pkg["Saturday"] = time.Saturday
+    Pkg["Second"] = time.Second
+    Pkg["September"] = time.September
     Pkg["Since"] = time.Since
     Pkg["Sleep"] = time.Sleep
     Pkg["Stamp"] = time.Stamp
     Pkg["StampMicro"] = time.StampMicro
     Pkg["StampMilli"] = time.StampMilli
     Pkg["StampNano"] = time.StampNano
+    Pkg["Sunday"] = time.Sunday
+    Pkg["Thursday"] = time.Thursday
     Pkg["Tick"] = time.Tick
     Ctor["Ticker"] = GijitShadow_NewStruct_Ticker
     Ctor["Time"] = GijitShadow_NewStruct_Time
+    Pkg["TimeOnly"] = time.TimeOnly
     Ctor["Timer"] = GijitShadow_NewStruct_Timer
+    Pkg["Tuesday"] = time.Tuesday
     Pkg["UTC"] = time.UTC
     Pkg["Unix"] = time.Unix
     Pkg["UnixDate"] = time.UnixDate
+    Pkg["UnixMicro"] = time.UnixMicro
+    Pkg["UnixMilli"] = time.UnixMilli
     Pkg["Until"] = time.Until
+    Pkg["Wednesday"] = time.Wednesday
+    Ctor["Weekday"] = GijitShadow_NewNamed_Weekday
 
+    shadow.Register("time", "time", Pkg, Ctor, InitLua)
+}
+func GijitShadow_NewNamed_Duration(src time.Duration) time.Duration {
+    return src
 }
+
+
 func GijitShadow_NewStruct_Location(src *time.Location) *time.Location {
     if src == nil {
 	   return &time.Location{}
@@ -56,6 +100,11 @@ func GijitShadow_NewStruct_Location(src *time.Location) *time.Location {
 }
 
 
+func GijitShadow_NewNamed_Month(src time.Month) time.Month {
+    return src
+}
+
+
 func GijitShadow_NewStruct_ParseError(src *time.ParseError) *time.ParseError {
     if src == nil {
 	   return &time.ParseError{}
@@ -92,6 +141,11 @@ func GijitShadow_NewStruct_Timer(src *time.Timer) *time.Timer {
 }
 
 
+func GijitShadow_NewNamed_Weekday(src time.Weekday) time.Weekday {
+    return src
+}
+
+
 
  func InitLua() string {
   return `
@@ -182,4 +236,55 @@ __type__.time.Timer = {
 setmetatable(__type__.time.Timer, __type__.time.Timer);
 
 
+-----------------
+-- named type Duration
+-----------------
+
+__type__.time.Duration = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "Duration",
+ __str = "Duration",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__time.Duration(src)
+ end,
+};
+setmetatable(__type__.time.Duration, __type__.time.Duration);
+
+
+-----------------
+-- named type Month
+-----------------
+
+__type__.time.Month = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "Month",
+ __str = "Month",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__time.Month(src)
+ end,
+};
+setmetatable(__type__.time.Month, __type__.time.Month);
+
+
+-----------------
+-- named type Weekday
+-----------------
+
+__type__.time.Weekday = {
+ id=0,
+ __name = "native_Go_named_type_wrapper",
+ __native_type = "Weekday",
+ __str = "Weekday",
+ exported = true,
+ __call = function(t, src)
+   return __ctor__time.Weekday(src)
+ end,
+};
+setmetatable(__type__.time.Weekday, __type__.time.Weekday);
+
+
 `}
\ No newline at end of file
@@ -0,0 +1,16 @@
+package shadow_time
+
+import (
+	"github.com/gijit/gi/pkg/compiler/shadow"
+)
+
+// time.Sleep, time.After, and the rest must park only
+// the calling goroutine; see timer.lua. This init runs
+// after the generated one in time.genimp.go, since Go
+// inits a package's files in name order, and registers
+// the package again with the timers installed.
+func init() {
+	shadow.Register("time", "time", Pkg, Ctor, func() string {
+		return InitLua() + "\n__installTimers(time);\n"
+	})
+}
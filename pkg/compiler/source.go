@@ -17,7 +17,7 @@ func (ic *IncrState) ImportSourcePackage(path, pkgDir string, depth int) (res *A
 	// from ~/go/src/github.com/gopherjs/gopherjs/build/build.go
 
 	if archive, ok := ic.Session.Archives[path]; ok {
-		fmt.Printf("using cached copy of package '%s'\n", path)
+		ic.warnf("using cached copy of package '%s'\n", path)
 		return archive, nil
 	}
 
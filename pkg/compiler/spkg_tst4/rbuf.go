@@ -1,9 +1,12 @@
+// Package spkg_tst4 is a source package for the import tests.
 package spkg_tst4
 
+// R holds two byte slices.
 type R struct {
 	A [2][]byte
 }
 
+// NewR returns an R holding hello and world.
 func NewR() *R {
 	r := &R{}
 	r.A[0] = []byte("hello")
@@ -11,6 +14,7 @@ func NewR() *R {
 	return r
 }
 
+// Get1 returns the second slice as a string.
 func (r *R) Get1() string {
 	return string(r.A[1])
 }
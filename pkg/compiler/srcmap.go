@@ -0,0 +1,145 @@
+package compiler
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"sync"
+
+	"github.com/gijit/gi/pkg/token"
+)
+
+// Source maps back to Go.
+//
+// The compiler marks the start of each Go statement in
+// the Lua it writes with the statement's position. As a
+// chunk of Lua is written out for the VM, a SourceMapFilter
+// strips those marks into a table of the Go position of
+// each Lua line, and a first line comment names the chunk.
+// Lua repeats that name in its error messages and stack
+// traces, as [string "-- gi chunk 7..."]:12, and
+// goPositions rewrites each such reference to the Go
+// file:line, or for Go typed at the prompt, to gi>:#N,
+// where N is the line's number in the :h history.
+
+const chunkTag = "-- gi chunk "
+
+var chunkRef = regexp.MustCompile(`\[string "-- gi chunk (\d+)\.\.\."\]:(\d+)`)
+
+// maxChunkMaps bounds the line tables kept. Past it, the
+// older half is forgotten, and references into those
+// chunks are left as Lua wrote them.
+const maxChunkMaps = 1024
+
+// srcMaps holds the line tables of the chunks an
+// IncrState has written most recently.
+type srcMaps struct {
+	mut     sync.Mutex
+	chunks  []*chunkMap // chunk n is chunks[n-1-dropped]
+	dropped int
+}
+
+// chunkMap maps the lines of one chunk back to Go.
+type chunkMap struct {
+	// the Go position at the start of Lua line i+1;
+	// invalid for a line where no statement starts.
+	lines []token.Position
+
+	// the history number of the first line of the
+	// input, for a chunk typed at the prompt.
+	histLine int
+}
+
+// chunk returns lua, still carrying its position marks
+// from fset, as a chunk of its own, named and mapped.
+func (m *srcMaps) chunk(lua []byte, fset *token.FileSet, histLine int) []byte {
+	buf, w := m.newChunk(fset, histLine)
+	w.Write(lua)
+	return buf.Bytes()
+}
+
+// newChunk starts a chunk, returning the buffer it is
+// written to and the filter to write it through.
+func (m *srcMaps) newChunk(fset *token.FileSet, histLine int) (*bytes.Buffer, *SourceMapFilter) {
+	m.mut.Lock()
+	if len(m.chunks) == maxChunkMaps {
+		n := copy(m.chunks, m.chunks[maxChunkMaps/2:])
+		for i := n; i < len(m.chunks); i++ {
+			m.chunks[i] = nil
+		}
+		m.chunks = m.chunks[:n]
+		m.dropped += maxChunkMaps - n
+	}
+	cm := &chunkMap{histLine: histLine}
+	m.chunks = append(m.chunks, cm)
+	id := m.dropped + len(m.chunks)
+	m.mut.Unlock()
+
+	buf := &bytes.Buffer{}
+	w := &SourceMapFilter{
+		Writer:  buf,
+		fileSet: fset,
+		MappingCallback: func(line, column int, pos token.Position) {
+			if !pos.IsValid() {
+				return
+			}
+			m.mut.Lock()
+			defer m.mut.Unlock()
+			for len(cm.lines) < line {
+				cm.lines = append(cm.lines, token.Position{})
+			}
+			if !cm.lines[line-1].IsValid() {
+				cm.lines[line-1] = pos
+			}
+		},
+	}
+	fmt.Fprintf(w, "%s%d\n", chunkTag, id)
+	return buf, w
+}
+
+// where gives the Go position of Lua line in chunk id,
+// or "" if it has none. A line where no statement starts
+// belongs to the statement before it.
+func (m *srcMaps) where(id, line int) string {
+	m.mut.Lock()
+	defer m.mut.Unlock()
+	i := id - 1 - m.dropped
+	if i < 0 || i >= len(m.chunks) {
+		return ""
+	}
+	cm := m.chunks[i]
+	if line > len(cm.lines) {
+		line = len(cm.lines)
+	}
+	for i := line - 1; i >= 0; i-- {
+		pos := cm.lines[i]
+		if !pos.IsValid() {
+			continue
+		}
+		if pos.Filename != "" {
+			return fmt.Sprintf("%s:%d", pos.Filename, pos.Line)
+		}
+		n := pos.Line
+		if cm.histLine > 0 {
+			n += cm.histLine - 1
+		}
+		return fmt.Sprintf("gi>:#%d", n)
+	}
+	return ""
+}
+
+// goPositions rewrites the references to chunk lines
+// in s, a Lua error message or stack trace, to the Go
+// positions they came from.
+func (m *srcMaps) goPositions(s string) string {
+	return chunkRef.ReplaceAllStringFunc(s, func(ref string) string {
+		sub := chunkRef.FindStringSubmatch(ref)
+		id, _ := strconv.Atoi(sub[1])
+		line, _ := strconv.Atoi(sub[2])
+		if w := m.where(id, line); w != "" {
+			return w
+		}
+		return ref
+	})
+}
@@ -0,0 +1,110 @@
+package compiler
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gijit/gi/pkg/token"
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+// lastEvalErr gives the error of the last eval, with
+// its Lua chunk lines mapped back to Go.
+func lastEvalErr(vm *LuaVm, inc *IncrState) string {
+	t := vm.goro.newTicket(`__lastEvalErr = tostring(__lastEvalErr)`, false)
+	t.varname["__lastEvalErr"] = nil
+	t.gettyp = GetString
+	panicOn(t.Do())
+	return inc.srcMaps.goPositions(t.varname["__lastEvalErr"].(string))
+}
+
+func Test1680RuntimeErrorsShowGoLines(t *testing.T) {
+
+	cv.Convey(`runtime errors should name the Go line they came from: the history line for input at the prompt, and file.go:line for a program`, t, func() {
+
+		vm, err := NewLuaVmWithPrelude(nil)
+		panicOn(err)
+		defer vm.Close()
+		inc := NewIncrState(vm, nil)
+
+		// as if typed at history line 10 and on.
+		inc.histLine = 10
+		translation, err := inc.Tr([]byte("a := []int{1, 2}\n\nb := a[1:5]\n"))
+		panicOn(err)
+		panicOn(LuaRun(vm, string(translation), true))
+		cv.So(lastEvalErr(vm, inc), cv.ShouldStartWith, "gi>:#12: runtime error: slice bounds out of range [:5] with capacity 2")
+
+		dir, err := ioutil.TempDir("", "gi-srcmap")
+		panicOn(err)
+		defer os.RemoveAll(dir)
+		prog := filepath.Join(dir, "main.go")
+		panicOn(ioutil.WriteFile(prog, []byte(`package main
+
+func main() {
+	var m map[string]int
+	m["a"] = 1
+}
+`), 0644))
+		lua, err := inc.CompileProgram([]string{prog})
+		panicOn(err)
+		panicOn(LuaRun(vm, string(lua), true))
+		cv.So(lastEvalErr(vm, inc), cv.ShouldStartWith, prog+":5: ")
+	})
+}
+
+func Test1681ProgramsAreOneChunk(t *testing.T) {
+
+	cv.Convey(`a program's imports should go into its own chunk, not one of their own, and its errors still name its lines`, t, func() {
+
+		vm, err := NewLuaVmWithPrelude(nil)
+		panicOn(err)
+		defer vm.Close()
+		inc := NewIncrState(vm, nil)
+
+		dir, err := ioutil.TempDir("", "gi-srcmap")
+		panicOn(err)
+		defer os.RemoveAll(dir)
+		prog := filepath.Join(dir, "main.go")
+		panicOn(ioutil.WriteFile(prog, []byte(`package main
+
+import "github.com/gijit/gi/pkg/compiler/spkg_tst"
+
+func main() {
+	a := []int{spkg_tst.Fish(1)}
+	_ = a[1:5]
+}
+`), 0644))
+		before := len(inc.srcMaps.chunks)
+		lua, err := inc.CompileProgram([]string{prog})
+		panicOn(err)
+		cv.So(len(inc.srcMaps.chunks), cv.ShouldEqual, before+1)
+		panicOn(LuaRun(vm, string(lua), true))
+		cv.So(lastEvalErr(vm, inc), cv.ShouldStartWith, prog+":7: ")
+	})
+}
+
+func Test1682OldChunkMapsAreDropped(t *testing.T) {
+
+	cv.Convey(`the line tables of old chunks should be let go, so a long session doesn't grow without bound; recent chunks should still map`, t, func() {
+
+		fset := token.NewFileSet()
+		f := fset.AddFile("x.go", -1, 100)
+		f.SetLines([]int{0, 10, 20})
+
+		// a Lua line marked as starting at Go line 3.
+		lua := []byte("\b1234x = 1\n")
+		binary.BigEndian.PutUint32(lua[1:5], uint32(f.Pos(20)))
+
+		var m srcMaps
+		for i := 0; i < maxChunkMaps+1; i++ {
+			m.chunk(lua, fset, 0)
+		}
+		cv.So(len(m.chunks), cv.ShouldBeLessThanOrEqualTo, maxChunkMaps)
+		cv.So(m.where(1, 2), cv.ShouldEqual, "")
+		cv.So(m.where(maxChunkMaps+1, 2), cv.ShouldEqual, "x.go:3")
+		cv.So(m.goPositions(`[string "-- gi chunk 1..."]:2: oops`), cv.ShouldEqual, `[string "-- gi chunk 1..."]:2: oops`)
+	})
+}
@@ -578,7 +578,8 @@ __defer_func(%s)
 				channels = append(channels, "{}")
 				hasDefault = true
 			case *ast.ExprStmt:
-				channels = append(channels, c.formatExpr("{%e}", astutil.RemoveParens(comm.X).(*ast.UnaryExpr).X).String())
+				// receive, discarding the value
+				channels = append(channels, c.formatExpr("{c=%e, op=__task.RECV}", astutil.RemoveParens(comm.X).(*ast.UnaryExpr).X).String())
 			case *ast.AssignStmt:
 				// receive
 				channels = append(channels, c.formatExpr("{c=%e, op=__task.RECV}", astutil.RemoveParens(comm.Rhs[0]).(*ast.UnaryExpr).X).String())
@@ -764,18 +765,20 @@ func (c *funcContext) translateLoopingStmt(cond func() string, body *ast.BlockSt
 			gotoLabel = c.gensym("label_")
 		}
 	}
+	// let Ctrl-C stop a runaway loop; see interrupt.lua. The
+	// countdown is a local so the hot path stays in the trace;
+	// the do-block keeps gotos from jumping into its scope.
+	c.Printf("do local __lc = %d;", loopCheckEvery)
 	c.Printf("while (true) do")
 	//c.PrintCond(!flatten, "while (true) do", fmt.Sprintf("case %d:", data.beginCase))
 	c.Indent(func() {
+		c.Printf("__lc = __lc - 1; if __lc == 0 then __lc = %d; __gijitLoopCheck(); end", loopCheckEvery)
 		condStr := cond()
 		if condStr != "true" {
 			c.Printf("if (not (%s)) then break; end", condStr)
 			//c.PrintCond(!flatten, fmt.Sprintf("if (not (%s)) then break; end", condStr), fmt.Sprintf("if(not (%s)) then __s = %d; continue; end ", condStr, data.endCase))
 		}
 
-		prevEV := c.p.escapingVars
-		c.handleEscapingVars(body)
-
 		if bodyPrefix != nil {
 			bodyPrefix()
 		}
@@ -791,9 +794,8 @@ func (c *funcContext) translateLoopingStmt(cond func() string, body *ast.BlockSt
 			post()
 		}
 
-		c.p.escapingVars = prevEV
 	})
-	c.Printf(" end ")
+	c.Printf(" end end ")
 	//c.PrintCond(!flatten, " end ", fmt.Sprintf("__s = %d; goto %s; case %d:", data.beginCase, data.endCase, gotoLabel))
 }
 
@@ -888,9 +890,6 @@ func (c *funcContext) translateForRangeStmt(s *ast.RangeStmt, body *ast.BlockStm
 		}
 		c.Printf("%s", s)
 	}
-	prevEV := c.p.escapingVars
-	c.handleEscapingVars(body)
-
 	if bodyPrefix != nil {
 		bodyPrefix()
 	}
@@ -906,7 +905,6 @@ func (c *funcContext) translateForRangeStmt(s *ast.RangeStmt, body *ast.BlockStm
 		post()
 	}
 
-	c.p.escapingVars = prevEV
 	if ipairs {
 		c.Printf("\n\t %[1]s=%[1]s+1;\n", privateI)
 	}
@@ -942,9 +940,6 @@ func (c *funcContext) translateBodyHelper(cond func() string, body *ast.BlockStm
 			//c.PrintCond(!flatten, fmt.Sprintf("if (not (%s)) then break; end", condStr), fmt.Sprintf("if(not (%s)) then __s = %d; continue; end ", condStr, data.endCase))
 		}
 
-		prevEV := c.p.escapingVars
-		c.handleEscapingVars(body)
-
 		if bodyPrefix != nil {
 			bodyPrefix()
 		}
@@ -960,7 +955,6 @@ func (c *funcContext) translateBodyHelper(cond func() string, body *ast.BlockStm
 			post()
 		}
 
-		c.p.escapingVars = prevEV
 	})
 	c.PrintCond(!flatten, " end ", fmt.Sprintf("__s = %d; goto ::continue::; elseif __s == %d then  --[[ statements.go:965 --]] ", data.beginCase, data.endCase))
 }
@@ -1051,7 +1045,7 @@ func (c *funcContext) translateAssign(lhs, rhs ast.Expr, define bool) string {
 			// binary shadow struct values need special handling.
 			tn := c.typeName(lhsType, nil)
 			shortPkg, typ := extractBasePackageName(tn)
-			isShad, shortType := isShadowStruct(tn)
+			isShad, shortType := c.p.isShadowStruct(tn)
 			pp("here, writing __copy for type = '%s'; shortPkg=%v, typ=%v, isShad=%v, shortType=%v", tn, shortPkg, typ, isShad, shortType)
 			if isShad {
 				// special handling for shadow binary struct value
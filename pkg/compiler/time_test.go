@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"testing"
 
+	"github.com/gijit/gi/pkg/compiler/shadow"
 	//"github.com/gijit/gi/pkg/verb"
 	cv "github.com/glycerine/goconvey/convey"
 )
@@ -91,7 +92,6 @@ func Test923TimeStruct(t *testing.T) {
 	})
 }
 
-/* work in progress
 func Test922TimeoutsInSelect(t *testing.T) {
 
 	cv.Convey(`channel timeouts in a select statement`, t, func() {
@@ -103,6 +103,7 @@ toCount:= 0
 go func() {
     for {
        select {
+          case <-ch:
           case <-time.After(time.Millisecond*100):
             if toCount < 3 {
                toCount++
@@ -124,10 +125,55 @@ time.Sleep(5 * time.Millisecond*100)
 
 		translation2 := inc.trMust([]byte(code2))
 		LuaRunAndReport(vm, string(translation2))
-		LuaMustInt(vm, "toCount", 3)
+		LuaMustInt64(vm, "toCount", 3)
 
 		cv.So(true, cv.ShouldBeTrue)
 
 	})
 }
-*/
+
+func Test924TimersParkOnlyTheirGoroutine(t *testing.T) {
+
+	cv.Convey(`the Lua timers behind time.Sleep and time.After should park just the calling goroutine, and wake select`, t, func() {
+
+		vm, err := NewLuaVmWithPrelude(nil)
+		panicOn(err)
+		defer vm.Close()
+		inc := NewIncrState(vm, nil)
+
+		// the time shadow's import installs the timers.
+		cv.So(shadow.Lookup("time").InitLua(), cv.ShouldContainSubstring, "__installTimers(time)")
+
+		code := `import "time"; tc := time.After(10 * time.Millisecond)`
+		LuaRunAndReport(vm, string(inc.trMust([]byte(code))))
+
+		code = `<-time.After(time.Millisecond); after := 1`
+		LuaRunAndReport(vm, string(inc.trMust([]byte(code))))
+		LuaMustInt64(vm, "after", 1)
+
+		// the goroutine sleeps; the prompt's code
+		// keeps going until it must wait on done.
+		code = `
+done := make(chan int)
+order := ""
+go func() { time.Sleep(30 * time.Millisecond); order += "b"; done <- 1 }()
+order += "a"
+<-done
+`
+		LuaRunAndReport(vm, string(inc.trMust([]byte(code))))
+		LuaMustString(vm, "order", "ab")
+
+		code = `
+ch := make(chan int)
+which := 0
+select {
+case <-ch:
+	which = 1
+case <-tc:
+	which = 2
+}
+`
+		LuaRunAndReport(vm, string(inc.trMust([]byte(code))))
+		LuaMustInt64(vm, "which", 2)
+	})
+}
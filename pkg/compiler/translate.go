@@ -9,6 +9,7 @@ import (
 	"github.com/gijit/gi/pkg/token"
 	"github.com/gijit/gi/pkg/types"
 	"github.com/glycerine/zygomys/zygo"
+	"strings"
 	//"github.com/gijit/gi/pkg/verb"
 	"unicode"
 	//luajit "github.com/glycerine/golua/lua"
@@ -45,9 +46,54 @@ type IncrState struct {
 
 	Session *Session
 
+	// set while recompileDependents replays
+	// declarations, so they don't cascade again.
+	recompiling bool
+
+	// the source-imported packages, and
+	// which have changed on disk.
+	srcWatch srcWatch
+
+	// the line tables of the chunks written, and the
+	// :h history number of the next line from the prompt.
+	srcMaps  srcMaps
+	histLine int
+
+	// set while Tr's caller writes the chunk itself,
+	// as compileProgram does; Tr then returns its Lua
+	// with the position marks still in.
+	unchunked bool
+
+	// the files :source put into the next input, so
+	// its type errors can name them; see goLine.
+	sourced []sourcedFile
+
+	// the static types of the values the last Tr
+	// put in __gijit_ans; nil if it put none.
+	ansTypes []types.Type
+
+	// the binary packages imported so far; each
+	// ImportContext of ours shares it.
+	binaryPackage map[string]bool
+
+	// warn, if set, takes the notes and warnings printed
+	// along the way: reloads, the watcher, what :load
+	// skipped, redefinitions. nil means os.Stdout.
+	warn func(s string)
+
 	zlisp *zygo.Zlisp
 }
 
+// warnf prints a note or warning, see IncrState.warn.
+func (tr *IncrState) warnf(format string, args ...interface{}) {
+	s := fmt.Sprintf(format, args...)
+	if tr.warn != nil {
+		tr.warn(s)
+		return
+	}
+	fmt.Print(s)
+}
+
 func NewIncrState(lvm *LuaVm, cfg *GIConfig) *IncrState {
 
 	if lvm == nil {
@@ -62,8 +108,10 @@ func NewIncrState(lvm *LuaVm, cfg *GIConfig) *IncrState {
 		goro:   lvm.goro,
 		pkgMap: make(map[string]*IncrPkg),
 		cfg:    cfg,
+
+		binaryPackage: make(map[string]bool),
 	}
-	ic.Session = NewSession(&Options{}, ic)
+	ic.Session = NewSession(&Options{CacheDir: cfg.CacheDir}, ic)
 
 	pack := &build.Package{
 		Name:       "main",
@@ -74,6 +122,7 @@ func NewIncrState(lvm *LuaVm, cfg *GIConfig) *IncrState {
 	importContext := &ImportContext{
 		Packages: make(map[string]*types.Package),
 		Import:   ic.CompileTimeGiImportFunc,
+		Binary:   ic.binaryPackage,
 	}
 
 	key := "main"
@@ -102,6 +151,13 @@ type IncrPkg struct {
 	//localImportPathCache map[string]*Archive
 
 	ic *IncrState
+
+	// what :save writes out, in entry order.
+	declLog []declLogEntry
+
+	// the funcs, methods and types entered, in
+	// order; see redef.go.
+	topDecls []topDecl
 }
 
 func newIncrPkg(key string,
@@ -129,6 +185,9 @@ func newIncrPkg(key string,
 type UniqPkgPath string
 
 func (tr *IncrState) Close() {
+	if tr.Session != nil && tr.Session.Watcher != nil {
+		tr.Session.Watcher.Close()
+	}
 	tr.goro.halt.RequestStop()
 	<-tr.goro.halt.Done.Chan
 	tr.goro.vm.Close()
@@ -154,6 +213,23 @@ func (tr *IncrState) Tr(src []byte) (by []byte, err error) {
 }
 
 func (tr *IncrState) TrWithPrepend(src []byte, prependOk bool) (by []byte, err error) {
+	orig := src
+
+	// each input is checked against a snapshot, and
+	// leaves no trace in the type checker unless it
+	// translates; a typo mustn't confuse later lines.
+	// This defer runs after the recover below has set err.
+	if arch := tr.CurPkg.Arch; arch != nil {
+		snap := arch.snapshot()
+		defer func() {
+			if err != nil {
+				snap.restore()
+				tr.CurPkg.Arch = arch
+			} else {
+				snap.release()
+			}
+		}()
+	}
 
 	defer func() {
 		r := recover()
@@ -166,9 +242,17 @@ func (tr *IncrState) TrWithPrepend(src []byte, prependOk bool) (by []byte, err e
 	// detect the leading '=' and turn it into
 	// __gijit_ans :=
 	var didPrepend, shouldPrepend bool
+	lead := len(orig) - len(bytes.TrimLeftFunc(orig, unicode.IsSpace))
+	ansStart := -1 // where the code prependAns wrapped starts in orig
 	if prependOk {
 		src, didPrepend = tr.prependAns(src)
 		pp("after prependAns, src = '%s'", src)
+		if didPrepend {
+			ansStart = lead
+			if !tr.cfg.CalculatorMode {
+				ansStart++ // past the '='
+			}
+		}
 	}
 
 	// classic
@@ -201,7 +285,11 @@ func (tr *IncrState) TrWithPrepend(src []byte, prependOk bool) (by []byte, err e
 			file2, err := parser.ParseFile(tr.CurPkg.fileSet, "", src, 0)
 			if err == nil {
 				file = file2
-			} // else we leave file as in, since it parsed without the prepend..
+				ansStart = lead
+			} else {
+				// we leave file as in, since it parsed without the prepend..
+				didPrepend = false
+			}
 			pp("after shouldPrepend, ParseFile gave err = '%v'; src='%s'", err, src)
 		}
 	}
@@ -218,17 +306,30 @@ func (tr *IncrState) TrWithPrepend(src []byte, prependOk bool) (by []byte, err e
 		Name: "", // jea: was "/repl", but that seemed to cause scope issues.
 	}
 
-	hasBadId, whichBad := checkAllowedIdents(file)
-	if hasBadId {
-		msg := fmt.Sprintf("bad identifier: cannot "+
-			"use '%s' as an identifier in gijit, as this may confuse the online type checker.",
-			whichBad)
-		panic(msg)
-		return nil, fmt.Errorf(msg)
+	// note the globals we have now, so :save can
+	// tell which ones this input declares.
+	before := make(map[string]bool)
+	if tr.CurPkg.Arch != nil {
+		for _, nm := range userGlobals(tr.CurPkg.Arch.Pkg.Scope()) {
+			before[nm] = true
+		}
 	}
+
 	depth := 0
-	tr.CurPkg.Arch, err = IncrementallyCompile(tr.CurPkg.Arch, tr.CurPkg.pack.ImportPath, files, tr.CurPkg.fileSet, tr.CurPkg.importContext, tr.minify, depth)
+	arch, err := IncrementallyCompile(tr.CurPkg.Arch, tr.CurPkg.pack.ImportPath, files, tr.CurPkg.fileSet, tr.CurPkg.importContext, tr.minify, depth)
+	if errs, ok := err.(ErrorList); ok {
+		return nil, tr.typeErrors(errs, orig, ansStart)
+	}
 	panicOn(err)
+	tr.CurPkg.Arch = arch
+	redefined, fresh := tr.CurPkg.noteTopDecls(file)
+	if !tr.recompiling {
+		tr.CurPkg.recordDecls(file, before)
+		tr.ansTypes = nil
+		if didPrepend {
+			tr.ansTypes = ansTypes(file, tr.CurPkg.Arch.TypesInfo)
+		}
+	}
 	//pp("archive = '%#v'", tr.CurPkg.Arch)
 	//pp("len(tr.CurPkg.Arch.Declarations)= '%v'", len(tr.CurPkg.Arch.Declarations))
 	//pp("len(tr.CurPkg.Arch.NewCode)= '%v'", len(tr.CurPkg.Arch.NewCodeText))
@@ -236,13 +337,29 @@ func (tr *IncrState) TrWithPrepend(src []byte, prependOk bool) (by []byte, err e
 	pp("got past config.Check")
 
 	var res bytes.Buffer
+	if tr.ansTypes != nil && !tr.recompiling {
+		res.WriteString(ansTypesLua(tr.ansTypes, tr.CurPkg.Arch.Pkg))
+	}
 	for i, d := range tr.CurPkg.Arch.NewCodeText {
 		pp("writing tr.CurPkg.Arch.NewCode[i=%v].Code = '%v'", i, string(tr.CurPkg.Arch.NewCodeText[i]))
 		res.Write(d)
 	}
 	tr.CurPkg.Arch.NewCodeText = nil
 
-	return res.Bytes(), nil
+	if len(redefined) > 0 && !tr.recompiling {
+		lua, warnings := tr.recompileDependents(redefined, fresh)
+		res.Write(lua)
+		for _, w := range append(warnings, tr.CurPkg.obsoleteValues()...) {
+			tr.warnf("%s\n", w)
+		}
+	}
+
+	if tr.recompiling || tr.unchunked {
+		// marks and all: the Tr doing the
+		// recompiling, or our caller, maps them.
+		return res.Bytes(), nil
+	}
+	return tr.srcMaps.chunk(res.Bytes(), tr.CurPkg.fileSet, tr.histLine), nil
 }
 
 var gijitAnsPrefix = []byte("__gijit_ans := []interface{}{")
@@ -271,6 +388,47 @@ func (tr *IncrState) prependAns(src []byte) (ret []byte, didPrepend bool) {
 	return src, false
 }
 
+// ansTypes gives the static types of the values in the
+// __gijit_ans line prependAns wrote into file.
+func ansTypes(file *ast.File, info *types.Info) []types.Type {
+	for _, nd := range file.Nodes {
+		as, ok := nd.(*ast.AssignStmt)
+		if !ok || len(as.Lhs) != 1 || len(as.Rhs) != 1 {
+			continue
+		}
+		if id, ok := as.Lhs[0].(*ast.Ident); !ok || id.Name != "__gijit_ans" {
+			continue
+		}
+		lit, ok := as.Rhs[0].(*ast.CompositeLit)
+		if !ok {
+			return nil
+		}
+		var ts []types.Type
+		for _, e := range lit.Elts {
+			ts = append(ts, info.TypeOf(e))
+		}
+		return ts
+	}
+	return nil
+}
+
+// ansTypesLua gives the Lua that tells __gijit_printQuoted
+// the static types of the values it will show; see
+// pretty.lua. Types of pkg go unqualified.
+func ansTypesLua(ts []types.Type, pkg *types.Package) string {
+	qual := func(p *types.Package) string {
+		if p == pkg {
+			return ""
+		}
+		return p.Name()
+	}
+	var names []string
+	for _, t := range ts {
+		names = append(names, encodeString(types.TypeString(t, qual)))
+	}
+	return "__gijit_ansTypes = {" + strings.Join(names, ", ") + "};\n"
+}
+
 // full package
 
 // FullPackage: translate a full package from go to Lua.
@@ -289,10 +447,32 @@ func (tr *IncrState) FullPackage(src []byte, importPath string, depth int) ([]by
 	file.Name = &ast.Ident{
 		Name: "main",
 	}
+	lua, _, err := tr.FullPackageFiles(fileSet, files, importPath, depth)
+	return lua, err
+}
+
+// FullPackageFiles compiles files as one whole
+// package main, not incrementally, and returns
+// the Lua program that runs its main(), and the
+// type-checked package.
+func (tr *IncrState) FullPackageFiles(fileSet *token.FileSet, files []*ast.File, importPath string, depth int) ([]byte, *types.Package, error) {
+	res, w := tr.srcMaps.newChunk(fileSet, 0)
+	pkg, err := tr.writeFullPackage(w, fileSet, files, importPath, depth)
+	if err != nil {
+		return nil, nil, err
+	}
+	return res.Bytes(), pkg, nil
+}
+
+// writeFullPackage compiles files as FullPackageFiles
+// does, writing the Lua to w.
+func (tr *IncrState) writeFullPackage(w *SourceMapFilter, fileSet *token.FileSet, files []*ast.File, importPath string, depth int) (*types.Package, error) {
 
 	//tr.CurPkg.Arch,
 	arch, err := FullPackageCompile(importPath, files, fileSet, tr.CurPkg.importContext, tr.minify, depth)
-	panicOn(err)
+	if err != nil {
+		return nil, err
+	}
 	arch.ImportPath = "main"
 	//pp("archive = '%#v'", tr.CurPkg.Arch)
 	//pp("len(tr.CurPkg.Arch.Declarations)= '%v'", len(tr.CurPkg.Arch.Declarations))
@@ -300,14 +480,9 @@ func (tr *IncrState) FullPackage(src []byte, importPath string, depth int) ([]by
 
 	pp("got past FullPackageCompile")
 
-	var res bytes.Buffer
-	w := &SourceMapFilter{
-		Writer: &res,
-	}
 	isMain := true
 	err = WriteProgramCode([]*Archive{arch}, w, isMain)
-
-	return res.Bytes(), err
+	return arch.Pkg, err
 }
 
 var semi = []byte{';'}
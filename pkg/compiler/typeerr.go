@@ -0,0 +1,125 @@
+package compiler
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/gijit/gi/pkg/types"
+)
+
+// TypeErrors are the type errors in one input at the
+// prompt, all of them, not just the first. Error shows
+// each one as gc would, at its Go position, and then the
+// line of source with a caret under the column:
+//
+//	gi>:#3:14: undeclared name: nope
+//		z := 1; q := nope
+//		             ^
+type TypeErrors []TypeError
+
+// TypeError is one of TypeErrors.
+type TypeError struct {
+	// Where is the position: gi>:#line:col, for the
+	// history line at the prompt, or file:line:col
+	// for a line from a :source'd file. Empty if the
+	// checker gave no position.
+	Where string
+	Msg   string
+
+	// Text is the line of source, and Col the column
+	// in it, counting from 1.
+	Text string
+	Col  int
+}
+
+func (errs TypeErrors) Error() string {
+	var buf bytes.Buffer
+	for i, e := range errs {
+		if i > 0 {
+			buf.WriteByte('\n')
+		}
+		buf.WriteString(e.Error())
+	}
+	return buf.String()
+}
+
+func (e TypeError) Error() string {
+	if e.Where == "" {
+		return e.Msg
+	}
+	s := e.Where + ": " + e.Msg
+	if e.Col < 1 || e.Col > len(e.Text)+1 {
+		return s
+	}
+	// one space a rune, keeping the tabs, so the
+	// caret lines up; Col counts bytes.
+	var pad []byte
+	for _, c := range e.Text[:e.Col-1] {
+		if c == '\t' {
+			pad = append(pad, '\t')
+		} else {
+			pad = append(pad, ' ')
+		}
+	}
+	return s + "\n\t" + e.Text + "\n\t" + string(pad) + "^"
+}
+
+// sourcedFile is one of the files that :source put
+// together into a single input, and its length in lines.
+type sourcedFile struct {
+	name  string
+	lines int
+}
+
+// typeErrors makes TypeErrors of the errors the checker
+// gave for src. ansStart is where in src the code begins
+// that prependAns wrapped in __gijit_ans; -1 if it didn't.
+func (tr *IncrState) typeErrors(errs ErrorList, src []byte, ansStart int) TypeErrors {
+	var res TypeErrors
+	for _, err := range errs {
+		te, ok := err.(types.Error)
+		if !ok || te.Fset == nil || !te.Pos.IsValid() {
+			res = append(res, TypeError{Msg: err.Error()})
+			continue
+		}
+		off := te.Fset.Position(te.Pos).Offset
+		if ansStart >= 0 {
+			off += ansStart - len(gijitAnsPrefix)
+			if off < ansStart {
+				off = ansStart
+			}
+		}
+		if off > len(src) {
+			off = len(src)
+		}
+		line := 1 + bytes.Count(src[:off], []byte("\n"))
+		bol := bytes.LastIndexByte(src[:off], '\n') + 1
+		eol := bytes.IndexByte(src[bol:], '\n')
+		if eol < 0 {
+			eol = len(src) - bol
+		}
+		res = append(res, TypeError{
+			Where: fmt.Sprintf("%s:%d", tr.goLine(line), off-bol+1),
+			Msg:   te.Msg,
+			Text:  strings.TrimRight(string(src[bol:bol+eol]), "\r"),
+			Col:   off - bol + 1,
+		})
+	}
+	return res
+}
+
+// goLine names line n of the input being translated: in
+// the file it came from if :source'd, else in the history.
+func (tr *IncrState) goLine(n int) string {
+	for _, f := range tr.sourced {
+		if n <= f.lines {
+			return fmt.Sprintf("%s:%d", f.name, n)
+		}
+		n -= f.lines
+	}
+	if tr.histLine > 0 {
+		n += tr.histLine - 1
+	}
+	return fmt.Sprintf("gi>:#%d", n)
+}
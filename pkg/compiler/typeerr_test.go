@@ -0,0 +1,57 @@
+package compiler
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+func Test1730AllTypeErrorsWithCarets(t *testing.T) {
+
+	cv.Convey(`every type error in an input should be reported, each at its line with the source and a caret under the column; a :source'd file should be named with its own lines`, t, func() {
+
+		in, err := NewInterpreter(nil)
+		panicOn(err)
+		defer in.Close()
+		ctx := context.Background()
+
+		_, err = in.Eval(ctx, `a := 1`)
+		panicOn(err)
+
+		_, err = in.Eval(ctx, "x := 2\ny := \"s\" + x\nvar w int = \"s\"")
+		cv.So(err, cv.ShouldHaveSameTypeAs, TypeErrors{})
+		cv.So(len(err.(TypeErrors)), cv.ShouldEqual, 2)
+		cv.So(err.Error(), cv.ShouldEqual, `gi>:#3:6: cannot convert "s" (untyped string constant) to int
+	y := "s" + x
+	     ^
+gi>:#4:13: cannot convert "s" (untyped string constant) to int
+	var w int = "s"
+	            ^`)
+
+		// the column is in what was typed, not in the
+		// __gijit_ans line made of it; tabs are kept.
+		_, err = in.Eval(ctx, "\tnope + 1")
+		cv.So(err.Error(), cv.ShouldEqual, "gi>:#5:2: undeclared name: nope\n\t\tnope + 1\n\t\t^")
+
+		// and one space for each rune, not each byte.
+		_, err = in.Eval(ctx, `s := "héllo" + nope`)
+		cv.So(err.Error(), cv.ShouldEqual, "gi>:#6:17: undeclared name: nope\n\ts := \"héllo\" + nope\n\t               ^")
+
+		dir, err := ioutil.TempDir("", "gi-typeerr")
+		panicOn(err)
+		defer os.RemoveAll(dir)
+		one := filepath.Join(dir, "one.go")
+		two := filepath.Join(dir, "two.go")
+		panicOn(ioutil.WriteFile(one, []byte("b := 1\nc := 2\n"), 0644))
+		panicOn(ioutil.WriteFile(two, []byte("d := 3\ne := nope\n"), 0644))
+		src, sourced, err := sourceGoFiles([]string{one, two})
+		panicOn(err)
+		in.inc.sourced = sourced
+		_, err = in.Eval(ctx, string(src))
+		cv.So(err.Error(), cv.ShouldEqual, two+":2:6: undeclared name: nope\n\te := nope\n\t     ^")
+	})
+}
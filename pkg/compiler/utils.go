@@ -9,12 +9,10 @@ import (
 	"github.com/gijit/gi/pkg/token"
 	"github.com/gijit/gi/pkg/types"
 	"net/url"
-	"sort"
 	"strconv"
 	"strings"
 	"sync/atomic"
 
-	"github.com/gijit/gi/pkg/compiler/analysis"
 	"github.com/gijit/gi/pkg/compiler/typesutil"
 )
 
@@ -50,8 +48,6 @@ func (c *funcContext) SetPos(pos token.Pos) {
 }
 
 func (c *funcContext) writePos() {
-	// jea debug: turn off writePos() for now
-	return
 	if c.posAvailable {
 		c.posAvailable = false
 		c.Write([]byte{'\b'})
@@ -248,7 +244,7 @@ func (c *funcContext) zeroValue(ty types.Type) ast.Expr {
 	case *types.Struct:
 		// shadow (binary Go) structs just need to be
 		// instantiated--we skip the Lua type system.
-		isShadow, shortTyp := isShadowStruct(ty.String())
+		isShadow, shortTyp := c.p.isShadowStruct(ty.String())
 		if isShadow {
 			//vv("found shadow '%s'; create call to type ctor, e.g. a __type__.time.Time() call.", shortTyp)
 			return &ast.CallExpr{
@@ -443,9 +439,6 @@ func (c *funcContext) objectName(o types.Object) (nam string) {
 		c.p.objectNames[o] = name
 	}
 
-	if v, ok := o.(*types.Var); ok && c.p.escapingVars[v] {
-		return name + "[0]"
-	}
 	return name
 }
 
@@ -554,7 +547,7 @@ func (c *funcContext) typeNameWithAnonInfo(
 
 	pkgName := c.getPkgName()
 	var shortTyp string
-	isShadow, shortTyp = isShadowStruct(ty.String())
+	isShadow, shortTyp = c.p.isShadowStruct(ty.String())
 	_ = shortTyp
 	//vv("ty.String() = '%s' -> %v isShadow: shortTyp='%s'", ty.String(), isShadow, shortTyp)
 
@@ -650,31 +643,6 @@ func (c *funcContext) externalize(s string, t types.Type) string {
 	return fmt.Sprintf("__externalize(%s, %s)", s, c.typeName(t, nil))
 }
 
-func (c *funcContext) handleEscapingVars(n ast.Node) {
-	newEscapingVars := make(map[*types.Var]bool)
-	for escaping := range c.p.escapingVars {
-		newEscapingVars[escaping] = true
-	}
-	c.p.escapingVars = newEscapingVars
-
-	var names []string
-	objs := analysis.EscapingObjects(n, c.p.Info.Info)
-	sort.Slice(objs, func(i, j int) bool {
-		if objs[i].Name() == objs[j].Name() {
-			return objs[i].Pos() < objs[j].Pos()
-		}
-		return objs[i].Name() < objs[j].Name()
-	})
-	for _, obj := range objs {
-		names = append(names, c.objectName(obj))
-		c.p.escapingVars[obj] = true
-	}
-	sort.Strings(names)
-	for _, name := range names {
-		c.Printf("%s = [%s];", name, name)
-	}
-}
-
 func fieldName(t *types.Struct, i int) string {
 	name := t.Field(i).Name()
 	if name == "_" || reservedKeywords[name] {
@@ -1125,9 +1093,9 @@ func extractBasePackageName(pkg string) (shortPkg, typ string) {
 	return
 }
 
-func isShadowStruct(pkgName string) (is bool, typeName string) {
+func (p *pkgContext) isShadowStruct(pkgName string) (is bool, typeName string) {
 	base, typ := extractBasePackageName(pkgName)
-	is = strings.Contains(pkgName, "/pkg/compiler/shadow/") || binaryPackage[base]
+	is = strings.Contains(pkgName, "/pkg/compiler/shadow/") || p.binaryPackage[base]
 	typeName = base + "." + typ
 	return
 }
@@ -643,6 +643,20 @@ func (ctxt *Context) Import(path string, srcDir string, mode ImportMode) (*Packa
 			}
 			tried.goroot = dir
 		}
+
+		// In module mode, the main module, its vendor
+		// directory and its requirements; see modules.go.
+		mods, err := ctxt.modulesFor(srcDir)
+		if err != nil {
+			return p, fmt.Errorf("import %q: %v", path, err)
+		}
+		if dir, found, err := ctxt.importFromModules(path, mods); err != nil {
+			return p, fmt.Errorf("import %q: %v", path, err)
+		} else if found {
+			p.Dir = dir
+			goto Found
+		}
+
 		for _, root := range gopath {
 			dir := ctxt.joinPath(root, "src", path)
 			isDir := ctxt.isDir(dir)
@@ -0,0 +1,282 @@
+package build
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Modules.
+//
+// This go/build was forked before modules, and finds
+// packages in GOROOT, vendor directories and GOPATH only.
+// When the go command would be in module mode, that is
+// when GO111MODULE is not off and a go.mod encloses the
+// working directory or srcDir, Import also looks, after
+// GOROOT and before GOPATH, in
+//
+//	- the main module: the packages of that go.mod itself;
+//	- its vendor directory, if vendor/modules.txt says it
+//	  is vendored and GOFLAGS has no -mod=mod;
+//	- the modules it requires, in the module cache
+//	  (GOMODCACHE, or pkg/mod in the first GOPATH), or
+//	  where its replace directives point instead.
+//
+// Nothing is downloaded: a required module missing from
+// the cache is an error that says to go mod download it.
+// Nor is there minimal version selection; since go 1.17,
+// a go.mod lists every module its packages need.
+
+// modFile is what we use of a go.mod.
+type modFile struct {
+	dir      string            // where the go.mod is
+	path     string            // the module path
+	require  map[string]string // module path -> version
+	replace  []modReplace
+	vendored bool
+}
+
+// modReplace is a replace directive, old => new.
+// If newVersion is "", new is a directory.
+type modReplace struct {
+	old, oldVersion string
+	new, newVersion string
+}
+
+// modulesFor gives the go.mod files that imports from
+// srcDir resolve against: the main module, of the working
+// directory, and then the module of srcDir if different.
+// None when not in module mode.
+func (ctxt *Context) modulesFor(srcDir string) (mods []*modFile, err error) {
+	if os.Getenv("GO111MODULE") == "off" {
+		return nil, nil
+	}
+	var dirs []string
+	if wd, err := os.Getwd(); err == nil {
+		dirs = append(dirs, wd)
+	}
+	if srcDir != "" {
+		dirs = append(dirs, srcDir)
+	}
+	seen := make(map[string]bool)
+	for _, dir := range dirs {
+		root := ctxt.findModuleRoot(dir)
+		if root == "" || seen[root] {
+			continue
+		}
+		seen[root] = true
+		m, err := ctxt.readModFile(root)
+		if err != nil {
+			return nil, err
+		}
+		mods = append(mods, m)
+	}
+	return mods, nil
+}
+
+// findModuleRoot gives the nearest directory at or
+// above dir that holds a go.mod, or "".
+func (ctxt *Context) findModuleRoot(dir string) string {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return ""
+	}
+	for {
+		if ctxt.isFile(ctxt.joinPath(dir, "go.mod")) {
+			return dir
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+func (ctxt *Context) readModFile(dir string) (*modFile, error) {
+	name := ctxt.joinPath(dir, "go.mod")
+	f, err := ctxt.openFile(name)
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadAll(f)
+	f.Close()
+	if err != nil {
+		return nil, err
+	}
+	m, err := parseModFile(name, data)
+	if err != nil {
+		return nil, err
+	}
+	m.dir = dir
+	m.vendored = ctxt.isFile(ctxt.joinPath(dir, "vendor", "modules.txt")) &&
+		!strings.Contains(os.Getenv("GOFLAGS"), "-mod=mod")
+	return m, nil
+}
+
+// parseModFile reads the module, require and replace
+// directives of a go.mod, in either the one line or the
+// block form, and ignores the rest.
+func parseModFile(name string, data []byte) (*modFile, error) {
+	m := &modFile{require: make(map[string]string)}
+	block := "" // the verb of the ( block we are in
+	for i, line := range strings.Split(string(data), "\n") {
+		if j := strings.Index(line, "//"); j >= 0 {
+			line = line[:j]
+		}
+		f := strings.Fields(line)
+		if len(f) == 0 {
+			continue
+		}
+		if block != "" {
+			if f[0] == ")" {
+				block = ""
+				continue
+			}
+			f = append([]string{block}, f...)
+		} else if len(f) == 2 && f[1] == "(" {
+			block = f[0]
+			continue
+		}
+		for k := range f {
+			if strings.HasPrefix(f[k], `"`) || strings.HasPrefix(f[k], "`") {
+				s, err := strconv.Unquote(f[k])
+				if err != nil {
+					return nil, fmt.Errorf("%s:%d: bad quoted string %s", name, i+1, f[k])
+				}
+				f[k] = s
+			}
+		}
+		malformed := false
+		switch f[0] {
+		case "module":
+			malformed = len(f) != 2
+			if !malformed {
+				m.path = f[1]
+			}
+		case "require":
+			malformed = len(f) != 3
+			if !malformed {
+				m.require[f[1]] = f[2]
+			}
+		case "replace":
+			arrow := 0
+			for k := range f {
+				if f[k] == "=>" {
+					arrow = k
+				}
+			}
+			lhs, rhs := f[1:arrow], f[arrow+1:]
+			malformed = arrow == 0 || len(lhs) < 1 || len(lhs) > 2 || len(rhs) < 1 || len(rhs) > 2
+			if !malformed {
+				r := modReplace{old: lhs[0], new: rhs[0]}
+				if len(lhs) == 2 {
+					r.oldVersion = lhs[1]
+				}
+				if len(rhs) == 2 {
+					r.newVersion = rhs[1]
+				}
+				m.replace = append(m.replace, r)
+			}
+		}
+		if malformed {
+			return nil, fmt.Errorf("%s:%d: malformed %s line", name, i+1, f[0])
+		}
+	}
+	if m.path == "" {
+		return nil, fmt.Errorf("%s: no module directive", name)
+	}
+	return m, nil
+}
+
+// importFromModules finds the directory of the package
+// path in mods, trying each module in turn.
+func (ctxt *Context) importFromModules(path string, mods []*modFile) (dir string, found bool, err error) {
+	for _, m := range mods {
+		if path == m.path || strings.HasPrefix(path, m.path+"/") {
+			return ctxt.joinPath(m.dir, strings.TrimPrefix(path, m.path)), true, nil
+		}
+		if m.vendored {
+			// then all its requirements are in vendor/.
+			dir := ctxt.joinPath(m.dir, "vendor", path)
+			if ctxt.isDir(dir) {
+				return dir, true, nil
+			}
+			continue
+		}
+		mod := m.provider(path)
+		if mod == "" {
+			continue
+		}
+		root, err := ctxt.moduleDir(m, mod)
+		if err != nil {
+			return "", false, err
+		}
+		dir := ctxt.joinPath(root, strings.TrimPrefix(path, mod))
+		if !ctxt.isDir(dir) {
+			return "", false, fmt.Errorf("module %s@%s, in %s, has no package %s", mod, m.require[mod], root, path)
+		}
+		return dir, true, nil
+	}
+	return "", false, nil
+}
+
+// provider gives the required module that path is
+// in: the one with the longest module path prefixing it.
+func (m *modFile) provider(path string) (mod string) {
+	for req := range m.require {
+		if (path == req || strings.HasPrefix(path, req+"/")) && len(req) > len(mod) {
+			mod = req
+		}
+	}
+	return
+}
+
+// moduleDir gives the root directory of mod, as
+// required by m: where a replace directive of m points,
+// else its place in the module cache.
+func (ctxt *Context) moduleDir(m *modFile, mod string) (string, error) {
+	ver := m.require[mod]
+	for _, r := range m.replace {
+		if r.old != mod || r.oldVersion != "" && r.oldVersion != ver {
+			continue
+		}
+		if r.newVersion == "" {
+			if filepath.IsAbs(r.new) {
+				return r.new, nil
+			}
+			return ctxt.joinPath(m.dir, r.new), nil
+		}
+		mod, ver = r.new, r.newVersion
+		break
+	}
+	cache := os.Getenv("GOMODCACHE")
+	if cache == "" {
+		if gopath := ctxt.gopath(); len(gopath) > 0 {
+			cache = ctxt.joinPath(gopath[0], "pkg", "mod")
+		}
+	}
+	dir := ctxt.joinPath(cache, escapeModPath(mod)+"@"+escapeModPath(ver))
+	if cache == "" || !ctxt.isDir(dir) {
+		return "", fmt.Errorf("module %s@%s is not in the module cache; run 'go mod download %s@%s'", mod, ver, mod, ver)
+	}
+	return dir, nil
+}
+
+// escapeModPath is how the module cache spells a module
+// path or version: each upper case letter becomes ! and
+// the letter in lower case.
+func escapeModPath(s string) string {
+	var buf []byte
+	for _, r := range s {
+		if 'A' <= r && r <= 'Z' {
+			buf = append(buf, '!', byte(r+'a'-'A'))
+			continue
+		}
+		buf = append(buf, string(r)...)
+	}
+	return string(buf)
+}
@@ -0,0 +1,210 @@
+package kernel
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+)
+
+// Client is a bare-bones Jupyter frontend, enough
+// to drive a Kernel from tests without Jupyter
+// installed: a DEALER on shell and control, a SUB
+// on iopub, and a REQ on the heartbeat.
+type Client struct {
+	// Timeout bounds each wait for the kernel.
+	Timeout time.Duration
+
+	key     []byte
+	session string
+
+	shell, control, iopub, hb *zmtpConn
+}
+
+// Dial connects to the kernel described by conn, and
+// waits until the kernel's iopub messages reach us.
+func Dial(conn *ConnectionInfo) (c *Client, err error) {
+	c = &Client{
+		Timeout: time.Minute,
+		key:     []byte(conn.Key),
+		session: newMsgID(),
+	}
+	cl := c
+	defer func() {
+		if err != nil {
+			cl.Close()
+		}
+	}()
+	dial := func(kind string, port int) (*zmtpConn, error) {
+		nc, err := net.Dial("tcp", net.JoinHostPort(conn.IP, strconv.Itoa(port)))
+		if err != nil {
+			return nil, err
+		}
+		z, err := handshake(nc, kind, false)
+		if err != nil {
+			nc.Close()
+			return nil, err
+		}
+		return z, nil
+	}
+	if c.shell, err = dial("DEALER", conn.ShellPort); err != nil {
+		return
+	}
+	if c.control, err = dial("DEALER", conn.ControlPort); err != nil {
+		return
+	}
+	if c.hb, err = dial("REQ", conn.HBPort); err != nil {
+		return
+	}
+	if c.iopub, err = dial("SUB", conn.IOPubPort); err != nil {
+		return
+	}
+	// subscribe to everything.
+	if err = c.iopub.writeFrames([][]byte{{1}}, false); err != nil {
+		return
+	}
+
+	// The subscription takes effect some time after
+	// we send it; until then, iopub messages are lost.
+	// Like jupyter_client, ask for kernel info until
+	// the status messages for it come through.
+	for try := 0; try < 50; try++ {
+		req, err := c.send(c.shell, "kernel_info_request", map[string]interface{}{})
+		if err != nil {
+			return nil, err
+		}
+		_, err = c.recv(c.shell, c.Timeout)
+		if err != nil {
+			return nil, err
+		}
+		for {
+			m, err := c.recv(c.iopub, 100*time.Millisecond)
+			if err != nil {
+				if ne, ok := err.(net.Error); ok && ne.Timeout() {
+					break
+				}
+				return nil, err
+			}
+			if isIdle(m, req) {
+				return c, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("kernel: iopub subscription never took effect")
+}
+
+func isIdle(m, req *Message) bool {
+	if m.Header.MsgType != "status" || m.Parent.MsgID != req.Header.MsgID {
+		return false
+	}
+	var st struct {
+		State string `json:"execution_state"`
+	}
+	json.Unmarshal(m.Content, &st)
+	return st.State == "idle"
+}
+
+func (c *Client) send(z *zmtpConn, msgType string, content interface{}) (*Message, error) {
+	m, err := NewMessage(msgType, nil, c.session, content)
+	if err != nil {
+		return nil, err
+	}
+	frames, err := m.Frames(c.key)
+	if err != nil {
+		return nil, err
+	}
+	return m, z.writeFrames(frames, false)
+}
+
+func (c *Client) recv(z *zmtpConn, timeout time.Duration) (*Message, error) {
+	z.nc.SetReadDeadline(time.Now().Add(timeout))
+	frames, err := z.readMsg(nil)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMessage(frames, c.key)
+}
+
+// Request sends a request on the shell channel
+// and returns the reply.
+func (c *Client) Request(msgType string, content interface{}) (*Message, error) {
+	return c.request(c.shell, msgType, content)
+}
+
+// ControlRequest sends a request on the control
+// channel and returns the reply.
+func (c *Client) ControlRequest(msgType string, content interface{}) (*Message, error) {
+	return c.request(c.control, msgType, content)
+}
+
+func (c *Client) request(z *zmtpConn, msgType string, content interface{}) (*Message, error) {
+	_, err := c.send(z, msgType, content)
+	if err != nil {
+		return nil, err
+	}
+	return c.recv(z, c.Timeout)
+}
+
+// Execute runs code in the kernel. It returns the
+// execute_reply and, in order, the iopub messages
+// published for the request, less the status ones.
+func (c *Client) Execute(code string) (reply *Message, pub []*Message, err error) {
+	req, err := c.send(c.shell, "execute_request", map[string]interface{}{
+		"code":             code,
+		"silent":           false,
+		"store_history":    true,
+		"user_expressions": map[string]interface{}{},
+		"allow_stdin":      false,
+		"stop_on_error":    true,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	reply, err = c.recv(c.shell, c.Timeout)
+	if err != nil {
+		return nil, nil, err
+	}
+	for {
+		m, err := c.recv(c.iopub, c.Timeout)
+		if err != nil {
+			return nil, nil, err
+		}
+		if m.Parent.MsgID != req.Header.MsgID {
+			continue
+		}
+		if isIdle(m, req) {
+			return reply, pub, nil
+		}
+		if m.Header.MsgType != "status" {
+			pub = append(pub, m)
+		}
+	}
+}
+
+// Heartbeat sends ping to the heartbeat channel
+// and returns what comes back.
+func (c *Client) Heartbeat(ping []byte) ([]byte, error) {
+	err := c.hb.writeFrames([][]byte{{}, ping}, false)
+	if err != nil {
+		return nil, err
+	}
+	c.hb.nc.SetReadDeadline(time.Now().Add(c.Timeout))
+	frames, err := c.hb.readMsg(nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(frames) != 2 || len(frames[0]) != 0 {
+		return nil, fmt.Errorf("kernel: malformed heartbeat reply")
+	}
+	return frames[1], nil
+}
+
+// Close hangs up on the kernel.
+func (c *Client) Close() {
+	for _, z := range []*zmtpConn{c.shell, c.control, c.iopub, c.hb} {
+		if z != nil {
+			z.Close()
+		}
+	}
+}
@@ -0,0 +1,291 @@
+/*
+package kernel lets a Jupyter frontend (the
+notebook, JupyterLab, jupyter console) drive an
+interpreter. It speaks the Jupyter messaging
+protocol, version 5.3, over its own small
+implementation of the ZeroMQ wire protocol,
+so it needs no C libraries.
+
+The interpreter itself is a Handler; see
+pkg/compiler for gijit's.
+*/
+package kernel
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+)
+
+// Handler runs code for a Kernel. The Kernel calls
+// Execute and IsComplete from one goroutine, one
+// request at a time; Interrupt may come at any time.
+type Handler interface {
+	// Execute runs code. Output should be passed
+	// to stream, as "stdout" or "stderr", as it is
+	// produced. If code was an expression, result
+	// is the text of its value.
+	Execute(code string, stream func(name, text string)) (result string, err error)
+
+	// IsComplete reports whether code could run as is:
+	// "complete", "incomplete", "invalid", or "unknown".
+	IsComplete(code string) string
+
+	// Interrupt asks a running Execute to give up.
+	Interrupt()
+}
+
+// LanguageInfo describes the kernel's language
+// in its kernel_info_reply.
+type LanguageInfo struct {
+	Name          string `json:"name"`
+	Version       string `json:"version"`
+	Mimetype      string `json:"mimetype"`
+	FileExtension string `json:"file_extension"`
+}
+
+// KernelInfo is the rest of the kernel_info_reply.
+type KernelInfo struct {
+	Implementation        string
+	ImplementationVersion string
+	Banner                string
+	Language              LanguageInfo
+}
+
+// Kernel serves one Handler on the five
+// sockets named in a connection file.
+type Kernel struct {
+	Conn *ConnectionInfo
+
+	handler Handler
+	info    KernelInfo
+	key     []byte
+	session string
+
+	shell, control, stdin, iopub, hb *Socket
+
+	count int
+
+	quit     chan struct{}
+	quitOnce sync.Once
+}
+
+// NewKernel binds the sockets in conn. A port of 0
+// there is replaced by the port actually bound.
+func NewKernel(conn *ConnectionInfo, h Handler, info KernelInfo) (k *Kernel, err error) {
+	k = &Kernel{
+		Conn:    conn,
+		handler: h,
+		info:    info,
+		key:     []byte(conn.Key),
+		session: newMsgID(),
+		quit:    make(chan struct{}),
+	}
+	defer func() {
+		if err != nil {
+			k.Close()
+		}
+	}()
+	bind := func(kind string, port *int) (*Socket, error) {
+		s, err := Bind(kind, net.JoinHostPort(conn.IP, strconv.Itoa(*port)))
+		if err != nil {
+			return nil, err
+		}
+		*port = s.Port
+		return s, nil
+	}
+	if k.shell, err = bind("ROUTER", &conn.ShellPort); err != nil {
+		return
+	}
+	if k.control, err = bind("ROUTER", &conn.ControlPort); err != nil {
+		return
+	}
+	if k.stdin, err = bind("ROUTER", &conn.StdinPort); err != nil {
+		return
+	}
+	if k.iopub, err = bind("PUB", &conn.IOPubPort); err != nil {
+		return
+	}
+	if k.hb, err = bind("REP", &conn.HBPort); err != nil {
+		return
+	}
+	return k, nil
+}
+
+// Serve handles requests until a shutdown_request
+// arrives or Close is called.
+func (k *Kernel) Serve() error {
+	go k.serveChannel(k.control)
+	k.serveChannel(k.shell)
+	return nil
+}
+
+func (k *Kernel) serveChannel(s *Socket) {
+	for {
+		select {
+		case frames := <-s.in:
+			msg, err := ParseMessage(frames, k.key)
+			if err != nil {
+				// unsigned or garbled; not from our frontend.
+				continue
+			}
+			k.handle(s, msg)
+		case <-k.quit:
+			return
+		case <-s.done:
+			return
+		}
+	}
+}
+
+// Close shuts down all the sockets.
+func (k *Kernel) Close() {
+	k.quitOnce.Do(func() { close(k.quit) })
+	for _, s := range []*Socket{k.shell, k.control, k.stdin, k.iopub, k.hb} {
+		if s != nil {
+			s.Close()
+		}
+	}
+}
+
+func (k *Kernel) send(s *Socket, msgType string, parent *Message, content interface{}) error {
+	m, err := NewMessage(msgType, parent, k.session, content)
+	if err != nil {
+		return err
+	}
+	if s == k.iopub {
+		// subscribers filter on the topic frame.
+		m.Identities = [][]byte{[]byte("kernel." + k.session + "." + msgType)}
+	}
+	frames, err := m.Frames(k.key)
+	if err != nil {
+		return err
+	}
+	return s.Send(frames)
+}
+
+func (k *Kernel) publish(msgType string, parent *Message, content interface{}) error {
+	return k.send(k.iopub, msgType, parent, content)
+}
+
+func (k *Kernel) status(parent *Message, state string) {
+	k.publish("status", parent, map[string]interface{}{"execution_state": state})
+}
+
+func (k *Kernel) handle(s *Socket, msg *Message) {
+	if s == k.control && msg.Header.MsgType == "interrupt_request" {
+		k.handler.Interrupt()
+		k.send(s, "interrupt_reply", msg, map[string]interface{}{"status": "ok"})
+		return
+	}
+
+	k.status(msg, "busy")
+	defer k.status(msg, "idle")
+
+	switch msg.Header.MsgType {
+	case "kernel_info_request":
+		k.send(s, "kernel_info_reply", msg, map[string]interface{}{
+			"status":                 "ok",
+			"protocol_version":       protocolVersion,
+			"implementation":         k.info.Implementation,
+			"implementation_version": k.info.ImplementationVersion,
+			"banner":                 k.info.Banner,
+			"language_info":          k.info.Language,
+		})
+	case "execute_request":
+		k.execute(s, msg)
+	case "is_complete_request":
+		var req struct {
+			Code string `json:"code"`
+		}
+		json.Unmarshal(msg.Content, &req)
+		k.send(s, "is_complete_reply", msg, map[string]interface{}{
+			"status": k.handler.IsComplete(req.Code),
+			"indent": "",
+		})
+	case "comm_info_request":
+		k.send(s, "comm_info_reply", msg, map[string]interface{}{
+			"status": "ok",
+			"comms":  map[string]interface{}{},
+		})
+	case "history_request":
+		k.send(s, "history_reply", msg, map[string]interface{}{
+			"status":  "ok",
+			"history": []interface{}{},
+		})
+	case "shutdown_request":
+		var req struct {
+			Restart bool `json:"restart"`
+		}
+		json.Unmarshal(msg.Content, &req)
+		k.send(s, "shutdown_reply", msg, map[string]interface{}{
+			"status":  "ok",
+			"restart": req.Restart,
+		})
+		k.quitOnce.Do(func() { close(k.quit) })
+	}
+}
+
+func (k *Kernel) execute(s *Socket, msg *Message) {
+	var req struct {
+		Code         string `json:"code"`
+		Silent       bool   `json:"silent"`
+		StoreHistory bool   `json:"store_history"`
+	}
+	err := json.Unmarshal(msg.Content, &req)
+	if err != nil {
+		k.replyError(s, msg, fmt.Errorf("bad execute_request: '%v'", err))
+		return
+	}
+	if !req.Silent && req.StoreHistory {
+		k.count++
+	}
+	if !req.Silent {
+		k.publish("execute_input", msg, map[string]interface{}{
+			"code":            req.Code,
+			"execution_count": k.count,
+		})
+	}
+	stream := func(name, text string) {
+		if req.Silent || text == "" {
+			return
+		}
+		k.publish("stream", msg, map[string]interface{}{
+			"name": name,
+			"text": text,
+		})
+	}
+
+	result, err := k.handler.Execute(req.Code, stream)
+	if err != nil {
+		k.replyError(s, msg, err)
+		return
+	}
+	if result != "" && !req.Silent {
+		k.publish("execute_result", msg, map[string]interface{}{
+			"execution_count": k.count,
+			"data":            map[string]interface{}{"text/plain": result},
+			"metadata":        map[string]interface{}{},
+		})
+	}
+	k.send(s, "execute_reply", msg, map[string]interface{}{
+		"status":           "ok",
+		"execution_count":  k.count,
+		"payload":          []interface{}{},
+		"user_expressions": map[string]interface{}{},
+	})
+}
+
+func (k *Kernel) replyError(s *Socket, msg *Message, err error) {
+	content := map[string]interface{}{
+		"ename":     "Error",
+		"evalue":    err.Error(),
+		"traceback": []string{err.Error()},
+	}
+	k.publish("error", msg, content)
+	content["status"] = "error"
+	content["execution_count"] = k.count
+	k.send(s, "execute_reply", msg, content)
+}
@@ -0,0 +1,130 @@
+package kernel
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	cv "github.com/glycerine/goconvey/convey"
+)
+
+// echoHandler prints its code, and returns it
+// upper-cased as the result; "fail" fails.
+type echoHandler struct {
+	interrupted bool
+}
+
+func (h *echoHandler) Execute(code string, stream func(name, text string)) (string, error) {
+	if code == "fail" {
+		return "", fmt.Errorf("failed on purpose")
+	}
+	stream("stdout", "ran "+code+"\n")
+	return strings.ToUpper(code), nil
+}
+
+func (h *echoHandler) IsComplete(code string) string {
+	if strings.HasSuffix(code, "{") {
+		return "incomplete"
+	}
+	return "complete"
+}
+
+func (h *echoHandler) Interrupt() {
+	h.interrupted = true
+}
+
+func startKernel(h Handler) (*Kernel, *Client) {
+	conn := &ConnectionInfo{
+		Transport:       "tcp",
+		IP:              "127.0.0.1",
+		Key:             "a-secret-key",
+		SignatureScheme: "hmac-sha256",
+	}
+	k, err := NewKernel(conn, h, KernelInfo{
+		Implementation: "echo",
+		Language:       LanguageInfo{Name: "echo"},
+	})
+	panicOn(err)
+	go k.Serve()
+	c, err := Dial(conn)
+	panicOn(err)
+	return k, c
+}
+
+func contentOf(m *Message) map[string]interface{} {
+	var c map[string]interface{}
+	panicOn(json.Unmarshal(m.Content, &c))
+	return c
+}
+
+func Test001KernelSpeaksJupyterToAFakeClient(t *testing.T) {
+
+	cv.Convey(`a fake frontend should get kernel info, execute results with their output, errors, heartbeats, and a clean shutdown`, t, func() {
+		h := &echoHandler{}
+		k, c := startKernel(h)
+		defer k.Close()
+		defer c.Close()
+
+		reply, err := c.Request("kernel_info_request", map[string]interface{}{})
+		panicOn(err)
+		cv.So(reply.Header.MsgType, cv.ShouldEqual, "kernel_info_reply")
+		cv.So(contentOf(reply)["implementation"], cv.ShouldEqual, "echo")
+
+		reply, pub, err := c.Execute("hello")
+		panicOn(err)
+		cv.So(contentOf(reply)["status"], cv.ShouldEqual, "ok")
+		cv.So(contentOf(reply)["execution_count"], cv.ShouldEqual, 1)
+		cv.So(len(pub), cv.ShouldEqual, 3)
+		cv.So(pub[0].Header.MsgType, cv.ShouldEqual, "execute_input")
+		cv.So(pub[1].Header.MsgType, cv.ShouldEqual, "stream")
+		cv.So(contentOf(pub[1])["text"], cv.ShouldEqual, "ran hello\n")
+		cv.So(pub[2].Header.MsgType, cv.ShouldEqual, "execute_result")
+		data := contentOf(pub[2])["data"].(map[string]interface{})
+		cv.So(data["text/plain"], cv.ShouldEqual, "HELLO")
+
+		reply, pub, err = c.Execute("fail")
+		panicOn(err)
+		cv.So(contentOf(reply)["status"], cv.ShouldEqual, "error")
+		cv.So(contentOf(reply)["evalue"], cv.ShouldEqual, "failed on purpose")
+		cv.So(contentOf(reply)["execution_count"], cv.ShouldEqual, 2)
+		cv.So(pub[len(pub)-1].Header.MsgType, cv.ShouldEqual, "error")
+
+		reply, err = c.Request("is_complete_request", map[string]interface{}{"code": "func f() {"})
+		panicOn(err)
+		cv.So(contentOf(reply)["status"], cv.ShouldEqual, "incomplete")
+
+		pong, err := c.Heartbeat([]byte("ping"))
+		panicOn(err)
+		cv.So(string(pong), cv.ShouldEqual, "ping")
+
+		reply, err = c.ControlRequest("interrupt_request", map[string]interface{}{})
+		panicOn(err)
+		cv.So(reply.Header.MsgType, cv.ShouldEqual, "interrupt_reply")
+		cv.So(h.interrupted, cv.ShouldBeTrue)
+
+		reply, err = c.ControlRequest("shutdown_request", map[string]interface{}{"restart": false})
+		panicOn(err)
+		cv.So(reply.Header.MsgType, cv.ShouldEqual, "shutdown_reply")
+	})
+}
+
+func Test002MessagesWithABadSignatureAreIgnored(t *testing.T) {
+
+	cv.Convey(`ParseMessage should refuse a message signed with the wrong key`, t, func() {
+		m, err := NewMessage("kernel_info_request", nil, "s", map[string]interface{}{})
+		panicOn(err)
+		frames, err := m.Frames([]byte("right"))
+		panicOn(err)
+		_, err = ParseMessage(frames, []byte("right"))
+		cv.So(err, cv.ShouldBeNil)
+		_, err = ParseMessage(frames, []byte("wrong"))
+		cv.So(err, cv.ShouldNotBeNil)
+	})
+}
+
+func panicOn(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,218 @@
+package kernel
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"sync"
+	"sync/atomic"
+)
+
+// Socket is the kernel's end of one Jupyter channel:
+// a bound listener plus the peers connected to it.
+// Its Kind decides what it does with messages:
+//
+//	ROUTER (shell, control, stdin): Recv returns each
+//	message with the sender's identity prepended;
+//	Send routes on that first frame.
+//
+//	PUB (iopub): Send goes to every subscribed peer.
+//
+//	REP (heartbeat): echoes whatever arrives.
+type Socket struct {
+	Kind string
+	Port int
+
+	ln net.Listener
+	in chan [][]byte
+
+	mu    sync.Mutex
+	peers map[string]*peer
+
+	done   chan struct{}
+	closed int32
+	nextID uint32
+}
+
+type peer struct {
+	z  *zmtpConn
+	id string
+
+	// PUB only: the prefixes the peer subscribed to.
+	subs map[string]int
+}
+
+// Bind listens on addr, a host:port. Port 0 picks a
+// free port; Port then holds the one in use.
+func Bind(kind, addr string) (*Socket, error) {
+	switch kind {
+	case "ROUTER", "PUB", "REP":
+	default:
+		return nil, fmt.Errorf("kernel: cannot bind a %s socket", kind)
+	}
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	s := &Socket{
+		Kind:  kind,
+		Port:  ln.Addr().(*net.TCPAddr).Port,
+		ln:    ln,
+		in:    make(chan [][]byte, 100),
+		peers: make(map[string]*peer),
+		done:  make(chan struct{}),
+	}
+	go s.accept()
+	return s, nil
+}
+
+func (s *Socket) accept() {
+	for {
+		nc, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		go s.serve(nc)
+	}
+}
+
+func (s *Socket) serve(nc net.Conn) {
+	z, err := handshake(nc, s.Kind, true)
+	if err != nil {
+		nc.Close()
+		return
+	}
+	pr := &peer{z: z, subs: make(map[string]int)}
+	if len(z.identity) > 0 {
+		pr.id = string(z.identity)
+	} else {
+		// like libzmq: a zero byte and a 32-bit counter.
+		n := atomic.AddUint32(&s.nextID, 1)
+		pr.id = string([]byte{0, byte(n >> 24), byte(n >> 16), byte(n >> 8), byte(n)})
+	}
+	s.mu.Lock()
+	s.peers[pr.id] = pr
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.peers, pr.id)
+		s.mu.Unlock()
+		z.Close()
+	}()
+
+	onCommand := func(name string, body []byte) {
+		// ZMTP 3.1 peers subscribe with commands.
+		switch name {
+		case "SUBSCRIBE":
+			s.subscribe(pr, body, true)
+		case "CANCEL":
+			s.subscribe(pr, body, false)
+		}
+	}
+	for {
+		msg, err := z.readMsg(onCommand)
+		if err != nil {
+			return
+		}
+		switch s.Kind {
+		case "ROUTER":
+			msg = append([][]byte{[]byte(pr.id)}, msg...)
+			select {
+			case s.in <- msg:
+			case <-s.done:
+				return
+			}
+		case "PUB":
+			// ZMTP 3.0 peers subscribe with a message
+			// whose first byte is 1 (subscribe) or 0.
+			if len(msg) == 1 && len(msg[0]) > 0 {
+				s.subscribe(pr, msg[0][1:], msg[0][0] == 1)
+			}
+		case "REP":
+			if z.writeFrames(msg, false) != nil {
+				return
+			}
+		}
+	}
+}
+
+func (s *Socket) subscribe(pr *peer, prefix []byte, on bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if on {
+		pr.subs[string(prefix)]++
+		return
+	}
+	if pr.subs[string(prefix)] > 1 {
+		pr.subs[string(prefix)]--
+	} else {
+		delete(pr.subs, string(prefix))
+	}
+}
+
+// Recv returns the next message from a ROUTER socket,
+// its first frame being the identity of the sender.
+func (s *Socket) Recv() ([][]byte, error) {
+	select {
+	case msg := <-s.in:
+		return msg, nil
+	case <-s.done:
+		return nil, fmt.Errorf("kernel: socket closed")
+	}
+}
+
+// Send sends msg. On a ROUTER socket the first frame
+// names the peer and is not sent; a message for a
+// peer that has gone away is dropped, as in ZeroMQ.
+func (s *Socket) Send(msg [][]byte) error {
+	switch s.Kind {
+	case "ROUTER":
+		if len(msg) == 0 {
+			return fmt.Errorf("kernel: no identity frame to route on")
+		}
+		s.mu.Lock()
+		pr := s.peers[string(msg[0])]
+		s.mu.Unlock()
+		if pr == nil {
+			return nil
+		}
+		return pr.z.writeFrames(msg[1:], false)
+	case "PUB":
+		var topic []byte
+		if len(msg) > 0 {
+			topic = msg[0]
+		}
+		s.mu.Lock()
+		var to []*peer
+		for _, pr := range s.peers {
+			for prefix := range pr.subs {
+				if bytes.HasPrefix(topic, []byte(prefix)) {
+					to = append(to, pr)
+					break
+				}
+			}
+		}
+		s.mu.Unlock()
+		for _, pr := range to {
+			// a slow or dead subscriber only loses its own copy.
+			pr.z.writeFrames(msg, false)
+		}
+		return nil
+	}
+	return fmt.Errorf("kernel: cannot send on a %s socket", s.Kind)
+}
+
+// Close stops listening and drops every peer.
+func (s *Socket) Close() error {
+	if !atomic.CompareAndSwapInt32(&s.closed, 0, 1) {
+		return nil
+	}
+	close(s.done)
+	err := s.ln.Close()
+	s.mu.Lock()
+	for _, pr := range s.peers {
+		pr.z.Close()
+	}
+	s.mu.Unlock()
+	return err
+}
@@ -0,0 +1,202 @@
+package kernel
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+)
+
+// ConnectionInfo is the connection file that
+// Jupyter hands a kernel on its command line.
+type ConnectionInfo struct {
+	Transport       string `json:"transport"`
+	IP              string `json:"ip"`
+	ShellPort       int    `json:"shell_port"`
+	ControlPort     int    `json:"control_port"`
+	StdinPort       int    `json:"stdin_port"`
+	IOPubPort       int    `json:"iopub_port"`
+	HBPort          int    `json:"hb_port"`
+	Key             string `json:"key"`
+	SignatureScheme string `json:"signature_scheme"`
+	KernelName      string `json:"kernel_name,omitempty"`
+}
+
+// ReadConnectionFile reads and checks a connection file.
+func ReadConnectionFile(path string) (*ConnectionInfo, error) {
+	by, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	ci := &ConnectionInfo{}
+	err = json.Unmarshal(by, ci)
+	if err != nil {
+		return nil, fmt.Errorf("could not read connection file '%s': '%v'", path, err)
+	}
+	if ci.Transport != "" && ci.Transport != "tcp" {
+		return nil, fmt.Errorf("connection file '%s' asks for transport '%s'; we only do tcp", path, ci.Transport)
+	}
+	if ci.Key != "" && ci.SignatureScheme != "" && ci.SignatureScheme != "hmac-sha256" {
+		return nil, fmt.Errorf("connection file '%s' asks for signature scheme '%s'; we only do hmac-sha256", path, ci.SignatureScheme)
+	}
+	if ci.IP == "" {
+		ci.IP = "127.0.0.1"
+	}
+	return ci, nil
+}
+
+// protocolVersion is the version of the Jupyter
+// messaging protocol that we speak.
+const protocolVersion = "5.3"
+
+// delimiter separates the routing identities
+// from the rest of a Jupyter message.
+var delimiter = []byte("<IDS|MSG>")
+
+// Header is a Jupyter message header.
+type Header struct {
+	MsgID    string `json:"msg_id"`
+	Username string `json:"username"`
+	Session  string `json:"session"`
+	Date     string `json:"date"`
+	MsgType  string `json:"msg_type"`
+	Version  string `json:"version"`
+}
+
+// Message is one Jupyter message.
+type Message struct {
+	// Identities route a reply back over a ROUTER socket.
+	Identities [][]byte
+
+	Header   Header
+	Parent   Header
+	Metadata map[string]interface{}
+	Content  json.RawMessage
+	Buffers  [][]byte
+}
+
+func newMsgID() string {
+	var b [16]byte
+	rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+// NewMessage starts a message of type msgType in
+// reply to parent, which may be nil.
+func NewMessage(msgType string, parent *Message, session string, content interface{}) (*Message, error) {
+	by, err := json.Marshal(content)
+	if err != nil {
+		return nil, err
+	}
+	m := &Message{
+		Header: Header{
+			MsgID:    newMsgID(),
+			Username: "gijit",
+			Session:  session,
+			Date:     time.Now().UTC().Format(time.RFC3339Nano),
+			MsgType:  msgType,
+			Version:  protocolVersion,
+		},
+		Content: by,
+	}
+	if parent != nil {
+		m.Parent = parent.Header
+		m.Identities = parent.Identities
+	}
+	return m, nil
+}
+
+// signer computes message signatures; a nil
+// signer (no key) signs with "".
+type signer []byte
+
+func (k signer) sign(parts [][]byte) string {
+	if len(k) == 0 {
+		return ""
+	}
+	mac := hmac.New(sha256.New, k)
+	for _, p := range parts {
+		mac.Write(p)
+	}
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// marshalHeader writes an unset header as {}, which
+// is what the protocol wants for a missing parent.
+func marshalHeader(h Header) ([]byte, error) {
+	if h.MsgID == "" {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(h)
+}
+
+// Frames encodes m for the wire, signed with key.
+func (m *Message) Frames(key []byte) ([][]byte, error) {
+	header, err := marshalHeader(m.Header)
+	if err != nil {
+		return nil, err
+	}
+	parent, err := marshalHeader(m.Parent)
+	if err != nil {
+		return nil, err
+	}
+	md := m.Metadata
+	if md == nil {
+		md = map[string]interface{}{}
+	}
+	metadata, err := json.Marshal(md)
+	if err != nil {
+		return nil, err
+	}
+	content := []byte(m.Content)
+	if len(content) == 0 {
+		content = []byte("{}")
+	}
+	parts := [][]byte{header, parent, metadata, content}
+	sig := signer(key).sign(parts)
+
+	var frames [][]byte
+	frames = append(frames, m.Identities...)
+	frames = append(frames, delimiter, []byte(sig))
+	frames = append(frames, parts...)
+	frames = append(frames, m.Buffers...)
+	return frames, nil
+}
+
+// ParseMessage decodes frames from the wire,
+// checking their signature against key.
+func ParseMessage(frames [][]byte, key []byte) (*Message, error) {
+	i := 0
+	for i < len(frames) && string(frames[i]) != string(delimiter) {
+		i++
+	}
+	if len(frames)-i < 6 {
+		return nil, fmt.Errorf("kernel: malformed message of %v frames", len(frames))
+	}
+	m := &Message{Identities: frames[:i]}
+	sig := frames[i+1]
+	parts := frames[i+2 : i+6]
+	want := signer(key).sign(parts)
+	if !hmac.Equal([]byte(want), sig) {
+		return nil, fmt.Errorf("kernel: message has a bad signature")
+	}
+	err := json.Unmarshal(parts[0], &m.Header)
+	if err != nil {
+		return nil, fmt.Errorf("kernel: bad message header: '%v'", err)
+	}
+	err = json.Unmarshal(parts[1], &m.Parent)
+	if err != nil {
+		return nil, fmt.Errorf("kernel: bad parent header: '%v'", err)
+	}
+	err = json.Unmarshal(parts[2], &m.Metadata)
+	if err != nil {
+		return nil, fmt.Errorf("kernel: bad message metadata: '%v'", err)
+	}
+	m.Content = json.RawMessage(parts[3])
+	m.Buffers = frames[i+6:]
+	return m, nil
+}
@@ -0,0 +1,224 @@
+package kernel
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+)
+
+// Just enough of ZMTP 3.0 (https://rfc.zeromq.org/spec/23/)
+// to talk to Jupyter: the NULL security mechanism, and
+// the ROUTER, PUB and REP socket types on the kernel
+// side, with DEALER, SUB and REQ for the test client.
+// Jupyter frontends use libzmq, which speaks this
+// on tcp:// endpoints, so we need no cgo library.
+
+const (
+	flagMore    = 0x01
+	flagLong    = 0x02
+	flagCommand = 0x04
+
+	// refuse frames bigger than this, rather
+	// than allocate whatever a peer asks for.
+	maxFrameSize = 1 << 30
+)
+
+// zmtpConn is one ZMTP connection, after the handshake.
+type zmtpConn struct {
+	nc net.Conn
+	r  *bufio.Reader
+
+	wmu sync.Mutex
+
+	// from the peer's READY command.
+	peerType string
+	identity []byte
+}
+
+func greeting(asServer bool) []byte {
+	g := make([]byte, 64)
+	g[0] = 0xff
+	g[9] = 0x7f
+	g[10] = 3 // version 3.0
+	g[11] = 0
+	copy(g[12:32], "NULL")
+	if asServer {
+		g[32] = 1
+	}
+	return g
+}
+
+// handshake exchanges greetings and READY
+// commands with the peer on nc.
+func handshake(nc net.Conn, sockType string, asServer bool) (*zmtpConn, error) {
+	z := &zmtpConn{nc: nc, r: bufio.NewReader(nc)}
+
+	_, err := nc.Write(greeting(asServer))
+	if err != nil {
+		return nil, err
+	}
+	peer := make([]byte, 64)
+	_, err = io.ReadFull(z.r, peer)
+	if err != nil {
+		return nil, err
+	}
+	if peer[0] != 0xff || peer[9] != 0x7f {
+		return nil, fmt.Errorf("zmtp: bad greeting signature from %v", nc.RemoteAddr())
+	}
+	if peer[10] < 3 {
+		return nil, fmt.Errorf("zmtp: peer %v speaks version %v.%v; we need 3.0 or later", nc.RemoteAddr(), peer[10], peer[11])
+	}
+	mech := string(bytes.TrimRight(peer[12:32], "\x00"))
+	if mech != "NULL" {
+		return nil, fmt.Errorf("zmtp: peer %v wants the '%s' mechanism; we only do NULL", nc.RemoteAddr(), mech)
+	}
+
+	err = z.writeFrames([][]byte{readyCommand(sockType)}, true)
+	if err != nil {
+		return nil, err
+	}
+	flags, body, err := z.readFrame()
+	if err != nil {
+		return nil, err
+	}
+	name, props, err := parseCommand(body)
+	if err != nil || flags&flagCommand == 0 || name != "READY" {
+		return nil, fmt.Errorf("zmtp: expected READY from %v", nc.RemoteAddr())
+	}
+	z.peerType = string(props["Socket-Type"])
+	z.identity = props["Identity"]
+	return z, nil
+}
+
+func readyCommand(sockType string) []byte {
+	var b bytes.Buffer
+	b.WriteByte(5)
+	b.WriteString("READY")
+	b.WriteByte(byte(len("Socket-Type")))
+	b.WriteString("Socket-Type")
+	var n [4]byte
+	binary.BigEndian.PutUint32(n[:], uint32(len(sockType)))
+	b.Write(n[:])
+	b.WriteString(sockType)
+	return b.Bytes()
+}
+
+// parseCommand splits a command body into its
+// name and, for READY, its metadata properties.
+func parseCommand(body []byte) (name string, props map[string][]byte, err error) {
+	if len(body) < 1 || len(body) < 1+int(body[0]) {
+		return "", nil, fmt.Errorf("zmtp: short command")
+	}
+	name = string(body[1 : 1+body[0]])
+	rest := body[1+body[0]:]
+	if name != "READY" {
+		return name, nil, nil
+	}
+	props = make(map[string][]byte)
+	for len(rest) > 0 {
+		nlen := int(rest[0])
+		if len(rest) < 1+nlen+4 {
+			return "", nil, fmt.Errorf("zmtp: short READY property")
+		}
+		pname := string(rest[1 : 1+nlen])
+		rest = rest[1+nlen:]
+		vlen := binary.BigEndian.Uint32(rest)
+		rest = rest[4:]
+		if uint32(len(rest)) < vlen {
+			return "", nil, fmt.Errorf("zmtp: short READY property value")
+		}
+		props[pname] = rest[:vlen]
+		rest = rest[vlen:]
+	}
+	return name, props, nil
+}
+
+func (z *zmtpConn) readFrame() (flags byte, body []byte, err error) {
+	flags, err = z.r.ReadByte()
+	if err != nil {
+		return
+	}
+	var size uint64
+	if flags&flagLong != 0 {
+		var n [8]byte
+		_, err = io.ReadFull(z.r, n[:])
+		if err != nil {
+			return
+		}
+		size = binary.BigEndian.Uint64(n[:])
+	} else {
+		var b byte
+		b, err = z.r.ReadByte()
+		if err != nil {
+			return
+		}
+		size = uint64(b)
+	}
+	if size > maxFrameSize {
+		return 0, nil, fmt.Errorf("zmtp: frame of %v bytes is too big", size)
+	}
+	body = make([]byte, size)
+	_, err = io.ReadFull(z.r, body)
+	return
+}
+
+// readMsg returns the next multipart message. Commands
+// that arrive in between are handed to onCommand, which
+// may be nil.
+func (z *zmtpConn) readMsg(onCommand func(name string, body []byte)) ([][]byte, error) {
+	var frames [][]byte
+	for {
+		flags, body, err := z.readFrame()
+		if err != nil {
+			return nil, err
+		}
+		if flags&flagCommand != 0 {
+			name, _, err := parseCommand(body)
+			if err == nil && onCommand != nil {
+				onCommand(name, body[1+len(name):])
+			}
+			continue
+		}
+		frames = append(frames, body)
+		if flags&flagMore == 0 {
+			return frames, nil
+		}
+	}
+}
+
+// writeFrames sends frames as one message, or as
+// one command if isCommand. It is safe to call from
+// several goroutines.
+func (z *zmtpConn) writeFrames(frames [][]byte, isCommand bool) error {
+	var b bytes.Buffer
+	for i, f := range frames {
+		var flags byte
+		if isCommand {
+			flags = flagCommand
+		} else if i < len(frames)-1 {
+			flags = flagMore
+		}
+		if len(f) > 255 {
+			b.WriteByte(flags | flagLong)
+			var n [8]byte
+			binary.BigEndian.PutUint64(n[:], uint64(len(f)))
+			b.Write(n[:])
+		} else {
+			b.WriteByte(flags)
+			b.WriteByte(byte(len(f)))
+		}
+		b.Write(f)
+	}
+	z.wmu.Lock()
+	defer z.wmu.Unlock()
+	_, err := z.nc.Write(b.Bytes())
+	return err
+}
+
+func (z *zmtpConn) Close() error {
+	return z.nc.Close()
+}
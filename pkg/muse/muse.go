@@ -24,6 +24,8 @@ var pp = verb.PP
 
 type Muse struct{}
 
+var emptyInterface = reflect.TypeOf((*interface{})(nil)).Elem()
+
 func NewMuse() *Muse { return &Muse{} }
 
 func (m *Muse) Pun(tt types.Type) (rt reflect.Type, err error) {
@@ -88,7 +90,6 @@ func (m *Muse) Pun(tt types.Type) (rt reflect.Type, err error) {
 			if !isField {
 				panic(fmt.Errorf("huh? why isn't this a field?: '%T'/'%#v'", f, f))
 			}
-			pkg := f.Pkg()   // *types.Package
 			name := f.Name() // string
 			ftyp := f.Type() // types.Type
 			rftyp, err := m.Pun(ftyp)
@@ -98,12 +99,16 @@ func (m *Muse) Pun(tt types.Type) (rt reflect.Type, err error) {
 			// exported := f.Exported() // bool
 			// id := f.Id() //string; Id(obj.pkg, obj.name)
 
+			if !f.Exported() {
+				// reflect.StructOf panics on these.
+				return nil, fmt.Errorf("muse: cannot make a reflect.Type of a struct with unexported field '%s'", name)
+			}
+
 			tag := x.Tag(i) // string
 			fields[i] = reflect.StructField{
-				Name:    name,
-				PkgPath: pkg.Path(),
-				Type:    rftyp,
-				Tag:     reflect.StructTag(tag),
+				Name: name,
+				Type: rftyp,
+				Tag:  reflect.StructTag(tag),
 
 				// jea: no idea what Offset should be set to.
 				Offset: 0, //    uintptr   // offset within struct, in bytes
@@ -177,12 +182,17 @@ func (m *Muse) Pun(tt types.Type) (rt reflect.Type, err error) {
 
 		*/
 
-		panic("not done: *types.Named")
+		// reflect cannot make a named type, so the
+		// closest we can give is the underlying one.
+		return m.Pun(under)
 	case *types.Interface:
+		// likewise, nor an interface with methods; any
+		// value of x fits an interface{}.
+		return emptyInterface, nil
 	default:
 		panic(fmt.Sprintf("unknown types.Type '%T'", tt))
 	}
-	panic(fmt.Errorf("unimplemented muse.Pun handling for type '%T'", tt))
+	return nil, fmt.Errorf("unimplemented muse.Pun handling for type '%T'", tt)
 }
 
 func (m *Muse) punBasic(tt *types.Basic) (rt reflect.Type, err error) {
@@ -234,7 +244,7 @@ func (m *Muse) punBasic(tt *types.Basic) (rt reflect.Type, err error) {
 		types.UntypedComplex,
 		types.UntypedString,
 		types.UntypedNil:
-		panic("can't take TypeOf an unfinished type.")
+		return nil, fmt.Errorf("can't take TypeOf an unfinished type: '%s'", tt)
 	}
 	return reflect.TypeOf(x), nil
 }
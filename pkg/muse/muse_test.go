@@ -15,8 +15,6 @@ import (
 	cv "github.com/glycerine/goconvey/convey"
 )
 
-var pp = verb.PP
-
 func init() {
 	verb.Verbose = true
 	verb.VerboseVerbose = true
@@ -98,7 +96,7 @@ type Tree struct {
 
 				rt, err := m.Pun(checked)
 				cv.So(err, cv.ShouldBeNil)
-				cv.So(rt.String(), cv.ShouldResemble, ``)
+				cv.So(rt.String(), cv.ShouldResemble, `struct { Name string }`)
 
 			}
 		}
@@ -120,3 +118,29 @@ func Test003InterfaceTypeConversion(t *testing.T) {
 
 	})
 }
+
+func Test004NamedTypeConversion(t *testing.T) {
+
+	cv.Convey(`muse.Pun() should give a named type as its underlying type, `+
+		`an interface as interface{}, and an error for a struct with unexported fields`, t, func() {
+
+		m := NewMuse()
+
+		pkg := types.NewPackage("main", "main")
+		celsius := types.NewNamed(types.NewTypeName(0, pkg, "Celsius", nil), types.Typ[types.Float64], nil)
+		rt, err := m.Pun(types.NewSlice(celsius))
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(rt.String(), cv.ShouldEqual, "[]float64")
+
+		rt, err = m.Pun(types.NewInterface(nil, nil).Complete())
+		cv.So(err, cv.ShouldBeNil)
+		cv.So(rt.String(), cv.ShouldEqual, "interface {}")
+
+		priv := types.NewStruct([]*types.Var{
+			types.NewField(0, pkg, "Pub", types.Typ[types.Int], false),
+			types.NewField(0, pkg, "priv", types.Typ[types.Int], false),
+		}, nil)
+		_, err = m.Pun(priv)
+		cv.So(err, cv.ShouldNotBeNil)
+	})
+}
@@ -102,7 +102,7 @@ func typeCheck(typ ast.Expr, fileSet *token.FileSet, file *ast.File) types.Type
 	}
 
 	importPath := ""
-	pkg, check, err := config.Check(nil, nil, importPath, fileSet, files, typesInfo, nil)
+	pkg, check, err := config.Check(nil, nil, importPath, fileSet, files, typesInfo, nil, 0)
 	panicOn(err)
 
 	pp("check: '%#v'", check)
@@ -78,6 +78,7 @@ type Checker struct {
 	*Info
 	ObjMap map[Object]*DeclInfo   // maps package-level object to declaration info
 	impMap map[importKey]*Package // maps (import path, source directory) to (complete or fake) package
+	snap   *Snapshot              // the latest Snapshot neither Restored nor Released
 
 	// information collected during type-checking of a set of package files
 	// (initialized by Files, valid only for the duration of check.Files;
@@ -276,6 +277,196 @@ func (check *Checker) checkFiles(files []*ast.File, depth int) (err error) {
 	return
 }
 
+// A Snapshot holds what checking more files can change
+// in a Checker: the package scope and imports, the object
+// map, the methods of the named types declared so far, and
+// the Info recorded for the files. The REPL takes one before
+// each input and Restores it if the input fails, so that a
+// half-checked input can't confuse the lines that follow.
+//
+// A Snapshot is an undo log: a name, object, import or
+// method list is saved the first time it changes after
+// the Snapshot, so an input that declares nothing costs
+// next to nothing however large the package has grown.
+type Snapshot struct {
+	check     *Checker
+	prev      *Snapshot // still live, and noting changes too
+	files     []*ast.File
+	nchildren int
+	imports   []*Package
+	complete  bool
+
+	// the values before the first change since the
+	// Snapshot; a nil value means there was none.
+	elems    map[string]Object
+	children []*Scope // nil unless a child was deleted
+	objMap   map[Object]*DeclInfo
+	impMap   map[importKey]*Package
+	methods  map[*Named][]*Func
+}
+
+// Snapshot notes the state of check, for Restore. Until it
+// is Restored or Released, the changes to check are logged.
+func (check *Checker) Snapshot() *Snapshot {
+	pkg := check.pkg
+	s := &Snapshot{
+		check:     check,
+		prev:      check.snap,
+		files:     check.files,
+		nchildren: len(pkg.scope.children),
+		imports:   append([]*Package(nil), pkg.imports...),
+		complete:  pkg.complete,
+		elems:     make(map[string]Object),
+		objMap:    make(map[Object]*DeclInfo),
+		impMap:    make(map[importKey]*Package),
+		methods:   make(map[*Named][]*Func),
+	}
+	pkg.scope.check = check
+	check.snap = s
+	return s
+}
+
+// Release ends the Snapshot s, keeping all that the
+// files checked since declared or recorded.
+func (s *Snapshot) Release() {
+	s.check.snap = s.prev
+}
+
+// Restore puts check back as it was at the Snapshot,
+// forgetting all that the files checked since declared
+// or recorded, and ends s.
+func (s *Snapshot) Restore() {
+	check := s.check
+	pkg := check.pkg
+	for name, obj := range s.elems {
+		if obj == nil {
+			delete(pkg.scope.elems, name)
+		} else {
+			pkg.scope.elems[name] = obj
+		}
+	}
+	if s.children != nil {
+		pkg.scope.children = s.children
+	} else {
+		kids := pkg.scope.children
+		for i := s.nchildren; i < len(kids); i++ {
+			kids[i] = nil
+		}
+		pkg.scope.children = kids[:s.nchildren]
+	}
+	pkg.imports = s.imports
+	pkg.complete = s.complete
+	for obj, d := range s.objMap {
+		if d == nil {
+			delete(check.ObjMap, obj)
+		} else {
+			check.ObjMap[obj] = d
+		}
+	}
+	for k, p := range s.impMap {
+		if p == nil {
+			delete(check.impMap, k)
+		} else {
+			check.impMap[k] = p
+		}
+	}
+	for named, methods := range s.methods {
+		named.methods = methods
+	}
+
+	if !sameFiles(check.files, s.files) {
+		for _, file := range check.files {
+			check.forgetInfo(file)
+		}
+	}
+	check.files = s.files
+	check.untyped = nil
+	check.funcs = nil
+	check.delayed = nil
+	check.context = context{}
+	check.snap = s.prev
+}
+
+// noteElem, noteChildren, noteObj, noteImp and noteMethods
+// save, in each live Snapshot that hasn't yet, what is
+// about to change.
+
+func (check *Checker) noteElem(name string) {
+	for s := check.snap; s != nil; s = s.prev {
+		if _, ok := s.elems[name]; !ok {
+			s.elems[name] = check.pkg.scope.elems[name]
+		}
+	}
+}
+
+func (check *Checker) noteChildren() {
+	for s := check.snap; s != nil; s = s.prev {
+		if s.children == nil {
+			s.children = append([]*Scope{}, check.pkg.scope.children[:s.nchildren]...)
+		}
+	}
+}
+
+func (check *Checker) noteObj(obj Object) {
+	for s := check.snap; s != nil; s = s.prev {
+		if _, ok := s.objMap[obj]; !ok {
+			s.objMap[obj] = check.ObjMap[obj]
+		}
+	}
+}
+
+func (check *Checker) noteImp(key importKey) {
+	for s := check.snap; s != nil; s = s.prev {
+		if _, ok := s.impMap[key]; !ok {
+			s.impMap[key] = check.impMap[key]
+		}
+	}
+}
+
+func (check *Checker) noteMethods(named *Named) {
+	for s := check.snap; s != nil; s = s.prev {
+		if _, ok := s.methods[named]; !ok {
+			s.methods[named] = append([]*Func(nil), named.methods...)
+		}
+	}
+}
+
+func sameFiles(a, b []*ast.File) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// forgetInfo deletes what check.Info holds for the
+// nodes of file.
+func (check *Checker) forgetInfo(file *ast.File) {
+	info := check.Info
+	ast.Inspect(file, func(n ast.Node) bool {
+		if n == nil {
+			return false
+		}
+		if x, ok := n.(ast.Expr); ok {
+			delete(info.Types, x)
+		}
+		if id, ok := n.(*ast.Ident); ok {
+			delete(info.Defs, id)
+			delete(info.Uses, id)
+		}
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			delete(info.Selections, sel)
+		}
+		delete(info.Implicits, n)
+		delete(info.Scopes, n)
+		return true
+	})
+}
+
 func (check *Checker) recordUntyped() {
 	if !debug && check.Types == nil {
 		return // nothing to do
@@ -120,6 +120,7 @@ func (check *Checker) declarePkgObj(ident *ast.Ident, obj Object, d *DeclInfo) {
 
 	check.declare(check.pkg.scope, ident, obj, token.NoPos)
 	pp("REDECLARE jea debug. check.ObjMap[obj] being assigned d. obj.Id()='%s', d='%#v'", obj.Id(), d)
+	check.noteObj(obj)
 	check.ObjMap[obj] = d
 	obj.setOrder(uint32(len(check.ObjMap)))
 }
@@ -199,6 +200,7 @@ func (check *Checker) importPackage(pos token.Pos, path, dir string, depth int)
 
 	// package should be complete or marked fake, but be cautious
 	if imp.complete || imp.fake {
+		check.noteImp(key)
 		check.impMap[key] = imp
 		return imp
 	}
@@ -492,6 +494,7 @@ func (check *Checker) collectObjects(depth int) {
 				}
 				info := &DeclInfo{File: fileScope, Fdecl: d}
 				pp("REDECLARE jea debug: check.ObjMap[obj.Id()='%s'] being set to info with Fdecl:d='%#v'", obj.Id(), d)
+				check.noteObj(obj)
 				check.ObjMap[obj] = info
 				obj.setOrder(uint32(len(check.ObjMap)))
 
@@ -31,6 +31,7 @@ type Scope struct {
 	comment    string            // for debugging only
 	isFunc     bool              // set if this is a function scope (internal use only)
 	methodName string            // function name; or method name with struct type-name prefix
+	check      *Checker          // for a package scope, logs its changes for Snapshots
 
 }
 
@@ -59,7 +60,7 @@ func NewScope(parent *Scope, pos, end token.Pos, comment, methodName string) (sc
 		}
 	}
 
-	s := &Scope{parent, nil, nil, pos, end, comment, false, methodName}
+	s := &Scope{parent, nil, nil, pos, end, comment, false, methodName, nil}
 	// don't add children to Universe scope!
 	if parent != nil && parent != Universe {
 		parent.children = append(parent.children, s)
@@ -77,6 +78,9 @@ func (s *Scope) DeleteChild(target *Scope) {
 	nch := len(s.children)
 	for i, ch := range s.children {
 		if target == ch {
+			if s.check != nil {
+				s.check.noteChildren()
+			}
 			if i == nch-1 {
 				s.children = s.children[:i]
 			} else {
@@ -120,6 +124,9 @@ func (s *Scope) Lookup(name string) Object {
 // jea add
 func (s *Scope) DeleteByName(name string) Object {
 	obj := s.elems[name]
+	if s.check != nil {
+		s.check.noteElem(name)
+	}
 	delete(s.elems, name)
 	return obj
 }
@@ -159,6 +166,9 @@ func (s *Scope) Insert(obj Object) Object {
 	if s.elems == nil {
 		s.elems = make(map[string]Object)
 	}
+	if s.check != nil {
+		s.check.noteElem(name)
+	}
 	s.elems[name] = obj
 	if obj.Parent() == nil {
 		obj.setParent(s) // obj.parent = s
@@ -178,6 +188,9 @@ func (s *Scope) Replace(obj Object) Object {
 		s.elems = make(map[string]Object)
 	}
 	alt := s.elems[name]
+	if s.check != nil {
+		s.check.noteElem(name)
+	}
 	s.elems[name] = obj
 	if obj.Parent() == nil {
 		obj.setParent(s)